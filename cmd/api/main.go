@@ -1,15 +1,32 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
 
+	companyService "pessoas-api/internal/domain/company/service"
+	jobService "pessoas-api/internal/domain/job/service"
 	operatorService "pessoas-api/internal/domain/operator/service"
+	"pessoas-api/internal/domain/person/ports"
 	personService "pessoas-api/internal/domain/person/service"
+	"pessoas-api/internal/infrastructure/artifact"
 	"pessoas-api/internal/infrastructure/database"
+	"pessoas-api/internal/infrastructure/encryption"
 	"pessoas-api/internal/infrastructure/http/handler"
+	"pessoas-api/internal/infrastructure/http/middleware"
 	"pessoas-api/internal/infrastructure/http/router"
+	auditPersistence "pessoas-api/internal/infrastructure/persistence/audit"
+	companyPersistence "pessoas-api/internal/infrastructure/persistence/company"
+	jobPersistence "pessoas-api/internal/infrastructure/persistence/job"
 	operatorPersistence "pessoas-api/internal/infrastructure/persistence/operator"
 	personPersistence "pessoas-api/internal/infrastructure/persistence/person"
+	"pessoas-api/internal/infrastructure/personjob"
+	"pessoas-api/internal/infrastructure/postalcode"
 
 	_ "pessoas-api/docs" // Swagger docs
 )
@@ -39,6 +56,39 @@ import (
 // @tag.name         Persons
 // @tag.description  CRUD operations for person management
 
+// @tag.name         Addresses
+// @tag.description  Postal addresses of a person
+
+// @tag.name         Contacts
+// @tag.description  Phone numbers and emails of a person
+
+// @tag.name         Identity Documents
+// @tag.description  RG, CNH, passport, voter ID and PIS/NIS of a person
+
+// @tag.name         Relationships
+// @tag.description  Family members, guardians, legal representatives and emergency contacts of a person
+
+// @tag.name         Duplicates
+// @tag.description  Detection of persons registered twice and merge of their records
+
+// @tag.name         Consents
+// @tag.description  Consents of a person to the processing of their data for marketing, analytics and data sharing (LGPD)
+
+// @tag.name         Data Subjects
+// @tag.description  LGPD requests of data subjects: access report, portability export and anonymization
+
+// @tag.name         Companies
+// @tag.description  CRUD operations for legal entities (CNPJ)
+
+// @tag.name         Documents
+// @tag.description  Lookup of the person or company holding a CPF or CNPJ
+
+// @tag.name         Jobs
+// @tag.description  Background jobs (imports and exports)
+
+// @tag.name         Operators
+// @tag.description  Administration of operators and of the roles that grant their permissions
+
 func main() {
 	config := database.LoadConfig()
 
@@ -47,23 +97,148 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
+	cipher, err := encryption.NewCipherFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to load encryption keys: %v", err)
+	}
+	if cipher != nil {
+		log.Printf("Encryption at rest enabled, current key: %s", cipher.CurrentKeyID())
+	} else {
+		log.Println("WARNING: PERSON_ENCRYPTION_KEYS is not set, sensitive person data is stored in plaintext")
+	}
+
+	tokenKeys, err := middleware.LoadTokenKeys()
+	if err != nil {
+		log.Fatalf("Failed to load token signing keys: %v", err)
+	}
+	if tokenKeys.KeyID() != "" {
+		log.Printf("Signing access tokens with %s, key %s", tokenKeys.Algorithm(), tokenKeys.KeyID())
+	} else {
+		log.Printf("Signing access tokens with %s, set JWT_SIGNING_KEY_FILE to use an asymmetric key", tokenKeys.Algorithm())
+	}
+
 	// Initialize repositories
-	personRepo := personPersistence.NewPersonRepository(db)
+	personRepo := personPersistence.NewPersonRepository(db, cipher)
+	addressRepo := personPersistence.NewAddressRepository(db)
+	contactRepo := personPersistence.NewContactRepository(db, cipher)
+	documentRepo := personPersistence.NewDocumentRepository(db)
+	relationshipRepo := personPersistence.NewRelationshipRepository(db)
+	duplicateRepo := personPersistence.NewDuplicateRepository(db, cipher)
+	subjectRightsRepo := personPersistence.NewSubjectRightsRepository(db, cipher)
+	consentRepo := personPersistence.NewConsentRepository(db)
+	companyRepo := companyPersistence.NewCompanyRepository(db)
 	operatorRepo := operatorPersistence.NewOperatorRepository(db)
+	sessionRepo := operatorPersistence.NewSessionRepository(db)
+	auditRepo := auditPersistence.NewAuditRepository(db, cipher)
+	jobRepo := jobPersistence.NewJobRepository(db)
+
+	artifactStore, err := artifact.NewFilesystemStore(getEnv("JOB_ARTIFACT_DIR", filepath.Join(os.TempDir(), "pessoas-api-artifacts")))
+	if err != nil {
+		log.Fatalf("Failed to initialize artifact store: %v", err)
+	}
 
 	// Initialize services
-	personSvc := personService.NewPersonService(personRepo)
-	authSvc := operatorService.NewAuthService(operatorRepo)
+	transactor := personPersistence.NewTransactor(db, cipher)
+	personSvc := personService.NewPersonService(personRepo, auditRepo, transactor)
+	postalCodes, err := newPostalCodeProvider()
+	if err != nil {
+		log.Fatalf("Failed to set up postal code lookup: %v", err)
+	}
+	addressSvc := personService.NewAddressService(addressRepo, personRepo, transactor, postalCodes)
+	contactSvc := personService.NewContactService(contactRepo, personRepo, transactor)
+	documentSvc := personService.NewDocumentService(documentRepo, personRepo, transactor)
+	relationshipSvc := personService.NewRelationshipService(relationshipRepo, personRepo, transactor)
+	duplicateSvc := personService.NewDuplicateService(duplicateRepo, personRepo, relationshipRepo)
+	subjectRightsSvc := personService.NewSubjectRightsService(subjectRightsRepo, personRepo, addressRepo, documentRepo, relationshipRepo, consentRepo, auditRepo)
+	consentSvc := personService.NewConsentService(consentRepo, personRepo)
+	companySvc := companyService.NewCompanyService(companyRepo, companyPersistence.NewTransactor(db, cipher))
+	refreshTokenTTL, err := time.ParseDuration(getEnv("REFRESH_TOKEN_TTL", operatorService.DefaultRefreshTokenTTL.String()))
+	if err != nil || refreshTokenTTL <= 0 {
+		log.Fatalf("REFRESH_TOKEN_TTL must be a positive duration, such as 168h")
+	}
+	authSvc := operatorService.NewAuthService(operatorRepo, sessionRepo, tokenKeys, refreshTokenTTL)
+	operatorSvc := operatorService.NewOperatorService(operatorRepo, sessionRepo, auditRepo)
+	if username := os.Getenv("ADMIN_USERNAME"); username != "" {
+		admin, err := operatorSvc.BootstrapAdmin(username, os.Getenv("ADMIN_EMAIL"), os.Getenv("ADMIN_PASSWORD"))
+		if err != nil {
+			log.Printf("WARNING: the first admin %s was not created: %v", username, err)
+		} else if admin != nil {
+			log.Printf("Created the first admin operator %s, ID %d", admin.Username, admin.ID)
+		}
+	}
+	jobSvc := jobService.NewJobService(jobRepo, artifactStore)
+
+	// Start background job workers
+	workers, err := strconv.Atoi(getEnv("JOB_WORKERS", "2"))
+	if err != nil || workers < 0 {
+		log.Fatalf("JOB_WORKERS must be a non-negative integer")
+	}
+	workerPool := jobService.NewWorkerPool(jobRepo, workers,
+		personjob.NewImportRunner(personSvc, artifactStore),
+		personjob.NewExportRunner(personSvc, artifactStore),
+	)
+	workerPool.Start(context.Background())
+
+	// Purge expired refresh tokens and revocations
+	go func() {
+		for range time.Tick(time.Hour) {
+			authSvc.PurgeExpiredTokens()
+		}
+	}()
 
 	// Initialize handlers
-	personHandler := handler.NewPersonHandler(personSvc)
+	personHandler := handler.NewPersonHandler(personSvc, jobSvc)
 	authHandler := handler.NewAuthHandler(authSvc)
+	jobHandler := handler.NewJobHandler(jobSvc)
+	addressHandler := handler.NewAddressHandler(addressSvc)
+	contactHandler := handler.NewContactHandler(contactSvc)
+	identityDocumentHandler := handler.NewIdentityDocumentHandler(documentSvc)
+	relationshipHandler := handler.NewRelationshipHandler(relationshipSvc)
+	duplicateHandler := handler.NewDuplicateHandler(duplicateSvc)
+	subjectRightsHandler := handler.NewSubjectRightsHandler(subjectRightsSvc)
+	consentHandler := handler.NewConsentHandler(consentSvc)
+	companyHandler := handler.NewCompanyHandler(companySvc)
+	documentHandler := handler.NewDocumentHandler(personSvc, companySvc)
+	operatorHandler := handler.NewOperatorHandler(operatorSvc)
 
 	// Setup router
-	r := router.SetupRouter(personHandler, authHandler)
+	r := router.SetupRouter(personHandler, authHandler, jobHandler, addressHandler, contactHandler, companyHandler, documentHandler, identityDocumentHandler, relationshipHandler, duplicateHandler, subjectRightsHandler, consentHandler, operatorHandler, tokenKeys, authSvc)
 
 	log.Println("Starting server on :8080")
 	if err := r.Run(":8080"); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// newPostalCodeProvider picks the CEP lookup source from POSTAL_CODE_PROVIDER:
+// "viacep" (default) queries VIACEP_URL, "offline" reads the CSV at
+// POSTAL_CODE_CSV. Either way the results are cached in memory.
+func newPostalCodeProvider() (ports.PostalCodeProvider, error) {
+	var provider ports.PostalCodeProvider
+
+	switch source := getEnv("POSTAL_CODE_PROVIDER", "viacep"); source {
+	case "viacep":
+		provider = postalcode.NewViaCEPProvider(getEnv("VIACEP_URL", postalcode.DefaultViaCEPURL), 5*time.Second)
+	case "offline":
+		path := os.Getenv("POSTAL_CODE_CSV")
+		if path == "" {
+			return nil, fmt.Errorf("POSTAL_CODE_CSV is required when POSTAL_CODE_PROVIDER is offline")
+		}
+		offline, err := postalcode.LoadOfflineProvider(path)
+		if err != nil {
+			return nil, err
+		}
+		provider = offline
+	default:
+		return nil, fmt.Errorf("unknown POSTAL_CODE_PROVIDER %q", source)
+	}
+
+	return postalcode.NewCachingProvider(provider), nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,57 @@
+// Command reencrypt brings the sensitive columns of people.person, the values
+// of people.person_contact and the snapshots of people.audit_log to the
+// current encryption key. Run it after making a new key current in
+// PERSON_ENCRYPTION_KEYS, and after enabling encryption on a database that
+// holds plaintext rows. It reads the same environment as the API.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"pessoas-api/internal/infrastructure/database"
+	"pessoas-api/internal/infrastructure/encryption"
+	personPersistence "pessoas-api/internal/infrastructure/persistence/person"
+)
+
+func main() {
+	batchSize := flag.Int("batch-size", 500, "Rows read per query")
+	flag.Parse()
+
+	if *batchSize < 1 {
+		log.Fatalf("batch-size must be positive")
+	}
+
+	// LoadConfig also loads the .env file, which may hold the keys.
+	config := database.LoadConfig()
+
+	cipher, err := encryption.NewCipherFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to load encryption keys: %v", err)
+	}
+	if cipher == nil {
+		log.Fatalf("PERSON_ENCRYPTION_KEYS is not set, there is no key to encrypt with")
+	}
+
+	db, err := database.NewPostgresConnection(config)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Re-encrypting persons, contacts and audit entries with key %s", cipher.CurrentKeyID())
+
+	result, err := personPersistence.NewReencryptor(db, cipher).Run(ctx, *batchSize)
+	log.Printf("Encrypted: %d, rewrapped: %d, skipped (changed concurrently): %d", result.Encrypted, result.Rewrapped, result.Skipped)
+	if err != nil {
+		log.Fatalf("Re-encryption stopped: %v", err)
+	}
+
+	log.Println("Every row is on the current key")
+}
@@ -23,19 +23,16 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/persons": {
+        "/companies": {
             "get": {
-                "description": "Returns a paginated list of persons with sorting options",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns a paginated list of companies",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Persons"
+                    "Companies"
                 ],
-                "summary": "List persons with pagination",
+                "summary": "List companies",
                 "parameters": [
                     {
                         "minimum": 1,
@@ -57,15 +54,15 @@ const docTemplate = `{
                     {
                         "enum": [
                             "id",
-                            "name",
-                            "cpf",
-                            "email",
-                            "created_at",
-                            "updated_at"
+                            "legal_name",
+                            "trade_name",
+                            "cnpj",
+                            "founding_date",
+                            "created_at"
                         ],
                         "type": "string",
                         "default": "id",
-                        "description": "Field to sort by",
+                        "description": "Sort field",
                         "name": "sort",
                         "in": "query"
                     },
@@ -76,7 +73,7 @@ const docTemplate = `{
                         ],
                         "type": "string",
                         "default": "desc",
-                        "description": "Sort direction",
+                        "description": "Sort order",
                         "name": "order",
                         "in": "query"
                     }
@@ -95,7 +92,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/contract.PersonResponseDTO"
+                                                "$ref": "#/definitions/contract.CompanyResponseDTO"
                                             }
                                         }
                                     }
@@ -103,6 +100,12 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -112,7 +115,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new person in the system with the provided data",
+                "description": "Creates a legal entity. The CNPJ may be numeric or alphanumeric, with or without mask",
                 "consumes": [
                     "application/json"
                 ],
@@ -120,17 +123,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Persons"
+                    "Companies"
                 ],
-                "summary": "Create a new person",
+                "summary": "Create a new company",
                 "parameters": [
                     {
-                        "description": "Person data to be created",
-                        "name": "person",
+                        "description": "Company data",
+                        "name": "company",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/contract.NewPersonDTO"
+                            "$ref": "#/definitions/contract.CompanyDTO"
                         }
                     }
                 ],
@@ -138,7 +141,17 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/contract.SuccessResponse"
+                            "$ref": "#/definitions/contract.CompanyResponseDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the company"
+                            },
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the created company"
+                            }
                         }
                     },
                     "400": {
@@ -147,18 +160,135 @@ const docTemplate = `{
                             "$ref": "#/definitions/contract.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "CNPJ already registered",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
                     "422": {
                         "description": "Business validation error",
                         "schema": {
                             "$ref": "#/definitions/contract.ErrorResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/persons/cpf/{cpf}": {
+        "/companies/cnpj/{cnpj}": {
+            "get": {
+                "description": "Returns company data based on the provided CNPJ, numeric or alphanumeric. Dots and dashes of the mask are accepted, but not the /",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Find company by CNPJ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "12ABC34501DE35",
+                        "description": "Company's CNPJ",
+                        "name": "cnpj",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.CompanyResponseDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the company"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid CNPJ",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}": {
             "get": {
-                "description": "Returns person data based on the provided Brazilian CPF",
+                "description": "Returns company data based on the provided company ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Find company by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.CompanyResponseDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the company"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces every field of a company",
                 "consumes": [
                     "application/json"
                 ],
@@ -166,34 +296,137 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Persons"
+                    "Companies"
                 ],
-                "summary": "Find person by CPF",
+                "summary": "Replace a company",
                 "parameters": [
                     {
-                        "type": "string",
-                        "example": "111.444.777-35",
-                        "description": "Person's CPF (with or without formatting)",
-                        "name": "cpf",
+                        "type": "integer",
+                        "description": "Company ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the company version being updated",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Company data",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.CompanyDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                            "$ref": "#/definitions/contract.CompanyResponseDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the company"
+                            }
                         }
                     },
                     "400": {
-                        "description": "CPF not provided",
+                        "description": "Invalid input data",
                         "schema": {
                             "$ref": "#/definitions/contract.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Person not found",
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "CNPJ already registered",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Company was modified by another request",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft deletes a company. Its CNPJ can then be registered again",
+                "tags": [
+                    "Companies"
+                ],
+                "summary": "Delete a company",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the company version being deleted",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Company not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Company was modified by another request",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required",
                         "schema": {
                             "$ref": "#/definitions/contract.ErrorResponse"
                         }
@@ -206,28 +439,5007 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "contract.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "description": "Error code",
-                    "type": "string",
-                    "example": "validation_error"
-                },
-                "message": {
-                    "description": "Descriptive error message",
-                    "type": "string",
-                    "example": "Invalid CPF"
+        },
+        "/data-subjects/access": {
+            "post": {
+                "description": "Returns every person registered with the CPF, deleted or not, and the persons merged into them, with their contacts, addresses and identity documents, the relationships involving them and the history of changes to all of these records. The request is logged for the data protection officer, also when no person holds the CPF",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Data Subjects"
+                ],
+                "summary": "Report everything stored about a data subject",
+                "parameters": [
+                    {
+                        "description": "Data subject",
+                        "name": "subject",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.SubjectDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.SubjectReportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No person holds the CPF",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid CPF",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "contract.NewPersonDTO": {
-            "type": "object",
-            "required": [
-                "birth_date",
+        "/data-subjects/anonymize": {
+            "post": {
+                "description": "Irreversibly scrubs the personal data of every person registered with the CPF and of the persons merged into them. The persons keep their IDs, so the records referencing them stay valid, and are soft deleted for good: name and CPF are replaced, the birth date is cut to the year, contacts and identity documents are removed and addresses keep only their type, city, state and country. Relationships are kept, and the history of the persons keeps only the references of each change. The request is logged for the data protection officer, also when no person holds the CPF",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Data Subjects"
+                ],
+                "summary": "Anonymize a data subject",
+                "parameters": [
+                    {
+                        "description": "Data subject",
+                        "name": "subject",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.SubjectDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.SubjectRequestResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No person holds the CPF",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid CPF",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/data-subjects/portability": {
+            "post": {
+                "description": "Returns the same report as POST /data-subjects/access as a JSON file to download, for the data subject to take to another provider. The request is logged for the data protection officer, also when no person holds the CPF",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Data Subjects"
+                ],
+                "summary": "Export the data of a data subject",
+                "parameters": [
+                    {
+                        "description": "Data subject",
+                        "name": "subject",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.SubjectDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.SubjectReportDTO"
+                        },
+                        "headers": {
+                            "Content-Disposition": {
+                                "type": "string",
+                                "description": "attachment; filename=\\\"titular-12.json\\"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No person holds the CPF",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid CPF",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/data-subjects/requests": {
+            "get": {
+                "description": "Returns the log of the LGPD requests answered, newest first, for the data protection officer. CPFs are masked",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Data Subjects"
+                ],
+                "summary": "List data subject requests",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/contract.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/contract.SubjectRequestResponseDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid pagination parameters",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/documents/{document}": {
+            "get": {
+                "description": "Accepts a CPF (11 digits) or a CNPJ (14 characters, numeric or alphanumeric), with or without mask, and returns the person or company it belongs to. The / of the CNPJ mask cannot be sent in the path",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Find the holder of a CPF or CNPJ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "12ABC34501DE35",
+                        "description": "CPF or CNPJ",
+                        "name": "document",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/contract.DocumentLookupDTO"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "company": {
+                                            "$ref": "#/definitions/contract.CompanyResponseDTO"
+                                        },
+                                        "person": {
+                                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the person or company"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Not a valid CPF or CNPJ",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No person or company with this document",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}": {
+            "get": {
+                "description": "Returns the status and progress of a background job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Get a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.JobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancels a queued or running job. A running job stops at the next heartbeat of its worker, within about 20 seconds; whatever it already wrote (e.g. imported rows) is kept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Cancel a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.JobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Job already finished",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}/result": {
+            "get": {
+                "description": "Downloads the file produced by a succeeded job: the exported file, or the report of an import. Requires pii:read, and only the operator who created the job or an admin can download it",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Download a job result",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission or job created by another operator",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Job has no result",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}/retry": {
+            "post": {
+                "description": "Queues a failed or cancelled job again, with a fresh set of attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Jobs"
+                ],
+                "summary": "Retry a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.JobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Job is not failed or cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operators": {
+            "get": {
+                "description": "Returns a paginated list of operators with their roles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operators"
+                ],
+                "summary": "List operators",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/contract.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/contract.OperatorResponseDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operators/{id}": {
+            "get": {
+                "description": "Returns the operator and its roles. The password hash is never returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operators"
+                ],
+                "summary": "Find operator by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Operator ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.OperatorResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Operator not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operators/{id}/activate": {
+            "post": {
+                "description": "Allows a deactivated operator to log in again. The sessions revoked at deactivation stay revoked. The change is audited",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operators"
+                ],
+                "summary": "Activate an operator",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Operator ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.OperatorResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Operator not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operators/{id}/deactivate": {
+            "post": {
+                "description": "Prevents the operator from logging in and revokes all of its sessions: its access and refresh tokens stop working immediately. The change is audited. The last active admin cannot be deactivated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operators"
+                ],
+                "summary": "Deactivate an operator",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Operator ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.OperatorResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Operator not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Last active admin",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operators/{id}/roles": {
+            "put": {
+                "description": "Replaces the roles of the operator. The change is audited and takes effect at the next login or token refresh of the operator. The last active admin cannot lose the admin role",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operators"
+                ],
+                "summary": "Assign roles to an operator",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Operator ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Roles to assign",
+                        "name": "roles",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.AssignRolesDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.OperatorResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Operator not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Last active admin",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown role",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons": {
+            "get": {
+                "description": "Returns a paginated list of persons with sorting and filtering options. Use pagination=cursor for keyset pagination, which stays fast on deep pages and stable under concurrent inserts. Without the pii:read permission the CPF, birth date, emails and phones are masked, and prefix searches and sorting by cpf or email are rejected with 403. When encryption at rest is enabled, sorting by cpf or email, birth date ranges and partial CPFs are rejected with 400",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "List persons with pagination",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "name",
+                            "cpf",
+                            "email",
+                            "created_at",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "default": "id",
+                        "description": "Field to sort by",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort direction",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name contains (case and accent insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Email (case insensitive)",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "How email is matched, prefix requires pii:read",
+                        "name": "email_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Phone number",
+                        "name": "phone",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "How phone is matched, prefix requires pii:read",
+                        "name": "phone_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CPF starts with (requires pii:read)",
+                        "name": "cpf_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Born on or after (YYYY-MM-DD)",
+                        "name": "birth_date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Born on or before (YYYY-MM-DD)",
+                        "name": "birth_date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (YYYY-MM-DD or RFC 3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (YYYY-MM-DD or RFC 3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Updated at or after (YYYY-MM-DD or RFC 3339)",
+                        "name": "updated_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Updated at or before (YYYY-MM-DD or RFC 3339)",
+                        "name": "updated_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "marketing",
+                            "analytics",
+                            "data_sharing"
+                        ],
+                        "type": "string",
+                        "description": "Has an active consent for the purpose",
+                        "name": "consent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include soft deleted persons (requires registry:deleted)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "default": "offset",
+                        "description": "Pagination mode",
+                        "name": "pagination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor or prev_cursor (implies pagination=cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Count total items in cursor mode",
+                        "name": "with_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Offset mode; cursor mode returns contract.CursorPaginatedResponse",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/contract.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/contract.PersonResponseDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new person in the system with the provided data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Create a new person",
+                "parameters": [
+                    {
+                        "description": "Person data to be created",
+                        "name": "person",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.NewPersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/contract.SuccessResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the created person"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/contact/{value}": {
+            "get": {
+                "description": "Returns every person that has the given phone number or email among its contacts, primary or not. Values containing @ are looked up as emails, case-insensitively; other values as phone numbers, in any format accepted on creation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Find persons by contact",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "joao.silva@email.com",
+                        "description": "Phone number or email",
+                        "name": "value",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.PersonResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid contact value",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/cpf/{cpf}": {
+            "get": {
+                "description": "Returns person data based on the provided Brazilian CPF. Without the pii:read permission the CPF, birth date, emails and phones are masked",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Find person by CPF",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "111.444.777-35",
+                        "description": "Person's CPF (with or without formatting)",
+                        "name": "cpf",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the person"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "CPF not provided",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/document/{number}": {
+            "get": {
+                "description": "Returns the persons holding a document with the given number. Without a type, every identity document type and the CPF are searched",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Identity Documents"
+                ],
+                "summary": "Find persons by document number",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "12.345.678-X",
+                        "description": "Document number, with or without mask",
+                        "name": "number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "cpf",
+                            "rg",
+                            "cnh",
+                            "passport",
+                            "voter_id",
+                            "pis"
+                        ],
+                        "type": "string",
+                        "description": "Document type",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.PersonResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid document type or number",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/documents/expiring": {
+            "get": {
+                "description": "Returns the documents of active persons whose expiry date falls within the next days, soonest first. Documents without an expiry date are never listed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Identity Documents"
+                ],
+                "summary": "List identity documents expiring soon",
+                "parameters": [
+                    {
+                        "maximum": 365,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Days ahead to look",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also list documents already expired",
+                        "name": "include_expired",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/contract.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/contract.DocumentResponseDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameter",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/export": {
+            "get": {
+                "description": "Streams every person matching the list filters as a CSV, NDJSON or XLSX file. The rows are read from the database as they are written, so exports of any size run in constant memory. The file holds personal data in full and requires the pii:read permission. When encryption at rest is enabled, sorting by cpf or email, birth date ranges and partial CPFs are rejected with 400",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Export persons",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "default": "csv",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated columns (id, name, cpf, birth_date, phone, email, version, created_at, updated_at, deleted_at); all by default",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Format CPF as 000.000.000-00 and phone as (00) 00000-0000",
+                        "name": "mask",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "name",
+                            "cpf",
+                            "email",
+                            "created_at",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "default": "id",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name contains (case and accent insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Email (case insensitive)",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "How email is matched",
+                        "name": "email_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Phone number",
+                        "name": "phone",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "How phone is matched",
+                        "name": "phone_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CPF starts with",
+                        "name": "cpf_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Born on or after (YYYY-MM-DD)",
+                        "name": "birth_date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Born on or before (YYYY-MM-DD)",
+                        "name": "birth_date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (YYYY-MM-DD or RFC 3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (YYYY-MM-DD or RFC 3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Updated at or after (YYYY-MM-DD or RFC 3339)",
+                        "name": "updated_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Updated at or before (YYYY-MM-DD or RFC 3339)",
+                        "name": "updated_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "marketing",
+                            "analytics",
+                            "data_sharing"
+                        ],
+                        "type": "string",
+                        "description": "Has an active consent for the purpose",
+                        "name": "consent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include soft deleted persons (requires registry:deleted)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Queues an export job with the same parameters as GET /persons/export. Follow the job at the returned Location and download the file from its result_url once it succeeds",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Export persons in the background",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "default": "csv",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated columns (id, name, cpf, birth_date, phone, email, version, created_at, updated_at, deleted_at); all by default",
+                        "name": "columns",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Format CPF as 000.000.000-00 and phone as (00) 00000-0000",
+                        "name": "mask",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "name",
+                            "cpf",
+                            "email",
+                            "created_at",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "default": "id",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name contains (case and accent insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Email (case insensitive)",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "How email is matched",
+                        "name": "email_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Phone number",
+                        "name": "phone",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "prefix"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "How phone is matched",
+                        "name": "phone_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CPF starts with",
+                        "name": "cpf_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Born on or after (YYYY-MM-DD)",
+                        "name": "birth_date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Born on or before (YYYY-MM-DD)",
+                        "name": "birth_date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (YYYY-MM-DD or RFC 3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (YYYY-MM-DD or RFC 3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Updated at or after (YYYY-MM-DD or RFC 3339)",
+                        "name": "updated_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Updated at or before (YYYY-MM-DD or RFC 3339)",
+                        "name": "updated_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "marketing",
+                            "analytics",
+                            "data_sharing"
+                        ],
+                        "type": "string",
+                        "description": "Has an active consent for the purpose",
+                        "name": "consent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include soft deleted persons (requires registry:deleted)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/contract.JobDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the job"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/import": {
+            "post": {
+                "description": "Imports persons from a CSV (header: name,cpf,birth_date,phone,email) or NDJSON file sent as the request body. Every row is validated like POST /persons; valid rows are inserted in batched transactions and invalid ones are reported with their line number, error code and message. Use dry_run=true to only validate, and async=true to run the import as a background job",
+                "consumes": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Bulk import persons",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Validate rows without writing",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Run as a background job and return 202 with the job",
+                        "name": "async",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV or NDJSON content",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ImportReportDTO"
+                        }
+                    },
+                    "202": {
+                        "description": "Import job queued",
+                        "schema": {
+                            "$ref": "#/definitions/contract.JobDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the job"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported file format",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/merge": {
+            "post": {
+                "description": "Keeps the survivor and removes the duplicate, which is soft deleted, marked with merged_into_id and can no longer be restored. The survivor takes over the addresses (as non-primary when it already has some), the documents and the relationships of the duplicate, and the duplicate contacts it lacks (as non-primary). Its history includes the history of the persons merged into it. Both versions must match the current ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Duplicates"
+                ],
+                "summary": "Merge a duplicate person into another",
+                "parameters": [
+                    {
+                        "description": "Persons to merge",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.MergeDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the survivor"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "A person was modified since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Survivor and duplicate are the same person",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}": {
+            "get": {
+                "description": "Returns person data based on the provided person ID. Without the pii:read permission the CPF, birth date, emails and phones are masked",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Find person by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Also find soft deleted persons (requires registry:deleted)",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the person"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing person with the provided data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Update a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the person version being updated",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated person data",
+                        "name": "person",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.UpdatePersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.SuccessResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the updated person"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Person was modified by another request",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft deletes a person, keeping the record for auditing. Use purge=true to remove it permanently",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Delete a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the person version being deleted",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Permanently delete the person (requires registry:deleted)",
+                        "name": "purge",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Person was modified by another request",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies a JSON Merge Patch (RFC 7396) or JSON Patch (RFC 6902) document to an existing person. Only the changed fields are persisted.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Partially update a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the person version being updated",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Merge patch document (or an array of contract.JSONPatchOperationDTO with application/json-patch+json)",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.PatchPersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.SuccessResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the updated person"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid patch document",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Person was modified by another request",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported patch format",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/addresses": {
+            "get": {
+                "description": "Returns every address of a person, the primary one first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "List the addresses of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.AddressResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a residential, billing or work address. CEP and UF are validated for Brazilian addresses, and blank street, district, city and state are filled from the CEP. The first address of a person becomes primary; marking a new address as primary demotes the current one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Add an address to a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Address data",
+                        "name": "address",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.AddressDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/contract.AddressResponseDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the created address"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/addresses/{addressId}": {
+            "get": {
+                "description": "Returns one address of a person",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Get an address",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Address ID",
+                        "name": "addressId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.AddressResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or address not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces every field of an address, filling blank street, district, city and state from the CEP. The primary address stays primary until another address is marked as primary",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Replace an address",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Address ID",
+                        "name": "addressId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Address data",
+                        "name": "address",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.AddressDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.AddressResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or address not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an address. When it was the primary address, the oldest remaining address becomes primary",
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Delete an address",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Address ID",
+                        "name": "addressId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or address not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/consents": {
+            "get": {
+                "description": "Returns the current state of every purpose the person was ever asked about, active or revoked",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Consents"
+                ],
+                "summary": "List the consents of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.ConsentResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Records that the person consented to a purpose, with the legal basis, the channel and a reference to the evidence of the grant, which is required when the legal basis is consent. A revoked purpose can be granted again; an active one must be revoked first. The grant is appended to the consent history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Consents"
+                ],
+                "summary": "Grant a consent",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Consent data",
+                        "name": "consent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.ConsentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ConsentResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Consent already granted",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/consents/history": {
+            "get": {
+                "description": "Returns every grant and revocation of consent of the person, newest first, with the channel, the evidence and the operator who recorded it. The history is append-only",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Consents"
+                ],
+                "summary": "Get the consent history of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.ConsentEventResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/consents/{purpose}": {
+            "get": {
+                "description": "Returns the current state of the consent of a person for a purpose",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Consents"
+                ],
+                "summary": "Get the consent of a person for a purpose",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "marketing",
+                            "analytics",
+                            "data_sharing"
+                        ],
+                        "type": "string",
+                        "description": "Purpose",
+                        "name": "purpose",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ConsentResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found or purpose never granted",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown purpose",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/consents/{purpose}/revoke": {
+            "post": {
+                "description": "Records that the person withdrew the consent to a purpose. The consent keeps the data of its grant; the channel and evidence of the revocation are appended to the consent history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Consents"
+                ],
+                "summary": "Revoke a consent",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "marketing",
+                            "analytics",
+                            "data_sharing"
+                        ],
+                        "type": "string",
+                        "description": "Purpose",
+                        "name": "purpose",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Revocation data",
+                        "name": "revocation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.RevokeConsentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ConsentResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found or purpose never granted",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Consent already revoked",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/contacts": {
+            "get": {
+                "description": "Returns every phone number and email of a person, the primary ones first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Contacts"
+                ],
+                "summary": "List the contacts of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.ContactResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a phone number or email. Marking it as primary demotes the current primary contact of the same type, which is the one reported in the phone and email fields of the person, so it requires the person's ETag in If-Match",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Contacts"
+                ],
+                "summary": "Add a contact to a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the person, required when the contact is primary",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Contact data",
+                        "name": "contact",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.ContactDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ContactResponseDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the created contact"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Person was modified by another request",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required for a primary contact",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/contacts/{contactId}": {
+            "get": {
+                "description": "Returns one contact of a person",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Contacts"
+                ],
+                "summary": "Get a contact",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Contact ID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ContactResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or contact not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the value, label and flags of a contact. Its type cannot change, and a primary contact stays primary until another contact of its type is marked as primary. Replacing a primary contact, or marking a contact as primary, requires the person's ETag in If-Match",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Contacts"
+                ],
+                "summary": "Replace a contact",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Contact ID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the person, required when the contact is or becomes primary",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Contact data",
+                        "name": "contact",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.ContactDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ContactResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or contact not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Person was modified by another request",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required for a primary contact",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a contact. The last phone number or email of a person cannot be removed. When it was the primary contact, the oldest remaining contact of the same type becomes primary, so removing a primary contact requires the person's ETag in If-Match",
+                "tags": [
+                    "Contacts"
+                ],
+                "summary": "Delete a contact",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Contact ID",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the person, required when the contact is primary",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or contact not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Person was modified by another request",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Last contact of its type",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header is required for a primary contact",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/documents": {
+            "get": {
+                "description": "Returns every identity document of a person, by type",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Identity Documents"
+                ],
+                "summary": "List the identity documents of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.DocumentResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an RG, CNH, passport, voter ID (título de eleitor) or PIS/NIS. Check digits are validated for CNH, voter ID and PIS/NIS; RG requires the issuing state and agency; passports require the issuing country and the expiry date. A number is registered to a single person per type and issuer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Identity Documents"
+                ],
+                "summary": "Add an identity document to a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Document data",
+                        "name": "document",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.DocumentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/contract.DocumentResponseDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the created document"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Document already registered",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/documents/{documentId}": {
+            "get": {
+                "description": "Returns one identity document of a person",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Identity Documents"
+                ],
+                "summary": "Get an identity document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "documentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.DocumentResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or document not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces every field of an identity document. The type cannot be changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Identity Documents"
+                ],
+                "summary": "Replace an identity document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "documentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Document data",
+                        "name": "document",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.DocumentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.DocumentResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or document not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Document already registered",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes an identity document of a person",
+                "tags": [
+                    "Identity Documents"
+                ],
+                "summary": "Delete an identity document",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Document ID",
+                        "name": "documentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or document not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/duplicates": {
+            "get": {
+                "description": "Scores the active persons sharing the birth date, the first name, a phone or an email with the person, or whose CPF is one typo away from its own. The score adds up name similarity (ignoring case, accents, misspellings and missing middle names) 0.35, birth date 0.25, CPF typo 0.10, shared phone 0.15 and shared email 0.15. Candidates are returned by decreasing score",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Duplicates"
+                ],
+                "summary": "Find possible duplicates of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 1,
+                        "minimum": 0,
+                        "type": "number",
+                        "default": 0.5,
+                        "description": "Minimum score of the candidates returned",
+                        "name": "min_score",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.DuplicateCandidateDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or minimum score",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/family": {
+            "get": {
+                "description": "Walks the relationships of a person up to depth links away and returns the persons reached, with their distance, and the relationships among them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Relationships"
+                ],
+                "summary": "Get the family graph of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 5,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 2,
+                        "description": "Maximum number of links to walk",
+                        "name": "depth",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.FamilyGraphDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or depth",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/history": {
+            "get": {
+                "description": "Returns the audit trail of a person, newest first, with before/after snapshots of every change. Without pii:read the snapshots are masked",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Get the change history of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/contract.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/contract.AuditEntryDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/relationships": {
+            "get": {
+                "description": "Returns every relationship of a person with another active person, as seen from the person: a relationship created as guardian on one end is listed as ward on the other",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Relationships"
+                ],
+                "summary": "List the relationships of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/contract.RelationshipResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Links the related person to the person in the path; type says what the related person is to it. The link is visible from both ends with the inverse type (parent/child, guardian/ward, legal_representative/represented, emergency_contact/emergency_contact_for; spouse is symmetric). Guardians and legal representatives must be adults, parents must be older than their children and no person may become their own ancestor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Relationships"
+                ],
+                "summary": "Link a person to another",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Relationship data",
+                        "name": "relationship",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.RelationshipDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/contract.RelationshipResponseDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the created relationship"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Relationship already exists",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/relationships/{relationshipId}": {
+            "get": {
+                "description": "Returns one relationship of a person, as seen from the person",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Relationships"
+                ],
+                "summary": "Get a relationship",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Relationship ID",
+                        "name": "relationshipId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.RelationshipResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a relationship from both of its ends",
+                "tags": [
+                    "Relationships"
+                ],
+                "summary": "Delete a relationship",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Relationship ID",
+                        "name": "relationshipId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person or relationship not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/restore": {
+            "post": {
+                "description": "Restores a soft deleted person",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Restore a deleted person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.SuccessResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URI of the restored person"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Person is not deleted, was merged or anonymized, or its CPF is in use",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/persons/{id}/reveal": {
+            "post": {
+                "description": "Returns the person with the CPF, birth date, emails and phones in full, for operators that see them masked elsewhere. Every reveal is recorded in the person history with the operator and the reason, and nothing is returned when it cannot be recorded",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Persons"
+                ],
+                "summary": "Reveal the personal data of a person",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason for the reveal",
+                        "name": "reveal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contract.RevealPersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing permission",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Person not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Business validation error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/postal-codes/{cep}": {
+            "get": {
+                "description": "Returns the street, district, city and state of a Brazilian CEP, as used to complete addresses",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Look up a CEP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CEP, with or without mask",
+                        "name": "cep",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/contract.PostalCodeDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid CEP",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "CEP not found",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Lookup service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/contract.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "contract.AddressDTO": {
+            "type": "object",
+            "required": [
+                "cep",
+                "number",
+                "type"
+            ],
+            "properties": {
+                "cep": {
+                    "description": "Postal code (CEP, can be formatted or digits only, for Brazilian addresses)",
+                    "type": "string",
+                    "example": "50030-230"
+                },
+                "city": {
+                    "description": "Município; filled from the CEP when blank",
+                    "type": "string",
+                    "example": "Recife"
+                },
+                "complement": {
+                    "description": "Complemento",
+                    "type": "string",
+                    "example": "Apto 101"
+                },
+                "country": {
+                    "description": "ISO 3166-1 alpha-2 code, BR by default",
+                    "type": "string",
+                    "example": "BR"
+                },
+                "district": {
+                    "description": "Bairro; filled from the CEP when blank",
+                    "type": "string",
+                    "example": "Boa Vista"
+                },
+                "number": {
+                    "description": "Número (\"S/N\" when there is none)",
+                    "type": "string",
+                    "example": "325"
+                },
+                "primary": {
+                    "description": "Whether this is the person's primary address",
+                    "type": "boolean",
+                    "example": true
+                },
+                "state": {
+                    "description": "UF for Brazilian addresses; filled from the CEP when blank",
+                    "type": "string",
+                    "example": "PE"
+                },
+                "street": {
+                    "description": "Logradouro; filled from the CEP when blank",
+                    "type": "string",
+                    "example": "Rua da Aurora"
+                },
+                "type": {
+                    "description": "residential, billing or work",
+                    "type": "string",
+                    "example": "residential"
+                }
+            }
+        },
+        "contract.AddressResponseDTO": {
+            "type": "object",
+            "properties": {
+                "cep": {
+                    "description": "Postal code (digits only for Brazilian addresses)",
+                    "type": "string",
+                    "example": "50030230"
+                },
+                "city": {
+                    "description": "Município",
+                    "type": "string",
+                    "example": "Recife"
+                },
+                "complement": {
+                    "description": "Complemento",
+                    "type": "string",
+                    "example": "Apto 101"
+                },
+                "country": {
+                    "description": "ISO 3166-1 alpha-2 code",
+                    "type": "string",
+                    "example": "BR"
+                },
+                "created_at": {
+                    "description": "Record creation timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "district": {
+                    "description": "Bairro",
+                    "type": "string",
+                    "example": "Boa Vista"
+                },
+                "id": {
+                    "description": "Unique address ID",
+                    "type": "integer",
+                    "example": 3
+                },
+                "number": {
+                    "description": "Número",
+                    "type": "string",
+                    "example": "325"
+                },
+                "person_id": {
+                    "description": "Owner of the address",
+                    "type": "integer",
+                    "example": 1
+                },
+                "primary": {
+                    "description": "Whether this is the person's primary address",
+                    "type": "boolean",
+                    "example": true
+                },
+                "state": {
+                    "description": "UF",
+                    "type": "string",
+                    "example": "PE"
+                },
+                "street": {
+                    "description": "Logradouro",
+                    "type": "string",
+                    "example": "Rua da Aurora"
+                },
+                "type": {
+                    "description": "residential, billing or work",
+                    "type": "string",
+                    "example": "residential"
+                },
+                "updated_at": {
+                    "description": "Last update timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                }
+            }
+        },
+        "contract.AssignRolesDTO": {
+            "type": "object",
+            "required": [
+                "roles"
+            ],
+            "properties": {
+                "roles": {
+                    "description": "viewer, editor, auditor and/or admin; replaces the current roles",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "editor",
+                        "auditor"
+                    ]
+                }
+            }
+        },
+        "contract.AuditEntryDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "create, update, delete, restore, purge, merge, anonymize or reveal",
+                    "type": "string",
+                    "example": "update"
+                },
+                "after": {
+                    "description": "Snapshot after the change (null on purge)",
+                    "type": "object"
+                },
+                "before": {
+                    "description": "Snapshot before the change (null on create)",
+                    "type": "object"
+                },
+                "client_ip": {
+                    "description": "Client IP address",
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "created_at": {
+                    "description": "When the change happened",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "entity_id": {
+                    "description": "ID of the changed record",
+                    "type": "integer",
+                    "example": 1
+                },
+                "entity_type": {
+                    "description": "Type of the changed record: person, address, contact, document, relationship, company or operator",
+                    "type": "string",
+                    "example": "person"
+                },
+                "id": {
+                    "description": "Unique audit entry ID",
+                    "type": "integer",
+                    "example": 1
+                },
+                "operator_id": {
+                    "description": "Operator who performed the change",
+                    "type": "integer",
+                    "example": 3
+                },
+                "request_id": {
+                    "description": "Request ID (X-Request-ID) of the change",
+                    "type": "string",
+                    "example": "5f2b7c1e9a0d4e36"
+                }
+            }
+        },
+        "contract.CompanyDTO": {
+            "type": "object",
+            "required": [
+                "cnpj",
+                "founding_date",
+                "legal_name"
+            ],
+            "properties": {
+                "cnpj": {
+                    "description": "CNPJ, numeric or alphanumeric (can be formatted or not)",
+                    "type": "string",
+                    "example": "12.ABC.345/01DE-35"
+                },
+                "founding_date": {
+                    "description": "Date the company was founded",
+                    "type": "string",
+                    "example": "2010-03-01T00:00:00Z"
+                },
+                "legal_name": {
+                    "description": "Razão social",
+                    "type": "string",
+                    "example": "Padaria Pão Quente Ltda"
+                },
+                "state_registration": {
+                    "description": "Inscrição estadual: 2 to 14 digits or ISENTO",
+                    "type": "string",
+                    "example": "0321418-40"
+                },
+                "trade_name": {
+                    "description": "Nome fantasia",
+                    "type": "string",
+                    "example": "Pão Quente"
+                }
+            }
+        },
+        "contract.CompanyResponseDTO": {
+            "type": "object",
+            "properties": {
+                "cnpj": {
+                    "description": "CNPJ without mask",
+                    "type": "string",
+                    "example": "12ABC34501DE35"
+                },
+                "cnpj_formatted": {
+                    "description": "CNPJ with the 00.000.000/0000-00 mask",
+                    "type": "string",
+                    "example": "12.ABC.345/01DE-35"
+                },
+                "created_at": {
+                    "description": "Record creation timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "deleted_at": {
+                    "description": "Soft deletion timestamp (only for deleted companies)",
+                    "type": "string",
+                    "example": "2024-02-01T10:00:00Z"
+                },
+                "founding_date": {
+                    "description": "Date the company was founded",
+                    "type": "string",
+                    "example": "2010-03-01T00:00:00Z"
+                },
+                "id": {
+                    "description": "Unique company ID",
+                    "type": "integer",
+                    "example": 1
+                },
+                "legal_name": {
+                    "description": "Razão social",
+                    "type": "string",
+                    "example": "Padaria Pão Quente Ltda"
+                },
+                "state_registration": {
+                    "description": "Inscrição estadual (digits only) or ISENTO",
+                    "type": "string",
+                    "example": "032141840"
+                },
+                "trade_name": {
+                    "description": "Nome fantasia",
+                    "type": "string",
+                    "example": "Pão Quente"
+                },
+                "updated_at": {
+                    "description": "Last update timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "version": {
+                    "description": "Record version, also sent as the ETag header",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "contract.ConsentDTO": {
+            "type": "object",
+            "required": [
+                "channel",
+                "legal_basis",
+                "purpose"
+            ],
+            "properties": {
+                "channel": {
+                    "description": "web, mobile_app, call_center, in_person, email or paper",
+                    "type": "string",
+                    "example": "web"
+                },
+                "evidence_ref": {
+                    "description": "Reference to the proof of the grant (required when the legal basis is consent)",
+                    "type": "string",
+                    "example": "form-2024-000123"
+                },
+                "legal_basis": {
+                    "description": "consent, contract, legal_obligation or legitimate_interest",
+                    "type": "string",
+                    "example": "consent"
+                },
+                "purpose": {
+                    "description": "marketing, analytics or data_sharing",
+                    "type": "string",
+                    "example": "marketing"
+                }
+            }
+        },
+        "contract.ConsentEventResponseDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "grant, revoke or merge",
+                    "type": "string",
+                    "example": "revoke"
+                },
+                "channel": {
+                    "description": "Channel of the grant or revocation",
+                    "type": "string",
+                    "example": "call_center"
+                },
+                "client_ip": {
+                    "description": "Client IP address",
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "consent_id": {
+                    "description": "Consent the event changed",
+                    "type": "integer",
+                    "example": 7
+                },
+                "evidence_ref": {
+                    "description": "Proof of the grant or revocation",
+                    "type": "string",
+                    "example": "call-2024-000456"
+                },
+                "id": {
+                    "description": "Unique event ID",
+                    "type": "integer",
+                    "example": 15
+                },
+                "legal_basis": {
+                    "description": "Legal basis of the consent at the time",
+                    "type": "string",
+                    "example": "consent"
+                },
+                "occurred_at": {
+                    "description": "When the event was recorded",
+                    "type": "string",
+                    "example": "2024-02-01T10:00:00Z"
+                },
+                "operator_id": {
+                    "description": "Operator who recorded the event",
+                    "type": "integer",
+                    "example": 3
+                },
+                "person_id": {
+                    "description": "Person who granted the consent",
+                    "type": "integer",
+                    "example": 1
+                },
+                "purpose": {
+                    "description": "marketing, analytics or data_sharing",
+                    "type": "string",
+                    "example": "marketing"
+                },
+                "request_id": {
+                    "description": "Request ID (X-Request-ID) of the request",
+                    "type": "string",
+                    "example": "5f2b7c1e9a0d4e36"
+                }
+            }
+        },
+        "contract.ConsentResponseDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Whether the consent is granted and not revoked",
+                    "type": "boolean",
+                    "example": true
+                },
+                "channel": {
+                    "description": "Channel of the last grant",
+                    "type": "string",
+                    "example": "web"
+                },
+                "evidence_ref": {
+                    "description": "Proof of the last grant",
+                    "type": "string",
+                    "example": "form-2024-000123"
+                },
+                "granted_at": {
+                    "description": "When the consent was last granted",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "id": {
+                    "description": "Unique consent ID",
+                    "type": "integer",
+                    "example": 7
+                },
+                "legal_basis": {
+                    "description": "Legal basis of the last grant",
+                    "type": "string",
+                    "example": "consent"
+                },
+                "person_id": {
+                    "description": "Person who granted the consent",
+                    "type": "integer",
+                    "example": 1
+                },
+                "purpose": {
+                    "description": "marketing, analytics or data_sharing",
+                    "type": "string",
+                    "example": "marketing"
+                },
+                "revoked_at": {
+                    "description": "When the consent was revoked, if it is not active",
+                    "type": "string",
+                    "example": "2024-02-01T10:00:00Z"
+                },
+                "updated_at": {
+                    "description": "Last update timestamp",
+                    "type": "string",
+                    "example": "2024-02-01T10:00:00Z"
+                }
+            }
+        },
+        "contract.ContactDTO": {
+            "type": "object",
+            "required": [
+                "type",
+                "value"
+            ],
+            "properties": {
+                "label": {
+                    "description": "Free description, such as mobile, home, work, personal or corporate",
+                    "type": "string",
+                    "example": "mobile"
+                },
+                "primary": {
+                    "description": "Whether this is the person's primary contact of its type",
+                    "type": "boolean",
+                    "example": true
+                },
+                "type": {
+                    "description": "phone or email",
+                    "type": "string",
+                    "example": "phone"
+                },
+                "value": {
+                    "description": "Phone number (Brazilian with DDD, or international starting with +) or email address",
+                    "type": "string",
+                    "example": "(81) 91234-5678"
+                },
+                "verified": {
+                    "description": "Whether the contact has been confirmed with the person",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "contract.ContactResponseDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Record creation timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "id": {
+                    "description": "Unique contact ID",
+                    "type": "integer",
+                    "example": 5
+                },
+                "label": {
+                    "description": "Free description",
+                    "type": "string",
+                    "example": "mobile"
+                },
+                "national": {
+                    "description": "Phone number as written in its country (phones only)",
+                    "type": "string",
+                    "example": "(81) 91234-5678"
+                },
+                "person_id": {
+                    "description": "Owner of the contact",
+                    "type": "integer",
+                    "example": 1
+                },
+                "primary": {
+                    "description": "Whether this is the person's primary contact of its type",
+                    "type": "boolean",
+                    "example": true
+                },
+                "type": {
+                    "description": "phone or email",
+                    "type": "string",
+                    "example": "phone"
+                },
+                "updated_at": {
+                    "description": "Last update timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "value": {
+                    "description": "Phone number (E.164) or email address",
+                    "type": "string",
+                    "example": "+5581912345678"
+                },
+                "verified": {
+                    "description": "Whether the contact has been confirmed with the person",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "contract.DocumentDTO": {
+            "type": "object",
+            "required": [
+                "number",
+                "type"
+            ],
+            "properties": {
+                "country": {
+                    "description": "ISO 3166-1 alpha-2 code of the issuer (passport only, required)",
+                    "type": "string",
+                    "example": "BR"
+                },
+                "expiry_date": {
+                    "description": "Expiry date (required for passports)",
+                    "type": "string",
+                    "example": "2030-06-01T00:00:00Z"
+                },
+                "issue_date": {
+                    "description": "Date of issue",
+                    "type": "string",
+                    "example": "2015-06-01T00:00:00Z"
+                },
+                "issuing_agency": {
+                    "description": "Issuing agency (RG only, required)",
+                    "type": "string",
+                    "example": "SSP"
+                },
+                "issuing_state": {
+                    "description": "UF of the issuer (RG only, required)",
+                    "type": "string",
+                    "example": "PE"
+                },
+                "number": {
+                    "description": "Document number (can be formatted)",
+                    "type": "string",
+                    "example": "12.345.678-X"
+                },
+                "type": {
+                    "description": "rg, cnh, passport, voter_id or pis",
+                    "type": "string",
+                    "example": "rg"
+                }
+            }
+        },
+        "contract.DocumentLookupDTO": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "description": "Holder of the CNPJ",
+                    "type": "object"
+                },
+                "person": {
+                    "description": "Holder of the CPF",
+                    "type": "object"
+                },
+                "type": {
+                    "description": "person for a CPF, company for a CNPJ",
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "company"
+                    ],
+                    "example": "company"
+                }
+            }
+        },
+        "contract.DocumentResponseDTO": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "description": "Country of the issuer (passport only)",
+                    "type": "string",
+                    "example": "BR"
+                },
+                "created_at": {
+                    "description": "Record creation timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "expired": {
+                    "description": "Whether the expiry date has passed",
+                    "type": "boolean",
+                    "example": false
+                },
+                "expiry_date": {
+                    "description": "Expiry date",
+                    "type": "string",
+                    "example": "2030-06-01T00:00:00Z"
+                },
+                "id": {
+                    "description": "Unique document ID",
+                    "type": "integer",
+                    "example": 4
+                },
+                "issue_date": {
+                    "description": "Date of issue",
+                    "type": "string",
+                    "example": "2015-06-01T00:00:00Z"
+                },
+                "issuing_agency": {
+                    "description": "Issuing agency (RG only)",
+                    "type": "string",
+                    "example": "SSP"
+                },
+                "issuing_state": {
+                    "description": "UF of the issuer (RG only)",
+                    "type": "string",
+                    "example": "PE"
+                },
+                "masked": {
+                    "description": "Whether the number is masked",
+                    "type": "boolean",
+                    "example": false
+                },
+                "number": {
+                    "description": "Document number, without mask",
+                    "type": "string",
+                    "example": "12345678X"
+                },
+                "person_id": {
+                    "description": "Holder of the document",
+                    "type": "integer",
+                    "example": 1
+                },
+                "type": {
+                    "description": "rg, cnh, passport, voter_id or pis",
+                    "type": "string",
+                    "example": "rg"
+                },
+                "updated_at": {
+                    "description": "Last update timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                }
+            }
+        },
+        "contract.DuplicateCandidateDTO": {
+            "type": "object",
+            "properties": {
+                "person": {
+                    "description": "The candidate",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                        }
+                    ]
+                },
+                "reasons": {
+                    "description": "Signals that matched: name, birth_date, cpf, phone or email",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "name",
+                        "birth_date",
+                        "email"
+                    ]
+                },
+                "score": {
+                    "description": "Likelihood of being the same human, from 0 to 1",
+                    "type": "number",
+                    "example": 0.85
+                }
+            }
+        },
+        "contract.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Error code",
+                    "type": "string",
+                    "example": "validation_error"
+                },
+                "message": {
+                    "description": "Descriptive error message",
+                    "type": "string",
+                    "example": "Invalid CPF"
+                }
+            }
+        },
+        "contract.FamilyGraphDTO": {
+            "type": "object",
+            "properties": {
+                "depth": {
+                    "description": "Maximum number of links walked",
+                    "type": "integer",
+                    "example": 2
+                },
+                "members": {
+                    "description": "Persons reached, the starting person first, by distance",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.FamilyMemberDTO"
+                    }
+                },
+                "person_id": {
+                    "description": "Starting person",
+                    "type": "integer",
+                    "example": 1
+                },
+                "relationships": {
+                    "description": "Relationships among the members",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.RelationshipResponseDTO"
+                    }
+                }
+            }
+        },
+        "contract.FamilyMemberDTO": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "description": "Number of links from the starting person",
+                    "type": "integer",
+                    "example": 1
+                },
+                "person": {
+                    "description": "The person",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "contract.ImportReportDTO": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "description": "Whether rows were only validated",
+                    "type": "boolean",
+                    "example": false
+                },
+                "failed": {
+                    "description": "Rows rejected",
+                    "type": "integer",
+                    "example": 1
+                },
+                "rows": {
+                    "description": "Outcome of every row, by line number",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.ImportRowResultDTO"
+                    }
+                },
+                "succeeded": {
+                    "description": "Rows imported (or valid, in a dry run)",
+                    "type": "integer",
+                    "example": 2
+                },
+                "total_rows": {
+                    "description": "Number of data rows in the file",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "contract.ImportRowResultDTO": {
+            "type": "object",
+            "properties": {
+                "error_code": {
+                    "description": "Machine readable error code",
+                    "type": "string",
+                    "example": "cpf_invalid"
+                },
+                "id": {
+                    "description": "ID of the imported person",
+                    "type": "integer",
+                    "example": 12
+                },
+                "line": {
+                    "description": "Line number in the file",
+                    "type": "integer",
+                    "example": 4
+                },
+                "message": {
+                    "description": "Error description",
+                    "type": "string",
+                    "example": "cpf is invalid"
+                },
+                "status": {
+                    "description": "imported, valid (dry run) or failed",
+                    "type": "string",
+                    "example": "failed"
+                }
+            }
+        },
+        "contract.JobDTO": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Times the job was started",
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "description": "When the job was enqueued",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "error": {
+                    "description": "Error of the last failed attempt",
+                    "type": "string"
+                },
+                "finished_at": {
+                    "description": "When the job succeeded, failed or was cancelled",
+                    "type": "string",
+                    "example": "2024-01-01T10:02:00Z"
+                },
+                "id": {
+                    "description": "Unique job ID",
+                    "type": "integer",
+                    "example": 12
+                },
+                "max_attempts": {
+                    "description": "Attempts before the job fails for good",
+                    "type": "integer",
+                    "example": 3
+                },
+                "progress": {
+                    "description": "Completion percentage",
+                    "type": "integer",
+                    "example": 40
+                },
+                "result_url": {
+                    "description": "Where to download the result, once succeeded",
+                    "type": "string",
+                    "example": "/api/v1/jobs/12/result"
+                },
+                "started_at": {
+                    "description": "When the first attempt started",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:01Z"
+                },
+                "status": {
+                    "description": "queued, running, succeeded, failed or cancelled",
+                    "type": "string",
+                    "example": "running"
+                },
+                "type": {
+                    "description": "person_import or person_export",
+                    "type": "string",
+                    "example": "person_export"
+                }
+            }
+        },
+        "contract.MergeDTO": {
+            "type": "object",
+            "required": [
+                "duplicate_id",
+                "duplicate_version",
+                "survivor_id",
+                "survivor_version"
+            ],
+            "properties": {
+                "duplicate_id": {
+                    "description": "Person merged into the survivor and removed",
+                    "type": "integer",
+                    "example": 2
+                },
+                "duplicate_version": {
+                    "description": "Expected version of the duplicate",
+                    "type": "integer",
+                    "example": 1
+                },
+                "survivor_id": {
+                    "description": "Person that is kept",
+                    "type": "integer",
+                    "example": 1
+                },
+                "survivor_version": {
+                    "description": "Expected version of the survivor",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "contract.NewPersonDTO": {
+            "type": "object",
+            "required": [
+                "birth_date",
                 "cpf",
                 "email",
                 "name",
@@ -239,6 +5451,13 @@ const docTemplate = `{
                     "type": "string",
                     "example": "1990-01-15T00:00:00Z"
                 },
+                "contacts": {
+                    "description": "Further contacts; phone and email become the primary ones unless a contact of their type is marked as primary",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.ContactDTO"
+                    }
+                },
                 "cpf": {
                     "description": "Brazilian CPF (can be formatted or digits only)",
                     "type": "string",
@@ -249,15 +5468,61 @@ const docTemplate = `{
                     "type": "string",
                     "example": "joao.silva@email.com"
                 },
-                "name": {
-                    "description": "Person's full name",
+                "name": {
+                    "description": "Person's full name",
+                    "type": "string",
+                    "example": "João Silva"
+                },
+                "phone": {
+                    "description": "Phone number (Brazilian with DDD, or international starting with +)",
+                    "type": "string",
+                    "example": "(81) 91234-5678"
+                }
+            }
+        },
+        "contract.OperatorResponseDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Whether the operator can log in",
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "description": "Record creation timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "email": {
+                    "description": "Email address",
+                    "type": "string",
+                    "example": "john.doe@company.com"
+                },
+                "id": {
+                    "description": "Unique operator ID",
+                    "type": "integer",
+                    "example": 3
+                },
+                "roles": {
+                    "description": "Roles held by the operator",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "editor",
+                        "auditor"
+                    ]
+                },
+                "updated_at": {
+                    "description": "Last update timestamp",
                     "type": "string",
-                    "example": "João Silva"
+                    "example": "2024-01-01T10:00:00Z"
                 },
-                "phone": {
-                    "description": "Phone number (10 or 11 digits)",
+                "username": {
+                    "description": "Username used to log in",
                     "type": "string",
-                    "example": "81912345678"
+                    "example": "john.doe"
                 }
             }
         },
@@ -293,16 +5558,53 @@ const docTemplate = `{
                 }
             }
         },
+        "contract.PatchPersonDTO": {
+            "type": "object",
+            "properties": {
+                "birth_date": {
+                    "type": "string",
+                    "example": "1990-01-15T00:00:00Z"
+                },
+                "cpf": {
+                    "type": "string",
+                    "example": "111.444.777-35"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "joao.silva@email.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "João Silva"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "81912345678"
+                }
+            }
+        },
         "contract.PersonResponseDTO": {
             "type": "object",
             "properties": {
+                "anonymized_at": {
+                    "description": "When the personal data was scrubbed (only for anonymized persons)",
+                    "type": "string",
+                    "example": "2024-03-01T10:00:00Z"
+                },
                 "birth_date": {
-                    "description": "Date of birth",
+                    "description": "Date of birth, or only its year as 1990-**-** when masked",
                     "type": "string",
                     "example": "1990-01-15T00:00:00Z"
                 },
+                "contacts": {
+                    "description": "Every phone number and email address, the primary ones first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.ContactResponseDTO"
+                    }
+                },
                 "cpf": {
-                    "description": "Brazilian CPF (digits only)",
+                    "description": "Brazilian CPF (digits only), or ***.444.777-** when masked",
                     "type": "string",
                     "example": "11144477735"
                 },
@@ -311,8 +5613,13 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2024-01-01T10:00:00Z"
                 },
+                "deleted_at": {
+                    "description": "Soft deletion timestamp (only for deleted persons)",
+                    "type": "string",
+                    "example": "2024-02-01T10:00:00Z"
+                },
                 "email": {
-                    "description": "Email address",
+                    "description": "Primary email address",
                     "type": "string",
                     "example": "joao.silva@email.com"
                 },
@@ -321,20 +5628,301 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
+                "masked": {
+                    "description": "Whether CPF, birth date, emails and phones are masked; see POST /persons/{id}/reveal",
+                    "type": "boolean",
+                    "example": false
+                },
+                "merged_into_id": {
+                    "description": "Person this one was merged into (only for merged persons)",
+                    "type": "integer",
+                    "example": 1
+                },
                 "name": {
                     "description": "Full name",
                     "type": "string",
                     "example": "João Silva"
                 },
                 "phone": {
-                    "description": "Phone number (digits only)",
+                    "description": "Primary phone number (E.164)",
                     "type": "string",
-                    "example": "81912345678"
+                    "example": "+5581912345678"
+                },
+                "phone_national": {
+                    "description": "Primary phone number as written in its country",
+                    "type": "string",
+                    "example": "(81) 91234-5678"
                 },
                 "updated_at": {
                     "description": "Last update timestamp",
                     "type": "string",
                     "example": "2024-01-01T10:00:00Z"
+                },
+                "version": {
+                    "description": "Record version, also sent as the ETag header",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "contract.PostalCodeDTO": {
+            "type": "object",
+            "properties": {
+                "cep": {
+                    "description": "CEP (digits only)",
+                    "type": "string",
+                    "example": "50030230"
+                },
+                "city": {
+                    "description": "Município",
+                    "type": "string",
+                    "example": "Recife"
+                },
+                "district": {
+                    "description": "Bairro; empty for CEPs that cover a whole city",
+                    "type": "string",
+                    "example": "Boa Vista"
+                },
+                "state": {
+                    "description": "UF",
+                    "type": "string",
+                    "example": "PE"
+                },
+                "street": {
+                    "description": "Logradouro; empty for CEPs that cover a whole city",
+                    "type": "string",
+                    "example": "Rua da Aurora"
+                }
+            }
+        },
+        "contract.RelationshipDTO": {
+            "type": "object",
+            "required": [
+                "related_person_id",
+                "type"
+            ],
+            "properties": {
+                "related_person_id": {
+                    "description": "Person linked to the one in the path",
+                    "type": "integer",
+                    "example": 2
+                },
+                "type": {
+                    "description": "What the related person is to the one in the path: parent, child, spouse, guardian, ward, legal_representative, represented, emergency_contact or emergency_contact_for",
+                    "type": "string",
+                    "example": "guardian"
+                }
+            }
+        },
+        "contract.RelationshipResponseDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Record creation timestamp",
+                    "type": "string",
+                    "example": "2024-01-01T10:00:00Z"
+                },
+                "id": {
+                    "description": "Unique relationship ID",
+                    "type": "integer",
+                    "example": 3
+                },
+                "person_id": {
+                    "description": "Person the relationship is seen from",
+                    "type": "integer",
+                    "example": 1
+                },
+                "related_person_id": {
+                    "description": "Person linked to it",
+                    "type": "integer",
+                    "example": 2
+                },
+                "type": {
+                    "description": "What the related person is to the person",
+                    "type": "string",
+                    "example": "guardian"
+                }
+            }
+        },
+        "contract.RevealPersonDTO": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "description": "Why the full data is needed; recorded in the audit trail",
+                    "type": "string",
+                    "example": "Customer identity check on call 2024-118"
+                }
+            }
+        },
+        "contract.RevokeConsentDTO": {
+            "type": "object",
+            "required": [
+                "channel"
+            ],
+            "properties": {
+                "channel": {
+                    "description": "web, mobile_app, call_center, in_person, email or paper",
+                    "type": "string",
+                    "example": "call_center"
+                },
+                "evidence_ref": {
+                    "description": "Reference to the proof of the revocation",
+                    "type": "string",
+                    "example": "call-2024-000456"
+                }
+            }
+        },
+        "contract.SubjectDTO": {
+            "type": "object",
+            "required": [
+                "cpf"
+            ],
+            "properties": {
+                "cpf": {
+                    "description": "CPF of the data subject (with or without formatting)",
+                    "type": "string",
+                    "example": "111.444.777-35"
+                }
+            }
+        },
+        "contract.SubjectRecordDTO": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "description": "Postal addresses of the person",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.AddressResponseDTO"
+                    }
+                },
+                "consents": {
+                    "description": "Consents of the person, active or revoked",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.ConsentResponseDTO"
+                    }
+                },
+                "documents": {
+                    "description": "Identity documents of the person",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.DocumentResponseDTO"
+                    }
+                },
+                "person": {
+                    "description": "The person, with its contacts",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/contract.PersonResponseDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "contract.SubjectReportDTO": {
+            "type": "object",
+            "properties": {
+                "consent_history": {
+                    "description": "Grants and revocations of consents of the persons, newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.ConsentEventResponseDTO"
+                    }
+                },
+                "cpf": {
+                    "description": "CPF of the data subject (digits only)",
+                    "type": "string",
+                    "example": "11144477735"
+                },
+                "generated_at": {
+                    "description": "When the report was generated",
+                    "type": "string",
+                    "example": "2024-03-01T10:00:00Z"
+                },
+                "history": {
+                    "description": "Changes to the persons and their records, newest first",
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "persons": {
+                    "description": "Every person registered with the CPF, deleted or not, and the persons merged into them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.SubjectRecordDTO"
+                    }
+                },
+                "relationships": {
+                    "description": "Relationships involving any of the persons",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contract.RelationshipResponseDTO"
+                    }
+                },
+                "request_id": {
+                    "description": "ID of the logged request",
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "contract.SubjectRequestResponseDTO": {
+            "type": "object",
+            "properties": {
+                "client_ip": {
+                    "description": "Client IP address",
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "created_at": {
+                    "description": "When the request was answered",
+                    "type": "string",
+                    "example": "2024-03-01T10:00:00Z"
+                },
+                "id": {
+                    "description": "Unique request ID",
+                    "type": "integer",
+                    "example": 12
+                },
+                "operator_id": {
+                    "description": "Operator who made the request",
+                    "type": "integer",
+                    "example": 3
+                },
+                "person_ids": {
+                    "description": "Persons covered by the request",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2
+                    ]
+                },
+                "request_id": {
+                    "description": "Request ID (X-Request-ID) of the request",
+                    "type": "string",
+                    "example": "5f2b7c1e9a0d4e36"
+                },
+                "status": {
+                    "description": "completed, or not_found when no person holds the CPF",
+                    "type": "string",
+                    "example": "completed"
+                },
+                "subject_cpf": {
+                    "description": "Masked CPF of the data subject",
+                    "type": "string",
+                    "example": "***.444.777-**"
+                },
+                "type": {
+                    "description": "access, portability or anonymization",
+                    "type": "string",
+                    "example": "access"
                 }
             }
         },
@@ -352,6 +5940,38 @@ const docTemplate = `{
                     "example": "Person created successfully"
                 }
             }
+        },
+        "contract.UpdatePersonDTO": {
+            "type": "object",
+            "required": [
+                "birth_date",
+                "cpf",
+                "email",
+                "name",
+                "phone"
+            ],
+            "properties": {
+                "birth_date": {
+                    "type": "string",
+                    "example": "1990-01-15T00:00:00Z"
+                },
+                "cpf": {
+                    "type": "string",
+                    "example": "111.444.777-35"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "joao.silva@email.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "João Silva"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "81912345678"
+                }
+            }
         }
     },
     "tags": [
@@ -366,6 +5986,50 @@ const docTemplate = `{
         {
             "description": "CRUD operations for person management",
             "name": "Persons"
+        },
+        {
+            "description": "Postal addresses of a person",
+            "name": "Addresses"
+        },
+        {
+            "description": "Phone numbers and emails of a person",
+            "name": "Contacts"
+        },
+        {
+            "description": "RG, CNH, passport, voter ID and PIS/NIS of a person",
+            "name": "Identity Documents"
+        },
+        {
+            "description": "Family members, guardians, legal representatives and emergency contacts of a person",
+            "name": "Relationships"
+        },
+        {
+            "description": "Detection of persons registered twice and merge of their records",
+            "name": "Duplicates"
+        },
+        {
+            "description": "Consents of a person to the processing of their data for marketing, analytics and data sharing (LGPD)",
+            "name": "Consents"
+        },
+        {
+            "description": "LGPD requests of data subjects: access report, portability export and anonymization",
+            "name": "Data Subjects"
+        },
+        {
+            "description": "CRUD operations for legal entities (CNPJ)",
+            "name": "Companies"
+        },
+        {
+            "description": "Lookup of the person or company holding a CPF or CNPJ",
+            "name": "Documents"
+        },
+        {
+            "description": "Background jobs (imports and exports)",
+            "name": "Jobs"
+        },
+        {
+            "description": "Administration of operators and of the roles that grant their permissions",
+            "name": "Operators"
         }
     ]
 }`
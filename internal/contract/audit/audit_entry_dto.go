@@ -0,0 +1,45 @@
+package contract
+
+import (
+	"encoding/json"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+)
+
+// AuditEntryDTO represents a change recorded in the audit trail
+type AuditEntryDTO struct {
+	ID         int             `json:"id" example:"1"`                            // Unique audit entry ID
+	EntityType string          `json:"entity_type" example:"person"`              // Type of the changed record: person, address, contact, document, relationship, company or operator
+	EntityID   int             `json:"entity_id" example:"1"`                     // ID of the changed record
+	Action     string          `json:"action" example:"update"`                   // create, update, delete, restore, purge, merge, anonymize or reveal
+	OperatorID int             `json:"operator_id" example:"3"`                   // Operator who performed the change
+	RequestID  string          `json:"request_id" example:"5f2b7c1e9a0d4e36"`     // Request ID (X-Request-ID) of the change
+	ClientIP   string          `json:"client_ip" example:"203.0.113.10"`          // Client IP address
+	Before     json.RawMessage `json:"before" swaggertype:"object"`               // Snapshot before the change (null on create)
+	After      json.RawMessage `json:"after" swaggertype:"object"`                // Snapshot after the change (null on purge)
+	CreatedAt  time.Time       `json:"created_at" example:"2024-01-01T10:00:00Z"` // When the change happened
+}
+
+// NewAuditEntryDTO maps a domain audit entry to its API representation.
+func NewAuditEntryDTO(entry *audit.AuditEntry) AuditEntryDTO {
+	return AuditEntryDTO{
+		ID:         entry.ID,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Action:     entry.Action,
+		OperatorID: entry.OperatorID,
+		RequestID:  entry.RequestID,
+		ClientIP:   entry.ClientIP,
+		Before:     nullIfEmpty(entry.Before),
+		After:      nullIfEmpty(entry.After),
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+func nullIfEmpty(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+	return raw
+}
@@ -1,11 +1,38 @@
 package contract
 
+import (
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
+)
+
 type LoginDTO struct {
 	Username string `json:"username" example:"john.doe" binding:"required"`       // Operator username
 	Password string `json:"password" example:"SecurePass123!" binding:"required"` // Operator password
 }
 
+// RefreshTokenDTO carries the refresh token to exchange for a new token pair
+type RefreshTokenDTO struct {
+	RefreshToken string `json:"refresh_token" example:"4q2nVh1u0eXQy3kq8y1m2S5n0bS7xZpD1e9fGm3tH6c" binding:"required"` // Refresh token received at login or at the previous refresh
+}
+
 type LoginResponseDTO struct {
-	Token   string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."` // JWT authentication token
-	Message string `json:"message" example:"Login successful"`                      // Success message
+	Token                 string    `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`             // JWT access token
+	TokenType             string    `json:"token_type" example:"Bearer"`                                         // Scheme of the Authorization header
+	ExpiresAt             time.Time `json:"expires_at" example:"2024-01-01T10:15:00Z"`                           // When the access token expires
+	RefreshToken          string    `json:"refresh_token" example:"4q2nVh1u0eXQy3kq8y1m2S5n0bS7xZpD1e9fGm3tH6c"` // Single-use token to obtain a new pair
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at" example:"2024-01-08T10:00:00Z"`             // When the refresh token expires if unused
+	Message               string    `json:"message" example:"Login successful"`                                  // Success message
+}
+
+// NewLoginResponseDTO maps a token pair to the response of a login or refresh.
+func NewLoginResponseDTO(pair *operator.TokenPair, message string) LoginResponseDTO {
+	return LoginResponseDTO{
+		Token:                 pair.AccessToken,
+		TokenType:             "Bearer",
+		ExpiresAt:             pair.AccessTokenExpiresAt,
+		RefreshToken:          pair.RefreshToken,
+		RefreshTokenExpiresAt: pair.RefreshTokenExpiresAt,
+		Message:               message,
+	}
 }
@@ -0,0 +1,42 @@
+package contract
+
+import (
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
+)
+
+// AssignRolesDTO represents the roles to assign to an operator
+type AssignRolesDTO struct {
+	Roles []string `json:"roles" example:"editor,auditor" binding:"required"` // viewer, editor, auditor and/or admin; replaces the current roles
+}
+
+// OperatorResponseDTO represents an operator returned by the API
+type OperatorResponseDTO struct {
+	ID        int       `json:"id" example:"3"`                            // Unique operator ID
+	Username  string    `json:"username" example:"john.doe"`               // Username used to log in
+	Email     string    `json:"email" example:"john.doe@company.com"`      // Email address
+	Active    bool      `json:"active" example:"true"`                     // Whether the operator can log in
+	Roles     []string  `json:"roles" example:"editor,auditor"`            // Roles held by the operator
+	CreatedAt time.Time `json:"created_at" example:"2024-01-01T10:00:00Z"` // Record creation timestamp
+	UpdatedAt time.Time `json:"updated_at" example:"2024-01-01T10:00:00Z"` // Last update timestamp
+}
+
+// NewOperatorResponseDTO maps a domain operator to its API representation.
+// The password hash is never exposed.
+func NewOperatorResponseDTO(op *operator.Operator) OperatorResponseDTO {
+	roles := op.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	return OperatorResponseDTO{
+		ID:        op.ID,
+		Username:  op.Username,
+		Email:     op.Email,
+		Active:    op.Active,
+		Roles:     roles,
+		CreatedAt: op.CreatedAt,
+		UpdatedAt: op.UpdatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package contract
+
+import (
+	"time"
+
+	company "pessoas-api/internal/domain/company/model"
+)
+
+// CompanyDTO represents the data required to create or replace a company
+type CompanyDTO struct {
+	CNPJ              string    `json:"cnpj" example:"12.ABC.345/01DE-35" binding:"required"`            // CNPJ, numeric or alphanumeric (can be formatted or not)
+	LegalName         string    `json:"legal_name" example:"Padaria Pão Quente Ltda" binding:"required"` // Razão social
+	TradeName         string    `json:"trade_name,omitempty" example:"Pão Quente"`                       // Nome fantasia
+	FoundingDate      time.Time `json:"founding_date" example:"2010-03-01T00:00:00Z" binding:"required"` // Date the company was founded
+	StateRegistration string    `json:"state_registration,omitempty" example:"0321418-40"`               // Inscrição estadual: 2 to 14 digits or ISENTO
+}
+
+// CompanyResponseDTO represents company data returned by the API
+type CompanyResponseDTO struct {
+	ID                int        `json:"id" example:"1"`                                      // Unique company ID
+	CNPJ              string     `json:"cnpj" example:"12ABC34501DE35"`                       // CNPJ without mask
+	CNPJFormatted     string     `json:"cnpj_formatted" example:"12.ABC.345/01DE-35"`         // CNPJ with the 00.000.000/0000-00 mask
+	LegalName         string     `json:"legal_name" example:"Padaria Pão Quente Ltda"`        // Razão social
+	TradeName         string     `json:"trade_name" example:"Pão Quente"`                     // Nome fantasia
+	FoundingDate      time.Time  `json:"founding_date" example:"2010-03-01T00:00:00Z"`        // Date the company was founded
+	StateRegistration string     `json:"state_registration" example:"032141840"`              // Inscrição estadual (digits only) or ISENTO
+	Version           int        `json:"version" example:"1"`                                 // Record version, also sent as the ETag header
+	CreatedAt         time.Time  `json:"created_at" example:"2024-01-01T10:00:00Z"`           // Record creation timestamp
+	UpdatedAt         time.Time  `json:"updated_at" example:"2024-01-01T10:00:00Z"`           // Last update timestamp
+	DeletedAt         *time.Time `json:"deleted_at,omitempty" example:"2024-02-01T10:00:00Z"` // Soft deletion timestamp (only for deleted companies)
+}
+
+// NewCompanyResponseDTO maps a domain company to its API representation.
+func NewCompanyResponseDTO(c *company.Company) CompanyResponseDTO {
+	return CompanyResponseDTO{
+		ID:                c.ID,
+		CNPJ:              c.CNPJ,
+		CNPJFormatted:     company.FormatCNPJ(c.CNPJ),
+		LegalName:         c.LegalName,
+		TradeName:         c.TradeName,
+		FoundingDate:      c.FoundingDate,
+		StateRegistration: c.StateRegistration,
+		Version:           c.Version,
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+		DeletedAt:         c.DeletedAt,
+	}
+}
+
+// NewCompanyResponseDTOs maps a list of domain companies to their API representation.
+func NewCompanyResponseDTOs(companies []*company.Company) []CompanyResponseDTO {
+	response := make([]CompanyResponseDTO, len(companies))
+	for i, c := range companies {
+		response[i] = NewCompanyResponseDTO(c)
+	}
+	return response
+}
@@ -0,0 +1,16 @@
+package contract
+
+// Kinds of document accepted by the document lookup.
+const (
+	DocumentPerson  = "person"
+	DocumentCompany = "company"
+)
+
+// DocumentLookupDTO represents the holder of a CPF or CNPJ. Only the field
+// matching Type is filled in: a PersonResponseDTO for a CPF, a
+// CompanyResponseDTO for a CNPJ.
+type DocumentLookupDTO struct {
+	Type    string      `json:"type" example:"company" enums:"person,company"` // person for a CPF, company for a CNPJ
+	Person  interface{} `json:"person,omitempty" swaggertype:"object"`         // Holder of the CPF
+	Company interface{} `json:"company,omitempty" swaggertype:"object"`        // Holder of the CNPJ
+}
@@ -0,0 +1,48 @@
+package contract
+
+import (
+	"fmt"
+	"time"
+
+	job "pessoas-api/internal/domain/job/model"
+)
+
+// JobDTO represents a background job and its progress
+type JobDTO struct {
+	ID          int        `json:"id" example:"12"`                             // Unique job ID
+	Type        string     `json:"type" example:"person_export"`                // person_import or person_export
+	Status      string     `json:"status" example:"running"`                    // queued, running, succeeded, failed or cancelled
+	Progress    int        `json:"progress" example:"40"`                       // Completion percentage
+	Attempts    int        `json:"attempts" example:"1"`                        // Times the job was started
+	MaxAttempts int        `json:"max_attempts" example:"3"`                    // Attempts before the job fails for good
+	Error       *string    `json:"error"`                                       // Error of the last failed attempt
+	ResultURL   *string    `json:"result_url" example:"/api/v1/jobs/12/result"` // Where to download the result, once succeeded
+	CreatedAt   time.Time  `json:"created_at" example:"2024-01-01T10:00:00Z"`   // When the job was enqueued
+	StartedAt   *time.Time `json:"started_at" example:"2024-01-01T10:00:01Z"`   // When the first attempt started
+	FinishedAt  *time.Time `json:"finished_at" example:"2024-01-01T10:02:00Z"`  // When the job succeeded, failed or was cancelled
+}
+
+// NewJobDTO maps a domain job to its API representation.
+func NewJobDTO(j *job.Job) JobDTO {
+	dto := JobDTO{
+		ID:          j.ID,
+		Type:        j.Type,
+		Status:      j.Status,
+		Progress:    j.Progress,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		CreatedAt:   j.CreatedAt,
+		StartedAt:   j.StartedAt,
+		FinishedAt:  j.FinishedAt,
+	}
+
+	if j.Error != "" {
+		dto.Error = &j.Error
+	}
+	if j.Status == job.StatusSucceeded && j.Result != nil {
+		url := fmt.Sprintf("/api/v1/jobs/%d/result", j.ID)
+		dto.ResultURL = &url
+	}
+
+	return dto
+}
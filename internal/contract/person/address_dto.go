@@ -0,0 +1,79 @@
+package contract
+
+import (
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// AddressDTO represents the data required to create or replace an address
+type AddressDTO struct {
+	Type       string `json:"type" example:"residential" binding:"required"` // residential, billing or work
+	CEP        string `json:"cep" example:"50030-230" binding:"required"`    // Postal code (CEP, can be formatted or digits only, for Brazilian addresses)
+	Street     string `json:"street,omitempty" example:"Rua da Aurora"`      // Logradouro; filled from the CEP when blank
+	Number     string `json:"number" example:"325" binding:"required"`       // Número ("S/N" when there is none)
+	Complement string `json:"complement,omitempty" example:"Apto 101"`       // Complemento
+	District   string `json:"district,omitempty" example:"Boa Vista"`        // Bairro; filled from the CEP when blank
+	City       string `json:"city,omitempty" example:"Recife"`               // Município; filled from the CEP when blank
+	State      string `json:"state,omitempty" example:"PE"`                  // UF for Brazilian addresses; filled from the CEP when blank
+	Country    string `json:"country,omitempty" example:"BR"`                // ISO 3166-1 alpha-2 code, BR by default
+	Primary    bool   `json:"primary" example:"true"`                        // Whether this is the person's primary address
+}
+
+// AddressResponseDTO represents an address returned by the API
+type AddressResponseDTO struct {
+	ID         int       `json:"id" example:"3"`                            // Unique address ID
+	PersonID   int       `json:"person_id" example:"1"`                     // Owner of the address
+	Type       string    `json:"type" example:"residential"`                // residential, billing or work
+	CEP        string    `json:"cep" example:"50030230"`                    // Postal code (digits only for Brazilian addresses)
+	Street     string    `json:"street" example:"Rua da Aurora"`            // Logradouro
+	Number     string    `json:"number" example:"325"`                      // Número
+	Complement string    `json:"complement" example:"Apto 101"`             // Complemento
+	District   string    `json:"district" example:"Boa Vista"`              // Bairro
+	City       string    `json:"city" example:"Recife"`                     // Município
+	State      string    `json:"state" example:"PE"`                        // UF
+	Country    string    `json:"country" example:"BR"`                      // ISO 3166-1 alpha-2 code
+	Primary    bool      `json:"primary" example:"true"`                    // Whether this is the person's primary address
+	CreatedAt  time.Time `json:"created_at" example:"2024-01-01T10:00:00Z"` // Record creation timestamp
+	UpdatedAt  time.Time `json:"updated_at" example:"2024-01-01T10:00:00Z"` // Last update timestamp
+}
+
+// NewAddressResponseDTO maps a domain address to its API representation.
+func NewAddressResponseDTO(a *person.Address) AddressResponseDTO {
+	return AddressResponseDTO{
+		ID:         a.ID,
+		PersonID:   a.PersonID,
+		Type:       a.Type,
+		CEP:        a.CEP,
+		Street:     a.Street,
+		Number:     a.Number,
+		Complement: a.Complement,
+		District:   a.District,
+		City:       a.City,
+		State:      a.State,
+		Country:    a.Country,
+		Primary:    a.Primary,
+		CreatedAt:  a.CreatedAt,
+		UpdatedAt:  a.UpdatedAt,
+	}
+}
+
+// PostalCodeDTO represents the location of a CEP
+type PostalCodeDTO struct {
+	CEP      string `json:"cep" example:"50030230"`         // CEP (digits only)
+	Street   string `json:"street" example:"Rua da Aurora"` // Logradouro; empty for CEPs that cover a whole city
+	District string `json:"district" example:"Boa Vista"`   // Bairro; empty for CEPs that cover a whole city
+	City     string `json:"city" example:"Recife"`          // Município
+	State    string `json:"state" example:"PE"`             // UF
+}
+
+// NewPostalCodeDTO maps a domain postal code to its API representation.
+func NewPostalCodeDTO(p *person.PostalCode) PostalCodeDTO {
+	return PostalCodeDTO{
+		CEP:      p.CEP,
+		Street:   p.Street,
+		District: p.District,
+		City:     p.City,
+		State:    p.State,
+	}
+}
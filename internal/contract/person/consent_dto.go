@@ -0,0 +1,98 @@
+package contract
+
+import (
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// ConsentDTO represents the data required to grant a consent
+type ConsentDTO struct {
+	Purpose     string `json:"purpose" example:"marketing" binding:"required"`    // marketing, analytics or data_sharing
+	LegalBasis  string `json:"legal_basis" example:"consent" binding:"required"`  // consent, contract, legal_obligation or legitimate_interest
+	Channel     string `json:"channel" example:"web" binding:"required"`          // web, mobile_app, call_center, in_person, email or paper
+	EvidenceRef string `json:"evidence_ref,omitempty" example:"form-2024-000123"` // Reference to the proof of the grant (required when the legal basis is consent)
+}
+
+// RevokeConsentDTO represents the data required to revoke a consent
+type RevokeConsentDTO struct {
+	Channel     string `json:"channel" example:"call_center" binding:"required"`  // web, mobile_app, call_center, in_person, email or paper
+	EvidenceRef string `json:"evidence_ref,omitempty" example:"call-2024-000456"` // Reference to the proof of the revocation
+}
+
+// ConsentResponseDTO represents the current state of a consent returned by the API
+type ConsentResponseDTO struct {
+	ID          int        `json:"id" example:"7"`                                      // Unique consent ID
+	PersonID    int        `json:"person_id" example:"1"`                               // Person who granted the consent
+	Purpose     string     `json:"purpose" example:"marketing"`                         // marketing, analytics or data_sharing
+	LegalBasis  string     `json:"legal_basis" example:"consent"`                       // Legal basis of the last grant
+	Channel     string     `json:"channel" example:"web"`                               // Channel of the last grant
+	EvidenceRef string     `json:"evidence_ref,omitempty" example:"form-2024-000123"`   // Proof of the last grant
+	Active      bool       `json:"active" example:"true"`                               // Whether the consent is granted and not revoked
+	GrantedAt   time.Time  `json:"granted_at" example:"2024-01-01T10:00:00Z"`           // When the consent was last granted
+	RevokedAt   *time.Time `json:"revoked_at,omitempty" example:"2024-02-01T10:00:00Z"` // When the consent was revoked, if it is not active
+	UpdatedAt   time.Time  `json:"updated_at" example:"2024-02-01T10:00:00Z"`           // Last update timestamp
+}
+
+// ConsentEventResponseDTO represents an entry of the consent history
+type ConsentEventResponseDTO struct {
+	ID          int       `json:"id" example:"15"`                                   // Unique event ID
+	ConsentID   int       `json:"consent_id" example:"7"`                            // Consent the event changed
+	PersonID    int       `json:"person_id" example:"1"`                             // Person who granted the consent
+	Purpose     string    `json:"purpose" example:"marketing"`                       // marketing, analytics or data_sharing
+	Action      string    `json:"action" example:"revoke"`                           // grant, revoke or merge
+	LegalBasis  string    `json:"legal_basis" example:"consent"`                     // Legal basis of the consent at the time
+	Channel     string    `json:"channel" example:"call_center"`                     // Channel of the grant or revocation
+	EvidenceRef string    `json:"evidence_ref,omitempty" example:"call-2024-000456"` // Proof of the grant or revocation
+	OperatorID  int       `json:"operator_id" example:"3"`                           // Operator who recorded the event
+	RequestID   string    `json:"request_id" example:"5f2b7c1e9a0d4e36"`             // Request ID (X-Request-ID) of the request
+	ClientIP    string    `json:"client_ip" example:"203.0.113.10"`                  // Client IP address
+	OccurredAt  time.Time `json:"occurred_at" example:"2024-02-01T10:00:00Z"`        // When the event was recorded
+}
+
+// NewConsentResponseDTO maps a domain consent to its API representation.
+func NewConsentResponseDTO(c *person.Consent) ConsentResponseDTO {
+	return ConsentResponseDTO{
+		ID:          c.ID,
+		PersonID:    c.PersonID,
+		Purpose:     c.Purpose,
+		LegalBasis:  c.LegalBasis,
+		Channel:     c.Channel,
+		EvidenceRef: c.EvidenceRef,
+		Active:      c.IsActive(),
+		GrantedAt:   c.GrantedAt,
+		RevokedAt:   c.RevokedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// NewConsentResponseDTOs maps a list of domain consents to their API representation.
+func NewConsentResponseDTOs(consents []*person.Consent) []ConsentResponseDTO {
+	response := make([]ConsentResponseDTO, len(consents))
+	for i, c := range consents {
+		response[i] = NewConsentResponseDTO(c)
+	}
+	return response
+}
+
+// NewConsentEventResponseDTOs maps a list of consent history events to their API representation.
+func NewConsentEventResponseDTOs(events []*person.ConsentEvent) []ConsentEventResponseDTO {
+	response := make([]ConsentEventResponseDTO, len(events))
+	for i, e := range events {
+		response[i] = ConsentEventResponseDTO{
+			ID:          e.ID,
+			ConsentID:   e.ConsentID,
+			PersonID:    e.PersonID,
+			Purpose:     e.Purpose,
+			Action:      e.Action,
+			LegalBasis:  e.LegalBasis,
+			Channel:     e.Channel,
+			EvidenceRef: e.EvidenceRef,
+			OperatorID:  e.OperatorID,
+			RequestID:   e.RequestID,
+			ClientIP:    e.ClientIP,
+			OccurredAt:  e.OccurredAt,
+		}
+	}
+	return response
+}
@@ -0,0 +1,67 @@
+package contract
+
+import (
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// ContactDTO represents the data required to create or replace a contact
+type ContactDTO struct {
+	Type     string `json:"type" example:"phone" binding:"required"`            // phone or email
+	Value    string `json:"value" example:"(81) 91234-5678" binding:"required"` // Phone number (Brazilian with DDD, or international starting with +) or email address
+	Label    string `json:"label,omitempty" example:"mobile"`                   // Free description, such as mobile, home, work, personal or corporate
+	Primary  bool   `json:"primary" example:"true"`                             // Whether this is the person's primary contact of its type
+	Verified bool   `json:"verified" example:"false"`                           // Whether the contact has been confirmed with the person
+}
+
+// ContactResponseDTO represents a contact returned by the API
+type ContactResponseDTO struct {
+	ID        int       `json:"id" example:"5"`                               // Unique contact ID
+	PersonID  int       `json:"person_id" example:"1"`                        // Owner of the contact
+	Type      string    `json:"type" example:"phone"`                         // phone or email
+	Value     string    `json:"value" example:"+5581912345678"`               // Phone number (E.164) or email address
+	National  string    `json:"national,omitempty" example:"(81) 91234-5678"` // Phone number as written in its country (phones only)
+	Label     string    `json:"label" example:"mobile"`                       // Free description
+	Primary   bool      `json:"primary" example:"true"`                       // Whether this is the person's primary contact of its type
+	Verified  bool      `json:"verified" example:"false"`                     // Whether the contact has been confirmed with the person
+	CreatedAt time.Time `json:"created_at" example:"2024-01-01T10:00:00Z"`    // Record creation timestamp
+	UpdatedAt time.Time `json:"updated_at" example:"2024-01-01T10:00:00Z"`    // Last update timestamp
+}
+
+// NewContactResponseDTO maps a domain contact to its API representation.
+func NewContactResponseDTO(c *person.Contact) ContactResponseDTO {
+	return ContactResponseDTO{
+		ID:        c.ID,
+		PersonID:  c.PersonID,
+		Type:      c.Type,
+		Value:     c.Value,
+		National:  nationalPhone(c.Type, c.Value),
+		Label:     c.Label,
+		Primary:   c.Primary,
+		Verified:  c.Verified,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// Mask returns a copy of the contact with its value masked.
+func (r ContactResponseDTO) Mask() ContactResponseDTO {
+	masked := r
+	if r.Type == person.ContactEmail {
+		masked.Value = person.MaskEmail(r.Value)
+	} else {
+		masked.Value = person.MaskPhone(r.Value)
+		masked.National = person.MaskPhone(r.National)
+	}
+	return masked
+}
+
+// nationalPhone formats phone contact values for display. Emails have no
+// national form.
+func nationalPhone(contactType, value string) string {
+	if contactType != person.ContactPhone {
+		return ""
+	}
+	return person.NationalPhone(value)
+}
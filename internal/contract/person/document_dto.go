@@ -0,0 +1,71 @@
+package contract
+
+import (
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// DocumentDTO represents the data required to create or replace an identity document
+type DocumentDTO struct {
+	Type          string     `json:"type" example:"rg" binding:"required"`                 // rg, cnh, passport, voter_id or pis
+	Number        string     `json:"number" example:"12.345.678-X" binding:"required"`     // Document number (can be formatted)
+	IssuingState  string     `json:"issuing_state,omitempty" example:"PE"`                 // UF of the issuer (RG only, required)
+	IssuingAgency string     `json:"issuing_agency,omitempty" example:"SSP"`               // Issuing agency (RG only, required)
+	Country       string     `json:"country,omitempty" example:"BR"`                       // ISO 3166-1 alpha-2 code of the issuer (passport only, required)
+	IssueDate     *time.Time `json:"issue_date,omitempty" example:"2015-06-01T00:00:00Z"`  // Date of issue
+	ExpiryDate    *time.Time `json:"expiry_date,omitempty" example:"2030-06-01T00:00:00Z"` // Expiry date (required for passports)
+}
+
+// DocumentResponseDTO represents an identity document returned by the API
+type DocumentResponseDTO struct {
+	ID            int        `json:"id" example:"4"`                                       // Unique document ID
+	PersonID      int        `json:"person_id" example:"1"`                                // Holder of the document
+	Type          string     `json:"type" example:"rg"`                                    // rg, cnh, passport, voter_id or pis
+	Number        string     `json:"number" example:"12345678X"`                           // Document number, without mask
+	IssuingState  string     `json:"issuing_state,omitempty" example:"PE"`                 // UF of the issuer (RG only)
+	IssuingAgency string     `json:"issuing_agency,omitempty" example:"SSP"`               // Issuing agency (RG only)
+	Country       string     `json:"country,omitempty" example:"BR"`                       // Country of the issuer (passport only)
+	IssueDate     *time.Time `json:"issue_date,omitempty" example:"2015-06-01T00:00:00Z"`  // Date of issue
+	ExpiryDate    *time.Time `json:"expiry_date,omitempty" example:"2030-06-01T00:00:00Z"` // Expiry date
+	Expired       bool       `json:"expired" example:"false"`                              // Whether the expiry date has passed
+	CreatedAt     time.Time  `json:"created_at" example:"2024-01-01T10:00:00Z"`            // Record creation timestamp
+	UpdatedAt     time.Time  `json:"updated_at" example:"2024-01-01T10:00:00Z"`            // Last update timestamp
+	Masked        bool       `json:"masked,omitempty" example:"false"`                     // Whether the number is masked
+}
+
+// NewDocumentResponseDTO maps a domain document to its API representation.
+func NewDocumentResponseDTO(d *person.Document) DocumentResponseDTO {
+	return DocumentResponseDTO{
+		ID:            d.ID,
+		PersonID:      d.PersonID,
+		Type:          d.Type,
+		Number:        d.Number,
+		IssuingState:  d.IssuingState,
+		IssuingAgency: d.IssuingAgency,
+		Country:       d.Country,
+		IssueDate:     d.IssueDate,
+		ExpiryDate:    d.ExpiryDate,
+		Expired:       d.IsExpired(time.Now()),
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
+
+// Mask returns a copy of the response with the number masked, for callers
+// not allowed to see it in full.
+func (r DocumentResponseDTO) Mask() DocumentResponseDTO {
+	masked := r
+	masked.Number = person.MaskDocumentNumber(r.Number)
+	masked.Masked = true
+	return masked
+}
+
+// NewDocumentResponseDTOs maps a list of domain documents to their API representation.
+func NewDocumentResponseDTOs(documents []*person.Document) []DocumentResponseDTO {
+	response := make([]DocumentResponseDTO, len(documents))
+	for i, d := range documents {
+		response[i] = NewDocumentResponseDTO(d)
+	}
+	return response
+}
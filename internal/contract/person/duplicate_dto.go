@@ -0,0 +1,33 @@
+package contract
+
+import (
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// DuplicateCandidateDTO represents a person that may be a duplicate of another
+type DuplicateCandidateDTO struct {
+	Score   float64           `json:"score" example:"0.85"`                    // Likelihood of being the same human, from 0 to 1
+	Reasons []string          `json:"reasons" example:"name,birth_date,email"` // Signals that matched: name, birth_date, cpf, phone or email
+	Person  PersonResponseDTO `json:"person"`                                  // The candidate
+}
+
+// MergeDTO represents the data required to merge a duplicate person into another
+type MergeDTO struct {
+	SurvivorID       int `json:"survivor_id" example:"1" binding:"required"`       // Person that is kept
+	SurvivorVersion  int `json:"survivor_version" example:"3" binding:"required"`  // Expected version of the survivor
+	DuplicateID      int `json:"duplicate_id" example:"2" binding:"required"`      // Person merged into the survivor and removed
+	DuplicateVersion int `json:"duplicate_version" example:"1" binding:"required"` // Expected version of the duplicate
+}
+
+// NewDuplicateCandidateDTOs maps duplicate candidates to their API representation.
+func NewDuplicateCandidateDTOs(candidates []person.DuplicateCandidate) []DuplicateCandidateDTO {
+	response := make([]DuplicateCandidateDTO, len(candidates))
+	for i, candidate := range candidates {
+		response[i] = DuplicateCandidateDTO{
+			Score:   candidate.Score,
+			Reasons: candidate.Reasons,
+			Person:  NewPersonResponseDTO(candidate.Person),
+		}
+	}
+	return response
+}
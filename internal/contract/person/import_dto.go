@@ -0,0 +1,45 @@
+package contract
+
+import person "pessoas-api/internal/domain/person/model"
+
+// ImportRow is one row read from an import file. Err is set when the row could
+// not be parsed; the import reports it and carries on with the next row.
+type ImportRow struct {
+	Line   int
+	Person NewPersonDTO
+	Err    error
+}
+
+// ImportReportDTO represents the result of a bulk import
+type ImportReportDTO struct {
+	DryRun    bool                 `json:"dry_run" example:"false"` // Whether rows were only validated
+	TotalRows int                  `json:"total_rows" example:"3"`  // Number of data rows in the file
+	Succeeded int                  `json:"succeeded" example:"2"`   // Rows imported (or valid, in a dry run)
+	Failed    int                  `json:"failed" example:"1"`      // Rows rejected
+	Rows      []ImportRowResultDTO `json:"rows"`                    // Outcome of every row, by line number
+}
+
+// ImportRowResultDTO represents the outcome of one row of an import file
+type ImportRowResultDTO struct {
+	Line      int    `json:"line" example:"4"`                           // Line number in the file
+	Status    string `json:"status" example:"failed"`                    // imported, valid (dry run) or failed
+	ID        int    `json:"id,omitempty" example:"12"`                  // ID of the imported person
+	ErrorCode string `json:"error_code,omitempty" example:"cpf_invalid"` // Machine readable error code
+	Message   string `json:"message,omitempty" example:"cpf is invalid"` // Error description
+}
+
+// NewImportReportDTO maps a domain import report to its API representation.
+func NewImportReportDTO(report *person.ImportReport) ImportReportDTO {
+	rows := make([]ImportRowResultDTO, len(report.Rows))
+	for i, row := range report.Rows {
+		rows[i] = ImportRowResultDTO(row)
+	}
+
+	return ImportReportDTO{
+		DryRun:    report.DryRun,
+		TotalRows: report.TotalRows,
+		Succeeded: report.Succeeded,
+		Failed:    report.Failed,
+		Rows:      rows,
+	}
+}
@@ -20,3 +20,12 @@ type SuccessResponse struct {
 	ID      int    `json:"id" example:"1"`                                        // ID of created resource
 	Message string `json:"message" example:"Person created successfully"`          // Success message
 }
+
+// CursorPaginatedResponse represents a page of a cursor (keyset) paginated listing
+type CursorPaginatedResponse struct {
+	Data       interface{} `json:"data" swaggertype:"array,object"`          // List of items in current page
+	PageSize   int         `json:"page_size" example:"10"`                   // Maximum number of items per page
+	NextCursor *string     `json:"next_cursor" example:"eyJzIjoiaWQiLCJvIjoiZGVzYyIsInYiOiI0MiIsImkiOjQyfQ"` // Cursor of the next page (null on the last page)
+	PrevCursor *string     `json:"prev_cursor" example:"eyJzIjoiaWQiLCJvIjoiZGVzYyIsInYiOiI1MSIsImkiOjUxLCJiIjp0cnVlfQ"` // Cursor of the previous page (null on the first page)
+	TotalItems *int64      `json:"total_items,omitempty" example:"100"`      // Total number of items (omitted when with_total=false)
+}
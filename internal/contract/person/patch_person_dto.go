@@ -0,0 +1,20 @@
+package contract
+
+import "time"
+
+// PatchPersonDTO represents a partial update of a person.
+// Only the fields present in the request are changed.
+type PatchPersonDTO struct {
+	Name        *string    `json:"name,omitempty" example:"João Silva"`
+	CPF         *string    `json:"cpf,omitempty" example:"111.444.777-35"`
+	BirthDate   *time.Time `json:"birth_date,omitempty" example:"1990-01-15T00:00:00Z"`
+	PhoneNumber *string    `json:"phone,omitempty" example:"81912345678"`
+	Email       *string    `json:"email,omitempty" example:"joao.silva@email.com"`
+}
+
+// JSONPatchOperationDTO represents a single RFC 6902 JSON Patch operation
+type JSONPatchOperationDTO struct {
+	Op    string      `json:"op" example:"replace"`                               // Operation: add or replace
+	Path  string      `json:"path" example:"/email"`                              // JSON Pointer to the target field
+	Value interface{} `json:"value" swaggertype:"string" example:"new@email.com"` // New value for the field
+}
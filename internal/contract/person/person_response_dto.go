@@ -1,15 +1,79 @@
 package contract
 
-import "time"
+import (
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+)
 
 // PersonResponseDTO represents person data returned by the API
 type PersonResponseDTO struct {
 	ID          int       `json:"id" example:"1"`                                       // Unique person ID
 	Name        string    `json:"name" example:"João Silva"`                            // Full name
-	CPF         string    `json:"cpf" example:"11144477735"`                            // Brazilian CPF (digits only)
-	BirthDate   time.Time `json:"birth_date" example:"1990-01-15T00:00:00Z"`            // Date of birth
-	PhoneNumber string    `json:"phone" example:"81912345678"`                          // Phone number (digits only)
-	Email       string    `json:"email" example:"joao.silva@email.com"`                 // Email address
+	CPF         string    `json:"cpf" example:"11144477735"`                            // Brazilian CPF (digits only), or ***.444.777-** when masked
+	BirthDate   string    `json:"birth_date" example:"1990-01-15T00:00:00Z"`            // Date of birth, or only its year as 1990-**-** when masked
+	PhoneNumber string    `json:"phone" example:"+5581912345678"`                       // Primary phone number (E.164)
+	PhoneNational string  `json:"phone_national" example:"(81) 91234-5678"`             // Primary phone number as written in its country
+	Email       string    `json:"email" example:"joao.silva@email.com"`                 // Primary email address
+	Contacts    []ContactResponseDTO `json:"contacts"`                              // Every phone number and email address, the primary ones first
+	Version     int       `json:"version" example:"1"`                                  // Record version, also sent as the ETag header
 	CreatedAt   time.Time `json:"created_at" example:"2024-01-01T10:00:00Z"`            // Record creation timestamp
 	UpdatedAt   time.Time `json:"updated_at" example:"2024-01-01T10:00:00Z"`            // Last update timestamp
+	DeletedAt   *time.Time `json:"deleted_at,omitempty" example:"2024-02-01T10:00:00Z"` // Soft deletion timestamp (only for deleted persons)
+	MergedIntoID *int     `json:"merged_into_id,omitempty" example:"1"`                // Person this one was merged into (only for merged persons)
+	AnonymizedAt *time.Time `json:"anonymized_at,omitempty" example:"2024-03-01T10:00:00Z"` // When the personal data was scrubbed (only for anonymized persons)
+	Masked      bool      `json:"masked,omitempty" example:"false"`                     // Whether CPF, birth date, emails and phones are masked; see POST /persons/{id}/reveal
+}
+
+// NewPersonResponseDTO maps a domain person to its API representation.
+func NewPersonResponseDTO(p *person.Person) PersonResponseDTO {
+	contacts := make([]ContactResponseDTO, len(p.Contacts))
+	for i := range p.Contacts {
+		contacts[i] = NewContactResponseDTO(&p.Contacts[i])
+	}
+
+	return PersonResponseDTO{
+		ID:          p.ID,
+		Name:        p.Name,
+		CPF:         p.CPF,
+		BirthDate:   p.BirthDate.Format(time.RFC3339),
+		PhoneNumber: p.Phone(),
+		PhoneNational: person.NationalPhone(p.Phone()),
+		Email:       p.Email(),
+		Contacts:    contacts,
+		Version:     p.Version,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		DeletedAt:   p.DeletedAt,
+		MergedIntoID: p.MergedIntoID,
+		AnonymizedAt: p.AnonymizedAt,
+	}
+}
+
+// Mask returns a copy of the response with the CPF, the birth date and
+// every email and phone masked, for callers not allowed to see them in full.
+func (r PersonResponseDTO) Mask() PersonResponseDTO {
+	masked := r
+	masked.CPF = person.MaskCPF(r.CPF)
+	if birthDate, err := time.Parse(time.RFC3339, r.BirthDate); err == nil {
+		masked.BirthDate = person.MaskBirthDate(birthDate)
+	}
+	masked.PhoneNumber = person.MaskPhone(r.PhoneNumber)
+	masked.PhoneNational = person.MaskPhone(r.PhoneNational)
+	masked.Email = person.MaskEmail(r.Email)
+	masked.Contacts = make([]ContactResponseDTO, len(r.Contacts))
+	for i, contact := range r.Contacts {
+		masked.Contacts[i] = contact.Mask()
+	}
+	masked.Masked = true
+	return masked
+}
+
+// NewPersonResponseDTOs maps a list of domain persons to their API representation.
+func NewPersonResponseDTOs(persons []*person.Person) []PersonResponseDTO {
+	response := make([]PersonResponseDTO, len(persons))
+	for i, p := range persons {
+		response[i] = NewPersonResponseDTO(p)
+	}
+	return response
 }
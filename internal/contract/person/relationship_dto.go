@@ -0,0 +1,74 @@
+package contract
+
+import (
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// RelationshipDTO represents the data required to link a person to another
+type RelationshipDTO struct {
+	RelatedPersonID int    `json:"related_person_id" example:"2" binding:"required"` // Person linked to the one in the path
+	Type            string `json:"type" example:"guardian" binding:"required"`       // What the related person is to the one in the path: parent, child, spouse, guardian, ward, legal_representative, represented, emergency_contact or emergency_contact_for
+}
+
+// RelationshipResponseDTO represents a relationship returned by the API
+type RelationshipResponseDTO struct {
+	ID              int       `json:"id" example:"3"`                            // Unique relationship ID
+	PersonID        int       `json:"person_id" example:"1"`                     // Person the relationship is seen from
+	RelatedPersonID int       `json:"related_person_id" example:"2"`             // Person linked to it
+	Type            string    `json:"type" example:"guardian"`                   // What the related person is to the person
+	CreatedAt       time.Time `json:"created_at" example:"2024-01-01T10:00:00Z"` // Record creation timestamp
+}
+
+// FamilyMemberDTO represents a person reached while walking relationships
+type FamilyMemberDTO struct {
+	Distance int               `json:"distance" example:"1"` // Number of links from the starting person
+	Person   PersonResponseDTO `json:"person"`               // The person
+}
+
+// FamilyGraphDTO represents the persons linked to a person up to some depth
+type FamilyGraphDTO struct {
+	PersonID      int                       `json:"person_id" example:"1"` // Starting person
+	Depth         int                       `json:"depth" example:"2"`     // Maximum number of links walked
+	Members       []FamilyMemberDTO         `json:"members"`               // Persons reached, the starting person first, by distance
+	Relationships []RelationshipResponseDTO `json:"relationships"`         // Relationships among the members
+}
+
+// NewRelationshipResponseDTO maps a domain relationship to its API representation.
+func NewRelationshipResponseDTO(r *person.Relationship) RelationshipResponseDTO {
+	return RelationshipResponseDTO{
+		ID:              r.ID,
+		PersonID:        r.PersonID,
+		RelatedPersonID: r.RelatedID,
+		Type:            r.Type,
+		CreatedAt:       r.CreatedAt,
+	}
+}
+
+// NewRelationshipResponseDTOs maps a list of domain relationships to their API representation.
+func NewRelationshipResponseDTOs(relationships []*person.Relationship) []RelationshipResponseDTO {
+	response := make([]RelationshipResponseDTO, len(relationships))
+	for i, r := range relationships {
+		response[i] = NewRelationshipResponseDTO(r)
+	}
+	return response
+}
+
+// NewFamilyGraphDTO maps a family graph to its API representation.
+func NewFamilyGraphDTO(g *person.FamilyGraph) FamilyGraphDTO {
+	members := make([]FamilyMemberDTO, len(g.Members))
+	for i, member := range g.Members {
+		members[i] = FamilyMemberDTO{
+			Distance: member.Distance,
+			Person:   NewPersonResponseDTO(member.Person),
+		}
+	}
+
+	return FamilyGraphDTO{
+		PersonID:      g.PersonID,
+		Depth:         g.Depth,
+		Members:       members,
+		Relationships: NewRelationshipResponseDTOs(g.Relationships),
+	}
+}
@@ -0,0 +1,7 @@
+package contract
+
+// RevealPersonDTO represents the justification to see the masked personal
+// data of a person in full
+type RevealPersonDTO struct {
+	Reason string `json:"reason" example:"Customer identity check on call 2024-118" binding:"required"` // Why the full data is needed; recorded in the audit trail
+}
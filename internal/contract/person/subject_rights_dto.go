@@ -0,0 +1,98 @@
+package contract
+
+import (
+	"time"
+
+	auditContract "pessoas-api/internal/contract/audit"
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// SubjectDTO identifies the data subject of an LGPD request
+type SubjectDTO struct {
+	CPF string `json:"cpf" example:"111.444.777-35" binding:"required"` // CPF of the data subject (with or without formatting)
+}
+
+// SubjectReportDTO represents everything stored about a data subject
+type SubjectReportDTO struct {
+	RequestID      int                           `json:"request_id" example:"12"`                     // ID of the logged request
+	CPF            string                        `json:"cpf" example:"11144477735"`                   // CPF of the data subject (digits only)
+	GeneratedAt    time.Time                     `json:"generated_at" example:"2024-03-01T10:00:00Z"` // When the report was generated
+	Persons        []SubjectRecordDTO            `json:"persons"`                                     // Every person registered with the CPF, deleted or not, and the persons merged into them
+	Relationships  []RelationshipResponseDTO     `json:"relationships"`                               // Relationships involving any of the persons
+	History        []auditContract.AuditEntryDTO `json:"history" swaggertype:"array,object"`          // Changes to the persons and their records, newest first
+	ConsentHistory []ConsentEventResponseDTO     `json:"consent_history"`                             // Grants and revocations of consents of the persons, newest first
+}
+
+// SubjectRecordDTO represents a person of a subject report with the records it owns
+type SubjectRecordDTO struct {
+	Person    PersonResponseDTO     `json:"person"`    // The person, with its contacts
+	Addresses []AddressResponseDTO  `json:"addresses"` // Postal addresses of the person
+	Documents []DocumentResponseDTO `json:"documents"` // Identity documents of the person
+	Consents  []ConsentResponseDTO  `json:"consents"`  // Consents of the person, active or revoked
+}
+
+// SubjectRequestResponseDTO represents a logged LGPD request
+type SubjectRequestResponseDTO struct {
+	ID         int       `json:"id" example:"12"`                           // Unique request ID
+	Type       string    `json:"type" example:"access"`                     // access, portability or anonymization
+	SubjectCPF string    `json:"subject_cpf" example:"***.444.777-**"`      // Masked CPF of the data subject
+	PersonIDs  []int     `json:"person_ids" example:"1,2"`                  // Persons covered by the request
+	Status     string    `json:"status" example:"completed"`                // completed, or not_found when no person holds the CPF
+	OperatorID int       `json:"operator_id" example:"3"`                   // Operator who made the request
+	RequestID  string    `json:"request_id" example:"5f2b7c1e9a0d4e36"`     // Request ID (X-Request-ID) of the request
+	ClientIP   string    `json:"client_ip" example:"203.0.113.10"`          // Client IP address
+	CreatedAt  time.Time `json:"created_at" example:"2024-03-01T10:00:00Z"` // When the request was answered
+}
+
+// NewSubjectReportDTO maps a subject report to its API representation.
+func NewSubjectReportDTO(r *person.SubjectReport) SubjectReportDTO {
+	records := make([]SubjectRecordDTO, len(r.Records))
+	for i, record := range r.Records {
+		addresses := make([]AddressResponseDTO, len(record.Addresses))
+		for j, address := range record.Addresses {
+			addresses[j] = NewAddressResponseDTO(address)
+		}
+
+		records[i] = SubjectRecordDTO{
+			Person:    NewPersonResponseDTO(record.Person),
+			Addresses: addresses,
+			Documents: NewDocumentResponseDTOs(record.Documents),
+			Consents:  NewConsentResponseDTOs(record.Consents),
+		}
+	}
+
+	history := make([]auditContract.AuditEntryDTO, len(r.History))
+	for i, entry := range r.History {
+		history[i] = auditContract.NewAuditEntryDTO(entry)
+	}
+
+	return SubjectReportDTO{
+		RequestID:      r.RequestID,
+		CPF:            r.CPF,
+		GeneratedAt:    r.GeneratedAt,
+		Persons:        records,
+		Relationships:  NewRelationshipResponseDTOs(r.Relationships),
+		History:        history,
+		ConsentHistory: NewConsentEventResponseDTOs(r.ConsentHistory),
+	}
+}
+
+// NewSubjectRequestResponseDTO maps a logged LGPD request to its API representation.
+func NewSubjectRequestResponseDTO(r *person.SubjectRequest) SubjectRequestResponseDTO {
+	personIDs := r.PersonIDs
+	if personIDs == nil {
+		personIDs = []int{}
+	}
+
+	return SubjectRequestResponseDTO{
+		ID:         r.ID,
+		Type:       r.Type,
+		SubjectCPF: r.SubjectCPF,
+		PersonIDs:  personIDs,
+		Status:     r.Status,
+		OperatorID: r.OperatorID,
+		RequestID:  r.RequestID,
+		ClientIP:   r.ClientIP,
+		CreatedAt:  r.CreatedAt,
+	}
+}
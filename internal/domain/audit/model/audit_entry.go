@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Entity types tracked by the audit trail.
+const (
+	EntityPerson       = "person"
+	EntityAddress      = "address"
+	EntityContact      = "contact"
+	EntityCompany      = "company"
+	EntityDocument     = "document"
+	EntityRelationship = "relationship"
+	EntityOperator     = "operator"
+)
+
+// Actions recorded in the audit trail.
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionDelete    = "delete"
+	ActionRestore   = "restore"
+	ActionPurge     = "purge"
+	ActionMerge     = "merge"
+	ActionAnonymize = "anonymize"
+	ActionReveal    = "reveal"
+)
+
+// Actor identifies who performed a change and from where.
+type Actor struct {
+	OperatorID int
+	RequestID  string
+	ClientIP   string
+}
+
+// AuditEntry records a single change to an entity, with JSON snapshots of its
+// state before and after the change. Before is nil for creations and After is
+// nil for permanent deletions.
+type AuditEntry struct {
+	ID         int
+	EntityType string
+	EntityID   int
+	Action     string
+	OperatorID int
+	RequestID  string
+	ClientIP   string
+	Before     json.RawMessage
+	After      json.RawMessage
+	CreatedAt  time.Time
+}
+
+func NewAuditEntry(entityType string, entityID int, action string, actor Actor, before, after json.RawMessage) *AuditEntry {
+	return &AuditEntry{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		OperatorID: actor.OperatorID,
+		RequestID:  actor.RequestID,
+		ClientIP:   actor.ClientIP,
+		Before:     before,
+		After:      after,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// snapshotReferences are the snapshot fields linking an entry to persons and
+// records, the only ones left by RedactSnapshot.
+var snapshotReferences = []string{"id", "person_id", "related_person_id"}
+
+// RedactSnapshot strips a snapshot down to its references, so that an entry
+// no longer holds personal data but can still be traced to its records.
+// Snapshots that are not JSON objects are removed entirely.
+func RedactSnapshot(snapshot json.RawMessage) json.RawMessage {
+	if len(snapshot) == 0 {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(snapshot, &fields); err != nil {
+		return nil
+	}
+
+	redacted := make(map[string]json.RawMessage)
+	for _, key := range snapshotReferences {
+		if value, ok := fields[key]; ok {
+			redacted[key] = value
+		}
+	}
+
+	result, err := json.Marshal(redacted)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
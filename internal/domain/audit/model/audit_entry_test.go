@@ -0,0 +1,21 @@
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactSnapshot(t *testing.T) {
+	assert := assert.New(t)
+
+	redacted := RedactSnapshot(json.RawMessage(`{"id":3,"person_id":1,"number":"FZ123456","type":"passport"}`))
+	assert.JSONEq(`{"id":3,"person_id":1}`, string(redacted))
+
+	redacted = RedactSnapshot(json.RawMessage(`{"id":1,"name":"José da Silva","cpf":"11144477735"}`))
+	assert.JSONEq(`{"id":1}`, string(redacted))
+
+	assert.Nil(RedactSnapshot(nil))
+	assert.Nil(RedactSnapshot(json.RawMessage(`"not an object"`)))
+}
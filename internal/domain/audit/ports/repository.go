@@ -0,0 +1,18 @@
+package ports
+
+import audit "pessoas-api/internal/domain/audit/model"
+
+// AuditRepository defines the contract for audit trail persistence.
+// Entries are append-only: they are never updated nor deleted, except by the
+// anonymization of a data subject, which scrubs their snapshots. FindByEntities
+// lists the entries of several entities of one type together, newest first.
+// FindBySubject lists every entry about the given persons, newest first: their
+// own changes and the changes to their contacts, addresses, documents and
+// relationships.
+type AuditRepository interface {
+	Save(entry *audit.AuditEntry) error
+	SaveAll(entries []*audit.AuditEntry) error
+	FindByEntity(entityType string, entityID int, page, size int) ([]*audit.AuditEntry, int64, error)
+	FindByEntities(entityType string, entityIDs []int, page, size int) ([]*audit.AuditEntry, int64, error)
+	FindBySubject(personIDs []int) ([]*audit.AuditEntry, error)
+}
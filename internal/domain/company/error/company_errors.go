@@ -0,0 +1,14 @@
+package company
+
+import "errors"
+
+var (
+	ErrCNPJRequired             = errors.New("cnpj is required")
+	ErrCNPJInvalid              = errors.New("cnpj is invalid")
+	ErrLegalNameRequired        = errors.New("legal name is required")
+	ErrFoundingDateInvalid      = errors.New("founding date is invalid")
+	ErrStateRegistrationInvalid = errors.New("state registration must be ISENTO or have 2 to 14 digits")
+	ErrCompanyNotFound          = errors.New("company not found")
+	ErrVersionConflict          = errors.New("company was modified by another request")
+	ErrCNPJAlreadyInUse         = errors.New("cnpj is already registered to another company")
+)
@@ -0,0 +1,77 @@
+package company
+
+import "strings"
+
+// CNPJLength is the number of characters of a CNPJ without its mask.
+const CNPJLength = 14
+
+var (
+	cnpjFirstWeights  = []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	cnpjSecondWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+// NormalizeCNPJ strips the mask of a CNPJ and uppercases its letters, so that
+// "12.ABC.345/01de-35" becomes "12ABC34501DE35". Any character other than a
+// letter or a digit is dropped.
+func NormalizeCNPJ(input string) string {
+	var b strings.Builder
+
+	for _, r := range strings.ToUpper(input) {
+		if (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// ValidCNPJ checks a normalized CNPJ. Besides the numeric format, it accepts the
+// alphanumeric one introduced by the Receita Federal in July 2026, in which the
+// first 12 characters may be uppercase letters. The last 2 are always check
+// digits, computed modulo 11 with each character worth its ASCII code minus 48.
+func ValidCNPJ(cnpj string) bool {
+	if len(cnpj) != CNPJLength {
+		return false
+	}
+
+	for i := 0; i < CNPJLength; i++ {
+		c := cnpj[i]
+		isDigit := c >= '0' && c <= '9'
+		isLetter := c >= 'A' && c <= 'Z'
+		if !isDigit && (i >= 12 || !isLetter) {
+			return false
+		}
+	}
+
+	if strings.Count(cnpj, cnpj[:1]) == CNPJLength {
+		return false
+	}
+
+	return cnpj[12] == cnpjCheckDigit(cnpj[:12], cnpjFirstWeights) &&
+		cnpj[13] == cnpjCheckDigit(cnpj[:13], cnpjSecondWeights)
+}
+
+func cnpjCheckDigit(base string, weights []int) byte {
+	sum := 0
+
+	for i := range weights {
+		sum += int(base[i]-'0') * weights[i]
+	}
+
+	remainder := sum % 11
+	if remainder < 2 {
+		return '0'
+	}
+
+	return byte('0' + (11 - remainder))
+}
+
+// FormatCNPJ applies the 00.000.000/0000-00 mask to a 14 character CNPJ. Any
+// other input is returned unchanged.
+func FormatCNPJ(cnpj string) string {
+	if len(cnpj) != CNPJLength {
+		return cnpj
+	}
+
+	return cnpj[0:2] + "." + cnpj[2:5] + "." + cnpj[5:8] + "/" + cnpj[8:12] + "-" + cnpj[12:14]
+}
@@ -0,0 +1,142 @@
+package company
+
+import (
+	"strings"
+	"time"
+
+	companyErr "pessoas-api/internal/domain/company/error"
+)
+
+// StateRegistrationExempt is the state registration of companies that are not
+// required to have one.
+const StateRegistrationExempt = "ISENTO"
+
+// Company is the aggregate root of the legal entity domain. It is identified
+// by its CNPJ, which may be numeric or alphanumeric.
+type Company struct {
+	ID                int
+	CNPJ              string
+	LegalName         string
+	TradeName         string
+	FoundingDate      time.Time
+	StateRegistration string
+	Version           int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time
+}
+
+// CompanyFields are the values of a company set by its clients.
+type CompanyFields struct {
+	CNPJ              string
+	LegalName         string
+	TradeName         string
+	FoundingDate      time.Time
+	StateRegistration string
+}
+
+// NewCompany creates a company from the given fields, normalizing its CNPJ
+// and state registration.
+func NewCompany(fields CompanyFields) (*Company, error) {
+	now := time.Now()
+
+	company := &Company{
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	company.setFields(fields)
+
+	if err := company.Validate(); err != nil {
+		return nil, err
+	}
+
+	return company, nil
+}
+
+// Replace sets every field of the company and validates the result. The company
+// is only modified when the result is valid.
+func (c *Company) Replace(fields CompanyFields) error {
+	candidate := *c
+	candidate.setFields(fields)
+
+	if err := candidate.Validate(); err != nil {
+		return err
+	}
+
+	candidate.UpdatedAt = time.Now()
+	*c = candidate
+
+	return nil
+}
+
+// IsDeleted reports whether the company has been soft deleted.
+func (c *Company) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// Validate checks the business rules of the company aggregate.
+func (c *Company) Validate() error {
+	if c.CNPJ == "" {
+		return companyErr.ErrCNPJRequired
+	}
+	if !ValidCNPJ(c.CNPJ) {
+		return companyErr.ErrCNPJInvalid
+	}
+	if c.LegalName == "" {
+		return companyErr.ErrLegalNameRequired
+	}
+	if c.FoundingDate.IsZero() || c.FoundingDate.After(time.Now()) {
+		return companyErr.ErrFoundingDateInvalid
+	}
+	if !validStateRegistration(c.StateRegistration) {
+		return companyErr.ErrStateRegistrationInvalid
+	}
+
+	return nil
+}
+
+func (c *Company) setFields(fields CompanyFields) {
+	c.CNPJ = NormalizeCNPJ(fields.CNPJ)
+	c.LegalName = strings.TrimSpace(fields.LegalName)
+	c.TradeName = strings.TrimSpace(fields.TradeName)
+	c.FoundingDate = fields.FoundingDate
+	c.StateRegistration = normalizeStateRegistration(fields.StateRegistration)
+}
+
+// normalizeStateRegistration strips the mask of a state registration, whose
+// format varies from state to state.
+func normalizeStateRegistration(input string) string {
+	input = strings.ToUpper(strings.TrimSpace(input))
+	if input == StateRegistrationExempt {
+		return input
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '-', '/', ' ':
+			return -1
+		}
+		return r
+	}, input)
+}
+
+// validStateRegistration accepts an empty state registration, the exemption
+// marker or 2 to 14 digits. Check digits are not verified, since every state
+// computes them differently.
+func validStateRegistration(value string) bool {
+	if value == "" || value == StateRegistrationExempt {
+		return true
+	}
+	if len(value) < 2 || len(value) > 14 {
+		return false
+	}
+
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,137 @@
+package company
+
+import (
+	"testing"
+	"time"
+
+	companyErr "pessoas-api/internal/domain/company/error"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validCompanyFields() CompanyFields {
+	return CompanyFields{
+		CNPJ:              "12.abc.345/01de-35",
+		LegalName:         "  Padaria Pão Quente Ltda ",
+		TradeName:         "Pão Quente",
+		FoundingDate:      time.Date(2010, time.March, 1, 0, 0, 0, 0, time.UTC),
+		StateRegistration: "0321418-40",
+	}
+}
+
+func TestNewCompany_ShouldCreateCompany_WhenInputIsValid(t *testing.T) {
+	assert := assert.New(t)
+
+	company, err := NewCompany(validCompanyFields())
+
+	assert.NoError(err)
+	assert.Equal("12ABC34501DE35", company.CNPJ)
+	assert.Equal("Padaria Pão Quente Ltda", company.LegalName)
+	assert.Equal("Pão Quente", company.TradeName)
+	assert.Equal("032141840", company.StateRegistration)
+	assert.Equal(1, company.Version)
+	assert.WithinDuration(time.Now(), company.CreatedAt, time.Second)
+}
+
+func TestNewCompany_ShouldAcceptOptionalFields_WhenEmptyOrExempt(t *testing.T) {
+	assert := assert.New(t)
+
+	fields := validCompanyFields()
+	fields.TradeName = ""
+	fields.StateRegistration = ""
+
+	company, err := NewCompany(fields)
+	assert.NoError(err)
+	assert.Equal("", company.StateRegistration)
+
+	fields.StateRegistration = " isento "
+
+	company, err = NewCompany(fields)
+	assert.NoError(err)
+	assert.Equal(StateRegistrationExempt, company.StateRegistration)
+}
+
+func TestNewCompany_ShouldFail_WhenInputIsInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*CompanyFields)
+		err    error
+	}{
+		{"empty cnpj", func(f *CompanyFields) { f.CNPJ = " ./- " }, companyErr.ErrCNPJRequired},
+		{"invalid cnpj", func(f *CompanyFields) { f.CNPJ = "11.222.333/0001-80" }, companyErr.ErrCNPJInvalid},
+		{"empty legal name", func(f *CompanyFields) { f.LegalName = "  " }, companyErr.ErrLegalNameRequired},
+		{"zero founding date", func(f *CompanyFields) { f.FoundingDate = time.Time{} }, companyErr.ErrFoundingDateInvalid},
+		{"future founding date", func(f *CompanyFields) { f.FoundingDate = time.Now().AddDate(0, 0, 1) }, companyErr.ErrFoundingDateInvalid},
+		{"state registration with letters", func(f *CompanyFields) { f.StateRegistration = "12AB" }, companyErr.ErrStateRegistrationInvalid},
+		{"state registration too short", func(f *CompanyFields) { f.StateRegistration = "1" }, companyErr.ErrStateRegistrationInvalid},
+		{"state registration too long", func(f *CompanyFields) { f.StateRegistration = "123456789012345" }, companyErr.ErrStateRegistrationInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := validCompanyFields()
+			tt.modify(&fields)
+
+			company, err := NewCompany(fields)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Nil(t, company)
+		})
+	}
+}
+
+func TestReplace_ShouldKeepCompany_WhenResultIsInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	company, _ := NewCompany(validCompanyFields())
+	original := *company
+
+	fields := validCompanyFields()
+	fields.CNPJ = "00000000000000"
+
+	assert.ErrorIs(company.Replace(fields), companyErr.ErrCNPJInvalid)
+	assert.Equal(original, *company)
+
+	fields.CNPJ = "11.222.333/0001-81"
+	fields.LegalName = "Padaria Nova Ltda"
+
+	assert.NoError(company.Replace(fields))
+	assert.Equal("11222333000181", company.CNPJ)
+	assert.Equal("Padaria Nova Ltda", company.LegalName)
+	assert.Equal(original.CreatedAt, company.CreatedAt)
+}
+
+func TestValidCNPJ(t *testing.T) {
+	tests := []struct {
+		cnpj  string
+		valid bool
+	}{
+		{"11222333000181", true},
+		{"11444777000161", true},
+		{"12ABC34501DE35", true},
+		{"AB123456000110", true},
+		{"11222333000180", false},
+		{"12ABC34501DE36", false},
+		{"12ABC34501DE3A", false},
+		{"00000000000000", false},
+		{"AAAAAAAAAAAAAA", false},
+		{"1122233300018", false},
+		{"112223330001810", false},
+		{"12abc34501de35", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cnpj, func(t *testing.T) {
+			assert.Equal(t, tt.valid, ValidCNPJ(tt.cnpj))
+		})
+	}
+}
+
+func TestNormalizeAndFormatCNPJ(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("12ABC34501DE35", NormalizeCNPJ(" 12.abc.345/01de-35 "))
+	assert.Equal("11222333000181", NormalizeCNPJ("11.222.333/0001-81"))
+	assert.Equal("12.ABC.345/01DE-35", FormatCNPJ("12ABC34501DE35"))
+	assert.Equal("123", FormatCNPJ("123"))
+}
@@ -0,0 +1,17 @@
+package ports
+
+import company "pessoas-api/internal/domain/company/model"
+
+// CompanyRepository defines the contract for company data persistence operations.
+// Update and Delete only succeed when the stored version matches the given one and
+// return ErrVersionConflict otherwise. Successful writes increment the company's version.
+// Soft deleted companies are ignored by every method except FindByIDIncludingDeleted.
+type CompanyRepository interface {
+	Save(company *company.Company) (ID int, err error)
+	Update(company *company.Company) error
+	Delete(id int, version int) error
+	FindAll(page, pageSize int, sortBy, sortOrder string) ([]*company.Company, int64, error)
+	FindByID(id int) (*company.Company, error)
+	FindByIDIncludingDeleted(id int) (*company.Company, error)
+	FindByCNPJ(cnpj string) (*company.Company, error)
+}
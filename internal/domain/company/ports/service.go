@@ -0,0 +1,16 @@
+package ports
+
+import (
+	contract "pessoas-api/internal/contract/company"
+	audit "pessoas-api/internal/domain/audit/model"
+	company "pessoas-api/internal/domain/company/model"
+)
+
+type CompanyService interface {
+	CreateCompany(dto contract.CompanyDTO, actor audit.Actor) (*company.Company, error)
+	UpdateCompany(id int, version int, dto contract.CompanyDTO, actor audit.Actor) (*company.Company, error)
+	DeleteCompany(id int, version int, actor audit.Actor) error
+	ListCompanies(page, pageSize int, sort, order string) ([]*company.Company, int64, error)
+	FindCompanyByID(id int) (*company.Company, error)
+	FindCompanyByCNPJ(cnpj string) (*company.Company, error)
+}
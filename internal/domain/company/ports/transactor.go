@@ -0,0 +1,10 @@
+package ports
+
+import auditPorts "pessoas-api/internal/domain/audit/ports"
+
+// Transactor runs a company write and its audit entry in one database
+// transaction. The repositories handed to fn are bound to the transaction,
+// which is committed when fn returns nil and rolled back otherwise.
+type Transactor interface {
+	WithinTransaction(fn func(companies CompanyRepository, audits auditPorts.AuditRepository) error) error
+}
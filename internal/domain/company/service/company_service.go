@@ -0,0 +1,224 @@
+package company
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	contract "pessoas-api/internal/contract/company"
+	audit "pessoas-api/internal/domain/audit/model"
+	auditPorts "pessoas-api/internal/domain/audit/ports"
+	companyError "pessoas-api/internal/domain/company/error"
+	company "pessoas-api/internal/domain/company/model"
+	"pessoas-api/internal/domain/company/ports"
+)
+
+// CompanyServiceImpl implements the ports.CompanyService interface.
+// Every write is recorded in the audit trail with the acting operator, within
+// the transaction of the write.
+type CompanyServiceImpl struct {
+	repository ports.CompanyRepository
+	transactor ports.Transactor
+}
+
+// NewCompanyService creates a new instance of CompanyServiceImpl.
+// It returns the implementation as the CompanyService interface.
+func NewCompanyService(repository ports.CompanyRepository, transactor ports.Transactor) ports.CompanyService {
+	return &CompanyServiceImpl{
+		repository: repository,
+		transactor: transactor,
+	}
+}
+
+func (s *CompanyServiceImpl) CreateCompany(dto contract.CompanyDTO, actor audit.Actor) (*company.Company, error) {
+	newCompany, err := company.NewCompany(companyFields(dto))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireAvailableCNPJ(newCompany.CNPJ, 0); err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(func(companies ports.CompanyRepository, audits auditPorts.AuditRepository) error {
+		id, err := companies.Save(newCompany)
+		if err != nil {
+			return err
+		}
+
+		newCompany.ID = id
+		return recordChange(audits, id, audit.ActionCreate, actor, nil, newCompany)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newCompany, nil
+}
+
+func (s *CompanyServiceImpl) UpdateCompany(id int, version int, dto contract.CompanyDTO, actor audit.Actor) (*company.Company, error) {
+	existing, err := s.FindCompanyByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.Version != version {
+		return nil, companyError.ErrVersionConflict
+	}
+
+	updated := *existing
+	if err := updated.Replace(companyFields(dto)); err != nil {
+		return nil, err
+	}
+
+	if updated.CNPJ != existing.CNPJ {
+		if err := s.requireAvailableCNPJ(updated.CNPJ, id); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.transactor.WithinTransaction(func(companies ports.CompanyRepository, audits auditPorts.AuditRepository) error {
+		if err := companies.Update(&updated); err != nil {
+			return err
+		}
+
+		current, err := companies.FindByIDIncludingDeleted(id)
+		if err != nil {
+			return err
+		}
+
+		return recordChange(audits, id, audit.ActionUpdate, actor, existing, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (s *CompanyServiceImpl) DeleteCompany(id int, version int, actor audit.Actor) error {
+	existing, err := s.FindCompanyByID(id)
+	if err != nil {
+		return err
+	}
+
+	if existing.Version != version {
+		return companyError.ErrVersionConflict
+	}
+
+	return s.transactor.WithinTransaction(func(companies ports.CompanyRepository, audits auditPorts.AuditRepository) error {
+		if err := companies.Delete(id, version); err != nil {
+			return err
+		}
+
+		current, err := companies.FindByIDIncludingDeleted(id)
+		if err != nil {
+			return err
+		}
+
+		return recordChange(audits, id, audit.ActionDelete, actor, existing, current)
+	})
+}
+
+func (s *CompanyServiceImpl) ListCompanies(page, pageSize int, sort, order string) ([]*company.Company, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+	if sort == "" {
+		sort = "id"
+	}
+	if order == "" {
+		order = "desc"
+	}
+
+	return s.repository.FindAll(page, pageSize, sort, order)
+}
+
+func (s *CompanyServiceImpl) FindCompanyByID(id int) (*company.Company, error) {
+	found, err := s.repository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, companyError.ErrCompanyNotFound
+	}
+
+	return found, nil
+}
+
+// FindCompanyByCNPJ looks up an active company by its CNPJ, with or without
+// mask. A CNPJ that fails validation is reported as ErrCNPJInvalid.
+func (s *CompanyServiceImpl) FindCompanyByCNPJ(cnpj string) (*company.Company, error) {
+	cnpj = company.NormalizeCNPJ(cnpj)
+	if !company.ValidCNPJ(cnpj) {
+		return nil, companyError.ErrCNPJInvalid
+	}
+
+	found, err := s.repository.FindByCNPJ(cnpj)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, companyError.ErrCompanyNotFound
+	}
+
+	return found, nil
+}
+
+// requireAvailableCNPJ fails when an active company other than the given one
+// is already registered with the CNPJ.
+func (s *CompanyServiceImpl) requireAvailableCNPJ(cnpj string, id int) error {
+	holder, err := s.repository.FindByCNPJ(cnpj)
+	if err != nil {
+		return err
+	}
+
+	if holder != nil && holder.ID != id {
+		return companyError.ErrCNPJAlreadyInUse
+	}
+
+	return nil
+}
+
+// recordChange appends an entry to the audit trail within the transaction of
+// the write it describes. The after state is reloaded by the caller so the
+// snapshot reflects what was persisted (new version, timestamps, deletion
+// mark). A failure is returned so that the write is rolled back along with it.
+func recordChange(audits auditPorts.AuditRepository, id int, action string, actor audit.Actor, before, after *company.Company) error {
+	entry := audit.NewAuditEntry(audit.EntityCompany, id, action, actor, companySnapshot(before), companySnapshot(after))
+
+	if err := audits.Save(entry); err != nil {
+		log.Printf("[ERROR] CompanyService - Failed to record %s of company ID %d by operator %d: %v", action, id, actor.OperatorID, err)
+		return fmt.Errorf("failed to record %s in the audit trail: %w", action, err)
+	}
+
+	return nil
+}
+
+func companyFields(dto contract.CompanyDTO) company.CompanyFields {
+	return company.CompanyFields{
+		CNPJ:              dto.CNPJ,
+		LegalName:         dto.LegalName,
+		TradeName:         dto.TradeName,
+		FoundingDate:      dto.FoundingDate,
+		StateRegistration: dto.StateRegistration,
+	}
+}
+
+func companySnapshot(c *company.Company) json.RawMessage {
+	if c == nil {
+		return nil
+	}
+
+	snapshot, err := json.Marshal(contract.NewCompanyResponseDTO(c))
+	if err != nil {
+		return nil
+	}
+
+	return snapshot
+}
@@ -0,0 +1,355 @@
+package company
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/company"
+	audit "pessoas-api/internal/domain/audit/model"
+	auditPorts "pessoas-api/internal/domain/audit/ports"
+	companyError "pessoas-api/internal/domain/company/error"
+	company "pessoas-api/internal/domain/company/model"
+	"pessoas-api/internal/domain/company/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type repositoryMock struct {
+	mock.Mock
+}
+
+func (r *repositoryMock) Save(c *company.Company) (int, error) {
+	args := r.Called(c)
+	return args.Int(0), args.Error(1)
+}
+
+func (r *repositoryMock) Update(c *company.Company) error {
+	args := r.Called(c)
+	return args.Error(0)
+}
+
+func (r *repositoryMock) Delete(id int, version int) error {
+	args := r.Called(id, version)
+	return args.Error(0)
+}
+
+func (r *repositoryMock) FindAll(page, pageSize int, sortBy, sortOrder string) ([]*company.Company, int64, error) {
+	args := r.Called(page, pageSize, sortBy, sortOrder)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*company.Company), args.Get(1).(int64), args.Error(2)
+}
+
+func (r *repositoryMock) FindByID(id int) (*company.Company, error) {
+	args := r.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*company.Company), args.Error(1)
+}
+
+func (r *repositoryMock) FindByIDIncludingDeleted(id int) (*company.Company, error) {
+	args := r.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*company.Company), args.Error(1)
+}
+
+func (r *repositoryMock) FindByCNPJ(cnpj string) (*company.Company, error) {
+	args := r.Called(cnpj)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*company.Company), args.Error(1)
+}
+
+type auditRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *auditRepositoryMock) Save(entry *audit.AuditEntry) error {
+	args := r.Called(entry)
+	return args.Error(0)
+}
+
+func (r *auditRepositoryMock) SaveAll(entries []*audit.AuditEntry) error {
+	args := r.Called(entries)
+	return args.Error(0)
+}
+
+func (r *auditRepositoryMock) FindByEntity(entityType string, entityID int, page, size int) ([]*audit.AuditEntry, int64, error) {
+	args := r.Called(entityType, entityID, page, size)
+	return args.Get(0).([]*audit.AuditEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func (r *auditRepositoryMock) FindByEntities(entityType string, entityIDs []int, page, size int) ([]*audit.AuditEntry, int64, error) {
+	args := r.Called(entityType, entityIDs, page, size)
+	return args.Get(0).([]*audit.AuditEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func (r *auditRepositoryMock) FindBySubject(personIDs []int) ([]*audit.AuditEntry, error) {
+	args := r.Called(personIDs)
+	return args.Get(0).([]*audit.AuditEntry), args.Error(1)
+}
+
+// newAuditRepositoryMock accepts any audit entry, for tests that do not assert on the trail.
+func newAuditRepositoryMock() *auditRepositoryMock {
+	auditMock := new(auditRepositoryMock)
+	auditMock.On("Save", mock.Anything).Return(nil).Maybe()
+	return auditMock
+}
+
+// transactorMock runs the writes on the mocked repositories, as the
+// transaction would on the real ones.
+type transactorMock struct {
+	companies ports.CompanyRepository
+	audits    auditPorts.AuditRepository
+}
+
+func (t transactorMock) WithinTransaction(fn func(companies ports.CompanyRepository, audits auditPorts.AuditRepository) error) error {
+	return fn(t.companies, t.audits)
+}
+
+var testActor = audit.Actor{OperatorID: 7, RequestID: "req-123", ClientIP: "203.0.113.10"}
+
+func validCompanyDTO() contract.CompanyDTO {
+	return contract.CompanyDTO{
+		CNPJ:         "12.ABC.345/01DE-35",
+		LegalName:    "Padaria Pão Quente Ltda",
+		TradeName:    "Pão Quente",
+		FoundingDate: time.Date(2010, time.March, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// storedCompany builds a company as the repository would return it.
+func storedCompany(id, version int, cnpj string) *company.Company {
+	dto := validCompanyDTO()
+	dto.CNPJ = cnpj
+	stored, _ := company.NewCompany(companyFields(dto))
+	stored.ID = id
+	stored.Version = version
+	return stored
+}
+
+func TestCompanyService_CreateCompany_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	repoMock.On("FindByCNPJ", "12ABC34501DE35").Return(nil, nil)
+	repoMock.On("Save", mock.MatchedBy(func(c *company.Company) bool {
+		return c.CNPJ == "12ABC34501DE35" && c.LegalName == "Padaria Pão Quente Ltda"
+	})).Return(3, nil)
+	auditMock.On("Save", mock.MatchedBy(func(entry *audit.AuditEntry) bool {
+		return entry.EntityType == audit.EntityCompany && entry.EntityID == 3 &&
+			entry.Action == audit.ActionCreate && entry.OperatorID == 7 &&
+			entry.Before == nil && entry.After != nil
+	})).Return(nil)
+
+	service := NewCompanyService(repoMock, transactorMock{repoMock, auditMock})
+
+	created, err := service.CreateCompany(validCompanyDTO(), testActor)
+
+	assert.NoError(err)
+	assert.Equal(3, created.ID)
+	repoMock.AssertExpectations(t)
+	auditMock.AssertExpectations(t)
+}
+
+func TestCompanyService_CreateCompany_ValidationError(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	dto := validCompanyDTO()
+	dto.CNPJ = "12.ABC.345/01DE-36"
+
+	service := NewCompanyService(repoMock, transactorMock{repoMock, newAuditRepositoryMock()})
+
+	_, err := service.CreateCompany(dto, testActor)
+
+	assert.ErrorIs(err, companyError.ErrCNPJInvalid)
+	repoMock.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCompanyService_CreateCompany_CNPJAlreadyInUse(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByCNPJ", "12ABC34501DE35").Return(storedCompany(1, 1, "12ABC34501DE35"), nil)
+
+	service := NewCompanyService(repoMock, transactorMock{repoMock, newAuditRepositoryMock()})
+
+	_, err := service.CreateCompany(validCompanyDTO(), testActor)
+
+	assert.ErrorIs(err, companyError.ErrCNPJAlreadyInUse)
+	repoMock.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCompanyService_UpdateCompany_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByID", 5).Return(storedCompany(5, 2, "12ABC34501DE35"), nil)
+	repoMock.On("FindByCNPJ", "11222333000181").Return(nil, nil)
+	repoMock.On("Update", mock.MatchedBy(func(c *company.Company) bool {
+		return c.ID == 5 && c.Version == 2 && c.CNPJ == "11222333000181" && c.StateRegistration == "ISENTO"
+	})).Return(nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(storedCompany(5, 3, "11222333000181"), nil)
+
+	dto := validCompanyDTO()
+	dto.CNPJ = "11.222.333/0001-81"
+	dto.StateRegistration = "Isento"
+
+	service := NewCompanyService(repoMock, transactorMock{repoMock, newAuditRepositoryMock()})
+
+	updated, err := service.UpdateCompany(5, 2, dto, testActor)
+
+	assert.NoError(err)
+	assert.Equal("11222333000181", updated.CNPJ)
+	repoMock.AssertExpectations(t)
+}
+
+func TestCompanyService_UpdateCompany_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		cnpj    string
+		found   *company.Company
+		holder  *company.Company
+		err     error
+	}{
+		{"not found", 2, "12ABC34501DE35", nil, nil, companyError.ErrCompanyNotFound},
+		{"version conflict", 1, "12ABC34501DE35", storedCompany(5, 2, "12ABC34501DE35"), nil, companyError.ErrVersionConflict},
+		{"cnpj of another company", 2, "11222333000181", storedCompany(5, 2, "12ABC34501DE35"), storedCompany(6, 1, "11222333000181"), companyError.ErrCNPJAlreadyInUse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := new(repositoryMock)
+			repoMock.On("FindByID", 5).Return(tt.found, nil)
+			repoMock.On("FindByCNPJ", tt.cnpj).Return(tt.holder, nil)
+
+			dto := validCompanyDTO()
+			dto.CNPJ = tt.cnpj
+
+			service := NewCompanyService(repoMock, transactorMock{repoMock, newAuditRepositoryMock()})
+
+			_, err := service.UpdateCompany(5, tt.version, dto, testActor)
+
+			assert.ErrorIs(t, err, tt.err)
+			repoMock.AssertNotCalled(t, "Update", mock.Anything)
+		})
+	}
+}
+
+func TestCompanyService_DeleteCompany_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	deleted := storedCompany(5, 5, "12ABC34501DE35")
+	deletedAt := time.Now()
+	deleted.DeletedAt = &deletedAt
+
+	repoMock.On("FindByID", 5).Return(storedCompany(5, 4, "12ABC34501DE35"), nil)
+	repoMock.On("Delete", 5, 4).Return(nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(deleted, nil)
+	auditMock.On("Save", mock.MatchedBy(func(entry *audit.AuditEntry) bool {
+		return entry.Action == audit.ActionDelete && entry.Before != nil && entry.After != nil
+	})).Return(nil)
+
+	service := NewCompanyService(repoMock, transactorMock{repoMock, auditMock})
+
+	err := service.DeleteCompany(5, 4, testActor)
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+	auditMock.AssertExpectations(t)
+}
+
+func TestCompanyService_DeleteCompany_AuditFailureFailsWrite(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	repoMock.On("FindByID", 5).Return(storedCompany(5, 4, "12ABC34501DE35"), nil)
+	repoMock.On("Delete", 5, 4).Return(nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(storedCompany(5, 5, "12ABC34501DE35"), nil)
+	auditMock.On("Save", mock.Anything).Return(errors.New("audit table unavailable"))
+
+	service := NewCompanyService(repoMock, transactorMock{repoMock, auditMock})
+
+	err := service.DeleteCompany(5, 4, testActor)
+
+	assert.ErrorContains(err, "failed to record delete in the audit trail", "the transaction is rolled back with the error")
+	auditMock.AssertExpectations(t)
+}
+
+func TestCompanyService_DeleteCompany_VersionConflict(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByID", 5).Return(storedCompany(5, 4, "12ABC34501DE35"), nil)
+
+	service := NewCompanyService(repoMock, transactorMock{repoMock, newAuditRepositoryMock()})
+
+	err := service.DeleteCompany(5, 3, testActor)
+
+	assert.ErrorIs(err, companyError.ErrVersionConflict)
+	repoMock.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestCompanyService_ListCompanies_DefaultsInvalidParameters(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindAll", 1, 10, "id", "desc").Return([]*company.Company{}, int64(0), nil)
+
+	service := NewCompanyService(repoMock, transactorMock{repoMock, newAuditRepositoryMock()})
+
+	_, _, err := service.ListCompanies(0, 500, "", "")
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+}
+
+func TestCompanyService_FindCompanyByCNPJ(t *testing.T) {
+	stored := storedCompany(5, 1, "12ABC34501DE35")
+	repoErr := errors.New("connection refused")
+
+	tests := []struct {
+		name     string
+		input    string
+		expected *company.Company
+		repoErr  error
+		err      error
+	}{
+		{"formatted and lowercase", "12.abc.345/01de-35", stored, nil, nil},
+		{"not found", "12ABC34501DE35", nil, nil, companyError.ErrCompanyNotFound},
+		{"repository error", "12ABC34501DE35", nil, repoErr, repoErr},
+		{"invalid cnpj", "12ABC34501DE36", nil, nil, companyError.ErrCNPJInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := new(repositoryMock)
+			repoMock.On("FindByCNPJ", "12ABC34501DE35").Return(tt.expected, tt.repoErr)
+
+			service := NewCompanyService(repoMock, transactorMock{repoMock, newAuditRepositoryMock()})
+
+			found, err := service.FindCompanyByCNPJ(tt.input)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Nil(t, found)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, found)
+		})
+	}
+}
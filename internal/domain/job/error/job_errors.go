@@ -0,0 +1,32 @@
+package job
+
+import "errors"
+
+var (
+	ErrJobNotFound     = errors.New("job not found")
+	ErrJobNotActive    = errors.New("job has already finished")
+	ErrJobNotRetryable = errors.New("only failed or cancelled jobs can be retried")
+	ErrJobLost         = errors.New("job is no longer held by this worker")
+	ErrNoResult        = errors.New("job has no result")
+	ErrUnknownJobType  = errors.New("unknown job type")
+	ErrArtifactInvalid = errors.New("artifact reference is invalid")
+)
+
+// PermanentError marks a failure that retrying cannot fix, such as an invalid
+// input file. Jobs failing with it are not retried automatically.
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent wraps err in a PermanentError.
+func Permanent(err error) error {
+	return &PermanentError{Err: err}
+}
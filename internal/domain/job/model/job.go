@@ -0,0 +1,99 @@
+package job
+
+import (
+	"encoding/json"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+)
+
+// Job statuses. Queued and running jobs are active; the others are final,
+// although failed and cancelled jobs can be retried.
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+)
+
+// DefaultMaxAttempts is how many times a job runs before it is marked as failed.
+const DefaultMaxAttempts = 3
+
+// Artifact references a file produced by a job, held in an artifact store.
+type Artifact struct {
+	Ref         string
+	Name        string
+	ContentType string
+}
+
+// Job is a unit of background work. Payload holds the parameters of its type,
+// and Attempts counts how many times a worker has claimed it; the attempt
+// number also identifies the current claim, so a worker whose claim was taken
+// over cannot overwrite the outcome of the new one.
+type Job struct {
+	ID          int
+	Type        string
+	Status      string
+	Payload     json.RawMessage
+	Progress    int
+	Result      *Artifact
+	Error       string
+	Attempts    int
+	MaxAttempts int
+	OperatorID  int
+	RequestID   string
+	ClientIP    string
+	RunAt       time.Time
+	HeartbeatAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+}
+
+// NewJob creates a queued job of the given type, ready to run immediately.
+func NewJob(jobType string, payload any, actor audit.Actor) (*Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Job{
+		Type:        jobType,
+		Status:      StatusQueued,
+		Payload:     data,
+		MaxAttempts: DefaultMaxAttempts,
+		OperatorID:  actor.OperatorID,
+		RequestID:   actor.RequestID,
+		ClientIP:    actor.ClientIP,
+		RunAt:       now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Actor returns who enqueued the job, so that its changes are audited under their name.
+func (j *Job) Actor() audit.Actor {
+	return audit.Actor{OperatorID: j.OperatorID, RequestID: j.RequestID, ClientIP: j.ClientIP}
+}
+
+// IsActive reports whether the job is waiting to run or running.
+func (j *Job) IsActive() bool {
+	return j.Status == StatusQueued || j.Status == StatusRunning
+}
+
+// IsRetryable reports whether the job can be queued again.
+func (j *Job) IsRetryable() bool {
+	return j.Status == StatusFailed || j.Status == StatusCancelled
+}
+
+// RetryDelay is how long to wait before running a failed attempt again: 30s, 1m, 2m...
+func (j *Job) RetryDelay() time.Duration {
+	if j.Attempts < 1 {
+		return 30 * time.Second
+	}
+	return 30 * time.Second << (j.Attempts - 1)
+}
@@ -0,0 +1,65 @@
+package job
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJob_ShouldCreateQueuedJob(t *testing.T) {
+	assert := assert.New(t)
+	actor := audit.Actor{OperatorID: 7, RequestID: "req-123", ClientIP: "203.0.113.10"}
+
+	job, err := NewJob("person_export", map[string]string{"format": "csv"}, actor)
+
+	assert.NoError(err)
+	assert.Equal("person_export", job.Type)
+	assert.Equal(StatusQueued, job.Status)
+	assert.JSONEq(`{"format":"csv"}`, string(job.Payload))
+	assert.Equal(DefaultMaxAttempts, job.MaxAttempts)
+	assert.Zero(job.Attempts)
+	assert.Equal(actor, job.Actor())
+	assert.False(job.RunAt.After(time.Now()))
+}
+
+func TestNewJob_ShouldFail_WhenPayloadCannotBeEncoded(t *testing.T) {
+	_, err := NewJob("person_export", make(chan int), audit.Actor{})
+
+	var unsupported *json.UnsupportedTypeError
+	assert.ErrorAs(t, err, &unsupported)
+}
+
+func TestJob_Statuses(t *testing.T) {
+	tests := []struct {
+		status    string
+		active    bool
+		retryable bool
+	}{
+		{StatusQueued, true, false},
+		{StatusRunning, true, false},
+		{StatusSucceeded, false, false},
+		{StatusFailed, false, true},
+		{StatusCancelled, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			job := &Job{Status: tt.status}
+
+			assert.Equal(t, tt.active, job.IsActive())
+			assert.Equal(t, tt.retryable, job.IsRetryable())
+		})
+	}
+}
+
+func TestJob_RetryDelay_ShouldDoubleWithEachAttempt(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(30*time.Second, (&Job{Attempts: 1}).RetryDelay())
+	assert.Equal(time.Minute, (&Job{Attempts: 2}).RetryDelay())
+	assert.Equal(2*time.Minute, (&Job{Attempts: 3}).RetryDelay())
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"time"
+
+	job "pessoas-api/internal/domain/job/model"
+)
+
+// JobRepository defines the contract for job persistence. It doubles as the
+// queue shared by every API replica: Claim hands each queued job to exactly one
+// worker, and a running job whose heartbeat is older than the lease is
+// considered abandoned and can be claimed again.
+// Heartbeat, Complete and Fail only apply while the job is running under the
+// given attempt, and return ErrJobLost otherwise (the job was cancelled or
+// claimed again after its lease expired).
+type JobRepository interface {
+	Save(job *job.Job) (ID int, err error)
+	FindByID(id int) (*job.Job, error)
+	Claim(types []string, lease time.Duration) (*job.Job, error)
+	Heartbeat(id, attempt, progress int) error
+	Complete(id, attempt int, result *job.Artifact) error
+	Fail(id, attempt int, message string, retryAt *time.Time) error
+	Cancel(id int) error
+	Requeue(id int) error
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"io"
+
+	job "pessoas-api/internal/domain/job/model"
+)
+
+// Runner executes the jobs of one type. Run should report progress as a
+// percentage and stop promptly once ctx is cancelled, which happens when the
+// job is cancelled or the worker loses it. The returned artifact, if any,
+// becomes the job result.
+type Runner interface {
+	Type() string
+	Run(ctx context.Context, job *job.Job, progress func(percent int)) (*job.Artifact, error)
+}
+
+// ArtifactStore keeps the files consumed and produced by jobs. References are
+// opaque strings generated by the store.
+type ArtifactStore interface {
+	Create(extension string) (ref string, file io.WriteCloser, err error)
+	Open(ref string) (file io.ReadCloser, size int64, err error)
+	Delete(ref string) error
+}
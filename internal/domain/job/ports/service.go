@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"io"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	job "pessoas-api/internal/domain/job/model"
+)
+
+type JobService interface {
+	Enqueue(jobType string, payload any, actor audit.Actor) (*job.Job, error)
+	SaveArtifact(extension string, content io.Reader) (ref string, err error)
+	FindJob(id int) (*job.Job, error)
+	CancelJob(id int) (*job.Job, error)
+	RetryJob(id int) (*job.Job, error)
+	OpenJobResult(id int) (*job.Artifact, io.ReadCloser, error)
+}
@@ -0,0 +1,128 @@
+package job
+
+import (
+	"io"
+	"log"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	jobError "pessoas-api/internal/domain/job/error"
+	job "pessoas-api/internal/domain/job/model"
+	"pessoas-api/internal/domain/job/ports"
+)
+
+// JobServiceImpl implements the ports.JobService interface.
+// It manages jobs on behalf of API clients; running them is up to the WorkerPool.
+type JobServiceImpl struct {
+	repository ports.JobRepository
+	artifacts  ports.ArtifactStore
+}
+
+// NewJobService creates a new instance of JobServiceImpl.
+// It returns the implementation as the JobService interface.
+func NewJobService(repository ports.JobRepository, artifacts ports.ArtifactStore) ports.JobService {
+	return &JobServiceImpl{
+		repository: repository,
+		artifacts:  artifacts,
+	}
+}
+
+func (s *JobServiceImpl) Enqueue(jobType string, payload any, actor audit.Actor) (*job.Job, error) {
+	newJob, err := job.NewJob(jobType, payload, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.repository.Save(newJob)
+	if err != nil {
+		return nil, err
+	}
+	newJob.ID = id
+
+	log.Printf("[INFO] JobService - Enqueued %s job %d for operator %d", jobType, id, actor.OperatorID)
+
+	return newJob, nil
+}
+
+// SaveArtifact stores a file a job will consume, such as an uploaded import file.
+func (s *JobServiceImpl) SaveArtifact(extension string, content io.Reader) (string, error) {
+	ref, file, err := s.artifacts.Create(extension)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if deleteErr := s.artifacts.Delete(ref); deleteErr != nil {
+			log.Printf("[ERROR] JobService - Failed to delete incomplete artifact %s: %v", ref, deleteErr)
+		}
+		return "", err
+	}
+
+	return ref, nil
+}
+
+func (s *JobServiceImpl) FindJob(id int) (*job.Job, error) {
+	found, err := s.repository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, jobError.ErrJobNotFound
+	}
+	return found, nil
+}
+
+// CancelJob stops a queued or running job. A running job is cancelled in the
+// database right away; its worker notices on its next heartbeat and aborts.
+func (s *JobServiceImpl) CancelJob(id int) (*job.Job, error) {
+	found, err := s.FindJob(id)
+	if err != nil {
+		return nil, err
+	}
+	if !found.IsActive() {
+		return nil, jobError.ErrJobNotActive
+	}
+
+	if err := s.repository.Cancel(id); err != nil {
+		return nil, err
+	}
+
+	return s.FindJob(id)
+}
+
+// RetryJob queues a failed or cancelled job again with a fresh set of attempts.
+func (s *JobServiceImpl) RetryJob(id int) (*job.Job, error) {
+	found, err := s.FindJob(id)
+	if err != nil {
+		return nil, err
+	}
+	if !found.IsRetryable() {
+		return nil, jobError.ErrJobNotRetryable
+	}
+
+	if err := s.repository.Requeue(id); err != nil {
+		return nil, err
+	}
+
+	return s.FindJob(id)
+}
+
+func (s *JobServiceImpl) OpenJobResult(id int) (*job.Artifact, io.ReadCloser, error) {
+	found, err := s.FindJob(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if found.Status != job.StatusSucceeded || found.Result == nil {
+		return nil, nil, jobError.ErrNoResult
+	}
+
+	file, _, err := s.artifacts.Open(found.Result.Ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return found.Result, file, nil
+}
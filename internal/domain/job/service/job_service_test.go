@@ -0,0 +1,260 @@
+package job
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	jobError "pessoas-api/internal/domain/job/error"
+	job "pessoas-api/internal/domain/job/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testActor = audit.Actor{OperatorID: 7, RequestID: "req-123", ClientIP: "203.0.113.10"}
+
+type jobRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *jobRepositoryMock) Save(job *job.Job) (ID int, err error) {
+	args := r.Called(job)
+	return args.Int(0), args.Error(1)
+}
+
+func (r *jobRepositoryMock) FindByID(id int) (*job.Job, error) {
+	args := r.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (r *jobRepositoryMock) Claim(types []string, lease time.Duration) (*job.Job, error) {
+	args := r.Called(types, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (r *jobRepositoryMock) Heartbeat(id, attempt, progress int) error {
+	args := r.Called(id, attempt, progress)
+	return args.Error(0)
+}
+
+func (r *jobRepositoryMock) Complete(id, attempt int, result *job.Artifact) error {
+	args := r.Called(id, attempt, result)
+	return args.Error(0)
+}
+
+func (r *jobRepositoryMock) Fail(id, attempt int, message string, retryAt *time.Time) error {
+	args := r.Called(id, attempt, message, retryAt)
+	return args.Error(0)
+}
+
+func (r *jobRepositoryMock) Cancel(id int) error {
+	args := r.Called(id)
+	return args.Error(0)
+}
+
+func (r *jobRepositoryMock) Requeue(id int) error {
+	args := r.Called(id)
+	return args.Error(0)
+}
+
+type artifactStoreMock struct {
+	mock.Mock
+}
+
+func (s *artifactStoreMock) Create(extension string) (string, io.WriteCloser, error) {
+	args := s.Called(extension)
+	if args.Get(1) == nil {
+		return "", nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(io.WriteCloser), args.Error(2)
+}
+
+func (s *artifactStoreMock) Open(ref string) (io.ReadCloser, int64, error) {
+	args := s.Called(ref)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).(io.ReadCloser), args.Get(1).(int64), args.Error(2)
+}
+
+func (s *artifactStoreMock) Delete(ref string) error {
+	args := s.Called(ref)
+	return args.Error(0)
+}
+
+// bufferFile is an in-memory io.WriteCloser standing in for an artifact file.
+type bufferFile struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (f *bufferFile) Close() error {
+	f.closed = true
+	return nil
+}
+
+// failingReader returns an error as soon as it is read.
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestJobServiceImpl_Enqueue(t *testing.T) {
+	assert := assert.New(t)
+	repo := new(jobRepositoryMock)
+	service := NewJobService(repo, new(artifactStoreMock))
+
+	repo.On("Save", mock.MatchedBy(func(j *job.Job) bool {
+		return j.Type == "person_export" && j.Status == job.StatusQueued && j.OperatorID == 7
+	})).Return(12, nil)
+
+	queued, err := service.Enqueue("person_export", map[string]string{"format": "csv"}, testActor)
+
+	assert.NoError(err)
+	assert.Equal(12, queued.ID)
+	assert.JSONEq(`{"format":"csv"}`, string(queued.Payload))
+	repo.AssertExpectations(t)
+}
+
+func TestJobServiceImpl_Enqueue_ShouldReturnError_WhenSaveFails(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	service := NewJobService(repo, new(artifactStoreMock))
+	repo.On("Save", mock.Anything).Return(0, errors.New("database error"))
+
+	queued, err := service.Enqueue("person_export", nil, testActor)
+
+	assert.Nil(t, queued)
+	assert.EqualError(t, err, "database error")
+}
+
+func TestJobServiceImpl_SaveArtifact(t *testing.T) {
+	assert := assert.New(t)
+	artifacts := new(artifactStoreMock)
+	service := NewJobService(new(jobRepositoryMock), artifacts)
+	file := &bufferFile{}
+	artifacts.On("Create", "csv").Return("abc.csv", file, nil)
+
+	ref, err := service.SaveArtifact("csv", strings.NewReader("name,cpf\n"))
+
+	assert.NoError(err)
+	assert.Equal("abc.csv", ref)
+	assert.Equal("name,cpf\n", file.String())
+	assert.True(file.closed)
+}
+
+func TestJobServiceImpl_SaveArtifact_ShouldDeleteFile_WhenCopyFails(t *testing.T) {
+	assert := assert.New(t)
+	artifacts := new(artifactStoreMock)
+	service := NewJobService(new(jobRepositoryMock), artifacts)
+	artifacts.On("Create", "csv").Return("abc.csv", &bufferFile{}, nil)
+	artifacts.On("Delete", "abc.csv").Return(nil)
+
+	ref, err := service.SaveArtifact("csv", failingReader{})
+
+	assert.Empty(ref)
+	assert.EqualError(err, "connection reset")
+	artifacts.AssertExpectations(t)
+}
+
+func TestJobServiceImpl_FindJob_ShouldReturnNotFound(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	service := NewJobService(repo, new(artifactStoreMock))
+	repo.On("FindByID", 99).Return(nil, nil)
+
+	found, err := service.FindJob(99)
+
+	assert.Nil(t, found)
+	assert.ErrorIs(t, err, jobError.ErrJobNotFound)
+}
+
+func TestJobServiceImpl_CancelJob(t *testing.T) {
+	assert := assert.New(t)
+	repo := new(jobRepositoryMock)
+	service := NewJobService(repo, new(artifactStoreMock))
+	repo.On("FindByID", 3).Return(&job.Job{ID: 3, Status: job.StatusRunning}, nil).Once()
+	repo.On("Cancel", 3).Return(nil)
+	repo.On("FindByID", 3).Return(&job.Job{ID: 3, Status: job.StatusCancelled}, nil).Once()
+
+	cancelled, err := service.CancelJob(3)
+
+	assert.NoError(err)
+	assert.Equal(job.StatusCancelled, cancelled.Status)
+	repo.AssertExpectations(t)
+}
+
+func TestJobServiceImpl_CancelJob_ShouldReturnConflict_WhenJobFinished(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	service := NewJobService(repo, new(artifactStoreMock))
+	repo.On("FindByID", 3).Return(&job.Job{ID: 3, Status: job.StatusSucceeded}, nil)
+
+	_, err := service.CancelJob(3)
+
+	assert.ErrorIs(t, err, jobError.ErrJobNotActive)
+	repo.AssertNotCalled(t, "Cancel", mock.Anything)
+}
+
+func TestJobServiceImpl_RetryJob(t *testing.T) {
+	assert := assert.New(t)
+	repo := new(jobRepositoryMock)
+	service := NewJobService(repo, new(artifactStoreMock))
+	repo.On("FindByID", 4).Return(&job.Job{ID: 4, Status: job.StatusFailed, Attempts: 3}, nil).Once()
+	repo.On("Requeue", 4).Return(nil)
+	repo.On("FindByID", 4).Return(&job.Job{ID: 4, Status: job.StatusQueued}, nil).Once()
+
+	queued, err := service.RetryJob(4)
+
+	assert.NoError(err)
+	assert.Equal(job.StatusQueued, queued.Status)
+	repo.AssertExpectations(t)
+}
+
+func TestJobServiceImpl_RetryJob_ShouldReturnConflict_WhenJobIsActive(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	service := NewJobService(repo, new(artifactStoreMock))
+	repo.On("FindByID", 4).Return(&job.Job{ID: 4, Status: job.StatusQueued}, nil)
+
+	_, err := service.RetryJob(4)
+
+	assert.ErrorIs(t, err, jobError.ErrJobNotRetryable)
+	repo.AssertNotCalled(t, "Requeue", mock.Anything)
+}
+
+func TestJobServiceImpl_OpenJobResult(t *testing.T) {
+	assert := assert.New(t)
+	repo := new(jobRepositoryMock)
+	artifacts := new(artifactStoreMock)
+	service := NewJobService(repo, artifacts)
+	result := &job.Artifact{Ref: "abc.csv", Name: "persons-5.csv", ContentType: "text/csv"}
+	repo.On("FindByID", 5).Return(&job.Job{ID: 5, Status: job.StatusSucceeded, Result: result}, nil)
+	artifacts.On("Open", "abc.csv").Return(io.NopCloser(strings.NewReader("id\n1\n")), int64(5), nil)
+
+	found, file, err := service.OpenJobResult(5)
+
+	assert.NoError(err)
+	assert.Equal(result, found)
+	content, _ := io.ReadAll(file)
+	assert.Equal("id\n1\n", string(content))
+}
+
+func TestJobServiceImpl_OpenJobResult_ShouldReturnNoResult_WhenJobNotSucceeded(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	service := NewJobService(repo, new(artifactStoreMock))
+	repo.On("FindByID", 5).Return(&job.Job{ID: 5, Status: job.StatusRunning}, nil)
+
+	_, file, err := service.OpenJobResult(5)
+
+	assert.Nil(t, file)
+	assert.ErrorIs(t, err, jobError.ErrNoResult)
+}
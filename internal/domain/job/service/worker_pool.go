@@ -0,0 +1,206 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	jobError "pessoas-api/internal/domain/job/error"
+	job "pessoas-api/internal/domain/job/model"
+	"pessoas-api/internal/domain/job/ports"
+)
+
+// Default timings of the worker pool.
+const (
+	DefaultPollInterval = 2 * time.Second
+	DefaultLease        = time.Minute
+)
+
+// WorkerPool runs queued jobs in the background. Every API replica can run its
+// own pool against the same database: jobs are claimed through the repository,
+// which never hands the same job to two workers at once.
+type WorkerPool struct {
+	repository   ports.JobRepository
+	runners      map[string]ports.Runner
+	types        []string
+	workers      int
+	pollInterval time.Duration
+	lease        time.Duration
+	wg           sync.WaitGroup
+}
+
+// NewWorkerPool creates a pool of the given number of workers that runs the jobs
+// handled by the given runners. Jobs of other types are left in the queue.
+func NewWorkerPool(repository ports.JobRepository, workers int, runners ...ports.Runner) *WorkerPool {
+	pool := &WorkerPool{
+		repository:   repository,
+		runners:      make(map[string]ports.Runner, len(runners)),
+		workers:      workers,
+		pollInterval: DefaultPollInterval,
+		lease:        DefaultLease,
+	}
+
+	for _, runner := range runners {
+		pool.runners[runner.Type()] = runner
+		pool.types = append(pool.types, runner.Type())
+	}
+
+	return pool
+}
+
+// Start launches the workers. They stop claiming jobs once ctx is cancelled and
+// Wait returns when the jobs they were running have finished.
+func (p *WorkerPool) Start(ctx context.Context) {
+	log.Printf("[INFO] WorkerPool - Starting %d workers for job types %v", p.workers, p.types)
+
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go p.work(ctx)
+	}
+}
+
+// Wait blocks until every worker has stopped.
+func (p *WorkerPool) Wait() {
+	p.wg.Wait()
+}
+
+func (p *WorkerPool) work(ctx context.Context) {
+	defer p.wg.Done()
+
+	for {
+		ran, err := p.RunNext(ctx)
+		if err != nil {
+			log.Printf("[ERROR] WorkerPool - Failed to claim job: %v", err)
+		}
+		if ran {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
+
+// RunNext claims one job and runs it to completion. It reports false when there
+// was nothing to run.
+func (p *WorkerPool) RunNext(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+
+	claimed, err := p.repository.Claim(p.types, p.lease)
+	if err != nil || claimed == nil {
+		return false, err
+	}
+
+	p.run(ctx, claimed)
+	return true, nil
+}
+
+// run executes a claimed job. While it runs, a heartbeat keeps the claim alive
+// and publishes progress; a lost heartbeat (the job was cancelled or taken over)
+// cancels the context given to the runner.
+func (p *WorkerPool) run(ctx context.Context, claimed *job.Job) {
+	log.Printf("[INFO] WorkerPool - Running %s job %d (attempt %d/%d)", claimed.Type, claimed.ID, claimed.Attempts, claimed.MaxAttempts)
+
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	var progress atomic.Int32
+	var lost atomic.Bool
+
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+
+		ticker := time.NewTicker(p.lease / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-jobCtx.Done():
+				return
+			case <-ticker.C:
+				err := p.repository.Heartbeat(claimed.ID, claimed.Attempts, int(progress.Load()))
+				if errors.Is(err, jobError.ErrJobLost) {
+					log.Printf("[WARN] WorkerPool - Job %d was cancelled or taken over, stopping it", claimed.ID)
+					lost.Store(true)
+					cancel()
+					return
+				}
+				if err != nil {
+					log.Printf("[ERROR] WorkerPool - Failed to record heartbeat of job %d: %v", claimed.ID, err)
+				}
+			}
+		}
+	}()
+
+	result, err := p.execute(jobCtx, claimed, func(percent int) {
+		progress.Store(int32(min(max(percent, 0), 100)))
+	})
+
+	cancel()
+	<-heartbeatDone
+
+	if lost.Load() {
+		return
+	}
+
+	if err != nil {
+		p.fail(claimed, err)
+		return
+	}
+
+	if err := p.repository.Complete(claimed.ID, claimed.Attempts, result); err != nil {
+		log.Printf("[ERROR] WorkerPool - Failed to complete job %d: %v", claimed.ID, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] WorkerPool - Job %d succeeded", claimed.ID)
+}
+
+// execute runs the job through its runner, turning a panic into an error.
+func (p *WorkerPool) execute(ctx context.Context, claimed *job.Job, progress func(int)) (result *job.Artifact, err error) {
+	runner, exists := p.runners[claimed.Type]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", jobError.ErrUnknownJobType, claimed.Type)
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+
+	return runner.Run(ctx, claimed, progress)
+}
+
+// fail records a failed attempt, scheduling another one while attempts remain
+// and the failure is not permanent.
+func (p *WorkerPool) fail(claimed *job.Job, cause error) {
+	var permanent *jobError.PermanentError
+
+	var retryAt *time.Time
+	if claimed.Attempts < claimed.MaxAttempts && !errors.As(cause, &permanent) {
+		next := time.Now().Add(claimed.RetryDelay())
+		retryAt = &next
+	}
+
+	if err := p.repository.Fail(claimed.ID, claimed.Attempts, cause.Error(), retryAt); err != nil {
+		log.Printf("[ERROR] WorkerPool - Failed to record failure of job %d: %v", claimed.ID, err)
+		return
+	}
+
+	if retryAt != nil {
+		log.Printf("[WARN] WorkerPool - Job %d failed, retrying at %s: %v", claimed.ID, retryAt.Format(time.RFC3339), cause)
+		return
+	}
+	log.Printf("[ERROR] WorkerPool - Job %d failed on attempt %d, giving up: %v", claimed.ID, claimed.Attempts, cause)
+}
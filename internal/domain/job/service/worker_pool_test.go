@@ -0,0 +1,178 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	jobError "pessoas-api/internal/domain/job/error"
+	job "pessoas-api/internal/domain/job/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// runnerFunc adapts a function to the ports.Runner interface.
+type runnerFunc func(ctx context.Context, j *job.Job, progress func(int)) (*job.Artifact, error)
+
+func (runnerFunc) Type() string {
+	return "test_job"
+}
+
+func (f runnerFunc) Run(ctx context.Context, j *job.Job, progress func(int)) (*job.Artifact, error) {
+	return f(ctx, j, progress)
+}
+
+// newTestPool creates a pool with a short lease so heartbeats happen within a test.
+func newTestPool(repo *jobRepositoryMock, runner runnerFunc) *WorkerPool {
+	pool := NewWorkerPool(repo, 1, runner)
+	pool.lease = 30 * time.Millisecond
+	pool.pollInterval = 10 * time.Millisecond
+	return pool
+}
+
+func claimedJob(attempts int) *job.Job {
+	return &job.Job{ID: 1, Type: "test_job", Status: job.StatusRunning, Attempts: attempts, MaxAttempts: job.DefaultMaxAttempts}
+}
+
+func TestWorkerPool_RunNext_ShouldReturnFalse_WhenQueueIsEmpty(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	repo.On("Claim", []string{"test_job"}, 30*time.Millisecond).Return(nil, nil)
+	pool := newTestPool(repo, nil)
+
+	ran, err := pool.RunNext(context.Background())
+
+	assert.False(t, ran)
+	assert.NoError(t, err)
+}
+
+func TestWorkerPool_RunNext_ShouldCompleteJob(t *testing.T) {
+	assert := assert.New(t)
+	repo := new(jobRepositoryMock)
+	result := &job.Artifact{Ref: "abc.csv", Name: "persons-1.csv", ContentType: "text/csv"}
+	repo.On("Claim", mock.Anything, mock.Anything).Return(claimedJob(1), nil)
+	repo.On("Heartbeat", 1, 1, 60).Return(nil)
+	repo.On("Complete", 1, 1, result).Return(nil)
+
+	pool := newTestPool(repo, func(ctx context.Context, j *job.Job, progress func(int)) (*job.Artifact, error) {
+		progress(60)
+		time.Sleep(50 * time.Millisecond)
+		return result, nil
+	})
+
+	ran, err := pool.RunNext(context.Background())
+
+	assert.True(ran)
+	assert.NoError(err)
+	repo.AssertExpectations(t)
+}
+
+func TestWorkerPool_RunNext_ShouldScheduleRetry_WhenAttemptsRemain(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	repo.On("Claim", mock.Anything, mock.Anything).Return(claimedJob(2), nil)
+	repo.On("Fail", 1, 2, "connection reset", mock.MatchedBy(func(retryAt *time.Time) bool {
+		return retryAt != nil && time.Until(*retryAt) > 50*time.Second
+	})).Return(nil)
+
+	pool := newTestPool(repo, func(context.Context, *job.Job, func(int)) (*job.Artifact, error) {
+		return nil, errors.New("connection reset")
+	})
+
+	pool.RunNext(context.Background())
+
+	repo.AssertExpectations(t)
+}
+
+func TestWorkerPool_RunNext_ShouldNotRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		runner   runnerFunc
+		message  string
+	}{
+		{
+			name:     "last attempt",
+			attempts: job.DefaultMaxAttempts,
+			runner: func(context.Context, *job.Job, func(int)) (*job.Artifact, error) {
+				return nil, errors.New("connection reset")
+			},
+			message: "connection reset",
+		},
+		{
+			name:     "permanent error",
+			attempts: 1,
+			runner: func(context.Context, *job.Job, func(int)) (*job.Artifact, error) {
+				return nil, jobError.Permanent(errors.New("invalid header"))
+			},
+			message: "invalid header",
+		},
+		{
+			name:     "panic",
+			attempts: job.DefaultMaxAttempts,
+			runner: func(context.Context, *job.Job, func(int)) (*job.Artifact, error) {
+				panic("nil map")
+			},
+			message: "job panicked: nil map",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(jobRepositoryMock)
+			repo.On("Claim", mock.Anything, mock.Anything).Return(claimedJob(tt.attempts), nil)
+			repo.On("Fail", 1, tt.attempts, tt.message, (*time.Time)(nil)).Return(nil)
+
+			newTestPool(repo, tt.runner).RunNext(context.Background())
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkerPool_RunNext_ShouldStopJob_WhenItIsLost(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	repo.On("Claim", mock.Anything, mock.Anything).Return(claimedJob(1), nil)
+	repo.On("Heartbeat", 1, 1, 0).Return(jobError.ErrJobLost)
+
+	stopped := make(chan struct{})
+	pool := newTestPool(repo, func(ctx context.Context, j *job.Job, progress func(int)) (*job.Artifact, error) {
+		<-ctx.Done()
+		close(stopped)
+		return nil, ctx.Err()
+	})
+
+	pool.RunNext(context.Background())
+
+	select {
+	case <-stopped:
+	default:
+		t.Fatal("runner was not stopped")
+	}
+	repo.AssertNotCalled(t, "Fail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorkerPool_Start_ShouldStopClaiming_WhenContextIsCancelled(t *testing.T) {
+	repo := new(jobRepositoryMock)
+	repo.On("Claim", mock.Anything, mock.Anything).Return(nil, nil)
+	pool := newTestPool(repo, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.Start(ctx)
+	time.Sleep(25 * time.Millisecond)
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		pool.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("workers did not stop")
+	}
+	repo.AssertCalled(t, "Claim", []string{"test_job"}, 30*time.Millisecond)
+}
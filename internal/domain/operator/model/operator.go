@@ -13,6 +13,7 @@ type Operator struct {
 	Email        string    `gorm:"uniqueIndex;not null"`
 	PasswordHash string    `gorm:"column:password_hash;not null"`
 	Active       bool      `gorm:"default:true"`
+	Roles        []string  `gorm:"-"`
 	CreatedAt    time.Time `gorm:"autoCreateTime"`
 	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
 }
@@ -32,11 +33,41 @@ func NewOperator(username, email, password string) (*Operator, error) {
 		Email:        email,
 		PasswordHash: hashedPassword,
 		Active:       true,
+		Roles:        []string{},
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}, nil
 }
 
+// HasRole reports whether the operator holds the role.
+func (o *Operator) HasRole(role string) bool {
+	for _, held := range o.Roles {
+		if held == role {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignRoles replaces the roles of the operator.
+func (o *Operator) AssignRoles(roles []string) error {
+	normalized, err := NormalizeRoles(roles)
+	if err != nil {
+		return err
+	}
+
+	o.Roles = normalized
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetActive activates or deactivates the operator. Inactive operators cannot
+// log in and their sessions are revoked.
+func (o *Operator) SetActive(active bool) {
+	o.Active = active
+	o.UpdatedAt = time.Now()
+}
+
 func (o *Operator) ValidatePassword(password string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(o.PasswordHash), []byte(password))
 	return err == nil
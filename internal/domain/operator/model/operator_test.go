@@ -314,3 +314,66 @@ func TestOperator_CanDeactivate(t *testing.T) {
 	op.Active = false
 	assert.False(t, op.Active)
 }
+
+func TestNewOperator_HasNoRoles(t *testing.T) {
+	op, err := NewOperator("johndoe", "john@example.com", "password123")
+
+	assert.NoError(t, err)
+	assert.Empty(t, op.Roles)
+	assert.False(t, op.HasRole(RoleViewer))
+	assert.False(t, HasPermission(op.Roles, PermissionRegistryRead))
+}
+
+func TestOperator_AssignRoles(t *testing.T) {
+	op := &Operator{Roles: []string{RoleViewer}}
+
+	err := op.AssignRoles([]string{" Editor", "auditor", "editor"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{RoleAuditor, RoleEditor}, op.Roles)
+
+	assert.ErrorIs(t, op.AssignRoles([]string{"root"}), ErrRoleInvalid)
+	assert.ErrorIs(t, op.AssignRoles([]string{}), ErrRolesRequired)
+	assert.Equal(t, []string{RoleAuditor, RoleEditor}, op.Roles, "invalid roles leave the operator unchanged")
+}
+
+func TestHasPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		roles      []string
+		permission string
+		expected   bool
+	}{
+		{"viewer reads", []string{RoleViewer}, PermissionRegistryRead, true},
+		{"viewer cannot write", []string{RoleViewer}, PermissionRegistryWrite, false},
+		{"editor writes", []string{RoleEditor}, PermissionRegistryWrite, true},
+		{"editor cannot read history", []string{RoleEditor}, PermissionAuditRead, false},
+		{"auditor reads history", []string{RoleAuditor}, PermissionAuditRead, true},
+		{"auditor cannot anonymize", []string{RoleAuditor}, PermissionSubjectAnonymize, false},
+		{"roles add up", []string{RoleEditor, RoleAuditor}, PermissionAuditRead, true},
+		{"admin manages operators", []string{RoleAdmin}, PermissionOperatorManage, true},
+		{"viewer sees masked data", []string{RoleViewer}, PermissionPIIRead, false},
+		{"editor sees full data", []string{RoleEditor}, PermissionPIIRead, true},
+		{"auditor reveals data", []string{RoleAuditor}, PermissionPIIReveal, true},
+		{"viewer cannot reveal data", []string{RoleViewer}, PermissionPIIReveal, false},
+		{"editor cannot see deleted persons", []string{RoleEditor}, PermissionRegistryDeleted, false},
+		{"admin sees deleted persons", []string{RoleAdmin}, PermissionRegistryDeleted, true},
+		{"unknown role", []string{"root"}, PermissionRegistryRead, false},
+		{"no roles", nil, PermissionRegistryRead, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, HasPermission(tt.roles, tt.permission))
+		})
+	}
+}
+
+func TestOperator_SetActive(t *testing.T) {
+	op := &Operator{Active: true}
+
+	op.SetActive(false)
+
+	assert.False(t, op.Active)
+	assert.NotZero(t, op.UpdatedAt)
+}
@@ -0,0 +1,125 @@
+package operator
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Roles an operator can hold. An operator may hold several roles and is
+// granted the permissions of all of them.
+const (
+	RoleViewer  = "viewer"
+	RoleEditor  = "editor"
+	RoleAuditor = "auditor"
+	RoleAdmin   = "admin"
+)
+
+// Permissions checked on each route.
+const (
+	// PermissionRegistryRead allows reading persons, companies and their records.
+	PermissionRegistryRead = "registry:read"
+	// PermissionRegistryWrite allows creating, changing and deleting them.
+	PermissionRegistryWrite = "registry:write"
+	// PermissionRegistryDeleted allows finding soft deleted persons and
+	// deleting persons permanently.
+	PermissionRegistryDeleted = "registry:deleted"
+	// PermissionAuditRead allows reading the change history and the log of
+	// data subject requests.
+	PermissionAuditRead = "audit:read"
+	// PermissionSubjectReport allows answering access and portability requests.
+	PermissionSubjectReport = "subjects:report"
+	// PermissionSubjectAnonymize allows anonymizing data subjects.
+	PermissionSubjectAnonymize = "subjects:anonymize"
+	// PermissionOperatorManage allows listing operators, assigning roles and
+	// deactivating operators.
+	PermissionOperatorManage = "operators:manage"
+	// PermissionPIIRead allows seeing CPFs, birth dates, emails and phones in
+	// full in the responses and exports. Without it they are masked.
+	PermissionPIIRead = "pii:read"
+	// PermissionPIIReveal allows revealing the masked data of one person at a
+	// time, with a reason that goes to the audit trail.
+	PermissionPIIReveal = "pii:reveal"
+)
+
+var (
+	ErrRoleInvalid       = errors.New("role must be viewer, editor, auditor or admin")
+	ErrRolesRequired     = errors.New("at least one role is required")
+	ErrOperatorNotFound  = errors.New("operator not found")
+	ErrLastAdminRequired = errors.New("the last active admin cannot lose the admin role")
+	ErrLastAdminActive   = errors.New("the last active admin cannot be deactivated")
+	ErrAdminAccountTaken = errors.New("the admin username or email already belongs to a registered operator")
+)
+
+// rolePermissions maps each role to the permissions it grants.
+var rolePermissions = map[string][]string{
+	RoleViewer: {
+		PermissionRegistryRead,
+	},
+	RoleEditor: {
+		PermissionRegistryRead,
+		PermissionRegistryWrite,
+		PermissionPIIRead,
+	},
+	RoleAuditor: {
+		PermissionRegistryRead,
+		PermissionAuditRead,
+		PermissionSubjectReport,
+		PermissionPIIReveal,
+	},
+	RoleAdmin: {
+		PermissionRegistryRead,
+		PermissionRegistryWrite,
+		PermissionRegistryDeleted,
+		PermissionAuditRead,
+		PermissionSubjectReport,
+		PermissionSubjectAnonymize,
+		PermissionOperatorManage,
+		PermissionPIIRead,
+		PermissionPIIReveal,
+	},
+}
+
+// IsRole reports whether role is a known role.
+func IsRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// HasPermission reports whether any of the roles grants the permission.
+// Unknown roles grant nothing.
+func HasPermission(roles []string, permission string) bool {
+	for _, role := range roles {
+		for _, granted := range rolePermissions[role] {
+			if granted == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NormalizeRoles trims, lowercases, deduplicates and sorts a set of roles,
+// rejecting unknown roles and empty sets.
+func NormalizeRoles(roles []string) ([]string, error) {
+	seen := map[string]bool{}
+	normalized := make([]string, 0, len(roles))
+
+	for _, role := range roles {
+		role = strings.ToLower(strings.TrimSpace(role))
+		if !IsRole(role) {
+			return nil, ErrRoleInvalid
+		}
+		if !seen[role] {
+			seen[role] = true
+			normalized = append(normalized, role)
+		}
+	}
+
+	if len(normalized) == 0 {
+		return nil, ErrRolesRequired
+	}
+
+	sort.Strings(normalized)
+	return normalized, nil
+}
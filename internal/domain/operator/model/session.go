@@ -0,0 +1,99 @@
+package operator
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token was already used")
+	ErrOperatorInactive    = errors.New("operator account is inactive")
+)
+
+// AccessToken is a signed access token with the ID and expiry of its claims.
+type AccessToken struct {
+	Token     string
+	ID        string
+	ExpiresAt time.Time
+}
+
+// TokenPair is what an operator receives at login and at each refresh: a
+// short-lived access token sent on every request and a refresh token that is
+// exchanged for a new pair when the access token expires.
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// RefreshToken is a refresh token issued to an operator. Only the SHA-256 hash
+// of the token is kept. The tokens issued from one login share a session ID:
+// each refresh revokes the token used and issues the next one of the session,
+// along with a new access token whose ID is recorded so that it can be revoked
+// with the session.
+type RefreshToken struct {
+	ID                   int
+	OperatorID           int
+	SessionID            string
+	TokenHash            string
+	AccessTokenID        string
+	AccessTokenExpiresAt time.Time
+	ExpiresAt            time.Time
+	CreatedAt            time.Time
+	RevokedAt            *time.Time
+}
+
+// NewRefreshToken creates the refresh token issued along with an access token
+// and returns it with the token to hand to the operator.
+func NewRefreshToken(operatorID int, sessionID, accessTokenID string, accessTokenExpiresAt time.Time, ttl time.Duration, now time.Time) (*RefreshToken, string, error) {
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &RefreshToken{
+		OperatorID:           operatorID,
+		SessionID:            sessionID,
+		TokenHash:            HashRefreshToken(secret),
+		AccessTokenID:        accessTokenID,
+		AccessTokenExpiresAt: accessTokenExpiresAt,
+		ExpiresAt:            now.Add(ttl),
+		CreatedAt:            now,
+	}, secret, nil
+}
+
+// NewSessionID creates the ID shared by the tokens issued from one login.
+func NewSessionID() (string, error) {
+	return randomToken(16)
+}
+
+// HashRefreshToken returns the hash under which a refresh token is stored.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsExpired reports whether the refresh token can no longer be used at now.
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsRevoked reports whether the refresh token was used or revoked.
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func randomToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,49 @@
+package operator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRefreshToken(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	accessExpiry := now.Add(15 * time.Minute)
+
+	token, secret, err := NewRefreshToken(3, "session-1", "access-1", accessExpiry, 24*time.Hour, now)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, secret)
+	assert.Equal(t, HashRefreshToken(secret), token.TokenHash)
+	assert.NotContains(t, token.TokenHash, secret, "only the hash of the token is kept")
+	assert.Equal(t, 3, token.OperatorID)
+	assert.Equal(t, "session-1", token.SessionID)
+	assert.Equal(t, "access-1", token.AccessTokenID)
+	assert.Equal(t, accessExpiry, token.AccessTokenExpiresAt)
+	assert.Equal(t, now.Add(24*time.Hour), token.ExpiresAt)
+	assert.False(t, token.IsRevoked())
+
+	_, other, err := NewRefreshToken(3, "session-1", "access-2", accessExpiry, 24*time.Hour, now)
+	assert.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestRefreshToken_IsExpired(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	token := &RefreshToken{ExpiresAt: now}
+
+	assert.False(t, token.IsExpired(now.Add(-time.Second)))
+	assert.True(t, token.IsExpired(now))
+}
+
+func TestNewSessionID(t *testing.T) {
+	first, err := NewSessionID()
+	assert.NoError(t, err)
+
+	second, err := NewSessionID()
+	assert.NoError(t, err)
+
+	assert.Len(t, first, 22)
+	assert.NotEqual(t, first, second)
+}
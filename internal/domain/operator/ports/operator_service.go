@@ -0,0 +1,14 @@
+package ports
+
+import (
+	audit "pessoas-api/internal/domain/audit/model"
+	operator "pessoas-api/internal/domain/operator/model"
+)
+
+type OperatorService interface {
+	ListOperators(page, pageSize int) ([]*operator.Operator, int64, error)
+	FindOperator(id int) (*operator.Operator, error)
+	AssignRoles(id int, roles []string, actor audit.Actor) (*operator.Operator, error)
+	SetActive(id int, active bool, actor audit.Actor) (*operator.Operator, error)
+	BootstrapAdmin(username, email, password string) (*operator.Operator, error)
+}
@@ -2,9 +2,17 @@ package ports
 
 import operator "pessoas-api/internal/domain/operator/model"
 
+// OperatorRepository defines the contract for persistence of operators and of
+// their roles. Operators are read with their roles. UpdateRoles replaces the
+// roles of an operator, UpdateActive activates or deactivates it and
+// CountActiveWithRole counts the active operators holding a role.
 type OperatorRepository interface {
 	Save(operator *operator.Operator) (ID int, err error)
 	FindByUsername(username string) (*operator.Operator, error)
 	FindByEmail(email string) (*operator.Operator, error)
 	FindByID(id int) (*operator.Operator, error)
+	FindAll(page, pageSize int) ([]*operator.Operator, int64, error)
+	UpdateRoles(id int, roles []string) error
+	UpdateActive(id int, active bool) error
+	CountActiveWithRole(role string) (int64, error)
 }
@@ -1,6 +1,13 @@
 package ports
 
+import operator "pessoas-api/internal/domain/operator/model"
+
 type AuthService interface {
 	Register(username, email, password string) (operatorID int, err error)
-	Login(username, password string) (token string, err error)
+	Login(username, password string) (*operator.TokenPair, error)
+	Refresh(refreshToken string) (*operator.TokenPair, error)
+	Logout(operatorID int, sessionID string) error
+	LogoutAll(operatorID int) error
+	IsAccessTokenRevoked(tokenID string) (bool, error)
+	PurgeExpiredTokens() error
 }
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
+)
+
+// SessionRepository defines the contract for persistence of refresh tokens and
+// of the list of revoked access tokens.
+//
+// Rotate revokes the token used in a refresh and saves the next one of its
+// session, failing with ErrRefreshTokenReused when the used token was already
+// revoked. RevokeSession and RevokeAll revoke the refresh tokens of a session
+// or of every session of an operator, and add the access tokens issued with
+// them that have not expired yet to the revocation list. DeleteExpired removes
+// what can no longer be used.
+type SessionRepository interface {
+	Save(token *operator.RefreshToken) error
+	FindByHash(tokenHash string) (*operator.RefreshToken, error)
+	Rotate(used, next *operator.RefreshToken) error
+	RevokeSession(operatorID int, sessionID string, now time.Time) error
+	RevokeAll(operatorID int, now time.Time) error
+	IsAccessTokenRevoked(tokenID string) (bool, error)
+	DeleteExpired(now time.Time) (int64, error)
+}
@@ -0,0 +1,8 @@
+package ports
+
+import operator "pessoas-api/internal/domain/operator/model"
+
+// TokenIssuer signs the access tokens of the sessions of operators.
+type TokenIssuer interface {
+	IssueAccessToken(operatorID int, username string, roles []string, sessionID string) (*operator.AccessToken, error)
+}
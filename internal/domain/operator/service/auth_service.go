@@ -3,19 +3,34 @@ package service
 import (
 	"errors"
 	"log"
+	"time"
 
 	operator "pessoas-api/internal/domain/operator/model"
 	"pessoas-api/internal/domain/operator/ports"
-	"pessoas-api/internal/infrastructure/http/middleware"
 )
 
+// DefaultRefreshTokenTTL is how long a refresh token lasts when unused. Each
+// refresh issues a new one, so an operator active within this period keeps the
+// session.
+const DefaultRefreshTokenTTL = 7 * 24 * time.Hour
+
+// AuthServiceImpl implements the ports.AuthService interface. A login opens a
+// session that issues short-lived access tokens and rotating refresh tokens.
+// Presenting a refresh token that was already used revokes its whole session,
+// since either the operator or someone holding a leaked copy is replaying it.
 type AuthServiceImpl struct {
-	repository ports.OperatorRepository
+	repository      ports.OperatorRepository
+	sessions        ports.SessionRepository
+	tokens          ports.TokenIssuer
+	refreshTokenTTL time.Duration
 }
 
-func NewAuthService(repository ports.OperatorRepository) ports.AuthService {
+func NewAuthService(repository ports.OperatorRepository, sessions ports.SessionRepository, tokens ports.TokenIssuer, refreshTokenTTL time.Duration) ports.AuthService {
 	return &AuthServiceImpl{
-		repository: repository,
+		repository:      repository,
+		sessions:        sessions,
+		tokens:          tokens,
+		refreshTokenTTL: refreshTokenTTL,
 	}
 }
 
@@ -54,34 +69,168 @@ func (s *AuthServiceImpl) Register(username, email, password string) (int, error
 	return id, nil
 }
 
-func (s *AuthServiceImpl) Login(username, password string) (string, error) {
+func (s *AuthServiceImpl) Login(username, password string) (*operator.TokenPair, error) {
 	op, err := s.repository.FindByUsername(username)
 	if err != nil {
 		log.Printf("[ERROR] Login - Failed to find operator: %v", err)
-		return "", errors.New("invalid credentials")
+		return nil, errors.New("invalid credentials")
 	}
 
 	if op == nil {
 		log.Printf("[WARN] Login - Operator not found: %s", username)
-		return "", errors.New("invalid credentials")
+		return nil, errors.New("invalid credentials")
 	}
 
 	if !op.Active {
 		log.Printf("[WARN] Login - Inactive operator attempted login: %s", username)
-		return "", errors.New("operator account is inactive")
+		return nil, operator.ErrOperatorInactive
 	}
 
 	if !op.ValidatePassword(password) {
 		log.Printf("[WARN] Login - Invalid password for operator: %s", username)
-		return "", errors.New("invalid credentials")
+		return nil, errors.New("invalid credentials")
+	}
+
+	sessionID, err := operator.NewSessionID()
+	if err != nil {
+		log.Printf("[ERROR] Login - Failed to open session: %v", err)
+		return nil, errors.New("failed to generate authentication token")
 	}
 
-	token, err := middleware.GenerateToken(op.ID, op.Username)
+	pair, refreshToken, err := s.issue(op, sessionID, time.Now())
 	if err != nil {
 		log.Printf("[ERROR] Login - Failed to generate token: %v", err)
-		return "", errors.New("failed to generate authentication token")
+		return nil, errors.New("failed to generate authentication token")
+	}
+
+	if err := s.sessions.Save(refreshToken); err != nil {
+		log.Printf("[ERROR] Login - Failed to save refresh token: %v", err)
+		return nil, errors.New("failed to generate authentication token")
 	}
 
 	log.Printf("[SUCCESS] Login - Operator authenticated: %s (ID: %d)", username, op.ID)
-	return token, nil
+	return pair, nil
+}
+
+// Refresh exchanges a refresh token for a new token pair of the same session.
+// The new access token carries the current roles of the operator.
+func (s *AuthServiceImpl) Refresh(token string) (*operator.TokenPair, error) {
+	now := time.Now()
+
+	current, err := s.sessions.FindByHash(operator.HashRefreshToken(token))
+	if err != nil {
+		log.Printf("[ERROR] Refresh - Failed to find refresh token: %v", err)
+		return nil, errors.New("failed to refresh authentication token")
+	}
+
+	if current == nil || current.IsExpired(now) {
+		log.Printf("[WARN] Refresh - Unknown or expired refresh token")
+		return nil, operator.ErrInvalidRefreshToken
+	}
+
+	if current.IsRevoked() {
+		log.Printf("[WARN] Refresh - Refresh token reused, revoking session of operator ID %d", current.OperatorID)
+		s.revokeSession(current, now)
+		return nil, operator.ErrInvalidRefreshToken
+	}
+
+	op, err := s.repository.FindByID(current.OperatorID)
+	if err != nil {
+		log.Printf("[ERROR] Refresh - Failed to find operator ID %d: %v", current.OperatorID, err)
+		return nil, errors.New("failed to refresh authentication token")
+	}
+
+	if op == nil || !op.Active {
+		log.Printf("[WARN] Refresh - Operator ID %d is inactive or no longer exists", current.OperatorID)
+		s.revokeSession(current, now)
+		return nil, operator.ErrOperatorInactive
+	}
+
+	pair, next, err := s.issue(op, current.SessionID, now)
+	if err != nil {
+		log.Printf("[ERROR] Refresh - Failed to generate token: %v", err)
+		return nil, errors.New("failed to refresh authentication token")
+	}
+
+	if err := s.sessions.Rotate(current, next); err != nil {
+		if errors.Is(err, operator.ErrRefreshTokenReused) {
+			log.Printf("[WARN] Refresh - Refresh token used concurrently, revoking session of operator ID %d", current.OperatorID)
+			s.revokeSession(current, now)
+			return nil, operator.ErrInvalidRefreshToken
+		}
+		log.Printf("[ERROR] Refresh - Failed to rotate refresh token: %v", err)
+		return nil, errors.New("failed to refresh authentication token")
+	}
+
+	log.Printf("[SUCCESS] Refresh - Token refreshed for operator ID %d", op.ID)
+	return pair, nil
+}
+
+// Logout revokes the session the request was authenticated with.
+func (s *AuthServiceImpl) Logout(operatorID int, sessionID string) error {
+	if err := s.sessions.RevokeSession(operatorID, sessionID, time.Now()); err != nil {
+		log.Printf("[ERROR] Logout - Failed to revoke session of operator ID %d: %v", operatorID, err)
+		return errors.New("failed to revoke session")
+	}
+
+	log.Printf("[SUCCESS] Logout - Session revoked for operator ID %d", operatorID)
+	return nil
+}
+
+// LogoutAll revokes every session of the operator.
+func (s *AuthServiceImpl) LogoutAll(operatorID int) error {
+	if err := s.sessions.RevokeAll(operatorID, time.Now()); err != nil {
+		log.Printf("[ERROR] LogoutAll - Failed to revoke sessions of operator ID %d: %v", operatorID, err)
+		return errors.New("failed to revoke sessions")
+	}
+
+	log.Printf("[SUCCESS] LogoutAll - Every session revoked for operator ID %d", operatorID)
+	return nil
+}
+
+func (s *AuthServiceImpl) IsAccessTokenRevoked(tokenID string) (bool, error) {
+	return s.sessions.IsAccessTokenRevoked(tokenID)
+}
+
+// PurgeExpiredTokens deletes the refresh tokens and revocations that expired.
+func (s *AuthServiceImpl) PurgeExpiredTokens() error {
+	deleted, err := s.sessions.DeleteExpired(time.Now())
+	if err != nil {
+		log.Printf("[ERROR] PurgeExpiredTokens - Failed to delete expired tokens: %v", err)
+		return err
+	}
+
+	if deleted > 0 {
+		log.Printf("[SUCCESS] PurgeExpiredTokens - Deleted %d expired tokens", deleted)
+	}
+	return nil
+}
+
+// issue generates an access token of the session and the refresh token that
+// will replace it.
+func (s *AuthServiceImpl) issue(op *operator.Operator, sessionID string, now time.Time) (*operator.TokenPair, *operator.RefreshToken, error) {
+	access, err := s.tokens.IssueAccessToken(op.ID, op.Username, op.Roles, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken, secret, err := operator.NewRefreshToken(op.ID, sessionID, access.ID, access.ExpiresAt, s.refreshTokenTTL, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &operator.TokenPair{
+		AccessToken:           access.Token,
+		AccessTokenExpiresAt:  access.ExpiresAt,
+		RefreshToken:          secret,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	}, refreshToken, nil
+}
+
+// revokeSession revokes the session of a refresh token that is being refused.
+// The refresh fails either way, so a failure here is only logged.
+func (s *AuthServiceImpl) revokeSession(token *operator.RefreshToken, now time.Time) {
+	if err := s.sessions.RevokeSession(token.OperatorID, token.SessionID, now); err != nil {
+		log.Printf("[ERROR] Refresh - Failed to revoke session of operator ID %d: %v", token.OperatorID, err)
+	}
 }
@@ -2,8 +2,8 @@ package service
 
 import (
 	"errors"
-	"os"
 	"testing"
+	"time"
 
 	operator "pessoas-api/internal/domain/operator/model"
 
@@ -44,9 +44,107 @@ func (m *MockOperatorRepository) FindByID(id int) (*operator.Operator, error) {
 	return args.Get(0).(*operator.Operator), args.Error(1)
 }
 
-func TestRegister_Success(t *testing.T) {
+func (m *MockOperatorRepository) FindAll(page, pageSize int) ([]*operator.Operator, int64, error) {
+	args := m.Called(page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*operator.Operator), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockOperatorRepository) UpdateRoles(id int, roles []string) error {
+	args := m.Called(id, roles)
+	return args.Error(0)
+}
+
+func (m *MockOperatorRepository) UpdateActive(id int, active bool) error {
+	args := m.Called(id, active)
+	return args.Error(0)
+}
+
+func (m *MockOperatorRepository) CountActiveWithRole(role string) (int64, error) {
+	args := m.Called(role)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockSessionRepository) Save(token *operator.RefreshToken) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) FindByHash(tokenHash string) (*operator.RefreshToken, error) {
+	args := m.Called(tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*operator.RefreshToken), args.Error(1)
+}
+
+func (m *MockSessionRepository) Rotate(used, next *operator.RefreshToken) error {
+	args := m.Called(used, next)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) RevokeSession(operatorID int, sessionID string, now time.Time) error {
+	args := m.Called(operatorID, sessionID, now)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) RevokeAll(operatorID int, now time.Time) error {
+	args := m.Called(operatorID, now)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) IsAccessTokenRevoked(tokenID string) (bool, error) {
+	args := m.Called(tokenID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSessionRepository) DeleteExpired(now time.Time) (int64, error) {
+	args := m.Called(now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockTokenIssuer is a mock implementation of TokenIssuer
+type MockTokenIssuer struct {
+	mock.Mock
+}
+
+func (m *MockTokenIssuer) IssueAccessToken(operatorID int, username string, roles []string, sessionID string) (*operator.AccessToken, error) {
+	args := m.Called(operatorID, username, roles, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*operator.AccessToken), args.Error(1)
+}
+
+// newTestAuthService creates the service with mocked repositories and an
+// issuer that signs every access token.
+func newTestAuthService() (*AuthServiceImpl, *MockOperatorRepository, *MockSessionRepository) {
+	service, mockRepo, mockSessions, mockTokens := newTestAuthServiceWithIssuer()
+	mockTokens.On("IssueAccessToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&operator.AccessToken{
+		Token:     "access-token",
+		ID:        "access-2",
+		ExpiresAt: time.Now().Add(15 * time.Minute),
+	}, nil)
+	return service, mockRepo, mockSessions
+}
+
+// newTestAuthServiceWithIssuer creates the service with mocked repositories
+// and a token issuer left to the test.
+func newTestAuthServiceWithIssuer() (*AuthServiceImpl, *MockOperatorRepository, *MockSessionRepository, *MockTokenIssuer) {
 	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	mockSessions := new(MockSessionRepository)
+	mockTokens := new(MockTokenIssuer)
+	return NewAuthService(mockRepo, mockSessions, mockTokens, time.Hour).(*AuthServiceImpl), mockRepo, mockSessions, mockTokens
+}
+
+func TestRegister_Success(t *testing.T) {
+	service, mockRepo, _ := newTestAuthService()
 
 	mockRepo.On("FindByUsername", "newuser").Return(nil, nil)
 	mockRepo.On("FindByEmail", "newuser@example.com").Return(nil, nil)
@@ -60,8 +158,7 @@ func TestRegister_Success(t *testing.T) {
 }
 
 func TestRegister_UsernameAlreadyExists(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	existingOp := &operator.Operator{
 		ID:       1,
@@ -81,8 +178,7 @@ func TestRegister_UsernameAlreadyExists(t *testing.T) {
 }
 
 func TestRegister_EmailAlreadyExists(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	existingOp := &operator.Operator{
 		ID:       1,
@@ -103,8 +199,7 @@ func TestRegister_EmailAlreadyExists(t *testing.T) {
 }
 
 func TestRegister_FindByUsernameError(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	mockRepo.On("FindByUsername", "testuser").Return(nil, errors.New("database error"))
 
@@ -117,8 +212,7 @@ func TestRegister_FindByUsernameError(t *testing.T) {
 }
 
 func TestRegister_FindByEmailError(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	mockRepo.On("FindByUsername", "testuser").Return(nil, nil)
 	mockRepo.On("FindByEmail", "test@example.com").Return(nil, errors.New("database error"))
@@ -132,8 +226,7 @@ func TestRegister_FindByEmailError(t *testing.T) {
 }
 
 func TestRegister_ValidationError_ShortUsername(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	mockRepo.On("FindByUsername", "ab").Return(nil, nil)
 	mockRepo.On("FindByEmail", "test@example.com").Return(nil, nil)
@@ -147,8 +240,7 @@ func TestRegister_ValidationError_ShortUsername(t *testing.T) {
 }
 
 func TestRegister_ValidationError_ShortPassword(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	mockRepo.On("FindByUsername", "testuser").Return(nil, nil)
 	mockRepo.On("FindByEmail", "test@example.com").Return(nil, nil)
@@ -162,8 +254,7 @@ func TestRegister_ValidationError_ShortPassword(t *testing.T) {
 }
 
 func TestRegister_SaveError(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	mockRepo.On("FindByUsername", "newuser").Return(nil, nil)
 	mockRepo.On("FindByEmail", "newuser@example.com").Return(nil, nil)
@@ -178,55 +269,57 @@ func TestRegister_SaveError(t *testing.T) {
 }
 
 func TestLogin_Success(t *testing.T) {
-	os.Setenv("JWT_SECRET", "test-secret-key-minimum-32-characters-long")
-	defer os.Unsetenv("JWT_SECRET")
-
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, mockSessions := newTestAuthService()
 
 	op, _ := operator.NewOperator("testuser", "test@example.com", "password123")
 	op.ID = 1
 
 	mockRepo.On("FindByUsername", "testuser").Return(op, nil)
+	mockSessions.On("Save", mock.AnythingOfType("*operator.RefreshToken")).Return(nil)
 
-	token, err := service.Login("testuser", "password123")
+	pair, err := service.Login("testuser", "password123")
 
 	assert.NoError(t, err)
-	assert.NotEmpty(t, token)
+	assert.Equal(t, "access-token", pair.AccessToken)
+	assert.NotEmpty(t, pair.RefreshToken)
+	assert.True(t, pair.RefreshTokenExpiresAt.After(pair.AccessTokenExpiresAt))
+
+	saved := mockSessions.Calls[0].Arguments.Get(0).(*operator.RefreshToken)
+	assert.Equal(t, 1, saved.OperatorID)
+	assert.Equal(t, operator.HashRefreshToken(pair.RefreshToken), saved.TokenHash)
+	assert.NotEmpty(t, saved.SessionID)
+	assert.Equal(t, "access-2", saved.AccessTokenID, "the ID of the access token is recorded to revoke it with the session")
 	mockRepo.AssertExpectations(t)
 }
 
 func TestLogin_UserNotFound(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	mockRepo.On("FindByUsername", "nonexistent").Return(nil, nil)
 
-	token, err := service.Login("nonexistent", "password123")
+	pair, err := service.Login("nonexistent", "password123")
 
 	assert.Error(t, err)
-	assert.Empty(t, token)
+	assert.Nil(t, pair)
 	assert.Equal(t, "invalid credentials", err.Error())
 	mockRepo.AssertExpectations(t)
 }
 
 func TestLogin_FindByUsernameError(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	mockRepo.On("FindByUsername", "testuser").Return(nil, errors.New("database error"))
 
-	token, err := service.Login("testuser", "password123")
+	pair, err := service.Login("testuser", "password123")
 
 	assert.Error(t, err)
-	assert.Empty(t, token)
+	assert.Nil(t, pair)
 	assert.Equal(t, "invalid credentials", err.Error())
 	mockRepo.AssertExpectations(t)
 }
 
 func TestLogin_InactiveOperator(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	op, _ := operator.NewOperator("testuser", "test@example.com", "password123")
 	op.ID = 1
@@ -234,45 +327,180 @@ func TestLogin_InactiveOperator(t *testing.T) {
 
 	mockRepo.On("FindByUsername", "testuser").Return(op, nil)
 
-	token, err := service.Login("testuser", "password123")
+	pair, err := service.Login("testuser", "password123")
 
 	assert.Error(t, err)
-	assert.Empty(t, token)
+	assert.Nil(t, pair)
 	assert.Equal(t, "operator account is inactive", err.Error())
 	mockRepo.AssertExpectations(t)
 }
 
 func TestLogin_InvalidPassword(t *testing.T) {
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, _ := newTestAuthService()
 
 	op, _ := operator.NewOperator("testuser", "test@example.com", "password123")
 	op.ID = 1
 
 	mockRepo.On("FindByUsername", "testuser").Return(op, nil)
 
-	token, err := service.Login("testuser", "wrongpassword")
+	pair, err := service.Login("testuser", "wrongpassword")
 
 	assert.Error(t, err)
-	assert.Empty(t, token)
+	assert.Nil(t, pair)
 	assert.Equal(t, "invalid credentials", err.Error())
 	mockRepo.AssertExpectations(t)
 }
 
 func TestLogin_TokenGenerationError(t *testing.T) {
-	os.Unsetenv("JWT_SECRET")
-
-	mockRepo := new(MockOperatorRepository)
-	service := NewAuthService(mockRepo)
+	service, mockRepo, mockSessions, mockTokens := newTestAuthServiceWithIssuer()
 
 	op, _ := operator.NewOperator("testuser", "test@example.com", "password123")
 	op.ID = 1
 
 	mockRepo.On("FindByUsername", "testuser").Return(op, nil)
+	mockTokens.On("IssueAccessToken", 1, "testuser", op.Roles, mock.AnythingOfType("string")).Return(nil, errors.New("signing error"))
+
+	pair, err := service.Login("testuser", "password123")
+
+	assert.Error(t, err)
+	assert.Nil(t, pair)
+	assert.Equal(t, "failed to generate authentication token", err.Error())
+	mockRepo.AssertExpectations(t)
+	mockTokens.AssertExpectations(t)
+	mockSessions.AssertNotCalled(t, "Save", mock.Anything)
+}
 
-	assert.Panics(t, func() {
-		service.Login("testuser", "password123")
-	})
+// activeRefreshToken is an unused refresh token of operator 1.
+func activeRefreshToken() *operator.RefreshToken {
+	now := time.Now()
+	return &operator.RefreshToken{
+		ID:                   7,
+		OperatorID:           1,
+		SessionID:            "session-1",
+		AccessTokenID:        "access-1",
+		AccessTokenExpiresAt: now.Add(10 * time.Minute),
+		ExpiresAt:            now.Add(time.Hour),
+		CreatedAt:            now.Add(-5 * time.Minute),
+	}
+}
+
+func TestRefresh_Success(t *testing.T) {
+	service, mockRepo, mockSessions := newTestAuthService()
+
+	op := operatorWithRoles(1, operator.RoleEditor)
+	current := activeRefreshToken()
+	mockSessions.On("FindByHash", operator.HashRefreshToken("refresh-1")).Return(current, nil)
+	mockRepo.On("FindByID", 1).Return(op, nil)
+	mockSessions.On("Rotate", current, mock.MatchedBy(func(next *operator.RefreshToken) bool {
+		return next.OperatorID == 1 && next.SessionID == "session-1" && next.AccessTokenID != "access-1"
+	})).Return(nil)
 
+	pair, err := service.Refresh("refresh-1")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, pair.AccessToken)
+	assert.NotEqual(t, "refresh-1", pair.RefreshToken)
 	mockRepo.AssertExpectations(t)
+	mockSessions.AssertExpectations(t)
+}
+
+func TestRefresh_Refused(t *testing.T) {
+	expired := activeRefreshToken()
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		found *operator.RefreshToken
+	}{
+		{"unknown token", nil},
+		{"expired token", expired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockSessions := newTestAuthService()
+
+			if tt.found != nil {
+				mockSessions.On("FindByHash", mock.Anything).Return(tt.found, nil)
+			} else {
+				mockSessions.On("FindByHash", mock.Anything).Return(nil, nil)
+			}
+
+			pair, err := service.Refresh("refresh-1")
+
+			assert.ErrorIs(t, err, operator.ErrInvalidRefreshToken)
+			assert.Nil(t, pair)
+			mockRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+			mockSessions.AssertNotCalled(t, "RevokeSession", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestRefresh_ReusedTokenRevokesSession(t *testing.T) {
+	service, mockRepo, mockSessions := newTestAuthService()
+
+	used := activeRefreshToken()
+	revokedAt := time.Now().Add(-time.Minute)
+	used.RevokedAt = &revokedAt
+	mockSessions.On("FindByHash", mock.Anything).Return(used, nil)
+	mockSessions.On("RevokeSession", 1, "session-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+	pair, err := service.Refresh("refresh-1")
+
+	assert.ErrorIs(t, err, operator.ErrInvalidRefreshToken)
+	assert.Nil(t, pair)
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+	mockSessions.AssertExpectations(t)
+}
+
+func TestRefresh_ConcurrentReuseRevokesSession(t *testing.T) {
+	service, mockRepo, mockSessions := newTestAuthService()
+
+	mockSessions.On("FindByHash", mock.Anything).Return(activeRefreshToken(), nil)
+	mockRepo.On("FindByID", 1).Return(operatorWithRoles(1, operator.RoleEditor), nil)
+	mockSessions.On("Rotate", mock.Anything, mock.Anything).Return(operator.ErrRefreshTokenReused)
+	mockSessions.On("RevokeSession", 1, "session-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+	pair, err := service.Refresh("refresh-1")
+
+	assert.ErrorIs(t, err, operator.ErrInvalidRefreshToken)
+	assert.Nil(t, pair)
+	mockSessions.AssertExpectations(t)
+}
+
+func TestRefresh_InactiveOperatorRevokesSession(t *testing.T) {
+	service, mockRepo, mockSessions := newTestAuthService()
+
+	inactive := operatorWithRoles(1, operator.RoleEditor)
+	inactive.Active = false
+	mockSessions.On("FindByHash", mock.Anything).Return(activeRefreshToken(), nil)
+	mockRepo.On("FindByID", 1).Return(inactive, nil)
+	mockSessions.On("RevokeSession", 1, "session-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+	pair, err := service.Refresh("refresh-1")
+
+	assert.ErrorIs(t, err, operator.ErrOperatorInactive)
+	assert.Nil(t, pair)
+	mockSessions.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything)
+	mockSessions.AssertExpectations(t)
+}
+
+func TestLogout(t *testing.T) {
+	service, _, mockSessions := newTestAuthService()
+
+	mockSessions.On("RevokeSession", 1, "session-1", mock.AnythingOfType("time.Time")).Return(nil).Once()
+	mockSessions.On("RevokeSession", 2, "session-2", mock.AnythingOfType("time.Time")).Return(errors.New("database error")).Once()
+
+	assert.NoError(t, service.Logout(1, "session-1"))
+	assert.EqualError(t, service.Logout(2, "session-2"), "failed to revoke session")
+	mockSessions.AssertExpectations(t)
+}
+
+func TestLogoutAll(t *testing.T) {
+	service, _, mockSessions := newTestAuthService()
+
+	mockSessions.On("RevokeAll", 1, mock.AnythingOfType("time.Time")).Return(nil)
+
+	assert.NoError(t, service.LogoutAll(1))
+	mockSessions.AssertExpectations(t)
 }
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	contract "pessoas-api/internal/contract/auth"
+	audit "pessoas-api/internal/domain/audit/model"
+	auditPorts "pessoas-api/internal/domain/audit/ports"
+	operator "pessoas-api/internal/domain/operator/model"
+	"pessoas-api/internal/domain/operator/ports"
+)
+
+// OperatorServiceImpl implements the ports.OperatorService interface.
+// Role assignments and deactivations are recorded in the audit trail, and the
+// last active admin can neither lose the admin role nor be deactivated, so
+// that someone can always assign roles.
+type OperatorServiceImpl struct {
+	repository      ports.OperatorRepository
+	sessions        ports.SessionRepository
+	auditRepository auditPorts.AuditRepository
+}
+
+// NewOperatorService creates a new instance of OperatorServiceImpl.
+// It returns the implementation as the OperatorService interface.
+func NewOperatorService(repository ports.OperatorRepository, sessions ports.SessionRepository, auditRepository auditPorts.AuditRepository) ports.OperatorService {
+	return &OperatorServiceImpl{
+		repository:      repository,
+		sessions:        sessions,
+		auditRepository: auditRepository,
+	}
+}
+
+func (s *OperatorServiceImpl) ListOperators(page, pageSize int) ([]*operator.Operator, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.repository.FindAll(page, pageSize)
+}
+
+func (s *OperatorServiceImpl) FindOperator(id int) (*operator.Operator, error) {
+	op, err := s.repository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if op == nil {
+		return nil, operator.ErrOperatorNotFound
+	}
+
+	return op, nil
+}
+
+// AssignRoles replaces the roles of an operator. The new roles apply from the
+// next login or token refresh of the operator.
+func (s *OperatorServiceImpl) AssignRoles(id int, roles []string, actor audit.Actor) (*operator.Operator, error) {
+	op, err := s.FindOperator(id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *op
+
+	if err := op.AssignRoles(roles); err != nil {
+		return nil, err
+	}
+
+	if before.Active && before.HasRole(operator.RoleAdmin) && !op.HasRole(operator.RoleAdmin) {
+		admins, err := s.repository.CountActiveWithRole(operator.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if admins <= 1 {
+			return nil, operator.ErrLastAdminRequired
+		}
+	}
+
+	if err := s.repository.UpdateRoles(id, op.Roles); err != nil {
+		return nil, err
+	}
+
+	s.recordChange(actor, &before, op)
+
+	return op, nil
+}
+
+// BootstrapAdmin creates the first admin of a fresh install, so that someone
+// can assign roles. It is applied at startup from ADMIN_USERNAME, ADMIN_EMAIL
+// and ADMIN_PASSWORD and returns nil without changes once an active admin
+// exists, so it never undoes a later role change. It only ever creates a new
+// operator: an existing one could have registered the username through the
+// public /auth/register route and is never promoted. The creation is
+// recorded with the system as its actor.
+func (s *OperatorServiceImpl) BootstrapAdmin(username, email, password string) (*operator.Operator, error) {
+	admins, err := s.repository.CountActiveWithRole(operator.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if admins > 0 {
+		return nil, nil
+	}
+
+	byUsername, err := s.repository.FindByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	byEmail, err := s.repository.FindByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if byUsername != nil || byEmail != nil {
+		return nil, operator.ErrAdminAccountTaken
+	}
+
+	op, err := operator.NewOperator(username, email, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := op.AssignRoles([]string{operator.RoleAdmin}); err != nil {
+		return nil, err
+	}
+
+	id, err := s.repository.Save(op)
+	if err != nil {
+		return nil, err
+	}
+	op.ID = id
+
+	entry := audit.NewAuditEntry(audit.EntityOperator, op.ID, audit.ActionCreate, audit.Actor{}, nil, operatorSnapshot(op))
+	if err := s.auditRepository.Save(entry); err != nil {
+		log.Printf("[ERROR] OperatorService - Failed to record creation of admin operator ID %d: %v", op.ID, err)
+	}
+
+	return op, nil
+}
+
+// SetActive activates or deactivates an operator. Deactivation revokes every
+// session of the operator, so its access tokens stop working immediately.
+func (s *OperatorServiceImpl) SetActive(id int, active bool, actor audit.Actor) (*operator.Operator, error) {
+	op, err := s.FindOperator(id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *op
+
+	if !active && op.Active && op.HasRole(operator.RoleAdmin) {
+		admins, err := s.repository.CountActiveWithRole(operator.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if admins <= 1 {
+			return nil, operator.ErrLastAdminActive
+		}
+	}
+
+	op.SetActive(active)
+
+	if err := s.repository.UpdateActive(id, active); err != nil {
+		return nil, err
+	}
+
+	if before.Active != op.Active {
+		s.recordChange(actor, &before, op)
+	}
+
+	// Revoking again when the operator was already inactive is harmless, and
+	// lets a failed revocation be retried
+	if !active {
+		if err := s.sessions.RevokeAll(id, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return op, nil
+}
+
+// recordChange appends a change of the operator to the audit trail. The
+// change has already been saved, so a failure here is logged instead of being
+// returned.
+func (s *OperatorServiceImpl) recordChange(actor audit.Actor, before, after *operator.Operator) {
+	entry := audit.NewAuditEntry(audit.EntityOperator, after.ID, audit.ActionUpdate, actor, operatorSnapshot(before), operatorSnapshot(after))
+
+	if err := s.auditRepository.Save(entry); err != nil {
+		log.Printf("[ERROR] OperatorService - Failed to record change of operator ID %d by operator %d: %v", after.ID, actor.OperatorID, err)
+	}
+}
+
+func operatorSnapshot(op *operator.Operator) json.RawMessage {
+	snapshot, err := json.Marshal(contract.NewOperatorResponseDTO(op))
+	if err != nil {
+		return nil
+	}
+
+	return snapshot
+}
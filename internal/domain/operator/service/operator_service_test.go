@@ -0,0 +1,292 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	operator "pessoas-api/internal/domain/operator/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) Save(entry *audit.AuditEntry) error {
+	args := m.Called(entry)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepository) SaveAll(entries []*audit.AuditEntry) error {
+	args := m.Called(entries)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepository) FindByEntity(entityType string, entityID int, page, size int) ([]*audit.AuditEntry, int64, error) {
+	args := m.Called(entityType, entityID, page, size)
+	return nil, 0, args.Error(2)
+}
+
+func (m *MockAuditRepository) FindByEntities(entityType string, entityIDs []int, page, size int) ([]*audit.AuditEntry, int64, error) {
+	args := m.Called(entityType, entityIDs, page, size)
+	return nil, 0, args.Error(2)
+}
+
+func (m *MockAuditRepository) FindBySubject(personIDs []int) ([]*audit.AuditEntry, error) {
+	args := m.Called(personIDs)
+	return nil, args.Error(1)
+}
+
+var adminActor = audit.Actor{OperatorID: 1, RequestID: "req-1", ClientIP: "203.0.113.10"}
+
+func operatorWithRoles(id int, roles ...string) *operator.Operator {
+	return &operator.Operator{ID: id, Username: "operator", Email: "operator@example.com", Active: true, Roles: roles}
+}
+
+func TestAssignRoles_Success(t *testing.T) {
+	mockRepo := new(MockOperatorRepository)
+	mockAudit := new(MockAuditRepository)
+	service := NewOperatorService(mockRepo, new(MockSessionRepository), mockAudit)
+
+	mockRepo.On("FindByID", 2).Return(operatorWithRoles(2, operator.RoleViewer), nil)
+	mockRepo.On("UpdateRoles", 2, []string{operator.RoleAuditor, operator.RoleEditor}).Return(nil)
+	mockAudit.On("Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityOperator && e.EntityID == 2 && e.Action == audit.ActionUpdate &&
+			e.OperatorID == adminActor.OperatorID && strings.Contains(string(e.Before), `"viewer"`) &&
+			strings.Contains(string(e.After), `"auditor","editor"`)
+	})).Return(nil)
+
+	op, err := service.AssignRoles(2, []string{"editor", "auditor"}, adminActor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{operator.RoleAuditor, operator.RoleEditor}, op.Roles)
+	mockRepo.AssertExpectations(t)
+	mockAudit.AssertExpectations(t)
+}
+
+func TestAssignRoles_KeepsLastAdmin(t *testing.T) {
+	tests := []struct {
+		name     string
+		admins   int64
+		expected error
+	}{
+		{"last admin", 1, operator.ErrLastAdminRequired},
+		{"other admins left", 2, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockOperatorRepository)
+			mockAudit := new(MockAuditRepository)
+			service := NewOperatorService(mockRepo, new(MockSessionRepository), mockAudit)
+
+			mockRepo.On("FindByID", 1).Return(operatorWithRoles(1, operator.RoleAdmin), nil)
+			mockRepo.On("CountActiveWithRole", operator.RoleAdmin).Return(tt.admins, nil)
+			mockRepo.On("UpdateRoles", 1, []string{operator.RoleEditor}).Return(nil)
+			mockAudit.On("Save", mock.Anything).Return(nil)
+
+			_, err := service.AssignRoles(1, []string{"editor"}, adminActor)
+
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				mockRepo.AssertNotCalled(t, "UpdateRoles", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAssignRoles_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		found    *operator.Operator
+		findErr  error
+		roles    []string
+		expected error
+	}{
+		{"operator not found", nil, nil, []string{"editor"}, operator.ErrOperatorNotFound},
+		{"unknown role", operatorWithRoles(2, operator.RoleViewer), nil, []string{"root"}, operator.ErrRoleInvalid},
+		{"no roles", operatorWithRoles(2, operator.RoleViewer), nil, []string{}, operator.ErrRolesRequired},
+		{"repository error", nil, errors.New("database error"), []string{"editor"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockOperatorRepository)
+			service := NewOperatorService(mockRepo, new(MockSessionRepository), new(MockAuditRepository))
+
+			if tt.found != nil {
+				mockRepo.On("FindByID", 2).Return(tt.found, tt.findErr)
+			} else {
+				mockRepo.On("FindByID", 2).Return(nil, tt.findErr)
+			}
+
+			_, err := service.AssignRoles(2, tt.roles, adminActor)
+
+			assert.Error(t, err)
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+			mockRepo.AssertNotCalled(t, "UpdateRoles", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListOperators_ClampsPagination(t *testing.T) {
+	mockRepo := new(MockOperatorRepository)
+	service := NewOperatorService(mockRepo, new(MockSessionRepository), new(MockAuditRepository))
+
+	mockRepo.On("FindAll", 1, 10).Return([]*operator.Operator{}, int64(0), nil)
+
+	_, _, err := service.ListOperators(0, 500)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetActive_DeactivateRevokesSessions(t *testing.T) {
+	mockRepo := new(MockOperatorRepository)
+	mockSessions := new(MockSessionRepository)
+	mockAudit := new(MockAuditRepository)
+	service := NewOperatorService(mockRepo, mockSessions, mockAudit)
+
+	mockRepo.On("FindByID", 2).Return(operatorWithRoles(2, operator.RoleEditor), nil)
+	mockRepo.On("UpdateActive", 2, false).Return(nil)
+	mockSessions.On("RevokeAll", 2, mock.AnythingOfType("time.Time")).Return(nil)
+	mockAudit.On("Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityOperator && e.EntityID == 2 && e.Action == audit.ActionUpdate &&
+			strings.Contains(string(e.Before), `"active":true`) && strings.Contains(string(e.After), `"active":false`)
+	})).Return(nil)
+
+	op, err := service.SetActive(2, false, adminActor)
+
+	assert.NoError(t, err)
+	assert.False(t, op.Active)
+	mockRepo.AssertExpectations(t)
+	mockSessions.AssertExpectations(t)
+	mockAudit.AssertExpectations(t)
+}
+
+func TestSetActive_ActivateKeepsSessions(t *testing.T) {
+	mockRepo := new(MockOperatorRepository)
+	mockSessions := new(MockSessionRepository)
+	mockAudit := new(MockAuditRepository)
+	service := NewOperatorService(mockRepo, mockSessions, mockAudit)
+
+	inactive := operatorWithRoles(2, operator.RoleEditor)
+	inactive.Active = false
+	mockRepo.On("FindByID", 2).Return(inactive, nil)
+	mockRepo.On("UpdateActive", 2, true).Return(nil)
+	mockAudit.On("Save", mock.Anything).Return(nil)
+
+	op, err := service.SetActive(2, true, adminActor)
+
+	assert.NoError(t, err)
+	assert.True(t, op.Active)
+	mockSessions.AssertNotCalled(t, "RevokeAll", mock.Anything, mock.Anything)
+}
+
+func TestSetActive_KeepsLastAdmin(t *testing.T) {
+	mockRepo := new(MockOperatorRepository)
+	mockSessions := new(MockSessionRepository)
+	service := NewOperatorService(mockRepo, mockSessions, new(MockAuditRepository))
+
+	mockRepo.On("FindByID", 1).Return(operatorWithRoles(1, operator.RoleAdmin), nil)
+	mockRepo.On("CountActiveWithRole", operator.RoleAdmin).Return(int64(1), nil)
+
+	_, err := service.SetActive(1, false, adminActor)
+
+	assert.ErrorIs(t, err, operator.ErrLastAdminActive)
+	mockRepo.AssertNotCalled(t, "UpdateActive", mock.Anything, mock.Anything)
+	mockSessions.AssertNotCalled(t, "RevokeAll", mock.Anything, mock.Anything)
+}
+
+func TestBootstrapAdmin_CreatesAdmin(t *testing.T) {
+	mockRepo := new(MockOperatorRepository)
+	mockAudit := new(MockAuditRepository)
+	service := NewOperatorService(mockRepo, new(MockSessionRepository), mockAudit)
+
+	mockRepo.On("CountActiveWithRole", operator.RoleAdmin).Return(int64(0), nil)
+	mockRepo.On("FindByUsername", "admin").Return(nil, nil)
+	mockRepo.On("FindByEmail", "admin@example.com").Return(nil, nil)
+	mockRepo.On("Save", mock.MatchedBy(func(op *operator.Operator) bool {
+		return op.Username == "admin" && op.Active && op.ValidatePassword("Admin@123456") &&
+			len(op.Roles) == 1 && op.Roles[0] == operator.RoleAdmin
+	})).Return(1, nil)
+	mockAudit.On("Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityOperator && e.EntityID == 1 && e.Action == audit.ActionCreate &&
+			e.OperatorID == 0 && e.Before == nil && strings.Contains(string(e.After), `"admin"`)
+	})).Return(nil)
+
+	op, err := service.BootstrapAdmin("admin", "admin@example.com", "Admin@123456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, op.ID)
+	assert.Equal(t, []string{operator.RoleAdmin}, op.Roles)
+	mockRepo.AssertExpectations(t)
+	mockAudit.AssertExpectations(t)
+}
+
+func TestBootstrapAdmin_AdminExists(t *testing.T) {
+	mockRepo := new(MockOperatorRepository)
+	mockAudit := new(MockAuditRepository)
+	service := NewOperatorService(mockRepo, new(MockSessionRepository), mockAudit)
+
+	mockRepo.On("CountActiveWithRole", operator.RoleAdmin).Return(int64(1), nil)
+
+	op, err := service.BootstrapAdmin("admin", "admin@example.com", "Admin@123456")
+
+	assert.NoError(t, err)
+	assert.Nil(t, op)
+	mockRepo.AssertNotCalled(t, "FindByUsername", mock.Anything)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateRoles", mock.Anything, mock.Anything)
+	mockAudit.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestBootstrapAdmin_NeverPromotesRegisteredOperator(t *testing.T) {
+	tests := []struct {
+		name       string
+		byUsername *operator.Operator
+		byEmail    *operator.Operator
+	}{
+		{"username registered", operatorWithRoles(1), nil},
+		{"email registered", nil, operatorWithRoles(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockOperatorRepository)
+			service := NewOperatorService(mockRepo, new(MockSessionRepository), new(MockAuditRepository))
+
+			mockRepo.On("CountActiveWithRole", operator.RoleAdmin).Return(int64(0), nil)
+			mockRepo.On("FindByUsername", "admin").Return(tt.byUsername, nil)
+			mockRepo.On("FindByEmail", "admin@example.com").Return(tt.byEmail, nil)
+
+			_, err := service.BootstrapAdmin("admin", "admin@example.com", "Admin@123456")
+
+			assert.ErrorIs(t, err, operator.ErrAdminAccountTaken)
+			mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+			mockRepo.AssertNotCalled(t, "UpdateRoles", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestBootstrapAdmin_InvalidCredentials(t *testing.T) {
+	mockRepo := new(MockOperatorRepository)
+	service := NewOperatorService(mockRepo, new(MockSessionRepository), new(MockAuditRepository))
+
+	mockRepo.On("CountActiveWithRole", operator.RoleAdmin).Return(int64(0), nil)
+	mockRepo.On("FindByUsername", "admin").Return(nil, nil)
+	mockRepo.On("FindByEmail", "").Return(nil, nil)
+
+	_, err := service.BootstrapAdmin("admin", "", "short")
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
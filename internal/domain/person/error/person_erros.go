@@ -1,6 +1,9 @@
 package person
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrNameRequired     = errors.New("name is required")
@@ -12,4 +15,108 @@ var (
 	ErrEmailInvalid     = errors.New("email is invalid")
 	ErrBirthDateInvalid = errors.New("birth date is invalid")
 	ErrPersonNotFound   = errors.New("person not found")
+	ErrVersionConflict  = errors.New("person was modified by another request")
+	ErrPersonNotDeleted = errors.New("person is not deleted")
+	ErrCPFAlreadyInUse  = errors.New("cpf is already registered to another person")
+	ErrInvalidCursor    = errors.New("cursor is invalid for this listing")
+	ErrMalformedRow     = errors.New("row could not be parsed")
+	ErrDuplicateInFile  = errors.New("cpf appears more than once in the file")
+	ErrUnknownColumn    = errors.New("unknown export column")
+	ErrEncryptedField   = errors.New("field is encrypted at rest and cannot be sorted or matched partially")
+)
+
+var (
+	ErrAddressNotFound       = errors.New("address not found")
+	ErrAddressTypeInvalid    = errors.New("address type must be residential, billing or work")
+	ErrCEPRequired           = errors.New("cep is required")
+	ErrCEPInvalid            = errors.New("cep is invalid")
+	ErrStreetRequired        = errors.New("street is required")
+	ErrAddressNumberRequired = errors.New("address number is required")
+	ErrCityRequired          = errors.New("city is required")
+	ErrStateInvalid          = errors.New("state is invalid")
+	ErrCountryInvalid        = errors.New("country must be an ISO 3166-1 alpha-2 code")
+)
+
+var (
+	ErrPostalCodeNotFound    = errors.New("postal code not found")
+	ErrPostalCodeUnavailable = errors.New("postal code lookup is unavailable")
+)
+
+var (
+	ErrContactNotFound      = errors.New("contact not found")
+	ErrContactTypeInvalid   = errors.New("contact type must be phone or email")
+	ErrContactTypeImmutable = errors.New("contact type cannot be changed")
+	ErrContactLabelTooLong  = errors.New("contact label must have at most 50 characters")
+	ErrContactRequired      = errors.New("a person must keep at least one phone and one email")
+	ErrVersionRequired      = errors.New("the person version is required to change a primary contact")
+)
+
+// Detailed phone errors. They wrap ErrPhoneInvalid, so callers that only care
+// whether a phone number is valid can keep matching on it.
+var (
+	ErrPhoneAreaCodeInvalid    = fmt.Errorf("%w: unknown area code (DDD)", ErrPhoneInvalid)
+	ErrPhoneNinthDigitMissing  = fmt.Errorf("%w: mobile numbers must have 9 digits starting with 9", ErrPhoneInvalid)
+	ErrPhoneCountryCodeInvalid = fmt.Errorf("%w: unknown country calling code", ErrPhoneInvalid)
+)
+
+var (
+	ErrDocumentNotFound              = errors.New("document not found")
+	ErrDocumentTypeInvalid           = errors.New("document type must be rg, cnh, passport, voter_id or pis")
+	ErrDocumentTypeImmutable         = errors.New("document type cannot be changed")
+	ErrDocumentNumberRequired        = errors.New("document number is required")
+	ErrDocumentNumberInvalid         = errors.New("document number is invalid")
+	ErrDocumentIssuingStateInvalid   = errors.New("issuing state must be a Brazilian UF")
+	ErrDocumentIssuingAgencyRequired = errors.New("issuing agency is required")
+	ErrDocumentExpiryRequired        = errors.New("expiry date is required")
+	ErrDocumentDatesInvalid          = errors.New("issue date must not be in the future nor after the expiry date")
+	ErrDocumentAlreadyInUse          = errors.New("document is already registered to a person")
+	ErrExpiryWindowInvalid           = errors.New("days must be between 1 and 365")
+)
+
+// Detailed document number errors. They wrap ErrDocumentNumberInvalid.
+var (
+	ErrRGInvalid       = fmt.Errorf("%w: rg must have 5 to 14 digits, the last one possibly X", ErrDocumentNumberInvalid)
+	ErrCNHInvalid      = fmt.Errorf("%w: cnh must have 11 digits with valid check digits", ErrDocumentNumberInvalid)
+	ErrPassportInvalid = fmt.Errorf("%w: passport must have 5 to 20 letters or digits", ErrDocumentNumberInvalid)
+	ErrVoterIDInvalid  = fmt.Errorf("%w: voter id must have 12 digits with a valid state code and check digits", ErrDocumentNumberInvalid)
+	ErrPISInvalid      = fmt.Errorf("%w: pis/nis must have 11 digits with a valid check digit", ErrDocumentNumberInvalid)
+)
+
+var (
+	ErrRelationshipNotFound    = errors.New("relationship not found")
+	ErrRelationshipTypeInvalid = errors.New("relationship type must be parent, child, spouse, guardian, ward, legal_representative, represented, emergency_contact or emergency_contact_for")
+	ErrRelationshipSelf        = errors.New("a person cannot be related to themselves")
+	ErrRelationshipExists      = errors.New("relationship already exists")
+	ErrRelatedPersonNotFound   = errors.New("related person not found")
+	ErrGuardianNotAdult        = errors.New("guardians and legal representatives must be adults")
+	ErrParentNotOlder          = errors.New("a parent must be older than the child")
+	ErrParentCycle             = errors.New("a person cannot be their own ancestor")
+	ErrGraphDepthInvalid       = errors.New("depth must be between 1 and 5")
+)
+
+var (
+	ErrMergeSamePerson = errors.New("a person cannot be merged into itself")
+	ErrPersonMerged    = errors.New("person was merged into another and cannot be restored")
+	ErrMinScoreInvalid = errors.New("min_score must be between 0 and 1")
+)
+
+var (
+	ErrPersonAnonymized          = errors.New("person was anonymized and cannot be restored")
+	ErrSubjectRequestTypeInvalid = errors.New("subject request type must be access or portability")
+)
+
+var (
+	ErrConsentNotFound         = errors.New("consent not found")
+	ErrConsentPurposeInvalid   = errors.New("consent purpose must be marketing, analytics or data_sharing")
+	ErrLegalBasisInvalid       = errors.New("legal basis must be consent, contract, legal_obligation or legitimate_interest")
+	ErrConsentChannelInvalid   = errors.New("channel must be web, mobile_app, call_center, in_person, email or paper")
+	ErrConsentEvidenceRequired = errors.New("evidence reference is required when the legal basis is consent")
+	ErrConsentEvidenceTooLong  = errors.New("evidence reference must have at most 255 characters")
+	ErrConsentAlreadyGranted   = errors.New("consent is already granted for this purpose")
+	ErrConsentNotGranted       = errors.New("consent is not granted for this purpose")
+)
+
+var (
+	ErrRevealReasonRequired = errors.New("reason is required to reveal personal data")
+	ErrRevealReasonTooLong  = errors.New("reason must have at most 255 characters")
 )
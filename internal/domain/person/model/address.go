@@ -0,0 +1,162 @@
+package person
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	personErr "pessoas-api/internal/domain/person/error"
+	utils "pessoas-api/internal/domain/person/utils"
+)
+
+// Address types.
+const (
+	AddressResidential = "residential"
+	AddressBilling     = "billing"
+	AddressWork        = "work"
+)
+
+// CountryBrazil is the default country of an address. Brazilian addresses have
+// their CEP and UF validated; foreign ones only need a postal code and a state.
+const CountryBrazil = "BR"
+
+// brazilianStates are the UFs of the 26 states and the Federal District.
+var brazilianStates = map[string]bool{
+	"AC": true, "AL": true, "AP": true, "AM": true, "BA": true, "CE": true, "DF": true,
+	"ES": true, "GO": true, "MA": true, "MT": true, "MS": true, "MG": true, "PA": true,
+	"PB": true, "PR": true, "PE": true, "PI": true, "RJ": true, "RN": true, "RS": true,
+	"RO": true, "RR": true, "SC": true, "SP": true, "SE": true, "TO": true,
+}
+
+var countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// AddressFields holds the parts of an address chosen by the client.
+type AddressFields struct {
+	Type       string
+	CEP        string
+	Street     string
+	Number     string
+	Complement string
+	District   string
+	City       string
+	State      string
+	Country    string
+	Primary    bool
+}
+
+// Address is a postal address of a person. A person may have several
+// addresses of each type; exactly one of them is the primary address.
+type Address struct {
+	AddressFields
+	ID        int
+	PersonID  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewAddress(personID int, fields AddressFields) (*Address, error) {
+	now := time.Now()
+
+	address := &Address{
+		AddressFields: fields,
+		PersonID:      personID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	address.normalize()
+
+	if err := address.Validate(); err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
+
+// Replace overwrites every client chosen field of the address. The address is
+// only modified when the result is valid.
+func (a *Address) Replace(fields AddressFields) error {
+	candidate := *a
+	candidate.AddressFields = fields
+
+	candidate.normalize()
+
+	if err := candidate.Validate(); err != nil {
+		return err
+	}
+
+	candidate.UpdatedAt = time.Now()
+	*a = candidate
+
+	return nil
+}
+
+// Validate checks the business rules of an address.
+func (a *Address) Validate() error {
+	if !IsAddressType(a.Type) {
+		return personErr.ErrAddressTypeInvalid
+	}
+	if !countryPattern.MatchString(a.Country) {
+		return personErr.ErrCountryInvalid
+	}
+	if a.CEP == "" {
+		return personErr.ErrCEPRequired
+	}
+	if a.Street == "" {
+		return personErr.ErrStreetRequired
+	}
+	if a.Number == "" {
+		return personErr.ErrAddressNumberRequired
+	}
+	if a.City == "" {
+		return personErr.ErrCityRequired
+	}
+	if a.State == "" {
+		return personErr.ErrStateInvalid
+	}
+
+	if a.Country == CountryBrazil {
+		if !IsValidCEP(a.CEP) {
+			return personErr.ErrCEPInvalid
+		}
+		if !brazilianStates[a.State] {
+			return personErr.ErrStateInvalid
+		}
+	}
+
+	return nil
+}
+
+// IsAddressType reports whether addressType is a known address type.
+func IsAddressType(addressType string) bool {
+	switch addressType {
+	case AddressResidential, AddressBilling, AddressWork:
+		return true
+	}
+	return false
+}
+
+func (a *Address) normalize() {
+	a.Type = strings.ToLower(strings.TrimSpace(a.Type))
+	a.Street = strings.TrimSpace(a.Street)
+	a.Number = strings.TrimSpace(a.Number)
+	a.Complement = strings.TrimSpace(a.Complement)
+	a.District = strings.TrimSpace(a.District)
+	a.City = strings.TrimSpace(a.City)
+	a.State = strings.ToUpper(strings.TrimSpace(a.State))
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	if a.Country == "" {
+		a.Country = CountryBrazil
+	}
+
+	if a.Country == CountryBrazil {
+		a.CEP = utils.OnlyDigits(a.CEP)
+	} else {
+		a.CEP = strings.TrimSpace(a.CEP)
+	}
+}
+
+// IsValidCEP reports whether cep is a well formed CEP: 8 digits, not all zeros.
+func IsValidCEP(cep string) bool {
+	return len(cep) == 8 && cep != "00000000"
+}
@@ -0,0 +1,136 @@
+package person
+
+import (
+	personErr "pessoas-api/internal/domain/person/error"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validAddressFields() AddressFields {
+	return AddressFields{
+		Type:       "Residential",
+		CEP:        "50030-230",
+		Street:     " Rua da Aurora ",
+		Number:     "325",
+		Complement: "Apto 101",
+		District:   "Boa Vista",
+		City:       "Recife",
+		State:      "pe",
+	}
+}
+
+func TestNewAddress_ShouldCreateAddress_WhenInputIsValid(t *testing.T) {
+	assert := assert.New(t)
+
+	address, err := NewAddress(1, validAddressFields())
+
+	assert.NoError(err)
+	assert.Equal(1, address.PersonID)
+	assert.Equal(AddressResidential, address.Type)
+	assert.Equal("50030230", address.CEP)
+	assert.Equal("Rua da Aurora", address.Street)
+	assert.Equal("PE", address.State)
+	assert.Equal(CountryBrazil, address.Country)
+	assert.False(address.Primary)
+	assert.WithinDuration(time.Now(), address.CreatedAt, time.Second)
+}
+
+func TestNewAddress_ShouldAcceptForeignAddress(t *testing.T) {
+	assert := assert.New(t)
+
+	address, err := NewAddress(1, AddressFields{
+		Type:    AddressWork,
+		CEP:     "SW1A 1AA",
+		Street:  "Downing Street",
+		Number:  "10",
+		City:    "London",
+		State:   "England",
+		Country: "gb",
+	})
+
+	assert.NoError(err)
+	assert.Equal("SW1A 1AA", address.CEP)
+	assert.Equal("GB", address.Country)
+}
+
+func TestNewAddress_ShouldReturnError_WhenInputIsInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   func(*AddressFields)
+		expected error
+	}{
+		{"unknown type", func(f *AddressFields) { f.Type = "vacation" }, personErr.ErrAddressTypeInvalid},
+		{"missing cep", func(f *AddressFields) { f.CEP = "" }, personErr.ErrCEPRequired},
+		{"short cep", func(f *AddressFields) { f.CEP = "5003023" }, personErr.ErrCEPInvalid},
+		{"zero cep", func(f *AddressFields) { f.CEP = "00000-000" }, personErr.ErrCEPInvalid},
+		{"missing street", func(f *AddressFields) { f.Street = "  " }, personErr.ErrStreetRequired},
+		{"missing number", func(f *AddressFields) { f.Number = "" }, personErr.ErrAddressNumberRequired},
+		{"missing city", func(f *AddressFields) { f.City = "" }, personErr.ErrCityRequired},
+		{"unknown uf", func(f *AddressFields) { f.State = "XX" }, personErr.ErrStateInvalid},
+		{"missing state", func(f *AddressFields) { f.State = "" }, personErr.ErrStateInvalid},
+		{"invalid country", func(f *AddressFields) { f.Country = "Brasil" }, personErr.ErrCountryInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := validAddressFields()
+			tt.change(&fields)
+
+			address, err := NewAddress(1, fields)
+
+			assert.Nil(t, address)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestAddress_Replace(t *testing.T) {
+	assert := assert.New(t)
+	address, _ := NewAddress(1, validAddressFields())
+	address.ID = 3
+	address.UpdatedAt = time.Now().Add(-time.Hour)
+
+	fields := validAddressFields()
+	fields.Type = AddressBilling
+	fields.Complement = ""
+	err := address.Replace(fields)
+
+	assert.NoError(err)
+	assert.Equal(3, address.ID)
+	assert.Equal(AddressBilling, address.Type)
+	assert.Empty(address.Complement)
+	assert.WithinDuration(time.Now(), address.UpdatedAt, time.Second)
+}
+
+func TestAddress_Replace_ShouldKeepAddress_WhenResultIsInvalid(t *testing.T) {
+	assert := assert.New(t)
+	address, _ := NewAddress(1, validAddressFields())
+
+	fields := validAddressFields()
+	fields.State = "ZZ"
+	err := address.Replace(fields)
+
+	assert.ErrorIs(err, personErr.ErrStateInvalid)
+	assert.Equal("PE", address.State)
+}
+
+func TestAddressFields_Complete_ShouldOnlyFillBlankFields(t *testing.T) {
+	assert := assert.New(t)
+
+	fields := AddressFields{CEP: "50030230", Street: "Avenida Cais do Apolo", Number: "325", City: "  "}
+	fields.Complete(&PostalCode{
+		CEP:      "50030230",
+		Street:   "Rua da Aurora",
+		District: "Boa Vista",
+		City:     "Recife",
+		State:    "PE",
+	})
+
+	assert.Equal("Avenida Cais do Apolo", fields.Street)
+	assert.Equal("Boa Vista", fields.District)
+	assert.Equal("Recife", fields.City)
+	assert.Equal("PE", fields.State)
+	assert.Equal("325", fields.Number)
+}
@@ -0,0 +1,233 @@
+package person
+
+import (
+	"strings"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	personErr "pessoas-api/internal/domain/person/error"
+)
+
+// Purposes a person may consent to the processing of their data for.
+const (
+	ConsentMarketing   = "marketing"
+	ConsentAnalytics   = "analytics"
+	ConsentDataSharing = "data_sharing"
+)
+
+// Legal bases of the LGPD (art. 7) a processing purpose may rest on.
+const (
+	LegalBasisConsent            = "consent"
+	LegalBasisContract           = "contract"
+	LegalBasisLegalObligation    = "legal_obligation"
+	LegalBasisLegitimateInterest = "legitimate_interest"
+)
+
+// Channels through which a consent is granted or revoked.
+const (
+	ChannelWeb        = "web"
+	ChannelMobileApp  = "mobile_app"
+	ChannelCallCenter = "call_center"
+	ChannelInPerson   = "in_person"
+	ChannelEmail      = "email"
+	ChannelPaper      = "paper"
+)
+
+// Actions recorded in the consent history. A merge event records a consent
+// taken over by a person from a duplicate merged into them.
+const (
+	ConsentActionGrant  = "grant"
+	ConsentActionRevoke = "revoke"
+	ConsentActionMerge  = "merge"
+)
+
+// maxEvidenceRefLength bounds the evidence reference of a consent.
+const maxEvidenceRefLength = 255
+
+// ConsentFields holds the parts of a consent chosen by the client. The
+// evidence reference points to the proof of the grant, such as the ID of a
+// signed form or of a recorded call, and is required when the legal basis is
+// the consent of the person itself.
+type ConsentFields struct {
+	Purpose     string
+	LegalBasis  string
+	Channel     string
+	EvidenceRef string
+}
+
+// Consent is the current state of a processing purpose for a person. A person
+// has at most one consent per purpose: granting a revoked consent again reuses
+// it. Every grant and revocation is also appended to the consent history.
+type Consent struct {
+	ConsentFields
+	ID        int
+	PersonID  int
+	GrantedAt time.Time
+	RevokedAt *time.Time
+	UpdatedAt time.Time
+}
+
+// ConsentEvent is an entry of the append-only consent history: a grant or a
+// revocation, with the channel and evidence it was made with and the operator
+// who recorded it. Events are never deleted, and the only change they take is
+// the removal of their evidence reference when the person is anonymized.
+type ConsentEvent struct {
+	ID          int
+	ConsentID   int
+	PersonID    int
+	Purpose     string
+	Action      string
+	LegalBasis  string
+	Channel     string
+	EvidenceRef string
+	OperatorID  int
+	RequestID   string
+	ClientIP    string
+	OccurredAt  time.Time
+}
+
+// NewConsent grants a purpose to a person for the first time.
+func NewConsent(personID int, fields ConsentFields) (*Consent, error) {
+	now := time.Now()
+
+	consent := &Consent{
+		ConsentFields: fields,
+		PersonID:      personID,
+		GrantedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	consent.normalize()
+
+	if err := consent.Validate(); err != nil {
+		return nil, err
+	}
+
+	return consent, nil
+}
+
+// Grant grants a revoked consent again, replacing its legal basis, channel and
+// evidence. The purpose cannot change. The consent is only modified when the
+// result is valid.
+func (c *Consent) Grant(fields ConsentFields) error {
+	if c.IsActive() {
+		return personErr.ErrConsentAlreadyGranted
+	}
+
+	candidate := *c
+	candidate.ConsentFields = fields
+	candidate.Purpose = c.Purpose
+
+	candidate.normalize()
+
+	if err := candidate.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	candidate.GrantedAt = now
+	candidate.RevokedAt = nil
+	candidate.UpdatedAt = now
+	*c = candidate
+
+	return nil
+}
+
+// Revoke withdraws an active consent. The channel and evidence of the
+// revocation are only kept in the history: the consent keeps those of its
+// grant.
+func (c *Consent) Revoke(channel, evidenceRef string) error {
+	if !c.IsActive() {
+		return personErr.ErrConsentNotGranted
+	}
+	if !IsConsentChannel(strings.TrimSpace(channel)) {
+		return personErr.ErrConsentChannelInvalid
+	}
+	if len(strings.TrimSpace(evidenceRef)) > maxEvidenceRefLength {
+		return personErr.ErrConsentEvidenceTooLong
+	}
+
+	now := time.Now()
+	c.RevokedAt = &now
+	c.UpdatedAt = now
+
+	return nil
+}
+
+// IsActive reports whether the consent is granted and not revoked.
+func (c *Consent) IsActive() bool {
+	return c.RevokedAt == nil
+}
+
+// Validate checks the business rules of a consent.
+func (c *Consent) Validate() error {
+	if !IsConsentPurpose(c.Purpose) {
+		return personErr.ErrConsentPurposeInvalid
+	}
+	if !IsLegalBasis(c.LegalBasis) {
+		return personErr.ErrLegalBasisInvalid
+	}
+	if !IsConsentChannel(c.Channel) {
+		return personErr.ErrConsentChannelInvalid
+	}
+	if c.LegalBasis == LegalBasisConsent && c.EvidenceRef == "" {
+		return personErr.ErrConsentEvidenceRequired
+	}
+	if len(c.EvidenceRef) > maxEvidenceRefLength {
+		return personErr.ErrConsentEvidenceTooLong
+	}
+
+	return nil
+}
+
+// Event records an action on the consent for the history. For revocations the
+// channel and evidence are those of the revocation.
+func (c *Consent) Event(action, channel, evidenceRef string, actor audit.Actor) *ConsentEvent {
+	return &ConsentEvent{
+		ConsentID:   c.ID,
+		PersonID:    c.PersonID,
+		Purpose:     c.Purpose,
+		Action:      action,
+		LegalBasis:  c.LegalBasis,
+		Channel:     strings.TrimSpace(channel),
+		EvidenceRef: strings.TrimSpace(evidenceRef),
+		OperatorID:  actor.OperatorID,
+		RequestID:   actor.RequestID,
+		ClientIP:    actor.ClientIP,
+		OccurredAt:  time.Now(),
+	}
+}
+
+// IsConsentPurpose reports whether purpose is a known processing purpose.
+func IsConsentPurpose(purpose string) bool {
+	switch purpose {
+	case ConsentMarketing, ConsentAnalytics, ConsentDataSharing:
+		return true
+	}
+	return false
+}
+
+// IsLegalBasis reports whether legalBasis is a known legal basis.
+func IsLegalBasis(legalBasis string) bool {
+	switch legalBasis {
+	case LegalBasisConsent, LegalBasisContract, LegalBasisLegalObligation, LegalBasisLegitimateInterest:
+		return true
+	}
+	return false
+}
+
+// IsConsentChannel reports whether channel is a known consent channel.
+func IsConsentChannel(channel string) bool {
+	switch channel {
+	case ChannelWeb, ChannelMobileApp, ChannelCallCenter, ChannelInPerson, ChannelEmail, ChannelPaper:
+		return true
+	}
+	return false
+}
+
+func (c *Consent) normalize() {
+	c.Purpose = strings.TrimSpace(c.Purpose)
+	c.LegalBasis = strings.TrimSpace(c.LegalBasis)
+	c.Channel = strings.TrimSpace(c.Channel)
+	c.EvidenceRef = strings.TrimSpace(c.EvidenceRef)
+}
@@ -0,0 +1,119 @@
+package person
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	personErr "pessoas-api/internal/domain/person/error"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConsent_ShouldCreateConsent_WhenInputIsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   ConsentFields
+		expected ConsentFields
+	}{
+		{
+			"consent with evidence",
+			ConsentFields{Purpose: " marketing ", LegalBasis: "consent", Channel: "web", EvidenceRef: " form-2024-001 "},
+			ConsentFields{Purpose: ConsentMarketing, LegalBasis: LegalBasisConsent, Channel: ChannelWeb, EvidenceRef: "form-2024-001"},
+		},
+		{
+			"legitimate interest without evidence",
+			ConsentFields{Purpose: "analytics", LegalBasis: "legitimate_interest", Channel: "mobile_app"},
+			ConsentFields{Purpose: ConsentAnalytics, LegalBasis: LegalBasisLegitimateInterest, Channel: ChannelMobileApp},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consent, err := NewConsent(1, tt.fields)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, consent.PersonID)
+			assert.Equal(t, tt.expected, consent.ConsentFields)
+			assert.True(t, consent.IsActive())
+		})
+	}
+}
+
+func TestNewConsent_ShouldReturnError_WhenInputIsInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   ConsentFields
+		expected error
+	}{
+		{"unknown purpose", ConsentFields{Purpose: "profiling", LegalBasis: "consent", Channel: "web", EvidenceRef: "f-1"}, personErr.ErrConsentPurposeInvalid},
+		{"unknown legal basis", ConsentFields{Purpose: "marketing", LegalBasis: "vital_interest", Channel: "web", EvidenceRef: "f-1"}, personErr.ErrLegalBasisInvalid},
+		{"unknown channel", ConsentFields{Purpose: "marketing", LegalBasis: "consent", Channel: "fax", EvidenceRef: "f-1"}, personErr.ErrConsentChannelInvalid},
+		{"consent without evidence", ConsentFields{Purpose: "marketing", LegalBasis: "consent", Channel: "web", EvidenceRef: "  "}, personErr.ErrConsentEvidenceRequired},
+		{"evidence too long", ConsentFields{Purpose: "marketing", LegalBasis: "consent", Channel: "web", EvidenceRef: strings.Repeat("x", 256)}, personErr.ErrConsentEvidenceTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consent, err := NewConsent(1, tt.fields)
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Nil(t, consent)
+		})
+	}
+}
+
+func TestConsent_Revoke(t *testing.T) {
+	consent, _ := NewConsent(1, ConsentFields{Purpose: ConsentMarketing, LegalBasis: LegalBasisConsent, Channel: ChannelWeb, EvidenceRef: "form-1"})
+
+	assert.ErrorIs(t, consent.Revoke("fax", ""), personErr.ErrConsentChannelInvalid)
+	assert.True(t, consent.IsActive())
+
+	assert.NoError(t, consent.Revoke(ChannelCallCenter, "call-77"))
+	assert.False(t, consent.IsActive())
+	assert.Equal(t, ChannelWeb, consent.Channel, "the consent keeps the channel of its grant")
+
+	assert.ErrorIs(t, consent.Revoke(ChannelWeb, ""), personErr.ErrConsentNotGranted)
+}
+
+func TestConsent_Grant(t *testing.T) {
+	consent, _ := NewConsent(1, ConsentFields{Purpose: ConsentMarketing, LegalBasis: LegalBasisConsent, Channel: ChannelWeb, EvidenceRef: "form-1"})
+
+	assert.ErrorIs(t, consent.Grant(ConsentFields{LegalBasis: LegalBasisConsent, Channel: ChannelWeb, EvidenceRef: "form-2"}), personErr.ErrConsentAlreadyGranted)
+
+	consent.Revoke(ChannelWeb, "")
+	revoked := *consent
+
+	assert.ErrorIs(t, consent.Grant(ConsentFields{LegalBasis: LegalBasisConsent, Channel: ChannelPaper}), personErr.ErrConsentEvidenceRequired)
+	assert.Equal(t, revoked, *consent, "an invalid grant leaves the consent unchanged")
+
+	err := consent.Grant(ConsentFields{Purpose: ConsentAnalytics, LegalBasis: LegalBasisConsent, Channel: ChannelPaper, EvidenceRef: "form-2"})
+
+	assert.NoError(t, err)
+	assert.True(t, consent.IsActive())
+	assert.Equal(t, ConsentMarketing, consent.Purpose, "the purpose cannot change")
+	assert.Equal(t, ChannelPaper, consent.Channel)
+	assert.Equal(t, "form-2", consent.EvidenceRef)
+}
+
+func TestConsent_Event(t *testing.T) {
+	consent := &Consent{
+		ConsentFields: ConsentFields{Purpose: ConsentDataSharing, LegalBasis: LegalBasisContract, Channel: ChannelWeb},
+		ID:            4,
+		PersonID:      2,
+	}
+	actor := audit.Actor{OperatorID: 3, RequestID: "req-1", ClientIP: "203.0.113.10"}
+
+	event := consent.Event(ConsentActionRevoke, " email ", " ticket-9 ", actor)
+
+	assert.Equal(t, 4, event.ConsentID)
+	assert.Equal(t, 2, event.PersonID)
+	assert.Equal(t, ConsentDataSharing, event.Purpose)
+	assert.Equal(t, ConsentActionRevoke, event.Action)
+	assert.Equal(t, LegalBasisContract, event.LegalBasis)
+	assert.Equal(t, ChannelEmail, event.Channel)
+	assert.Equal(t, "ticket-9", event.EvidenceRef)
+	assert.Equal(t, 3, event.OperatorID)
+	assert.WithinDuration(t, time.Now(), event.OccurredAt, time.Second)
+}
@@ -0,0 +1,131 @@
+package person
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	personErr "pessoas-api/internal/domain/person/error"
+)
+
+// Contact types.
+const (
+	ContactPhone = "phone"
+	ContactEmail = "email"
+)
+
+// maxContactLabelLength bounds the free text label of a contact.
+const maxContactLabelLength = 50
+
+// ContactFields holds the parts of a contact chosen by the client. Label is a
+// free description such as "mobile", "home" or "work".
+type ContactFields struct {
+	Type     string
+	Value    string
+	Label    string
+	Primary  bool
+	Verified bool
+}
+
+// Contact is a phone number or email address of a person. A person may have
+// several contacts of each type; exactly one of each type is primary.
+type Contact struct {
+	ContactFields
+	ID        int
+	PersonID  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewContact(personID int, fields ContactFields) (*Contact, error) {
+	now := time.Now()
+
+	contact := &Contact{
+		ContactFields: fields,
+		PersonID:      personID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	contact.normalize()
+
+	if err := contact.Validate(); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+// Replace overwrites every client chosen field of the contact except its type,
+// which cannot change. The contact is only modified when the result is valid.
+func (c *Contact) Replace(fields ContactFields) error {
+	candidate := *c
+	candidate.ContactFields = fields
+
+	candidate.normalize()
+
+	if candidate.Type != c.Type {
+		return personErr.ErrContactTypeImmutable
+	}
+
+	if err := candidate.Validate(); err != nil {
+		return err
+	}
+
+	candidate.UpdatedAt = time.Now()
+	*c = candidate
+
+	return nil
+}
+
+// Validate checks the business rules of a contact: the value must be a valid
+// phone number or email address according to its type.
+func (c *Contact) Validate() error {
+	switch c.Type {
+	case ContactPhone:
+		if c.Value == "" {
+			return personErr.ErrPhoneRequired
+		}
+		if _, err := ParsePhoneNumber(c.Value); err != nil {
+			return err
+		}
+	case ContactEmail:
+		if c.Value == "" {
+			return personErr.ErrEmailRequired
+		}
+		if !validateEmail(c.Value) {
+			return personErr.ErrEmailInvalid
+		}
+	default:
+		return personErr.ErrContactTypeInvalid
+	}
+
+	if utf8.RuneCountInString(c.Label) > maxContactLabelLength {
+		return personErr.ErrContactLabelTooLong
+	}
+
+	return nil
+}
+
+// IsContactType reports whether contactType is a known contact type.
+func IsContactType(contactType string) bool {
+	return contactType == ContactPhone || contactType == ContactEmail
+}
+
+// NormalizeContactValue brings a contact value to the form it is stored in:
+// E.164 for phones, trimmed for emails. Phone numbers that cannot be parsed
+// are only trimmed, and rejected by Validate.
+func NormalizeContactValue(contactType, value string) string {
+	if contactType == ContactPhone {
+		if phone, err := ParsePhoneNumber(value); err == nil {
+			return phone.E164()
+		}
+	}
+	return strings.TrimSpace(value)
+}
+
+func (c *Contact) normalize() {
+	c.Type = strings.ToLower(strings.TrimSpace(c.Type))
+	c.Value = NormalizeContactValue(c.Type, c.Value)
+	c.Label = strings.TrimSpace(c.Label)
+}
@@ -0,0 +1,103 @@
+package person
+
+import (
+	personErr "pessoas-api/internal/domain/person/error"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewContact_ShouldNormalizeValueByType(t *testing.T) {
+	assert := assert.New(t)
+
+	phone, err := NewContact(1, ContactFields{Type: " Phone ", Value: "(81) 3222-1234", Label: " home "})
+	assert.NoError(err)
+	assert.Equal(ContactPhone, phone.Type)
+	assert.Equal("+558132221234", phone.Value)
+	assert.Equal("home", phone.Label)
+	assert.Equal(1, phone.PersonID)
+
+	email, err := NewContact(1, ContactFields{Type: "email", Value: " joao@empresa.com.br "})
+	assert.NoError(err)
+	assert.Equal("joao@empresa.com.br", email.Value)
+}
+
+func TestNewContact_ShouldValidatePerType(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      ContactFields
+		expectedErr error
+	}{
+		{"unknown type", ContactFields{Type: "fax", Value: "8132221234"}, personErr.ErrContactTypeInvalid},
+		{"empty phone", ContactFields{Type: ContactPhone, Value: " "}, personErr.ErrPhoneRequired},
+		{"short phone", ContactFields{Type: ContactPhone, Value: "12345"}, personErr.ErrPhoneInvalid},
+		{"empty email", ContactFields{Type: ContactEmail, Value: " "}, personErr.ErrEmailRequired},
+		{"invalid email", ContactFields{Type: ContactEmail, Value: "joao@empresa"}, personErr.ErrEmailInvalid},
+		{"phone as email", ContactFields{Type: ContactEmail, Value: "81912345678"}, personErr.ErrEmailInvalid},
+		{"long label", ContactFields{Type: ContactPhone, Value: "81912345678", Label: strings.Repeat("a", 51)}, personErr.ErrContactLabelTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contact, err := NewContact(1, tt.fields)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, contact)
+		})
+	}
+}
+
+func TestContact_Replace_ShouldKeepType(t *testing.T) {
+	assert := assert.New(t)
+	contact, _ := NewContact(1, ContactFields{Type: ContactPhone, Value: "81912345678"})
+	original := *contact
+
+	err := contact.Replace(ContactFields{Type: ContactEmail, Value: "joao@empresa.com.br"})
+
+	assert.ErrorIs(err, personErr.ErrContactTypeImmutable)
+	assert.Equal(original, *contact)
+
+	err = contact.Replace(ContactFields{Type: ContactPhone, Value: "81 98888-7777", Label: "mobile", Verified: true})
+
+	assert.NoError(err)
+	assert.Equal("+5581988887777", contact.Value)
+	assert.True(contact.Verified)
+}
+
+func TestPerson_AddContact_ShouldManagePrimaryPerType(t *testing.T) {
+	assert := assert.New(t)
+	name, cpf, birthDate, phone, email := validPersonInput()
+	person, _ := NewPerson(name, cpf, birthDate, phone, email)
+
+	assert.NoError(person.AddContact(ContactFields{Type: ContactPhone, Value: "8132221234", Label: "home"}))
+	assert.Equal("+5581912345678", person.Phone())
+
+	assert.NoError(person.AddContact(ContactFields{Type: ContactEmail, Value: "john@corp.com", Label: "work", Primary: true}))
+	assert.Equal("john@corp.com", person.Email())
+	assert.Equal("+5581912345678", person.Phone())
+	assert.Len(person.Contacts, 4)
+
+	err := person.AddContact(ContactFields{Type: ContactEmail, Value: "invalid"})
+	assert.ErrorIs(err, personErr.ErrEmailInvalid)
+	assert.Len(person.Contacts, 4)
+}
+
+func TestApplyChanges_ShouldReplacePrimaryContactOnly(t *testing.T) {
+	assert := assert.New(t)
+	name, cpf, birthDate, phone, email := validPersonInput()
+	person, _ := NewPerson(name, cpf, birthDate, phone, email)
+	person.AddContact(ContactFields{Type: ContactPhone, Value: "8132221234", Label: "home"})
+	person.Contacts[0].Verified = true
+	before := append([]Contact(nil), person.Contacts...)
+
+	newPhone := "(81) 98888-7777"
+	changed, err := person.ApplyChanges(PersonChanges{PhoneNumber: &newPhone})
+
+	assert.NoError(err)
+	assert.Equal([]string{FieldPhoneNumber}, changed)
+	assert.Equal("+5581988887777", person.Phone())
+	assert.False(person.PrimaryContact(ContactPhone).Verified)
+	assert.Equal("+558132221234", person.Contacts[2].Value)
+	assert.Equal("+5581912345678", before[0].Value, "the previous contacts must not be modified")
+}
@@ -0,0 +1,313 @@
+package person
+
+import (
+	"strings"
+	"time"
+
+	personErr "pessoas-api/internal/domain/person/error"
+)
+
+// Document types. The CPF is not among them: it is a field of the person.
+const (
+	DocumentRG       = "rg"
+	DocumentCNH      = "cnh"
+	DocumentPassport = "passport"
+	DocumentVoterID  = "voter_id"
+	DocumentPIS      = "pis"
+)
+
+// DocumentFields holds the parts of a document chosen by the client.
+// IssuingState and IssuingAgency only apply to RGs and Country to passports;
+// they are cleared for the other types.
+type DocumentFields struct {
+	Type          string
+	Number        string
+	IssuingState  string
+	IssuingAgency string
+	Country       string
+	IssueDate     *time.Time
+	ExpiryDate    *time.Time
+}
+
+// Document is an identity document of a person. A document number is unique
+// within its type, and for RGs within the issuing state and for passports
+// within the issuing country.
+type Document struct {
+	DocumentFields
+	ID        int
+	PersonID  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewDocument creates a document of a person, normalizing its number.
+func NewDocument(personID int, fields DocumentFields) (*Document, error) {
+	now := time.Now()
+
+	document := &Document{
+		DocumentFields: fields,
+		PersonID:       personID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	document.normalize()
+
+	if err := document.Validate(); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// Replace overwrites every client chosen field of the document but its type,
+// which cannot change. The document is only modified when the result is valid.
+func (d *Document) Replace(fields DocumentFields) error {
+	candidate := *d
+	candidate.DocumentFields = fields
+
+	candidate.normalize()
+
+	if candidate.Type != d.Type {
+		return personErr.ErrDocumentTypeImmutable
+	}
+
+	if err := candidate.Validate(); err != nil {
+		return err
+	}
+
+	candidate.UpdatedAt = time.Now()
+	*d = candidate
+
+	return nil
+}
+
+// Validate checks the business rules of a document.
+func (d *Document) Validate() error {
+	if !IsDocumentType(d.Type) {
+		return personErr.ErrDocumentTypeInvalid
+	}
+	if d.Number == "" {
+		return personErr.ErrDocumentNumberRequired
+	}
+
+	switch d.Type {
+	case DocumentRG:
+		if !validRG(d.Number) {
+			return personErr.ErrRGInvalid
+		}
+		if !brazilianStates[d.IssuingState] {
+			return personErr.ErrDocumentIssuingStateInvalid
+		}
+		if d.IssuingAgency == "" {
+			return personErr.ErrDocumentIssuingAgencyRequired
+		}
+	case DocumentCNH:
+		if !validCNH(d.Number) {
+			return personErr.ErrCNHInvalid
+		}
+	case DocumentPassport:
+		if len(d.Number) < 5 || len(d.Number) > 20 {
+			return personErr.ErrPassportInvalid
+		}
+		if !countryPattern.MatchString(d.Country) {
+			return personErr.ErrCountryInvalid
+		}
+		if d.ExpiryDate == nil {
+			return personErr.ErrDocumentExpiryRequired
+		}
+	case DocumentVoterID:
+		if !validVoterID(d.Number) {
+			return personErr.ErrVoterIDInvalid
+		}
+	case DocumentPIS:
+		if !validPIS(d.Number) {
+			return personErr.ErrPISInvalid
+		}
+	}
+
+	if d.IssueDate != nil {
+		if d.IssueDate.After(time.Now()) || (d.ExpiryDate != nil && !d.ExpiryDate.After(*d.IssueDate)) {
+			return personErr.ErrDocumentDatesInvalid
+		}
+	}
+
+	return nil
+}
+
+// IsExpired reports whether the document has an expiry date before the given day.
+func (d *Document) IsExpired(now time.Time) bool {
+	if d.ExpiryDate == nil {
+		return false
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return d.ExpiryDate.Before(today)
+}
+
+// IsDocumentType reports whether documentType is a known document type.
+func IsDocumentType(documentType string) bool {
+	switch documentType {
+	case DocumentRG, DocumentCNH, DocumentPassport, DocumentVoterID, DocumentPIS:
+		return true
+	}
+	return false
+}
+
+// NormalizeDocumentNumber strips the mask of a document number and uppercases
+// its letters, as document numbers are stored.
+func NormalizeDocumentNumber(number string) string {
+	var b strings.Builder
+
+	for _, r := range strings.ToUpper(number) {
+		if (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func (d *Document) normalize() {
+	d.Type = strings.ToLower(strings.TrimSpace(d.Type))
+	d.Number = NormalizeDocumentNumber(d.Number)
+	d.IssuingState = strings.ToUpper(strings.TrimSpace(d.IssuingState))
+	d.IssuingAgency = strings.ToUpper(strings.TrimSpace(d.IssuingAgency))
+	d.Country = strings.ToUpper(strings.TrimSpace(d.Country))
+
+	if d.Type != DocumentRG {
+		d.IssuingState = ""
+		d.IssuingAgency = ""
+	}
+	if d.Type != DocumentPassport {
+		d.Country = ""
+	}
+}
+
+// validRG checks the format of an RG. Each state computes its check digit
+// differently, when it has one at all, so it is not verified.
+func validRG(number string) bool {
+	if len(number) < 5 || len(number) > 14 {
+		return false
+	}
+
+	for i := 0; i < len(number); i++ {
+		if !isDigit(number[i]) && !(number[i] == 'X' && i == len(number)-1) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validCNH checks the two check digits of the registration number (número de
+// registro) of a CNH, as computed by the Denatran.
+func validCNH(number string) bool {
+	if !isDigitSequence(number, 11) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += digitAt(number, i) * (9 - i)
+	}
+
+	first, discount := sum%11, 0
+	if first >= 10 {
+		first, discount = 0, 2
+	}
+
+	sum = 0
+	for i := 0; i < 9; i++ {
+		sum += digitAt(number, i) * (i + 1)
+	}
+
+	second := sum % 11
+	if second >= 10 {
+		second = 0
+	} else {
+		second -= discount
+	}
+
+	return digitAt(number, 9) == first && digitAt(number, 10) == second
+}
+
+// validVoterID checks a título de eleitor: 8 sequential digits, the code of
+// the state (01 to 28) and two check digits. In São Paulo and Minas Gerais a
+// check digit of 0 is written as 1.
+func validVoterID(number string) bool {
+	if !isDigitSequence(number, 12) {
+		return false
+	}
+
+	state := digitAt(number, 8)*10 + digitAt(number, 9)
+	if state < 1 || state > 28 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += digitAt(number, i) * (i + 2)
+	}
+	first := voterIDCheckDigit(sum%11, state)
+
+	second := voterIDCheckDigit((digitAt(number, 8)*7+digitAt(number, 9)*8+first*9)%11, state)
+
+	return digitAt(number, 10) == first && digitAt(number, 11) == second
+}
+
+func voterIDCheckDigit(remainder, state int) int {
+	switch {
+	case remainder == 10:
+		return 0
+	case remainder == 0 && (state == 1 || state == 2):
+		return 1
+	default:
+		return remainder
+	}
+}
+
+// validPIS checks the check digit of a PIS/PASEP/NIS number.
+func validPIS(number string) bool {
+	if !isDigitSequence(number, 11) {
+		return false
+	}
+
+	weights := []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+	sum := 0
+	for i, weight := range weights {
+		sum += digitAt(number, i) * weight
+	}
+
+	check := 11 - sum%11
+	if check >= 10 {
+		check = 0
+	}
+
+	return digitAt(number, 10) == check
+}
+
+// isDigitSequence reports whether value has exactly length digits, not all
+// of them equal.
+func isDigitSequence(value string, length int) bool {
+	if len(value) != length {
+		return false
+	}
+
+	for i := 0; i < length; i++ {
+		if !isDigit(value[i]) {
+			return false
+		}
+	}
+
+	return strings.Count(value, value[:1]) != length
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func digitAt(value string, i int) int {
+	return int(value[i] - '0')
+}
@@ -0,0 +1,142 @@
+package person
+
+import (
+	personErr "pessoas-api/internal/domain/person/error"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func documentDate(year int, month time.Month, day int) *time.Time {
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+func TestNewDocument_ShouldCreateDocument_WhenInputIsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   DocumentFields
+		expected DocumentFields
+	}{
+		{
+			"rg",
+			DocumentFields{Type: " RG ", Number: "12.345.678-x", IssuingState: "pe", IssuingAgency: " ssp ", Country: "BR"},
+			DocumentFields{Type: DocumentRG, Number: "12345678X", IssuingState: "PE", IssuingAgency: "SSP"},
+		},
+		{
+			"cnh",
+			DocumentFields{Type: "cnh", Number: "026.503.064-61", IssuingState: "PE", ExpiryDate: documentDate(2030, time.May, 1)},
+			DocumentFields{Type: DocumentCNH, Number: "02650306461", ExpiryDate: documentDate(2030, time.May, 1)},
+		},
+		{
+			"cnh with discounted second check digit",
+			DocumentFields{Type: "cnh", Number: "73662585100"},
+			DocumentFields{Type: DocumentCNH, Number: "73662585100"},
+		},
+		{
+			"passport",
+			DocumentFields{Type: "passport", Number: "fz123456", Country: "br", IssueDate: documentDate(2020, time.January, 10), ExpiryDate: documentDate(2030, time.January, 9)},
+			DocumentFields{Type: DocumentPassport, Number: "FZ123456", Country: "BR", IssueDate: documentDate(2020, time.January, 10), ExpiryDate: documentDate(2030, time.January, 9)},
+		},
+		{
+			"voter id",
+			DocumentFields{Type: "voter_id", Number: "1066 4444 0302"},
+			DocumentFields{Type: DocumentVoterID, Number: "106644440302"},
+		},
+		{
+			"voter id from São Paulo with check digit 0 written as 1",
+			DocumentFields{Type: "voter_id", Number: "041144260116"},
+			DocumentFields{Type: DocumentVoterID, Number: "041144260116"},
+		},
+		{
+			"pis",
+			DocumentFields{Type: "pis", Number: "123.45678.91-9"},
+			DocumentFields{Type: DocumentPIS, Number: "12345678919"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := NewDocument(1, tt.fields)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, document.PersonID)
+			assert.Equal(t, tt.expected, document.DocumentFields)
+		})
+	}
+}
+
+func TestNewDocument_ShouldReturnError_WhenInputIsInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   DocumentFields
+		expected error
+	}{
+		{"unknown type", DocumentFields{Type: "cpf", Number: "11144477735"}, personErr.ErrDocumentTypeInvalid},
+		{"empty number", DocumentFields{Type: "pis", Number: " .-"}, personErr.ErrDocumentNumberRequired},
+		{"rg with letters", DocumentFields{Type: "rg", Number: "12A45678", IssuingState: "PE", IssuingAgency: "SSP"}, personErr.ErrRGInvalid},
+		{"rg too short", DocumentFields{Type: "rg", Number: "1234", IssuingState: "PE", IssuingAgency: "SSP"}, personErr.ErrRGInvalid},
+		{"rg without issuing state", DocumentFields{Type: "rg", Number: "1234567", IssuingAgency: "SSP"}, personErr.ErrDocumentIssuingStateInvalid},
+		{"rg with unknown issuing state", DocumentFields{Type: "rg", Number: "1234567", IssuingState: "XX", IssuingAgency: "SSP"}, personErr.ErrDocumentIssuingStateInvalid},
+		{"rg without issuing agency", DocumentFields{Type: "rg", Number: "1234567", IssuingState: "PE"}, personErr.ErrDocumentIssuingAgencyRequired},
+		{"cnh with wrong check digit", DocumentFields{Type: "cnh", Number: "02650306462"}, personErr.ErrCNHInvalid},
+		{"cnh with repeated digits", DocumentFields{Type: "cnh", Number: "11111111111"}, personErr.ErrCNHInvalid},
+		{"cnh with 10 digits", DocumentFields{Type: "cnh", Number: "0265030646"}, personErr.ErrCNHInvalid},
+		{"passport too short", DocumentFields{Type: "passport", Number: "AB12", Country: "BR", ExpiryDate: documentDate(2030, time.January, 1)}, personErr.ErrPassportInvalid},
+		{"passport without country", DocumentFields{Type: "passport", Number: "FZ123456", ExpiryDate: documentDate(2030, time.January, 1)}, personErr.ErrCountryInvalid},
+		{"passport without expiry", DocumentFields{Type: "passport", Number: "FZ123456", Country: "BR"}, personErr.ErrDocumentExpiryRequired},
+		{"voter id with wrong check digit", DocumentFields{Type: "voter_id", Number: "106644440303"}, personErr.ErrVoterIDInvalid},
+		{"voter id with unknown state code", DocumentFields{Type: "voter_id", Number: "106644442902"}, personErr.ErrVoterIDInvalid},
+		{"pis with wrong check digit", DocumentFields{Type: "pis", Number: "12345678910"}, personErr.ErrPISInvalid},
+		{"issue date in the future", DocumentFields{Type: "pis", Number: "12345678919", IssueDate: documentDate(time.Now().Year()+1, time.January, 1)}, personErr.ErrDocumentDatesInvalid},
+		{"expiry before issue", DocumentFields{Type: "cnh", Number: "02650306461", IssueDate: documentDate(2020, time.January, 1), ExpiryDate: documentDate(2019, time.January, 1)}, personErr.ErrDocumentDatesInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := NewDocument(1, tt.fields)
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Nil(t, document)
+		})
+	}
+}
+
+func TestNewDocument_DetailedNumberErrorsWrapNumberInvalid(t *testing.T) {
+	_, err := NewDocument(1, DocumentFields{Type: "cnh", Number: "02650306462"})
+
+	assert.ErrorIs(t, err, personErr.ErrDocumentNumberInvalid)
+}
+
+func TestDocument_Replace(t *testing.T) {
+	assert := assert.New(t)
+
+	document, _ := NewDocument(1, DocumentFields{Type: "rg", Number: "1234567", IssuingState: "PE", IssuingAgency: "SSP"})
+	original := *document
+
+	assert.ErrorIs(document.Replace(DocumentFields{Type: "cnh", Number: "02650306461"}), personErr.ErrDocumentTypeImmutable)
+	assert.Equal(original, *document)
+
+	assert.ErrorIs(document.Replace(DocumentFields{Type: "rg", Number: "1234567", IssuingState: "PE"}), personErr.ErrDocumentIssuingAgencyRequired)
+	assert.Equal(original, *document)
+
+	assert.NoError(document.Replace(DocumentFields{Type: "rg", Number: "7654321", IssuingState: "SP", IssuingAgency: "SSP"}))
+	assert.Equal("7654321", document.Number)
+	assert.Equal("SP", document.IssuingState)
+	assert.Equal(original.CreatedAt, document.CreatedAt)
+}
+
+func TestDocument_IsExpired(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2025, time.June, 15, 14, 0, 0, 0, time.UTC)
+
+	document := &Document{}
+	assert.False(document.IsExpired(now))
+
+	document.ExpiryDate = documentDate(2025, time.June, 15)
+	assert.False(document.IsExpired(now))
+
+	document.ExpiryDate = documentDate(2025, time.June, 14)
+	assert.True(document.IsExpired(now))
+}
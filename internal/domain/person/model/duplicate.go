@@ -0,0 +1,315 @@
+package person
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	utils "pessoas-api/internal/domain/person/utils"
+)
+
+// Signals that make two persons look like the same human, reported as the
+// reasons of a duplicate candidate.
+const (
+	MatchName      = "name"
+	MatchBirthDate = "birth_date"
+	MatchCPF       = "cpf"
+	MatchPhone     = "phone"
+	MatchEmail     = "email"
+)
+
+// DefaultDuplicateScore is the minimum score of the candidates reported when
+// the client does not choose one.
+const DefaultDuplicateScore = 0.5
+
+// duplicateWeights is the share of the score each signal contributes when it
+// matches fully. They add up to 1.
+var duplicateWeights = map[string]float64{
+	MatchName:      0.35,
+	MatchBirthDate: 0.25,
+	MatchCPF:       0.10,
+	MatchPhone:     0.15,
+	MatchEmail:     0.15,
+}
+
+// nameSimilarityThreshold is the similarity below which names are considered
+// different rather than misspelled.
+const nameSimilarityThreshold = 0.8
+
+// DuplicateCandidate is a person that may be a duplicate of another, with a
+// score from 0 to 1 and the signals that matched.
+type DuplicateCandidate struct {
+	Person  *Person
+	Score   float64
+	Reasons []string
+}
+
+// DuplicateCriteria selects the persons worth scoring against a person: those
+// sharing its birth date, the first word of its name or a contact, or whose
+// CPF is one typo away from its own. Names and emails are normalized.
+type DuplicateCriteria struct {
+	ExcludeID int
+	BirthDate time.Time
+	FirstName string
+	CPFs      []string
+	Phones    []string
+	Emails    []string
+}
+
+// NewDuplicateCriteria returns the criteria selecting the duplicate candidates of the person.
+func NewDuplicateCriteria(p *Person) DuplicateCriteria {
+	criteria := DuplicateCriteria{
+		ExcludeID: p.ID,
+		BirthDate: p.BirthDate,
+		CPFs:      cpfTypos(p.CPF),
+	}
+
+	if words := nameWords(p.Name); len(words) > 0 {
+		criteria.FirstName = words[0]
+	}
+
+	for _, contact := range p.Contacts {
+		if contact.Type == ContactPhone {
+			criteria.Phones = append(criteria.Phones, contact.Value)
+		} else {
+			criteria.Emails = append(criteria.Emails, strings.ToLower(contact.Value))
+		}
+	}
+
+	return criteria
+}
+
+// ScoreDuplicate compares a candidate with a person. Names are compared after
+// removing case and accents, so that misspellings and a missing middle name
+// still count; birth dates that only differ in one part count for half.
+func ScoreDuplicate(p, candidate *Person) DuplicateCandidate {
+	result := DuplicateCandidate{Person: candidate}
+
+	add := func(signal string, strength float64) {
+		result.Score += duplicateWeights[signal] * strength
+		result.Reasons = append(result.Reasons, signal)
+	}
+
+	if similarity := nameSimilarity(p.Name, candidate.Name); similarity >= nameSimilarityThreshold {
+		add(MatchName, similarity)
+	}
+
+	if strength := birthDateSimilarity(p.BirthDate, candidate.BirthDate); strength > 0 {
+		add(MatchBirthDate, strength)
+	}
+
+	if isCPFTypo(p.CPF, candidate.CPF) {
+		add(MatchCPF, 1)
+	}
+
+	if sharesContact(p, candidate, ContactPhone) {
+		add(MatchPhone, 1)
+	}
+
+	if sharesContact(p, candidate, ContactEmail) {
+		add(MatchEmail, 1)
+	}
+
+	result.Score = math.Round(result.Score*100) / 100
+
+	return result
+}
+
+func nameWords(name string) []string {
+	return strings.Fields(utils.NormalizeSearchText(name))
+}
+
+// nameSimilarity returns 1 for equal names, 0.9 when every word of the shorter
+// name appears in the longer one, and the edit distance ratio otherwise.
+func nameSimilarity(a, b string) float64 {
+	wordsA, wordsB := nameWords(a), nameWords(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	joinedA, joinedB := strings.Join(wordsA, " "), strings.Join(wordsB, " ")
+	if joinedA == joinedB {
+		return 1
+	}
+
+	similarity := 1 - float64(levenshtein(joinedA, joinedB))/float64(max(len([]rune(joinedA)), len([]rune(joinedB))))
+
+	if len(wordsA) > len(wordsB) {
+		wordsA, wordsB = wordsB, wordsA
+	}
+	if len(wordsA) > 1 && containsWords(wordsB, wordsA) {
+		similarity = max(similarity, 0.9)
+	}
+
+	return similarity
+}
+
+func containsWords(words, subset []string) bool {
+	present := make(map[string]bool, len(words))
+	for _, word := range words {
+		present[word] = true
+	}
+	for _, word := range subset {
+		if !present[word] {
+			return false
+		}
+	}
+	return true
+}
+
+// levenshtein returns the number of single character edits turning a into b.
+func levenshtein(a, b string) int {
+	runesA, runesB := []rune(a), []rune(b)
+	previous := make([]int, len(runesB)+1)
+	current := make([]int, len(runesB)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(runesA); i++ {
+		current[0] = i
+		for j := 1; j <= len(runesB); j++ {
+			cost := 1
+			if runesA[i-1] == runesB[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(runesB)]
+}
+
+// birthDateSimilarity returns 1 for the same date and 0.5 for dates of the
+// same year differing in the day or the month only, or with both swapped.
+func birthDateSimilarity(a, b time.Time) float64 {
+	if a.Year() != b.Year() {
+		return 0
+	}
+
+	switch {
+	case a.Month() == b.Month() && a.Day() == b.Day():
+		return 1
+	case a.Month() == b.Month() || a.Day() == b.Day():
+		return 0.5
+	case int(a.Month()) == b.Day() && a.Day() == int(b.Month()):
+		return 0.5
+	}
+
+	return 0
+}
+
+// cpfBaseLength is the number of CPF digits chosen by the registry; the
+// remaining two are check digits computed from them.
+const cpfBaseLength = 9
+
+// isCPFTypo reports whether two different CPFs have first nine digits that
+// differ by a single digit or by two swapped adjacent digits. A mistyped CPF
+// only passes validation with recomputed check digits, so those are ignored.
+func isCPFTypo(a, b string) bool {
+	if len(a) != 11 || len(b) != 11 || a == b {
+		return false
+	}
+
+	var diffs []int
+	for i := 0; i < cpfBaseLength; i++ {
+		if a[i] != b[i] {
+			diffs = append(diffs, i)
+		}
+	}
+
+	switch len(diffs) {
+	case 1:
+		return true
+	case 2:
+		i, j := diffs[0], diffs[1]
+		return j == i+1 && a[i] == b[j] && a[j] == b[i]
+	}
+
+	return false
+}
+
+// cpfTypos lists every valid CPF one typo away from the given one, as
+// recognized by isCPFTypo.
+func cpfTypos(cpf string) []string {
+	if len(cpf) != 11 {
+		return nil
+	}
+
+	var typos []string
+	add := func(base []byte) {
+		if typo := withCPFCheckDigits(base); validateCPF(typo) {
+			typos = append(typos, typo)
+		}
+	}
+
+	base := []byte(cpf[:cpfBaseLength])
+	for i, original := range base {
+		for digit := byte('0'); digit <= '9'; digit++ {
+			if digit == original {
+				continue
+			}
+			base[i] = digit
+			add(base)
+		}
+		base[i] = original
+	}
+
+	for i := 0; i+1 < len(base); i++ {
+		if base[i] == base[i+1] {
+			continue
+		}
+		base[i], base[i+1] = base[i+1], base[i]
+		add(base)
+		base[i], base[i+1] = base[i+1], base[i]
+	}
+
+	return typos
+}
+
+// withCPFCheckDigits returns the CPF made of the given first nine digits
+// followed by their check digits.
+func withCPFCheckDigits(base []byte) string {
+	cpf := append([]byte(nil), base...)
+
+	for _, weight := range []int{10, 11} {
+		sum := 0
+		for i, digit := range cpf {
+			sum += int(digit-'0') * (weight - i)
+		}
+
+		verifier := 11 - sum%11
+		if verifier >= 10 {
+			verifier = 0
+		}
+		cpf = append(cpf, byte('0'+verifier))
+	}
+
+	return string(cpf)
+}
+
+// sharesContact reports whether both persons have a contact of the given type
+// with the same value. Emails are compared case-insensitively.
+func sharesContact(p, other *Person, contactType string) bool {
+	for _, contact := range p.Contacts {
+		if contact.Type == contactType && other.hasContact(contactType, contact.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasContact reports whether the person has a contact of the given type and value.
+func (p *Person) hasContact(contactType, value string) bool {
+	for _, contact := range p.Contacts {
+		if contact.Type != contactType {
+			continue
+		}
+		if contact.Value == value || (contactType == ContactEmail && strings.EqualFold(contact.Value, value)) {
+			return true
+		}
+	}
+	return false
+}
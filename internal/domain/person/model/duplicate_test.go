@@ -0,0 +1,95 @@
+package person
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func duplicateSubject(id int, name, cpf string, birthDate time.Time, phone, email string) *Person {
+	return &Person{
+		ID:        id,
+		Name:      name,
+		CPF:       cpf,
+		BirthDate: birthDate,
+		Contacts: []Contact{
+			{ContactFields: ContactFields{Type: ContactPhone, Value: phone, Primary: true}},
+			{ContactFields: ContactFields{Type: ContactEmail, Value: email, Primary: true}},
+		},
+	}
+}
+
+func TestScoreDuplicate(t *testing.T) {
+	birthDate := time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)
+	subject := duplicateSubject(1, "José da Silva", "11144477735", birthDate, "+5581912345678", "jose@example.com")
+
+	tests := []struct {
+		name      string
+		candidate *Person
+		score     float64
+		reasons   []string
+	}{
+		{
+			"same human with a typo'd CPF",
+			duplicateSubject(2, "JOSE DA SILVA", "12144477752", birthDate, "+5581999999999", "JOSE@example.com"),
+			0.85,
+			[]string{MatchName, MatchBirthDate, MatchCPF, MatchEmail},
+		},
+		{
+			"misspelled name and shared phone",
+			duplicateSubject(3, "Jose da Silvs", "52998224725", birthDate, "+5581912345678", "other@example.com"),
+			0.72,
+			[]string{MatchName, MatchBirthDate, MatchPhone},
+		},
+		{
+			"missing middle word and day typo",
+			duplicateSubject(4, "José Silva", "39053344705", time.Date(1990, time.May, 21, 0, 0, 0, 0, time.UTC), "+5581900000000", "x@example.com"),
+			0.44,
+			[]string{MatchName, MatchBirthDate},
+		},
+		{
+			"unrelated person",
+			duplicateSubject(5, "Maria Souza", "52998224725", time.Date(1985, time.January, 2, 0, 0, 0, 0, time.UTC), "+5581900000000", "maria@example.com"),
+			0,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ScoreDuplicate(subject, tt.candidate)
+
+			assert.Equal(t, tt.score, result.Score)
+			assert.Equal(t, tt.reasons, result.Reasons)
+			assert.Same(t, tt.candidate, result.Person)
+		})
+	}
+}
+
+func TestNewDuplicateCriteria(t *testing.T) {
+	assert := assert.New(t)
+	birthDate := time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)
+	subject := duplicateSubject(1, "  Émile Zola", "11144477735", birthDate, "+5581912345678", "Emile@Example.com")
+
+	criteria := NewDuplicateCriteria(subject)
+
+	assert.Equal(1, criteria.ExcludeID)
+	assert.Equal(birthDate, criteria.BirthDate)
+	assert.Equal("emile", criteria.FirstName)
+	assert.Equal([]string{"+5581912345678"}, criteria.Phones)
+	assert.Equal([]string{"emile@example.com"}, criteria.Emails)
+	assert.NotEmpty(criteria.CPFs)
+	assert.NotContains(criteria.CPFs, "11144477735")
+	for _, cpf := range criteria.CPFs {
+		assert.True(validateCPF(cpf), cpf)
+		assert.True(isCPFTypo("11144477735", cpf), cpf)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, levenshtein("silva", "silva"))
+	assert.Equal(t, 1, levenshtein("silva", "silvs"))
+	assert.Equal(t, 1, levenshtein("joão", "jão"))
+	assert.Equal(t, 3, levenshtein("", "ana"))
+}
@@ -0,0 +1,82 @@
+package person
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	utils "pessoas-api/internal/domain/person/utils"
+)
+
+// MaskCPF hides all but the middle six digits of a CPF, with or without mask,
+// as in ***.444.777-**. Any input other than 11 digits is fully hidden.
+func MaskCPF(cpf string) string {
+	cpf = utils.OnlyDigits(cpf)
+	if len(cpf) != 11 {
+		return "***.***.***-**"
+	}
+
+	return "***." + cpf[3:6] + "." + cpf[6:9] + "-**"
+}
+
+// MaskEmail keeps the first character of the local part and the domain:
+// joao.silva@email.com becomes j***@email.com. The first character is taken
+// as a whole rune, so a multi-byte one is never cut in half.
+func MaskEmail(email string) string {
+	if email == "" {
+		return ""
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 1 {
+		return "***"
+	}
+	_, size := utf8.DecodeRuneInString(email)
+	return email[:size] + "***" + email[at:]
+}
+
+// MaskPhone hides every digit of a phone number but the last four, keeping
+// its punctuation: +5581912345678 becomes +*********5678 and
+// (81) 91234-5678 becomes (**) *****-5678.
+func MaskPhone(phone string) string {
+	digits := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+
+	var masked strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits--
+			if digits >= 4 {
+				masked.WriteByte('*')
+				continue
+			}
+		}
+		masked.WriteRune(r)
+	}
+	return masked.String()
+}
+
+// MaskDocumentNumber hides all but the last three characters of an identity
+// document number: 12345678X becomes ******78X. Numbers of up to four
+// characters are fully hidden.
+func MaskDocumentNumber(number string) string {
+	runes := []rune(number)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+
+	return strings.Repeat("*", len(runes)-3) + string(runes[len(runes)-3:])
+}
+
+// MaskBirthDate keeps only the year of a birth date: 1990-01-15 becomes
+// 1990-**-**.
+func MaskBirthDate(birthDate time.Time) string {
+	if birthDate.IsZero() {
+		return ""
+	}
+	return birthDate.Format("2006") + "-**-**"
+}
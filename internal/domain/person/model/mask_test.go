@@ -0,0 +1,81 @@
+package person
+
+import (
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskCPF(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("***.444.777-**", MaskCPF("11144477735"))
+	assert.Equal("***.444.777-**", MaskCPF("111.444.777-35"))
+	assert.Equal("***.***.***-**", MaskCPF("1114447"))
+}
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		email    string
+		expected string
+	}{
+		{"joao.silva@email.com", "j***@email.com"},
+		{"a@b.com", "a***@b.com"},
+		{"élida@email.com", "é***@email.com"},
+		{"名前@example.jp", "名***@example.jp"},
+		{"@email.com", "***"},
+		{"not-an-email", "***"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			masked := MaskEmail(tt.email)
+			assert.Equal(t, tt.expected, masked)
+			assert.True(t, utf8.ValidString(masked))
+		})
+	}
+}
+
+func TestMaskDocumentNumber(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"12345678X", "******78X"},
+		{"AB123456", "*****456"},
+		{"1234", "****"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.number, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MaskDocumentNumber(tt.number))
+		})
+	}
+}
+
+func TestMaskPhone(t *testing.T) {
+	tests := []struct {
+		phone    string
+		expected string
+	}{
+		{"+5581912345678", "+*********5678"},
+		{"(81) 91234-5678", "(**) *****-5678"},
+		{"123", "123"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phone, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MaskPhone(tt.phone))
+		})
+	}
+}
+
+func TestMaskBirthDate(t *testing.T) {
+	assert.Equal(t, "1990-**-**", MaskBirthDate(time.Date(1990, time.January, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "", MaskBirthDate(time.Time{}))
+}
@@ -0,0 +1,116 @@
+package person
+
+import (
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	personErr "pessoas-api/internal/domain/person/error"
+)
+
+// Merge folds a duplicate person into the survivor. The duplicate is soft
+// deleted and marked as merged into the survivor, which takes over its
+// addresses and documents. Contacts lists the duplicate contacts the survivor
+// lacks, added to it as non-primary. Relationships are the duplicate links
+// pointed at the survivor, keeping their IDs; the links between both persons
+// and the ones the survivor already has are dropped. The consents of the
+// duplicate for purposes the survivor has no consent for are moved to it,
+// with a merge event in the consent history on behalf of Actor.
+type Merge struct {
+	SurvivorID           int
+	SurvivorVersion      int
+	DuplicateID          int
+	DuplicateVersion     int
+	Contacts             []Contact
+	Relationships        []*Relationship
+	DroppedRelationships []int
+	Actor                audit.Actor
+	MergedAt             time.Time
+}
+
+// NewMerge plans the merge of the duplicate into the survivor by actor, given
+// the relationships of both persons.
+func NewMerge(survivor, duplicate *Person, relationships []*Relationship, actor audit.Actor, now time.Time) (*Merge, error) {
+	if survivor.ID == duplicate.ID {
+		return nil, personErr.ErrMergeSamePerson
+	}
+
+	merge := &Merge{
+		SurvivorID:       survivor.ID,
+		SurvivorVersion:  survivor.Version,
+		DuplicateID:      duplicate.ID,
+		DuplicateVersion: duplicate.Version,
+		Actor:            actor,
+		MergedAt:         now,
+	}
+
+	for _, contact := range duplicate.Contacts {
+		if survivor.hasContact(contact.Type, contact.Value) {
+			continue
+		}
+		merge.Contacts = append(merge.Contacts, Contact{
+			ContactFields: ContactFields{
+				Type:     contact.Type,
+				Value:    contact.Value,
+				Label:    contact.Label,
+				Verified: contact.Verified,
+			},
+			PersonID:  survivor.ID,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+
+	var kept []*Relationship
+	for _, relationship := range relationships {
+		if !relationship.Involves(duplicate.ID) {
+			kept = append(kept, relationship)
+		}
+	}
+
+	for _, relationship := range relationships {
+		if !relationship.Involves(duplicate.ID) {
+			continue
+		}
+
+		if relationship.Involves(survivor.ID) {
+			merge.DroppedRelationships = append(merge.DroppedRelationships, relationship.ID)
+			continue
+		}
+
+		moved := relationship.reassign(duplicate.ID, survivor.ID)
+		if containsLink(kept, moved) {
+			merge.DroppedRelationships = append(merge.DroppedRelationships, relationship.ID)
+			continue
+		}
+
+		kept = append(kept, moved)
+		merge.Relationships = append(merge.Relationships, moved)
+	}
+
+	return merge, nil
+}
+
+// reassign returns the relationship with one of its ends replaced by another
+// person, back in canonical form.
+func (r *Relationship) reassign(from, to int) *Relationship {
+	moved := *r
+	if moved.PersonID == from {
+		moved.PersonID = to
+	}
+	if moved.RelatedID == from {
+		moved.RelatedID = to
+	}
+	if moved.Type == RelationshipSpouse && moved.RelatedID < moved.PersonID {
+		moved.PersonID, moved.RelatedID = moved.RelatedID, moved.PersonID
+	}
+	return &moved
+}
+
+func containsLink(relationships []*Relationship, link *Relationship) bool {
+	for _, relationship := range relationships {
+		if relationship.SameLink(link) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package person
+
+import (
+	audit "pessoas-api/internal/domain/audit/model"
+	personErr "pessoas-api/internal/domain/person/error"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mergeNow = time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+func TestNewMerge_ShouldCopyMissingContacts(t *testing.T) {
+	assert := assert.New(t)
+	survivor := duplicateSubject(1, "Ana", "11144477735", mergeNow, "+5581912345678", "ana@example.com")
+	survivor.Version = 3
+	duplicate := duplicateSubject(2, "Ana", "11144477753", mergeNow, "+5581912345678", "ANA2@example.com")
+	duplicate.Version = 1
+	duplicate.Contacts[1].Verified = true
+
+	merge, err := NewMerge(survivor, duplicate, nil, audit.Actor{}, mergeNow)
+
+	assert.NoError(err)
+	assert.Equal(3, merge.SurvivorVersion)
+	assert.Equal(1, merge.DuplicateVersion)
+	assert.Len(merge.Contacts, 1)
+	assert.Equal("ANA2@example.com", merge.Contacts[0].Value)
+	assert.Equal(1, merge.Contacts[0].PersonID)
+	assert.False(merge.Contacts[0].Primary)
+	assert.True(merge.Contacts[0].Verified)
+}
+
+func TestNewMerge_ShouldRepointRelationships(t *testing.T) {
+	assert := assert.New(t)
+	survivor := &Person{ID: 5}
+	duplicate := &Person{ID: 7}
+
+	relationships := []*Relationship{
+		{ID: 1, PersonID: 5, RelatedID: 7, Type: RelationshipSpouse},
+		{ID: 2, PersonID: 7, RelatedID: 9, Type: RelationshipParent},
+		{ID: 3, PersonID: 5, RelatedID: 9, Type: RelationshipParent},
+		{ID: 4, PersonID: 3, RelatedID: 7, Type: RelationshipSpouse},
+		{ID: 5, PersonID: 11, RelatedID: 7, Type: RelationshipGuardian},
+	}
+
+	merge, err := NewMerge(survivor, duplicate, relationships, audit.Actor{}, mergeNow)
+
+	assert.NoError(err)
+	assert.Equal([]int{1, 2}, merge.DroppedRelationships)
+	assert.Equal([]*Relationship{
+		{ID: 4, PersonID: 3, RelatedID: 5, Type: RelationshipSpouse},
+		{ID: 5, PersonID: 11, RelatedID: 5, Type: RelationshipGuardian},
+	}, merge.Relationships)
+}
+
+func TestNewMerge_ShouldReturnError_WhenMergingPersonIntoItself(t *testing.T) {
+	_, err := NewMerge(&Person{ID: 5}, &Person{ID: 5}, nil, audit.Actor{}, mergeNow)
+
+	assert.ErrorIs(t, err, personErr.ErrMergeSamePerson)
+}
@@ -8,61 +8,301 @@ import (
 	"time"
 )
 
+// Person is the aggregate root of the person domain. Its phone numbers and
+// email addresses are kept in Contacts, which always holds a primary phone and
+// a primary email. MergedIntoID is set on persons removed by a merge and
+// AnonymizedAt on persons whose personal data was scrubbed.
 type Person struct {
-	ID          int
-	Name        string
-	CPF         string
-	BirthDate   time.Time
-	PhoneNumber string
-	Email       string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID           int
+	Name         string
+	CPF          string
+	BirthDate    time.Time
+	Contacts     []Contact
+	Version      int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+	MergedIntoID *int
+	AnonymizedAt *time.Time
 }
 
+// PersonChanges describes a partial modification of a person. Nil fields are
+// left untouched when the changes are applied. PhoneNumber and Email replace
+// the values of the primary phone and email contacts.
+type PersonChanges struct {
+	Name        *string
+	CPF         *string
+	BirthDate   *time.Time
+	PhoneNumber *string
+	Email       *string
+}
+
+// Names of the person fields reported by ApplyChanges.
+const (
+	FieldName        = "name"
+	FieldCPF         = "cpf"
+	FieldBirthDate   = "birth_date"
+	FieldPhoneNumber = "phone_number"
+	FieldEmail       = "email"
+)
+
+// NewPerson creates a person whose primary contacts are the given phone number
+// and email. Further contacts can be added with AddContact.
 func NewPerson(name string, cpf string, birthDate time.Time, phoneNumber string, email string) (*Person, error) {
+	now := time.Now()
+
+	person := &Person{
+		Name:      name,
+		CPF:       cpf,
+		BirthDate: birthDate,
+		Contacts: []Contact{
+			{ContactFields: ContactFields{Type: ContactPhone, Value: phoneNumber, Primary: true}, CreatedAt: now, UpdatedAt: now},
+			{ContactFields: ContactFields{Type: ContactEmail, Value: email, Primary: true}, CreatedAt: now, UpdatedAt: now},
+		},
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	person.normalize()
+
+	if err := person.Validate(); err != nil {
+		return nil, err
+	}
+
+	return person, nil
+}
 
-	name = strings.TrimSpace(name)
-	email = strings.TrimSpace(email)
-	cpf = utils.OnlyDigits(cpf)
-	phoneNumber = utils.OnlyDigits(phoneNumber)
+// ApplyChanges applies the given changes to the person and validates the
+// resulting aggregate. The person is only modified when the result is valid.
+// It returns the names of the fields whose values actually changed.
+func (p *Person) ApplyChanges(changes PersonChanges) ([]string, error) {
+	candidate := *p
+	candidate.Contacts = append([]Contact(nil), p.Contacts...)
 
-	if name == "" {
-		return nil, personErr.ErrNameRequired
+	if changes.Name != nil {
+		candidate.Name = *changes.Name
+	}
+	if changes.CPF != nil {
+		candidate.CPF = *changes.CPF
+	}
+	if changes.BirthDate != nil {
+		candidate.BirthDate = *changes.BirthDate
 	}
-	if cpf == "" {
-		return nil, personErr.ErrCPFRequired
+	if changes.PhoneNumber != nil {
+		candidate.setPrimaryContact(ContactPhone, *changes.PhoneNumber)
 	}
-	if phoneNumber == "" {
-		return nil, personErr.ErrPhoneRequired
+	if changes.Email != nil {
+		candidate.setPrimaryContact(ContactEmail, *changes.Email)
 	}
-	if email == "" {
-		return nil, personErr.ErrEmailRequired
+
+	candidate.normalize()
+
+	if err := candidate.Validate(); err != nil {
+		return nil, err
 	}
 
-	if birthDate.IsZero() || birthDate.After(time.Now()) {
-		return nil, personErr.ErrBirthDateInvalid
+	var changed []string
+	if candidate.Name != p.Name {
+		changed = append(changed, FieldName)
+	}
+	if candidate.CPF != p.CPF {
+		changed = append(changed, FieldCPF)
 	}
-	if !validateCPF(cpf) {
-		return nil, personErr.ErrCPFInvalid
+	if !candidate.BirthDate.Equal(p.BirthDate) {
+		changed = append(changed, FieldBirthDate)
 	}
-	if !validateEmail(email) {
-		return nil, personErr.ErrEmailInvalid
+	if candidate.Phone() != p.Phone() {
+		changed = append(changed, FieldPhoneNumber)
 	}
-	if !validatePhone(phoneNumber) {
-		return nil, personErr.ErrPhoneInvalid
+	if candidate.Email() != p.Email() {
+		changed = append(changed, FieldEmail)
+	}
+
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	candidate.UpdatedAt = time.Now()
+	*p = candidate
+
+	return changed, nil
+}
+
+// AddContact adds a contact to the person. Marking it as primary demotes the
+// current primary contact of its type. The person is only modified when the
+// contact is valid.
+func (p *Person) AddContact(fields ContactFields) error {
+	contact, err := NewContact(p.ID, fields)
+	if err != nil {
+		return err
+	}
+
+	if contact.Primary {
+		for i := range p.Contacts {
+			if p.Contacts[i].Type == contact.Type {
+				p.Contacts[i].Primary = false
+			}
+		}
+	}
+
+	p.Contacts = append(p.Contacts, *contact)
+	p.ensurePrimaryContacts()
+
+	return nil
+}
+
+// PrimaryContact returns the primary contact of the given type, or nil when
+// the person has no contact of that type.
+func (p *Person) PrimaryContact(contactType string) *Contact {
+	for i := range p.Contacts {
+		if p.Contacts[i].Type == contactType && p.Contacts[i].Primary {
+			return &p.Contacts[i]
+		}
+	}
+	return nil
+}
+
+// Phone returns the value of the primary phone contact.
+func (p *Person) Phone() string {
+	if contact := p.PrimaryContact(ContactPhone); contact != nil {
+		return contact.Value
+	}
+	return ""
+}
+
+// Email returns the value of the primary email contact.
+func (p *Person) Email() string {
+	if contact := p.PrimaryContact(ContactEmail); contact != nil {
+		return contact.Value
+	}
+	return ""
+}
+
+// IsDeleted reports whether the person has been soft deleted.
+func (p *Person) IsDeleted() bool {
+	return p.DeletedAt != nil
+}
+
+// IsMerged reports whether the person was merged into another one.
+func (p *Person) IsMerged() bool {
+	return p.MergedIntoID != nil
+}
+
+// IsAnonymized reports whether the personal data of the person was scrubbed.
+func (p *Person) IsAnonymized() bool {
+	return p.AnonymizedAt != nil
+}
+
+// AgeOn returns the age of the person in whole years on the given date.
+func (p *Person) AgeOn(date time.Time) int {
+	age := date.Year() - p.BirthDate.Year()
+	if date.Month() < p.BirthDate.Month() || (date.Month() == p.BirthDate.Month() && date.Day() < p.BirthDate.Day()) {
+		age--
+	}
+	return age
+}
+
+// Validate checks the business rules of the person aggregate.
+func (p *Person) Validate() error {
+	if p.Name == "" {
+		return personErr.ErrNameRequired
+	}
+	if p.CPF == "" {
+		return personErr.ErrCPFRequired
+	}
+	if p.Phone() == "" {
+		return personErr.ErrPhoneRequired
+	}
+	if p.Email() == "" {
+		return personErr.ErrEmailRequired
+	}
+
+	if p.BirthDate.IsZero() || p.BirthDate.After(time.Now()) {
+		return personErr.ErrBirthDateInvalid
+	}
+	if !validateCPF(p.CPF) {
+		return personErr.ErrCPFInvalid
+	}
+	if !validateEmail(p.Email()) {
+		return personErr.ErrEmailInvalid
+	}
+	if _, err := ParsePhoneNumber(p.Phone()); err != nil {
+		return err
+	}
+
+	for i := range p.Contacts {
+		if err := p.Contacts[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Person) normalize() {
+	p.Name = strings.TrimSpace(p.Name)
+	p.CPF = utils.OnlyDigits(p.CPF)
+	for i := range p.Contacts {
+		p.Contacts[i].normalize()
+	}
+	p.ensurePrimaryContacts()
+}
+
+// setPrimaryContact replaces the value of the primary contact of the given
+// type, or adds one when there is none. A changed value is no longer verified.
+func (p *Person) setPrimaryContact(contactType, value string) {
+	value = NormalizeContactValue(contactType, value)
+
+	if contact := p.PrimaryContact(contactType); contact != nil {
+		if contact.Value != value {
+			contact.Value = value
+			contact.Verified = false
+			contact.UpdatedAt = time.Now()
+		}
+		return
 	}
 
 	now := time.Now()
+	p.Contacts = append(p.Contacts, Contact{
+		ContactFields: ContactFields{Type: contactType, Value: value, Primary: true},
+		PersonID:      p.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+}
 
-	return &Person{
-		Name:        name,
-		CPF:         cpf,
-		BirthDate:   birthDate,
-		PhoneNumber: phoneNumber,
-		Email:       email,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-	}, nil
+// ensurePrimaryContacts leaves exactly one primary contact of each type the
+// person has: the first one marked as primary, or else the first of the type.
+func (p *Person) ensurePrimaryContacts() {
+	for _, contactType := range []string{ContactPhone, ContactEmail} {
+		first, primary := -1, -1
+		for i := range p.Contacts {
+			contact := &p.Contacts[i]
+			if contact.Type != contactType {
+				continue
+			}
+			if first == -1 {
+				first = i
+			}
+			if contact.Primary && primary == -1 {
+				primary = i
+			}
+			contact.Primary = false
+		}
+
+		if primary == -1 {
+			primary = first
+		}
+		if primary != -1 {
+			p.Contacts[primary].Primary = true
+		}
+	}
+}
+
+// ValidCPF reports whether the input, with or without mask, is a valid CPF.
+func ValidCPF(cpf string) bool {
+	return validateCPF(utils.OnlyDigits(cpf))
 }
 
 func validateCPF(cpf string) bool {
@@ -116,11 +356,3 @@ func validateEmail(email string) bool {
 
 	return emailRegex.MatchString(email)
 }
-
-func validatePhone(phone string) bool {
-	if len(phone) != 10 && len(phone) != 11 {
-		return false
-	}
-
-	return true
-}
@@ -0,0 +1,56 @@
+package person
+
+import (
+	"strconv"
+	"time"
+)
+
+// Cursor marks a position in a keyset-paginated listing: the sort key and ID of
+// the person at the edge of a page. Sort and Order record the ordering the
+// cursor was issued for, since it is meaningless under any other.
+type Cursor struct {
+	Sort     string
+	Order    string
+	Value    string
+	ID       int
+	Backward bool
+}
+
+// CursorPage is one page of a keyset-paginated listing. Next and Prev are nil
+// when there is nothing further in that direction; Total is nil when the
+// count was not requested.
+type CursorPage struct {
+	Persons []*Person
+	Next    *Cursor
+	Prev    *Cursor
+	Total   *int64
+}
+
+// NewCursor builds a cursor positioned at p for the given ordering.
+func NewCursor(p *Person, sort, order string, backward bool) *Cursor {
+	return &Cursor{
+		Sort:     sort,
+		Order:    order,
+		Value:    p.SortValue(sort),
+		ID:       p.ID,
+		Backward: backward,
+	}
+}
+
+// SortValue renders the value of a sortable field as a string; timestamps use RFC 3339 with nanoseconds.
+func (p *Person) SortValue(field string) string {
+	switch field {
+	case "name":
+		return p.Name
+	case "cpf":
+		return p.CPF
+	case "email":
+		return p.Email()
+	case "created_at":
+		return p.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		return p.UpdatedAt.Format(time.RFC3339Nano)
+	default:
+		return strconv.Itoa(p.ID)
+	}
+}
@@ -0,0 +1,80 @@
+package person
+
+import (
+	"time"
+
+	utils "pessoas-api/internal/domain/person/utils"
+)
+
+// ExportColumns lists the columns a person export can contain, in their default order.
+var ExportColumns = []string{
+	"id",
+	"name",
+	"cpf",
+	"birth_date",
+	"phone",
+	"email",
+	"version",
+	"created_at",
+	"updated_at",
+	"deleted_at",
+}
+
+// ExportOptions selects what goes into an export. No columns means all of
+// ExportColumns; Masked formats CPFs and phone numbers for display.
+type ExportOptions struct {
+	Columns []string
+	Masked  bool
+}
+
+// IsExportColumn reports whether column is one of ExportColumns.
+func IsExportColumn(column string) bool {
+	for _, known := range ExportColumns {
+		if column == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportValues returns the values of the given columns. IDs and versions are
+// ints, a missing deletion time is nil and everything else is a string: birth
+// dates as YYYY-MM-DD and timestamps as RFC 3339.
+func (p *Person) ExportValues(columns []string, masked bool) []any {
+	values := make([]any, len(columns))
+
+	for i, column := range columns {
+		switch column {
+		case "id":
+			values[i] = p.ID
+		case "name":
+			values[i] = p.Name
+		case "cpf":
+			values[i] = p.CPF
+			if masked {
+				values[i] = utils.FormatCPF(p.CPF)
+			}
+		case "birth_date":
+			values[i] = p.BirthDate.Format("2006-01-02")
+		case "phone":
+			values[i] = p.Phone()
+			if masked {
+				values[i] = NationalPhone(p.Phone())
+			}
+		case "email":
+			values[i] = p.Email()
+		case "version":
+			values[i] = p.Version
+		case "created_at":
+			values[i] = p.CreatedAt.Format(time.RFC3339)
+		case "updated_at":
+			values[i] = p.UpdatedAt.Format(time.RFC3339)
+		case "deleted_at":
+			if p.DeletedAt != nil {
+				values[i] = p.DeletedAt.Format(time.RFC3339)
+			}
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,30 @@
+package person
+
+import "time"
+
+// PersonFilter narrows down person listings. Zero values mean "no restriction";
+// every range bound is inclusive.
+type PersonFilter struct {
+	// Name matches persons whose name contains it, ignoring case and accents.
+	Name string
+
+	Email       string
+	EmailPrefix bool
+
+	PhoneNumber string
+	PhonePrefix bool
+
+	CPFPrefix string
+
+	BirthDateFrom *time.Time
+	BirthDateTo   *time.Time
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	UpdatedFrom   *time.Time
+	UpdatedTo     *time.Time
+
+	// ConsentPurpose matches persons with an active consent for the purpose.
+	ConsentPurpose string
+
+	IncludeDeleted bool
+}
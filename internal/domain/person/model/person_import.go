@@ -0,0 +1,40 @@
+package person
+
+// Statuses of a row in an import report.
+const (
+	ImportStatusImported = "imported"
+	ImportStatusValid    = "valid"
+	ImportStatusFailed   = "failed"
+)
+
+// ImportRowResult is the outcome of a single row of an import file.
+// ID is only set for imported rows; ErrorCode and Message only for failed ones.
+type ImportRowResult struct {
+	Line      int
+	Status    string
+	ID        int
+	ErrorCode string
+	Message   string
+}
+
+// ImportReport summarizes a bulk import. In a dry run nothing is written and
+// valid rows are reported with ImportStatusValid instead of ImportStatusImported.
+type ImportReport struct {
+	DryRun    bool
+	TotalRows int
+	Succeeded int
+	Failed    int
+	Rows      []ImportRowResult
+}
+
+// Succeed records a row that was imported (or found valid, in a dry run).
+func (r *ImportReport) Succeed(line int, status string, id int) {
+	r.Succeeded++
+	r.Rows = append(r.Rows, ImportRowResult{Line: line, Status: status, ID: id})
+}
+
+// Fail records a rejected row.
+func (r *ImportReport) Fail(line int, code, message string) {
+	r.Failed++
+	r.Rows = append(r.Rows, ImportRowResult{Line: line, Status: ImportStatusFailed, ErrorCode: code, Message: message})
+}
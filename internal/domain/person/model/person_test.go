@@ -30,8 +30,9 @@ func TestNewPerson_ShouldCreatePerson_WhenInputIsValid(t *testing.T) {
 	assert.Equal(name, person.Name)
 	assert.Equal(utils.OnlyDigits(cpf), person.CPF)
 	assert.Equal(birthDate, person.BirthDate)
-	assert.Equal(utils.OnlyDigits(phone), person.PhoneNumber)
-	assert.Equal(email, person.Email)
+	assert.Equal("+5581912345678", person.Phone())
+	assert.Equal(email, person.Email())
+	assert.Equal(1, person.Version)
 
 	assert.WithinDuration(time.Now(), person.CreatedAt, time.Second)
 	assert.WithinDuration(time.Now(), person.UpdatedAt, time.Second)
@@ -144,3 +145,76 @@ func TestNewPerson_ShouldFail_WhenBirthDateIsZero(t *testing.T) {
 	assert.ErrorIs(err, personErr.ErrBirthDateInvalid)
 	assert.Nil(person)
 }
+
+func TestApplyChanges_ShouldUpdateOnlyGivenFields(t *testing.T) {
+	assert := assert.New(t)
+
+	name, cpf, birthDate, phone, email := validPersonInput()
+	person, _ := NewPerson(name, cpf, birthDate, phone, email)
+	previousUpdatedAt := person.UpdatedAt
+
+	newEmail := "  john.new@example.com "
+	changed, err := person.ApplyChanges(PersonChanges{Email: &newEmail})
+
+	assert.NoError(err)
+	assert.Equal([]string{FieldEmail}, changed)
+	assert.Equal("john.new@example.com", person.Email())
+	assert.Equal(name, person.Name)
+	assert.Equal(utils.OnlyDigits(cpf), person.CPF)
+	assert.False(person.UpdatedAt.Before(previousUpdatedAt))
+}
+
+func TestApplyChanges_ShouldNotModifyPerson_WhenResultIsInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	name, cpf, birthDate, phone, email := validPersonInput()
+	person, _ := NewPerson(name, cpf, birthDate, phone, email)
+	original := *person
+
+	newName := "Jane Doe"
+	invalidCPF := "123.456.789-00"
+	changed, err := person.ApplyChanges(PersonChanges{Name: &newName, CPF: &invalidCPF})
+
+	assert.ErrorIs(err, personErr.ErrCPFInvalid)
+	assert.Nil(changed)
+	assert.Equal(original, *person)
+}
+
+func TestApplyChanges_ShouldReportNoChanges_WhenValuesAreEquivalent(t *testing.T) {
+	assert := assert.New(t)
+
+	name, cpf, birthDate, phone, email := validPersonInput()
+	person, _ := NewPerson(name, cpf, birthDate, phone, email)
+	original := *person
+
+	changed, err := person.ApplyChanges(PersonChanges{CPF: &cpf, PhoneNumber: &phone})
+
+	assert.NoError(err)
+	assert.Empty(changed)
+	assert.Equal(original, *person)
+}
+
+func TestExportValues_ShouldReturnSelectedColumnsInOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	name, cpf, birthDate, phone, email := validPersonInput()
+	person, _ := NewPerson(name, cpf, birthDate, phone, email)
+	person.ID = 42
+
+	values := person.ExportValues([]string{"email", "id", "birth_date", "cpf", "phone", "deleted_at"}, false)
+
+	assert.Equal([]any{"john.doe@example.com", 42, "1990-01-01", "11144477735", "+5581912345678", nil}, values)
+}
+
+func TestExportValues_ShouldFormatCPFAndPhone_WhenMasked(t *testing.T) {
+	assert := assert.New(t)
+
+	name, cpf, birthDate, phone, email := validPersonInput()
+	person, _ := NewPerson(name, cpf, birthDate, phone, email)
+	deletedAt := time.Date(2024, time.May, 2, 13, 4, 5, 0, time.UTC)
+	person.DeletedAt = &deletedAt
+
+	values := person.ExportValues([]string{"cpf", "phone", "deleted_at"}, true)
+
+	assert.Equal([]any{"111.444.777-35", "(81) 91234-5678", "2024-05-02T13:04:05Z"}, values)
+}
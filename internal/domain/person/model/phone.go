@@ -0,0 +1,187 @@
+package person
+
+import (
+	"strings"
+
+	personErr "pessoas-api/internal/domain/person/error"
+	utils "pessoas-api/internal/domain/person/utils"
+)
+
+// CallingCodeBrazil is the country calling code of Brazilian phone numbers.
+const CallingCodeBrazil = "55"
+
+// E.164 numbers have at most 15 digits, country calling code included.
+const (
+	minInternationalDigits = 7
+	maxInternationalDigits = 15
+)
+
+// brazilianAreaCodes are the DDDs assigned by Anatel.
+var brazilianAreaCodes = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true, "22": true, "24": true, "27": true, "28": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "53": true, "54": true, "55": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
+	"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
+	"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true, "99": true,
+}
+
+// callingCodes are the country calling codes assigned by the ITU. No code is a
+// prefix of another, so a number has at most one of them.
+var callingCodes = toSet(strings.Fields(`
+	1 7
+	20 27 30 31 32 33 34 36 39 40 41 43 44 45 46 47 48 49 51 52 53 54 55 56 57 58
+	60 61 62 63 64 65 66 81 82 84 86 90 91 92 93 94 95 98
+	211 212 213 216 218 220 221 222 223 224 225 226 227 228 229 230 231 232 233 234
+	235 236 237 238 239 240 241 242 243 244 245 246 247 248 249 250 251 252 253 254
+	255 256 257 258 260 261 262 263 264 265 266 267 268 269 290 291 297 298 299
+	350 351 352 353 354 355 356 357 358 359 370 371 372 373 374 375 376 377 378 379
+	380 381 382 383 385 386 387 389 420 421 423 500 501 502 503 504 505 506 507 508
+	509 590 591 592 593 594 595 596 597 598 599 670 672 673 674 675 676 677 678 679
+	680 681 682 683 685 686 687 688 689 690 691 692 850 852 853 855 856 880 886 960
+	961 962 963 964 965 966 967 968 970 971 972 973 974 975 976 977 992 993 994 995
+	996 998
+`))
+
+// PhoneNumber is a phone number split into its country calling code and its
+// national significant number, e.g. "55" and "81912345678".
+type PhoneNumber struct {
+	CountryCode string
+	Number      string
+}
+
+// ParsePhoneNumber reads a phone number typed in any usual format. Numbers
+// starting with + are international; anything else is read as Brazilian, with
+// or without the 55 country code, the 0 trunk prefix and a carrier code.
+// Brazilian numbers must have a known DDD, and mobile numbers (nine digits
+// after the DDD) must start with 9. Landlines have eight digits starting with
+// 2 to 5.
+func ParsePhoneNumber(input string) (PhoneNumber, error) {
+	input = strings.TrimSpace(input)
+	digits := utils.OnlyDigits(input)
+
+	if digits == "" {
+		return PhoneNumber{}, personErr.ErrPhoneInvalid
+	}
+
+	if strings.HasPrefix(input, "+") {
+		return parseInternationalPhone(digits)
+	}
+
+	switch {
+	case strings.HasPrefix(digits, CallingCodeBrazil) && (len(digits) == 12 || len(digits) == 13):
+		digits = digits[len(CallingCodeBrazil):]
+	case strings.HasPrefix(digits, "0"):
+		digits = digits[1:]
+		if len(digits) == 12 || len(digits) == 13 {
+			digits = digits[2:]
+		}
+	}
+
+	return parseBrazilianPhone(digits)
+}
+
+func parseInternationalPhone(digits string) (PhoneNumber, error) {
+	if len(digits) < minInternationalDigits || len(digits) > maxInternationalDigits {
+		return PhoneNumber{}, personErr.ErrPhoneInvalid
+	}
+
+	for size := 1; size <= 3; size++ {
+		code := digits[:size]
+		if !callingCodes[code] {
+			continue
+		}
+
+		if code == CallingCodeBrazil {
+			return parseBrazilianPhone(digits[size:])
+		}
+		return PhoneNumber{CountryCode: code, Number: digits[size:]}, nil
+	}
+
+	return PhoneNumber{}, personErr.ErrPhoneCountryCodeInvalid
+}
+
+func parseBrazilianPhone(digits string) (PhoneNumber, error) {
+	if len(digits) != 10 && len(digits) != 11 {
+		return PhoneNumber{}, personErr.ErrPhoneInvalid
+	}
+
+	if !brazilianAreaCodes[digits[:2]] {
+		return PhoneNumber{}, personErr.ErrPhoneAreaCodeInvalid
+	}
+
+	subscriber := digits[2:]
+	switch {
+	case len(subscriber) == 9 && subscriber[0] != '9':
+		return PhoneNumber{}, personErr.ErrPhoneInvalid
+	case len(subscriber) == 8 && subscriber[0] >= '6':
+		return PhoneNumber{}, personErr.ErrPhoneNinthDigitMissing
+	case len(subscriber) == 8 && subscriber[0] < '2':
+		return PhoneNumber{}, personErr.ErrPhoneInvalid
+	}
+
+	return PhoneNumber{CountryCode: CallingCodeBrazil, Number: digits}, nil
+}
+
+// E164 returns the canonical form of the number, e.g. "+5581912345678". It is
+// the form phone numbers are stored and compared in.
+func (p PhoneNumber) E164() string {
+	return "+" + p.CountryCode + p.Number
+}
+
+// National returns the number as written inside its country. Brazilian numbers
+// get the (81) 91234-5678 mask; others are returned without the country code.
+func (p PhoneNumber) National() string {
+	if p.IsBrazilian() {
+		return utils.FormatPhone(p.Number)
+	}
+	return p.Number
+}
+
+// IsBrazilian reports whether the number has the Brazilian country code.
+func (p PhoneNumber) IsBrazilian() bool {
+	return p.CountryCode == CallingCodeBrazil
+}
+
+// NationalPhone formats a stored phone number for display. Values that cannot
+// be parsed are returned unchanged.
+func NationalPhone(value string) string {
+	phone, err := ParsePhoneNumber(value)
+	if err != nil {
+		return value
+	}
+	return phone.National()
+}
+
+// PhoneSearchValue turns a phone filter into the stored form it is compared
+// with. Exact searches use the E.164 form; prefix searches read values without
+// + as the start of a Brazilian number, so "81" finds every number of DDD 81.
+func PhoneSearchValue(input string, prefix bool) string {
+	digits := utils.OnlyDigits(input)
+	if digits == "" {
+		return ""
+	}
+
+	if prefix {
+		if strings.HasPrefix(strings.TrimSpace(input), "+") {
+			return "+" + digits
+		}
+		return "+" + CallingCodeBrazil + digits
+	}
+
+	if phone, err := ParsePhoneNumber(input); err == nil {
+		return phone.E164()
+	}
+	return "+" + digits
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
@@ -0,0 +1,77 @@
+package person
+
+import (
+	"testing"
+
+	personErr "pessoas-api/internal/domain/person/error"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePhoneNumber_ShouldAcceptUsualFormats(t *testing.T) {
+	tests := []struct {
+		input    string
+		e164     string
+		national string
+	}{
+		{"81912345678", "+5581912345678", "(81) 91234-5678"},
+		{"(81) 91234-5678", "+5581912345678", "(81) 91234-5678"},
+		{"+55 81 91234-5678", "+5581912345678", "(81) 91234-5678"},
+		{"55 81 91234-5678", "+5581912345678", "(81) 91234-5678"},
+		{"081 91234-5678", "+5581912345678", "(81) 91234-5678"},
+		{"0 21 81 91234-5678", "+5581912345678", "(81) 91234-5678"},
+		{"(81) 3222-1234", "+558132221234", "(81) 3222-1234"},
+		{"(55) 3222-1234", "+555532221234", "(55) 3222-1234"},
+		{"+1 (202) 555-0123", "+12025550123", "2025550123"},
+		{"+44 20 7946 0958", "+442079460958", "2079460958"},
+		{"+351 912 345 678", "+351912345678", "912345678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			phone, err := ParsePhoneNumber(tt.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.e164, phone.E164())
+			assert.Equal(t, tt.national, phone.National())
+		})
+	}
+}
+
+func TestParsePhoneNumber_ShouldRejectInvalidNumbers(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectedErr error
+	}{
+		{"no digits", "--", personErr.ErrPhoneInvalid},
+		{"too short", "12345", personErr.ErrPhoneInvalid},
+		{"all zeros", "00000000000", personErr.ErrPhoneAreaCodeInvalid},
+		{"unknown DDD", "(20) 91234-5678", personErr.ErrPhoneAreaCodeInvalid},
+		{"mobile without ninth digit", "(81) 9123-4567", personErr.ErrPhoneNinthDigitMissing},
+		{"nine digits not starting with 9", "(81) 81234-5678", personErr.ErrPhoneInvalid},
+		{"landline starting with 1", "(81) 1234-5678", personErr.ErrPhoneInvalid},
+		{"brazilian with +55 and unknown DDD", "+55 10 91234-5678", personErr.ErrPhoneAreaCodeInvalid},
+		{"unknown country code", "+999 1234 5678", personErr.ErrPhoneCountryCodeInvalid},
+		{"international too long", "+1 2025 5501 2345 678", personErr.ErrPhoneInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePhoneNumber(tt.input)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.ErrorIs(t, err, personErr.ErrPhoneInvalid, "every phone error is an invalid phone")
+		})
+	}
+}
+
+func TestPhoneSearchValue(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("+5581912345678", PhoneSearchValue("(81) 91234-5678", false))
+	assert.Equal("+5581", PhoneSearchValue("81", true))
+	assert.Equal("+1202", PhoneSearchValue("+1 202", true))
+	assert.Equal("+12345", PhoneSearchValue("12345", false), "unparseable values match nothing instead of everything")
+	assert.Equal("", PhoneSearchValue(" ", false))
+}
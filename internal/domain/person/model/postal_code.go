@@ -0,0 +1,31 @@
+package person
+
+import "strings"
+
+// PostalCode is the location a Brazilian CEP refers to, as reported by a
+// PostalCodeProvider. Street and District are empty for CEPs that cover a
+// whole city.
+type PostalCode struct {
+	CEP      string
+	Street   string
+	District string
+	City     string
+	State    string
+}
+
+// Complete fills the blank street, district, city and state of the fields with
+// the ones of the postal code. Fields the client did fill are kept.
+func (f *AddressFields) Complete(postalCode *PostalCode) {
+	if strings.TrimSpace(f.Street) == "" {
+		f.Street = postalCode.Street
+	}
+	if strings.TrimSpace(f.District) == "" {
+		f.District = postalCode.District
+	}
+	if strings.TrimSpace(f.City) == "" {
+		f.City = postalCode.City
+	}
+	if strings.TrimSpace(f.State) == "" {
+		f.State = postalCode.State
+	}
+}
@@ -0,0 +1,153 @@
+package person
+
+import (
+	"time"
+
+	personErr "pessoas-api/internal/domain/person/error"
+)
+
+// Relationship types. A relationship is read from the point of view of the
+// person it is listed for: "parent" means the related person is a parent of
+// that person. Every type has an inverse, seen from the other end; spouse is
+// its own inverse.
+const (
+	RelationshipParent              = "parent"
+	RelationshipChild               = "child"
+	RelationshipSpouse              = "spouse"
+	RelationshipGuardian            = "guardian"
+	RelationshipWard                = "ward"
+	RelationshipLegalRepresentative = "legal_representative"
+	RelationshipRepresented         = "represented"
+	RelationshipEmergencyContact    = "emergency_contact"
+	RelationshipEmergencyContactFor = "emergency_contact_for"
+)
+
+// AdultAge is the age from which a person may be a guardian or a legal representative.
+const AdultAge = 18
+
+// inverseRelationships maps each type to the type seen from the other end.
+var inverseRelationships = map[string]string{
+	RelationshipParent:              RelationshipChild,
+	RelationshipChild:               RelationshipParent,
+	RelationshipSpouse:              RelationshipSpouse,
+	RelationshipGuardian:            RelationshipWard,
+	RelationshipWard:                RelationshipGuardian,
+	RelationshipLegalRepresentative: RelationshipRepresented,
+	RelationshipRepresented:         RelationshipLegalRepresentative,
+	RelationshipEmergencyContact:    RelationshipEmergencyContactFor,
+	RelationshipEmergencyContactFor: RelationshipEmergencyContact,
+}
+
+// canonicalRelationships are the types relationships are stored with. The
+// others are stored as the inverse, from the other end, so that a link between
+// two persons has a single representation.
+var canonicalRelationships = map[string]bool{
+	RelationshipParent:              true,
+	RelationshipSpouse:              true,
+	RelationshipGuardian:            true,
+	RelationshipLegalRepresentative: true,
+	RelationshipEmergencyContact:    true,
+}
+
+// Relationship links two persons: the related person is the Type of the person.
+// Relationships are stored in canonical form, with a canonical type and, for
+// spouses, the lower ID as the person.
+type Relationship struct {
+	ID        int
+	PersonID  int
+	RelatedID int
+	Type      string
+	CreatedAt time.Time
+}
+
+// NewRelationship links the related person to the holder with the given type,
+// as seen from the holder, and returns it in canonical form. Guardians and legal
+// representatives must be adults on the given date, and parents must be born
+// before their children.
+func NewRelationship(holder, related *Person, relationshipType string, now time.Time) (*Relationship, error) {
+	if !IsRelationshipType(relationshipType) {
+		return nil, personErr.ErrRelationshipTypeInvalid
+	}
+
+	if holder.ID == related.ID {
+		return nil, personErr.ErrRelationshipSelf
+	}
+
+	subject, object := holder, related
+	if !canonicalRelationships[relationshipType] {
+		subject, object = related, holder
+		relationshipType = inverseRelationships[relationshipType]
+	}
+	if relationshipType == RelationshipSpouse && object.ID < subject.ID {
+		subject, object = object, subject
+	}
+
+	switch relationshipType {
+	case RelationshipGuardian, RelationshipLegalRepresentative:
+		if object.AgeOn(now) < AdultAge {
+			return nil, personErr.ErrGuardianNotAdult
+		}
+	case RelationshipParent:
+		if !object.BirthDate.Before(subject.BirthDate) {
+			return nil, personErr.ErrParentNotOlder
+		}
+	}
+
+	return &Relationship{
+		PersonID:  subject.ID,
+		RelatedID: object.ID,
+		Type:      relationshipType,
+		CreatedAt: now,
+	}, nil
+}
+
+// IsRelationshipType reports whether the type is known, canonical or not.
+func IsRelationshipType(relationshipType string) bool {
+	_, ok := inverseRelationships[relationshipType]
+	return ok
+}
+
+// Involves reports whether the person is either end of the relationship.
+func (r *Relationship) Involves(personID int) bool {
+	return r.PersonID == personID || r.RelatedID == personID
+}
+
+// Other returns the ID of the end of the relationship that is not the person.
+func (r *Relationship) Other(personID int) int {
+	if r.PersonID == personID {
+		return r.RelatedID
+	}
+	return r.PersonID
+}
+
+// From returns the relationship as seen from one of its ends: the person
+// becomes PersonID and the type is inverted when needed.
+func (r *Relationship) From(personID int) *Relationship {
+	view := *r
+	if r.PersonID != personID {
+		view.PersonID, view.RelatedID = r.RelatedID, r.PersonID
+		view.Type = inverseRelationships[r.Type]
+	}
+	return &view
+}
+
+// SameLink reports whether both relationships link the same persons with the same type.
+func (r *Relationship) SameLink(other *Relationship) bool {
+	return r.PersonID == other.PersonID && r.RelatedID == other.RelatedID && r.Type == other.Type
+}
+
+// FamilyMember is a person reached while walking the relationships of another,
+// at the given number of links from it.
+type FamilyMember struct {
+	Person   *Person
+	Distance int
+}
+
+// FamilyGraph holds the persons within some links of a person, the person
+// itself first at distance 0, and the relationships among them.
+type FamilyGraph struct {
+	PersonID      int
+	Depth         int
+	Members       []FamilyMember
+	Relationships []*Relationship
+}
@@ -0,0 +1,99 @@
+package person
+
+import (
+	personErr "pessoas-api/internal/domain/person/error"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var relationshipNow = time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+func relative(id int, birthDate time.Time) *Person {
+	return &Person{ID: id, BirthDate: birthDate}
+}
+
+func TestNewRelationship_ShouldStoreCanonicalForm(t *testing.T) {
+	adult := relative(1, time.Date(1980, time.March, 1, 0, 0, 0, 0, time.UTC))
+	minor := relative(2, time.Date(2012, time.March, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		holder   *Person
+		related  *Person
+		kind     string
+		expected Relationship
+	}{
+		{"parent", minor, adult, RelationshipParent, Relationship{PersonID: 2, RelatedID: 1, Type: RelationshipParent}},
+		{"child is stored as parent", adult, minor, RelationshipChild, Relationship{PersonID: 2, RelatedID: 1, Type: RelationshipParent}},
+		{"ward is stored as guardian", adult, minor, RelationshipWard, Relationship{PersonID: 2, RelatedID: 1, Type: RelationshipGuardian}},
+		{"represented is stored as legal representative", adult, minor, RelationshipRepresented, Relationship{PersonID: 2, RelatedID: 1, Type: RelationshipLegalRepresentative}},
+		{"emergency contact for", adult, minor, RelationshipEmergencyContactFor, Relationship{PersonID: 2, RelatedID: 1, Type: RelationshipEmergencyContact}},
+		{"spouse keeps the lower ID as person", minor, adult, RelationshipSpouse, Relationship{PersonID: 1, RelatedID: 2, Type: RelationshipSpouse}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relationship, err := NewRelationship(tt.holder, tt.related, tt.kind, relationshipNow)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected.PersonID, relationship.PersonID)
+			assert.Equal(t, tt.expected.RelatedID, relationship.RelatedID)
+			assert.Equal(t, tt.expected.Type, relationship.Type)
+		})
+	}
+}
+
+func TestNewRelationship_ShouldReturnError_WhenRulesAreBroken(t *testing.T) {
+	adult := relative(1, time.Date(1980, time.March, 1, 0, 0, 0, 0, time.UTC))
+	minor := relative(2, time.Date(2012, time.March, 1, 0, 0, 0, 0, time.UTC))
+	almostAdult := relative(3, time.Date(2006, time.June, 16, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name        string
+		holder      *Person
+		related     *Person
+		kind        string
+		expectedErr error
+	}{
+		{"unknown type", minor, adult, "cousin", personErr.ErrRelationshipTypeInvalid},
+		{"self link", adult, adult, RelationshipSpouse, personErr.ErrRelationshipSelf},
+		{"minor guardian", adult, minor, RelationshipGuardian, personErr.ErrGuardianNotAdult},
+		{"minor ward's guardian seen from the minor", minor, adult, RelationshipWard, personErr.ErrGuardianNotAdult},
+		{"guardian turning 18 tomorrow", minor, almostAdult, RelationshipGuardian, personErr.ErrGuardianNotAdult},
+		{"minor legal representative", adult, minor, RelationshipLegalRepresentative, personErr.ErrGuardianNotAdult},
+		{"parent younger than child", adult, minor, RelationshipParent, personErr.ErrParentNotOlder},
+		{"child older than parent", minor, adult, RelationshipChild, personErr.ErrParentNotOlder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relationship, err := NewRelationship(tt.holder, tt.related, tt.kind, relationshipNow)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, relationship)
+		})
+	}
+}
+
+func TestRelationship_From(t *testing.T) {
+	relationship := &Relationship{ID: 7, PersonID: 2, RelatedID: 1, Type: RelationshipGuardian}
+
+	fromWard := relationship.From(2)
+	fromGuardian := relationship.From(1)
+
+	assert.Equal(t, relationship, fromWard)
+	assert.Equal(t, &Relationship{ID: 7, PersonID: 1, RelatedID: 2, Type: RelationshipWard}, fromGuardian)
+	assert.Equal(t, RelationshipGuardian, relationship.Type, "the stored relationship is not modified")
+	assert.Equal(t, 1, relationship.Other(2))
+	assert.True(t, relationship.Involves(1))
+	assert.False(t, relationship.Involves(3))
+}
+
+func TestPerson_AgeOn(t *testing.T) {
+	person := relative(1, time.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, 23, person.AgeOn(time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 24, person.AgeOn(time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,92 @@
+package person
+
+import (
+	"fmt"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+)
+
+// Types of the LGPD requests a data subject can make about their data.
+const (
+	SubjectRequestAccess        = "access"
+	SubjectRequestPortability   = "portability"
+	SubjectRequestAnonymization = "anonymization"
+)
+
+// Outcomes of a data subject request.
+const (
+	SubjectRequestCompleted = "completed"
+	SubjectRequestNotFound  = "not_found"
+)
+
+// AnonymizedName replaces the name of anonymized persons.
+const AnonymizedName = "Titular anonimizado"
+
+// SubjectRequest records an LGPD request answered on behalf of a data subject,
+// for the data protection officer. SubjectCPF is masked so that the log itself
+// does not identify the subject; PersonIDs are the records the request covered.
+type SubjectRequest struct {
+	ID         int
+	Type       string
+	SubjectCPF string
+	PersonIDs  []int
+	Status     string
+	OperatorID int
+	RequestID  string
+	ClientIP   string
+	CreatedAt  time.Time
+}
+
+// NewSubjectRequest records a request about the persons holding the CPF. It
+// is completed when any person was found.
+func NewSubjectRequest(requestType, cpf string, personIDs []int, actor audit.Actor) *SubjectRequest {
+	status := SubjectRequestCompleted
+	if len(personIDs) == 0 {
+		status = SubjectRequestNotFound
+	}
+
+	return &SubjectRequest{
+		Type:       requestType,
+		SubjectCPF: MaskCPF(cpf),
+		PersonIDs:  personIDs,
+		Status:     status,
+		OperatorID: actor.OperatorID,
+		RequestID:  actor.RequestID,
+		ClientIP:   actor.ClientIP,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// SubjectReport is everything stored about a data subject: every person
+// registered with their CPF, deleted or not, and the persons merged into them,
+// with their addresses, documents, consents, relationships and change history.
+type SubjectReport struct {
+	RequestID      int
+	CPF            string
+	GeneratedAt    time.Time
+	Records        []SubjectRecord
+	Relationships  []*Relationship
+	History        []*audit.AuditEntry
+	ConsentHistory []*ConsentEvent
+}
+
+// SubjectRecord is one person of a subject report with the records it owns.
+type SubjectRecord struct {
+	Person    *Person
+	Addresses []*Address
+	Documents []*Document
+	Consents  []*Consent
+}
+
+// AnonymizedCPF replaces the CPF of an anonymized person. It is unique per
+// person and, as it is not made of digits, can never match a real CPF.
+func AnonymizedCPF(personID int) string {
+	return fmt.Sprintf("A%010d", personID)
+}
+
+// AnonymizedBirthDate keeps only the year of a birth date, so that age
+// statistics survive the anonymization.
+func AnonymizedBirthDate(birthDate time.Time) time.Time {
+	return time.Date(birthDate.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+}
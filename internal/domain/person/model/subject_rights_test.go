@@ -0,0 +1,34 @@
+package person
+
+import (
+	"testing"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSubjectRequest(t *testing.T) {
+	assert := assert.New(t)
+	actor := audit.Actor{OperatorID: 3, RequestID: "req-1", ClientIP: "203.0.113.10"}
+
+	found := NewSubjectRequest(SubjectRequestAccess, "11144477735", []int{1, 4}, actor)
+
+	assert.Equal(SubjectRequestCompleted, found.Status)
+	assert.Equal("***.444.777-**", found.SubjectCPF)
+	assert.Equal([]int{1, 4}, found.PersonIDs)
+	assert.Equal(3, found.OperatorID)
+
+	missing := NewSubjectRequest(SubjectRequestAnonymization, "11144477735", nil, actor)
+
+	assert.Equal(SubjectRequestNotFound, missing.Status)
+}
+
+func TestAnonymizedValues(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("A0000000042", AnonymizedCPF(42))
+	assert.False(ValidCPF(AnonymizedCPF(42)))
+	assert.Equal(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), AnonymizedBirthDate(time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)))
+}
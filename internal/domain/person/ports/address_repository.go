@@ -0,0 +1,16 @@
+package ports
+
+import person "pessoas-api/internal/domain/person/model"
+
+// AddressRepository defines the contract for persistence of person addresses.
+// It keeps exactly one primary address per person that has any: saving or
+// updating a primary address demotes the previous one, the first address saved
+// for a person becomes primary, and deleting the primary address promotes the
+// oldest remaining one. Addresses are always looked up within their person.
+type AddressRepository interface {
+	Save(address *person.Address) (ID int, err error)
+	Update(address *person.Address) error
+	Delete(personID, id int) error
+	FindByID(personID, id int) (*person.Address, error)
+	FindByPerson(personID int) ([]*person.Address, error)
+}
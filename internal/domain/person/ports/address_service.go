@@ -0,0 +1,16 @@
+package ports
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+)
+
+type AddressService interface {
+	ListAddresses(personID int) ([]*person.Address, error)
+	FindAddress(personID, id int) (*person.Address, error)
+	AddAddress(personID int, dto contract.AddressDTO, actor audit.Actor) (*person.Address, error)
+	UpdateAddress(personID, id int, dto contract.AddressDTO, actor audit.Actor) (*person.Address, error)
+	DeleteAddress(personID, id int, actor audit.Actor) error
+	LookupPostalCode(cep string) (*person.PostalCode, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// ConsentRepository defines the contract for persistence of consents and of
+// their history. Record stores the current state of a consent, creating it
+// when it has no ID, and appends the event describing the change in the same
+// transaction; the event is given the ID of the consent. History events are
+// never updated nor deleted. FindEvents lists the events of the persons,
+// newest first.
+type ConsentRepository interface {
+	Record(consent *person.Consent, event *person.ConsentEvent) error
+	FindByPerson(personID int) ([]*person.Consent, error)
+	FindByPurpose(personID int, purpose string) (*person.Consent, error)
+	FindEvents(personIDs []int) ([]*person.ConsentEvent, error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+)
+
+type ConsentService interface {
+	ListConsents(personID int) ([]*person.Consent, error)
+	FindConsent(personID int, purpose string) (*person.Consent, error)
+	GrantConsent(personID int, dto contract.ConsentDTO, actor audit.Actor) (*person.Consent, error)
+	RevokeConsent(personID int, purpose string, dto contract.RevokeConsentDTO, actor audit.Actor) (*person.Consent, error)
+	ConsentHistory(personID int) ([]*person.ConsentEvent, error)
+}
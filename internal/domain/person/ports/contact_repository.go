@@ -0,0 +1,18 @@
+package ports
+
+import person "pessoas-api/internal/domain/person/model"
+
+// ContactRepository defines the contract for persistence of person contacts.
+// It keeps exactly one primary contact of each type per person: saving or
+// updating a primary contact demotes the previous one of its type, the first
+// contact of a type becomes primary, and deleting a primary contact promotes
+// the oldest remaining one of its type. Every write also refreshes the
+// person's primary phone and email and increments its version. Contacts are
+// always looked up within their person.
+type ContactRepository interface {
+	Save(contact *person.Contact) (ID int, err error)
+	Update(contact *person.Contact) error
+	Delete(personID, id int) error
+	FindByID(personID, id int) (*person.Contact, error)
+	FindByPerson(personID int) ([]*person.Contact, error)
+}
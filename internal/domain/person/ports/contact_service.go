@@ -0,0 +1,19 @@
+package ports
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// ContactService manages the contacts of a person. The writes take the version
+// of the person, which is required when they change a primary contact and
+// checked whenever it is not zero; they return ErrVersionRequired and
+// ErrVersionConflict otherwise.
+type ContactService interface {
+	ListContacts(personID int) ([]*person.Contact, error)
+	FindContact(personID, id int) (*person.Contact, error)
+	AddContact(personID, version int, dto contract.ContactDTO, actor audit.Actor) (*person.Contact, error)
+	UpdateContact(personID, id, version int, dto contract.ContactDTO, actor audit.Actor) (*person.Contact, error)
+	DeleteContact(personID, id, version int, actor audit.Actor) error
+}
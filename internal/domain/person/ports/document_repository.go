@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// DocumentRepository defines the contract for persistence of identity documents.
+// Documents are looked up within their person, except by FindByNumber, which
+// searches every person, and FindExpiring, which lists the documents of active
+// persons whose expiry date falls within [from, until], ordered by that date.
+// A nil from includes documents that have already expired.
+type DocumentRepository interface {
+	Save(document *person.Document) (ID int, err error)
+	Update(document *person.Document) error
+	Delete(personID, id int) error
+	FindByID(personID, id int) (*person.Document, error)
+	FindByPerson(personID int) ([]*person.Document, error)
+	FindByNumber(documentType, number string) ([]*person.Document, error)
+	FindExpiring(from *time.Time, until time.Time, page, pageSize int) ([]*person.Document, int64, error)
+}
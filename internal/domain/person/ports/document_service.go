@@ -0,0 +1,17 @@
+package ports
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+)
+
+type DocumentService interface {
+	ListDocuments(personID int) ([]*person.Document, error)
+	FindDocument(personID, id int) (*person.Document, error)
+	AddDocument(personID int, dto contract.DocumentDTO, actor audit.Actor) (*person.Document, error)
+	UpdateDocument(personID, id int, dto contract.DocumentDTO, actor audit.Actor) (*person.Document, error)
+	DeleteDocument(personID, id int, actor audit.Actor) error
+	ListExpiringDocuments(days int, includeExpired bool, page, pageSize int) ([]*person.Document, int64, error)
+	FindPersonsByDocument(documentType, number string) ([]*person.Person, error)
+}
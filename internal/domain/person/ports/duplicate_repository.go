@@ -0,0 +1,15 @@
+package ports
+
+import person "pessoas-api/internal/domain/person/model"
+
+// DuplicateRepository defines the contract for duplicate detection and merge
+// persistence. FindCandidates returns up to limit active persons matching any
+// of the criteria, with their contacts, ordered by ID. Merge applies a merge
+// in a single transaction and returns ErrVersionConflict when either person
+// changed since the merge was planned. Once the merge is applied, record is
+// called with the repositories bound to that transaction, so that the merge is
+// recorded in the audit trail; an error from record rolls the merge back.
+type DuplicateRepository interface {
+	FindCandidates(criteria person.DuplicateCriteria, limit int) ([]*person.Person, error)
+	Merge(merge *person.Merge, record func(tx Repositories) error) error
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// DuplicateService finds persons that are likely registered twice and merges
+// them. Candidates are returned by decreasing score.
+type DuplicateService interface {
+	FindDuplicates(personID int, minScore float64) ([]person.DuplicateCandidate, error)
+	MergePersons(dto contract.MergeDTO, actor audit.Actor) (*person.Person, error)
+}
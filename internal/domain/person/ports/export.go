@@ -0,0 +1,13 @@
+package ports
+
+// PersonRowWriter encodes the rows of an export file as they are produced, so
+// that exports run in constant memory regardless of the number of persons.
+type PersonRowWriter interface {
+	// WriteHeader starts the file with the names of the exported columns.
+	WriteHeader(columns []string) error
+	// WriteRow writes the values of one person, in column order. Values are
+	// ints, strings or nil.
+	WriteRow(values []any) error
+	// Close completes the file and flushes anything still buffered.
+	Close() error
+}
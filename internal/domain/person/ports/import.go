@@ -0,0 +1,12 @@
+package ports
+
+import contract "pessoas-api/internal/contract/person"
+
+// PersonRowReader streams the rows of an import file, one at a time, so that
+// imports run in constant memory regardless of the file size.
+type PersonRowReader interface {
+	// Read returns the next row, or io.EOF once the file is exhausted. A row that
+	// cannot be parsed is returned with its Err set; a non-nil error means the
+	// file as a whole cannot be read any further.
+	Read() (contract.ImportRow, error)
+}
@@ -0,0 +1,10 @@
+package ports
+
+import person "pessoas-api/internal/domain/person/model"
+
+// PostalCodeProvider looks up the location of a CEP, given as 8 digits. It
+// returns nil without an error when the CEP does not exist, and an error when
+// the lookup itself failed (e.g. the remote service is down).
+type PostalCodeProvider interface {
+	Lookup(cep string) (*person.PostalCode, error)
+}
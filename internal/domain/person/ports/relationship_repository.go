@@ -0,0 +1,17 @@
+package ports
+
+import person "pessoas-api/internal/domain/person/model"
+
+// RelationshipRepository defines the contract for persistence of relationships
+// between persons. Relationships are stored in canonical form and only returned
+// when both of their ends are active persons, except by FindAllByPersons,
+// which also returns the ones involving deleted persons. FindByPersons and
+// FindAllByPersons return every relationship with either end among the given
+// persons, ordered by ID.
+type RelationshipRepository interface {
+	Save(relationship *person.Relationship) (ID int, err error)
+	Delete(id int) error
+	FindByID(id int) (*person.Relationship, error)
+	FindByPersons(personIDs []int) ([]*person.Relationship, error)
+	FindAllByPersons(personIDs []int) ([]*person.Relationship, error)
+}
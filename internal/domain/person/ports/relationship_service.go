@@ -0,0 +1,17 @@
+package ports
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// RelationshipService manages the links between persons. Relationships are
+// returned as seen from the person they are requested for.
+type RelationshipService interface {
+	ListRelationships(personID int) ([]*person.Relationship, error)
+	FindRelationship(personID, id int) (*person.Relationship, error)
+	AddRelationship(personID int, dto contract.RelationshipDTO, actor audit.Actor) (*person.Relationship, error)
+	DeleteRelationship(personID, id int, actor audit.Actor) error
+	FamilyGraph(personID, depth int) (*person.FamilyGraph, error)
+}
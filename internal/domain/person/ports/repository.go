@@ -4,11 +4,36 @@ import person "pessoas-api/internal/domain/person/model"
 
 // PersonRepository defines the contract for person data persistence operations.
 // This is the secondary port (driven port) that the domain requires to be implemented by adapters.
+// Update, UpdateFields, Delete and Purge only succeed when the stored version matches the given one
+// and return ErrVersionConflict otherwise. Successful writes increment the person's version.
+// Soft deleted persons are ignored by every method except the ones that explicitly include them.
+// FindAfter walks a keyset listing from the cursor (or from the start when it is nil) and returns
+// up to limit persons in the direction of travel, so backward pages come in reverse display order.
+// Stream reads every person matching the filter in the given order and hands them to fn one at a
+// time, without loading the result set in memory; it stops at the first error returned by fn.
+// SaveBatch inserts all persons in a single transaction: either every person is saved or none is.
+// Persons are saved and read with their contacts, except in Stream, which only fills in the
+// primary phone and email. FindByContact returns the persons with any contact of the given type
+// and value; emails are compared case-insensitively. FindMergedInto returns the IDs of the persons
+// merged into any of the given ones, which are soft deleted. FindAllByCPF returns every person
+// registered with the CPF, deleted or not, ordered by ID.
 type PersonRepository interface {
 	Save(person *person.Person) (ID int, err error)
+	SaveBatch(persons []*person.Person) (IDs []int, err error)
 	Update(person *person.Person) error
-	Delete(id int) error
-	FindAll(page, size int, sortBy, sortOrder string) ([]*person.Person, int64, error)
+	UpdateFields(person *person.Person, fields []string) error
+	Delete(id int, version int) error
+	Restore(id int) error
+	Purge(id int, version int) error
+	FindAll(page, size int, sortBy, sortOrder string, filter person.PersonFilter) ([]*person.Person, int64, error)
+	FindAfter(cursor *person.Cursor, limit int, sortBy, sortOrder string, filter person.PersonFilter) ([]*person.Person, error)
+	Count(filter person.PersonFilter) (int64, error)
+	Stream(sortBy, sortOrder string, filter person.PersonFilter, fn func(*person.Person) error) error
 	FindByCPF(cpf string) (*person.Person, error)
+	FindAllByCPF(cpf string) ([]*person.Person, error)
+	FindByContact(contactType, value string) ([]*person.Person, error)
+	FindExistingCPFs(cpfs []string) (map[string]bool, error)
 	FindByID(id int) (*person.Person, error)
+	FindByIDIncludingDeleted(id int) (*person.Person, error)
+	FindMergedInto(ids []int) ([]int, error)
 }
@@ -2,14 +2,25 @@ package ports
 
 import (
 	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
 	person "pessoas-api/internal/domain/person/model"
 )
 
 type PersonService interface {
-	CreatePerson(dto contract.NewPersonDTO) (ID int, err error)
-	UpdatePerson(id int, dto contract.UpdatePersonDTO) error
-	DeletePerson(id int) error
-	ListPersons(page, pageSize int, sort, order string) ([]*person.Person, int64, error)
+	CreatePerson(dto contract.NewPersonDTO, actor audit.Actor) (ID int, err error)
+	UpdatePerson(id int, version int, dto contract.UpdatePersonDTO, actor audit.Actor) error
+	PatchPerson(id int, version int, dto contract.PatchPersonDTO, actor audit.Actor) error
+	DeletePerson(id int, version int, actor audit.Actor) error
+	RestorePerson(id int, actor audit.Actor) error
+	PurgePerson(id int, version int, actor audit.Actor) error
+	ImportPersons(reader PersonRowReader, dryRun bool, actor audit.Actor) (*person.ImportReport, error)
+	ExportPersons(writer PersonRowWriter, sort, order string, filter person.PersonFilter, options person.ExportOptions) (int, error)
+	PersonHistory(id int, page, pageSize int) ([]*audit.AuditEntry, int64, error)
+	ListPersons(page, pageSize int, sort, order string, filter person.PersonFilter) ([]*person.Person, int64, error)
+	CountPersons(filter person.PersonFilter) (int64, error)
+	ListPersonsByCursor(cursor *person.Cursor, pageSize int, sort, order string, filter person.PersonFilter, withTotal bool) (*person.CursorPage, error)
 	FindPersonByCPF(cpf string) (*person.Person, error)
-	FindPersonByID(id int) (*person.Person, error)
+	FindPersonsByContact(value string) ([]*person.Person, error)
+	FindPersonByID(id int, includeDeleted bool) (*person.Person, error)
+	RevealPerson(id int, dto contract.RevealPersonDTO, actor audit.Actor) (*person.Person, error)
 }
@@ -0,0 +1,17 @@
+package ports
+
+import person "pessoas-api/internal/domain/person/model"
+
+// SubjectRightsRepository defines the contract for persistence of LGPD data
+// subject requests. Save records a request for the data protection officer
+// and FindRequests lists them, newest first. Anonymize scrubs the personal
+// data of the given persons in a single transaction and records the request
+// in that same transaction, so an anonymization is never left unrecorded.
+// record is called within that transaction too, with the repositories bound
+// to it, to write the anonymization to the audit trail; an error from record
+// rolls the anonymization back.
+type SubjectRightsRepository interface {
+	Save(request *person.SubjectRequest) (ID int, err error)
+	FindRequests(page, size int) ([]*person.SubjectRequest, int64, error)
+	Anonymize(personIDs []int, request *person.SubjectRequest, record func(tx Repositories) error) error
+}
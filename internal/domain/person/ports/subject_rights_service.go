@@ -0,0 +1,16 @@
+package ports
+
+import (
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// SubjectRightsService answers the LGPD requests of a data subject, identified
+// by their CPF: reports of everything stored about them, for access or
+// portability, and the anonymization of their personal data. Every request is
+// recorded, including the ones matching no person.
+type SubjectRightsService interface {
+	SubjectReport(cpf, requestType string, actor audit.Actor) (*person.SubjectReport, error)
+	AnonymizeSubject(cpf string, actor audit.Actor) (*person.SubjectRequest, error)
+	ListSubjectRequests(page, pageSize int) ([]*person.SubjectRequest, int64, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import auditPorts "pessoas-api/internal/domain/audit/ports"
+
+// Transactor runs a write and its audit entry in one database transaction.
+// The repositories handed to fn are bound to the transaction, which is
+// committed when fn returns nil and rolled back otherwise, so a change is
+// never persisted without its entry in the audit trail.
+type Transactor interface {
+	WithinTransaction(fn func(tx Repositories) error) error
+}
+
+// Repositories are the repositories of the person aggregate and the audit
+// trail, bound to one transaction.
+type Repositories struct {
+	Persons       PersonRepository
+	Contacts      ContactRepository
+	Addresses     AddressRepository
+	Documents     DocumentRepository
+	Relationships RelationshipRepository
+	Audits        auditPorts.AuditRepository
+}
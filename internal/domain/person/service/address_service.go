@@ -0,0 +1,247 @@
+package person
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	personUtils "pessoas-api/internal/domain/person/utils"
+)
+
+// AddressServiceImpl implements the ports.AddressService interface.
+// Addresses belong to the person aggregate: they are only reachable through an
+// active person, and every write is recorded in the audit trail within its
+// transaction. Brazilian
+// addresses sent without street, district, city or state are completed from
+// their CEP.
+type AddressServiceImpl struct {
+	repository       ports.AddressRepository
+	personRepository ports.PersonRepository
+	transactor       ports.Transactor
+	postalCodes      ports.PostalCodeProvider
+}
+
+// NewAddressService creates a new instance of AddressServiceImpl.
+// It returns the implementation as the AddressService interface.
+func NewAddressService(repository ports.AddressRepository, personRepository ports.PersonRepository, transactor ports.Transactor, postalCodes ports.PostalCodeProvider) ports.AddressService {
+	return &AddressServiceImpl{
+		repository:       repository,
+		personRepository: personRepository,
+		transactor:       transactor,
+		postalCodes:      postalCodes,
+	}
+}
+
+func (s *AddressServiceImpl) ListAddresses(personID int) ([]*person.Address, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.FindByPerson(personID)
+}
+
+func (s *AddressServiceImpl) FindAddress(personID, id int) (*person.Address, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return findAddress(s.repository, personID, id)
+}
+
+func (s *AddressServiceImpl) AddAddress(personID int, dto contract.AddressDTO, actor audit.Actor) (*person.Address, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	fields := addressFields(dto)
+	s.completeFromPostalCode(&fields)
+
+	address, err := person.NewAddress(personID, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved *person.Address
+	err = s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		id, err := tx.Addresses.Save(address)
+		if err != nil {
+			return err
+		}
+
+		saved, err = findAddress(tx.Addresses, personID, id)
+		if err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityAddress, id, audit.ActionCreate, actor, nil, addressSnapshot(saved))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// UpdateAddress replaces an address. The primary address stays primary until
+// another address is marked as primary.
+func (s *AddressServiceImpl) UpdateAddress(personID, id int, dto contract.AddressDTO, actor audit.Actor) (*person.Address, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	existing, err := findAddress(s.repository, personID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *existing
+
+	fields := addressFields(dto)
+	fields.Primary = fields.Primary || existing.Primary
+	s.completeFromPostalCode(&fields)
+
+	if err := existing.Replace(fields); err != nil {
+		return nil, err
+	}
+
+	var updated *person.Address
+	err = s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Addresses.Update(existing); err != nil {
+			return err
+		}
+
+		updated, err = findAddress(tx.Addresses, personID, id)
+		if err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityAddress, id, audit.ActionUpdate, actor, addressSnapshot(&before), addressSnapshot(updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *AddressServiceImpl) DeleteAddress(personID, id int, actor audit.Actor) error {
+	if err := s.requirePerson(personID); err != nil {
+		return err
+	}
+
+	existing, err := findAddress(s.repository, personID, id)
+	if err != nil {
+		return err
+	}
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Addresses.Delete(personID, id); err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityAddress, id, audit.ActionDelete, actor, addressSnapshot(existing), nil)
+	})
+}
+
+// LookupPostalCode returns the location of a CEP, which may be formatted.
+func (s *AddressServiceImpl) LookupPostalCode(cep string) (*person.PostalCode, error) {
+	digits := personUtils.OnlyDigits(cep)
+	if !person.IsValidCEP(digits) {
+		return nil, personError.ErrCEPInvalid
+	}
+
+	postalCode, err := s.postalCodes.Lookup(digits)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", personError.ErrPostalCodeUnavailable, err)
+	}
+
+	if postalCode == nil {
+		return nil, personError.ErrPostalCodeNotFound
+	}
+
+	return postalCode, nil
+}
+
+// completeFromPostalCode fills the blank street, district, city and state of a
+// Brazilian address from its CEP. A failed lookup is only logged: the address
+// is then validated as it was sent.
+func (s *AddressServiceImpl) completeFromPostalCode(fields *person.AddressFields) {
+	country := strings.ToUpper(strings.TrimSpace(fields.Country))
+	if country != "" && country != person.CountryBrazil {
+		return
+	}
+
+	if strings.TrimSpace(fields.Street) != "" && strings.TrimSpace(fields.District) != "" &&
+		strings.TrimSpace(fields.City) != "" && strings.TrimSpace(fields.State) != "" {
+		return
+	}
+
+	postalCode, err := s.LookupPostalCode(fields.CEP)
+	if err != nil {
+		log.Printf("[WARN] AddressService - Could not complete address from CEP %s: %v", fields.CEP, err)
+		return
+	}
+
+	fields.Complete(postalCode)
+}
+
+// requirePerson checks that the owner of the addresses exists and is not deleted.
+func (s *AddressServiceImpl) requirePerson(personID int) error {
+	owner, err := s.personRepository.FindByID(personID)
+	if err != nil {
+		return err
+	}
+
+	if owner == nil {
+		return personError.ErrPersonNotFound
+	}
+
+	return nil
+}
+
+func findAddress(addresses ports.AddressRepository, personID, id int) (*person.Address, error) {
+	address, err := addresses.FindByID(personID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if address == nil {
+		return nil, personError.ErrAddressNotFound
+	}
+
+	return address, nil
+}
+
+func addressFields(dto contract.AddressDTO) person.AddressFields {
+	return person.AddressFields{
+		Type:       dto.Type,
+		CEP:        dto.CEP,
+		Street:     dto.Street,
+		Number:     dto.Number,
+		Complement: dto.Complement,
+		District:   dto.District,
+		City:       dto.City,
+		State:      dto.State,
+		Country:    dto.Country,
+		Primary:    dto.Primary,
+	}
+}
+
+func addressSnapshot(a *person.Address) json.RawMessage {
+	if a == nil {
+		return nil
+	}
+
+	snapshot, err := json.Marshal(contract.NewAddressResponseDTO(a))
+	if err != nil {
+		return nil
+	}
+
+	return snapshot
+}
@@ -0,0 +1,315 @@
+package person
+
+import (
+	"errors"
+	"testing"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type addressRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *addressRepositoryMock) Save(address *person.Address) (ID int, err error) {
+	args := r.Called(address)
+	return args.Int(0), args.Error(1)
+}
+
+func (r *addressRepositoryMock) Update(address *person.Address) error {
+	args := r.Called(address)
+	return args.Error(0)
+}
+
+func (r *addressRepositoryMock) Delete(personID, id int) error {
+	args := r.Called(personID, id)
+	return args.Error(0)
+}
+
+func (r *addressRepositoryMock) FindByID(personID, id int) (*person.Address, error) {
+	args := r.Called(personID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Address), args.Error(1)
+}
+
+func (r *addressRepositoryMock) FindByPerson(personID int) ([]*person.Address, error) {
+	args := r.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Address), args.Error(1)
+}
+
+type postalCodeProviderMock struct {
+	mock.Mock
+}
+
+func (p *postalCodeProviderMock) Lookup(cep string) (*person.PostalCode, error) {
+	args := p.Called(cep)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.PostalCode), args.Error(1)
+}
+
+func validAddressDTO() contract.AddressDTO {
+	return contract.AddressDTO{
+		Type:     "residential",
+		CEP:      "50030-230",
+		Street:   "Rua da Aurora",
+		Number:   "325",
+		District: "Boa Vista",
+		City:     "Recife",
+		State:    "PE",
+	}
+}
+
+func testPostalCode() *person.PostalCode {
+	return &person.PostalCode{
+		CEP:      "50030230",
+		Street:   "Rua da Aurora",
+		District: "Boa Vista",
+		City:     "Recife",
+		State:    "PE",
+	}
+}
+
+// storedAddress builds an address as the repository would return it.
+func storedAddress(id int, primary bool) *person.Address {
+	address, _ := person.NewAddress(1, person.AddressFields{
+		Type:    person.AddressResidential,
+		CEP:     "50030230",
+		Street:  "Rua da Aurora",
+		Number:  "325",
+		City:    "Recife",
+		State:   "PE",
+		Primary: primary,
+	})
+	address.ID = id
+	return address
+}
+
+// newAddressServiceWithPerson wires an address service whose person 1 exists.
+func newAddressServiceWithPerson() (*AddressServiceImpl, *addressRepositoryMock, *auditRepositoryMock) {
+	service, addressMock, auditMock, _ := newAddressServiceWithPostalCodes()
+	return service, addressMock, auditMock
+}
+
+// newAddressServiceWithPostalCodes is newAddressServiceWithPerson that also
+// exposes the CEP lookup.
+func newAddressServiceWithPostalCodes() (*AddressServiceImpl, *addressRepositoryMock, *auditRepositoryMock, *postalCodeProviderMock) {
+	addressMock := new(addressRepositoryMock)
+	personMock := new(repositoryMock)
+	auditMock := newAuditRepositoryMock()
+	postalCodeMock := new(postalCodeProviderMock)
+	personMock.On("FindByID", 1).Return(&person.Person{ID: 1}, nil)
+
+	service := NewAddressService(addressMock, personMock, transactorMock{ports.Repositories{Addresses: addressMock, Audits: auditMock}}, postalCodeMock).(*AddressServiceImpl)
+	return service, addressMock, auditMock, postalCodeMock
+}
+
+func TestAddressService_AddAddress_Success(t *testing.T) {
+	assert := assert.New(t)
+	service, addressMock, auditMock := newAddressServiceWithPerson()
+
+	addressMock.On("Save", mock.MatchedBy(func(a *person.Address) bool {
+		return a.PersonID == 1 && a.CEP == "50030230" && a.Country == person.CountryBrazil
+	})).Return(3, nil)
+	addressMock.On("FindByID", 1, 3).Return(storedAddress(3, true), nil)
+
+	address, err := service.AddAddress(1, validAddressDTO(), testActor)
+
+	assert.NoError(err)
+	assert.Equal(3, address.ID)
+	assert.True(address.Primary)
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityAddress && e.EntityID == 3 && e.Action == audit.ActionCreate && e.Before == nil
+	}))
+}
+
+func TestAddressService_AddAddress_ShouldReturnValidationError(t *testing.T) {
+	service, addressMock, _ := newAddressServiceWithPerson()
+
+	dto := validAddressDTO()
+	dto.State = "XX"
+	_, err := service.AddAddress(1, dto, testActor)
+
+	assert.ErrorIs(t, err, personError.ErrStateInvalid)
+	addressMock.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestAddressService_AddAddress_ShouldCompleteFromPostalCode(t *testing.T) {
+	assert := assert.New(t)
+	service, addressMock, _, postalCodeMock := newAddressServiceWithPostalCodes()
+
+	postalCodeMock.On("Lookup", "50030230").Return(testPostalCode(), nil)
+	addressMock.On("Save", mock.MatchedBy(func(a *person.Address) bool {
+		return a.Street == "Rua da Aurora" && a.District == "Boa Vista" && a.City == "Recife" && a.State == "PE"
+	})).Return(3, nil)
+	addressMock.On("FindByID", 1, 3).Return(storedAddress(3, true), nil)
+
+	_, err := service.AddAddress(1, contract.AddressDTO{Type: "residential", CEP: "50030-230", Number: "325"}, testActor)
+
+	assert.NoError(err)
+	addressMock.AssertExpectations(t)
+}
+
+func TestAddressService_AddAddress_ShouldKeepSentFieldsOverPostalCode(t *testing.T) {
+	service, addressMock, _, postalCodeMock := newAddressServiceWithPostalCodes()
+
+	postalCodeMock.On("Lookup", "50030230").Return(testPostalCode(), nil)
+	addressMock.On("Save", mock.MatchedBy(func(a *person.Address) bool {
+		return a.Street == "Avenida Cais do Apolo" && a.District == "Boa Vista"
+	})).Return(3, nil)
+	addressMock.On("FindByID", 1, 3).Return(storedAddress(3, true), nil)
+
+	dto := validAddressDTO()
+	dto.Street = "Avenida Cais do Apolo"
+	dto.District = ""
+	_, err := service.AddAddress(1, dto, testActor)
+
+	assert.NoError(t, err)
+	addressMock.AssertExpectations(t)
+}
+
+func TestAddressService_AddAddress_ShouldValidateWhenPostalCodeLookupFails(t *testing.T) {
+	service, addressMock, _, postalCodeMock := newAddressServiceWithPostalCodes()
+	postalCodeMock.On("Lookup", "50030230").Return(nil, errors.New("timeout"))
+
+	_, err := service.AddAddress(1, contract.AddressDTO{Type: "residential", CEP: "50030-230", Number: "325"}, testActor)
+
+	assert.ErrorIs(t, err, personError.ErrStreetRequired)
+	addressMock.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestAddressService_AddAddress_ShouldNotLookUpForeignAddress(t *testing.T) {
+	service, addressMock, _, postalCodeMock := newAddressServiceWithPostalCodes()
+	addressMock.On("Save", mock.Anything).Return(3, nil)
+	addressMock.On("FindByID", 1, 3).Return(storedAddress(3, true), nil)
+
+	_, err := service.AddAddress(1, contract.AddressDTO{
+		Type: "work", CEP: "1000-001", Street: "Rua Augusta", Number: "10", City: "Lisboa", State: "Lisboa", Country: "PT",
+	}, testActor)
+
+	assert.NoError(t, err)
+	postalCodeMock.AssertNotCalled(t, "Lookup", mock.Anything)
+}
+
+func TestAddressService_LookupPostalCode(t *testing.T) {
+	tests := []struct {
+		name        string
+		cep         string
+		found       *person.PostalCode
+		lookupErr   error
+		expectedErr error
+	}{
+		{name: "found with mask", cep: "50030-230", found: testPostalCode()},
+		{name: "not found", cep: "50030230", expectedErr: personError.ErrPostalCodeNotFound},
+		{name: "provider failure", cep: "50030230", lookupErr: errors.New("connection refused"), expectedErr: personError.ErrPostalCodeUnavailable},
+		{name: "invalid CEP", cep: "5003", expectedErr: personError.ErrCEPInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, postalCodeMock := newAddressServiceWithPostalCodes()
+			postalCodeMock.On("Lookup", "50030230").Return(tt.found, tt.lookupErr)
+
+			postalCode, err := service.LookupPostalCode(tt.cep)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, postalCode)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Recife", postalCode.City)
+		})
+	}
+}
+
+func TestAddressService_ShouldReturnPersonNotFound(t *testing.T) {
+	addressMock := new(addressRepositoryMock)
+	personMock := new(repositoryMock)
+	personMock.On("FindByID", 9).Return(nil, nil)
+	service := NewAddressService(addressMock, personMock, transactorMock{ports.Repositories{Addresses: addressMock, Audits: newAuditRepositoryMock()}}, new(postalCodeProviderMock))
+
+	_, listErr := service.ListAddresses(9)
+	_, addErr := service.AddAddress(9, validAddressDTO(), testActor)
+	deleteErr := service.DeleteAddress(9, 1, testActor)
+
+	assert.ErrorIs(t, listErr, personError.ErrPersonNotFound)
+	assert.ErrorIs(t, addErr, personError.ErrPersonNotFound)
+	assert.ErrorIs(t, deleteErr, personError.ErrPersonNotFound)
+	addressMock.AssertNotCalled(t, "FindByPerson", mock.Anything)
+}
+
+func TestAddressService_FindAddress_ShouldReturnAddressNotFound(t *testing.T) {
+	service, addressMock, _ := newAddressServiceWithPerson()
+	addressMock.On("FindByID", 1, 5).Return(nil, nil)
+
+	address, err := service.FindAddress(1, 5)
+
+	assert.Nil(t, address)
+	assert.ErrorIs(t, err, personError.ErrAddressNotFound)
+}
+
+func TestAddressService_UpdateAddress_ShouldKeepPrimary(t *testing.T) {
+	assert := assert.New(t)
+	service, addressMock, auditMock := newAddressServiceWithPerson()
+
+	addressMock.On("FindByID", 1, 3).Return(storedAddress(3, true), nil).Once()
+	addressMock.On("Update", mock.MatchedBy(func(a *person.Address) bool {
+		return a.ID == 3 && a.Type == person.AddressBilling && a.Primary
+	})).Return(nil)
+	updated := storedAddress(3, true)
+	updated.Type = person.AddressBilling
+	addressMock.On("FindByID", 1, 3).Return(updated, nil).Once()
+
+	dto := validAddressDTO()
+	dto.Type = "billing"
+	dto.Primary = false
+	address, err := service.UpdateAddress(1, 3, dto, testActor)
+
+	assert.NoError(err)
+	assert.Equal(person.AddressBilling, address.Type)
+	addressMock.AssertExpectations(t)
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.Action == audit.ActionUpdate && e.Before != nil && e.After != nil
+	}))
+}
+
+func TestAddressService_DeleteAddress(t *testing.T) {
+	assert := assert.New(t)
+	service, addressMock, auditMock := newAddressServiceWithPerson()
+
+	addressMock.On("FindByID", 1, 3).Return(storedAddress(3, false), nil)
+	addressMock.On("Delete", 1, 3).Return(nil)
+
+	err := service.DeleteAddress(1, 3, testActor)
+
+	assert.NoError(err)
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.Action == audit.ActionDelete && e.EntityID == 3 && e.After == nil
+	}))
+}
+
+func TestAddressService_DeleteAddress_ShouldReturnRepositoryError(t *testing.T) {
+	service, addressMock, auditMock := newAddressServiceWithPerson()
+
+	addressMock.On("FindByID", 1, 3).Return(storedAddress(3, false), nil)
+	addressMock.On("Delete", 1, 3).Return(errors.New("database error"))
+
+	err := service.DeleteAddress(1, 3, testActor)
+
+	assert.EqualError(t, err, "database error")
+	auditMock.AssertNotCalled(t, "Save", mock.Anything)
+}
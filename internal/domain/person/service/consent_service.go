@@ -0,0 +1,142 @@
+package person
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// ConsentServiceImpl implements the ports.ConsentService interface.
+// Consents belong to the person aggregate and are only reachable through an
+// active person. A person has one consent per purpose, which is granted,
+// revoked and granted again; every change is appended to the consent history,
+// which records the operator and takes the place of the audit trail.
+type ConsentServiceImpl struct {
+	repository       ports.ConsentRepository
+	personRepository ports.PersonRepository
+}
+
+// NewConsentService creates a new instance of ConsentServiceImpl.
+// It returns the implementation as the ConsentService interface.
+func NewConsentService(repository ports.ConsentRepository, personRepository ports.PersonRepository) ports.ConsentService {
+	return &ConsentServiceImpl{
+		repository:       repository,
+		personRepository: personRepository,
+	}
+}
+
+func (s *ConsentServiceImpl) ListConsents(personID int) ([]*person.Consent, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.FindByPerson(personID)
+}
+
+func (s *ConsentServiceImpl) FindConsent(personID int, purpose string) (*person.Consent, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return s.findConsent(personID, purpose)
+}
+
+// GrantConsent grants a purpose to a person. A purpose that was revoked is
+// granted again; one that is active must be revoked first.
+func (s *ConsentServiceImpl) GrantConsent(personID int, dto contract.ConsentDTO, actor audit.Actor) (*person.Consent, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	fields := person.ConsentFields{
+		Purpose:     dto.Purpose,
+		LegalBasis:  dto.LegalBasis,
+		Channel:     dto.Channel,
+		EvidenceRef: dto.EvidenceRef,
+	}
+
+	consent, err := s.repository.FindByPurpose(personID, dto.Purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	if consent == nil {
+		consent, err = person.NewConsent(personID, fields)
+	} else {
+		err = consent.Grant(fields)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	event := consent.Event(person.ConsentActionGrant, consent.Channel, consent.EvidenceRef, actor)
+	if err := s.repository.Record(consent, event); err != nil {
+		return nil, err
+	}
+
+	return consent, nil
+}
+
+func (s *ConsentServiceImpl) RevokeConsent(personID int, purpose string, dto contract.RevokeConsentDTO, actor audit.Actor) (*person.Consent, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	consent, err := s.findConsent(personID, purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := consent.Revoke(dto.Channel, dto.EvidenceRef); err != nil {
+		return nil, err
+	}
+
+	event := consent.Event(person.ConsentActionRevoke, dto.Channel, dto.EvidenceRef, actor)
+	if err := s.repository.Record(consent, event); err != nil {
+		return nil, err
+	}
+
+	return consent, nil
+}
+
+// ConsentHistory returns every grant and revocation of the person, newest first.
+func (s *ConsentServiceImpl) ConsentHistory(personID int) ([]*person.ConsentEvent, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.FindEvents([]int{personID})
+}
+
+// requirePerson checks that the person exists and is not deleted.
+func (s *ConsentServiceImpl) requirePerson(personID int) error {
+	holder, err := s.personRepository.FindByID(personID)
+	if err != nil {
+		return err
+	}
+
+	if holder == nil {
+		return personError.ErrPersonNotFound
+	}
+
+	return nil
+}
+
+func (s *ConsentServiceImpl) findConsent(personID int, purpose string) (*person.Consent, error) {
+	if !person.IsConsentPurpose(purpose) {
+		return nil, personError.ErrConsentPurposeInvalid
+	}
+
+	consent, err := s.repository.FindByPurpose(personID, purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	if consent == nil {
+		return nil, personError.ErrConsentNotFound
+	}
+
+	return consent, nil
+}
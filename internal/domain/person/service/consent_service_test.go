@@ -0,0 +1,225 @@
+package person
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type consentRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *consentRepositoryMock) Record(consent *person.Consent, event *person.ConsentEvent) error {
+	args := r.Called(consent, event)
+	return args.Error(0)
+}
+
+func (r *consentRepositoryMock) FindByPerson(personID int) ([]*person.Consent, error) {
+	args := r.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Consent), args.Error(1)
+}
+
+func (r *consentRepositoryMock) FindByPurpose(personID int, purpose string) (*person.Consent, error) {
+	args := r.Called(personID, purpose)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Consent), args.Error(1)
+}
+
+func (r *consentRepositoryMock) FindEvents(personIDs []int) ([]*person.ConsentEvent, error) {
+	args := r.Called(personIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.ConsentEvent), args.Error(1)
+}
+
+func marketingConsentDTO() contract.ConsentDTO {
+	return contract.ConsentDTO{
+		Purpose:     person.ConsentMarketing,
+		LegalBasis:  person.LegalBasisConsent,
+		Channel:     person.ChannelWeb,
+		EvidenceRef: "form-1",
+	}
+}
+
+// storedConsent builds a consent as the repository would return it, revoked
+// when revokedAt is set.
+func storedConsent(id, personID int, purpose string, revokedAt *time.Time) *person.Consent {
+	return &person.Consent{
+		ConsentFields: person.ConsentFields{Purpose: purpose, LegalBasis: person.LegalBasisConsent, Channel: person.ChannelWeb, EvidenceRef: "form-1"},
+		ID:            id,
+		PersonID:      personID,
+		GrantedAt:     time.Now().Add(-time.Hour),
+		RevokedAt:     revokedAt,
+	}
+}
+
+// newConsentServiceWithPerson wires a consent service whose person 1 exists.
+func newConsentServiceWithPerson() (*ConsentServiceImpl, *consentRepositoryMock) {
+	consentMock := new(consentRepositoryMock)
+	personMock := new(repositoryMock)
+	personMock.On("FindByID", 1).Return(&person.Person{ID: 1}, nil)
+	personMock.On("FindByID", 2).Return(nil, nil)
+
+	return NewConsentService(consentMock, personMock).(*ConsentServiceImpl), consentMock
+}
+
+func TestConsentService_GrantConsent_FirstGrant(t *testing.T) {
+	assert := assert.New(t)
+	service, consentMock := newConsentServiceWithPerson()
+
+	consentMock.On("FindByPurpose", 1, person.ConsentMarketing).Return(nil, nil)
+	consentMock.On("Record", mock.MatchedBy(func(c *person.Consent) bool {
+		return c.ID == 0 && c.PersonID == 1 && c.IsActive() && c.EvidenceRef == "form-1"
+	}), mock.MatchedBy(func(e *person.ConsentEvent) bool {
+		return e.Action == person.ConsentActionGrant && e.Channel == person.ChannelWeb && e.OperatorID == testActor.OperatorID
+	})).Return(nil)
+
+	consent, err := service.GrantConsent(1, marketingConsentDTO(), testActor)
+
+	assert.NoError(err)
+	assert.Equal(person.ConsentMarketing, consent.Purpose)
+	consentMock.AssertExpectations(t)
+}
+
+func TestConsentService_GrantConsent_GrantsRevokedConsentAgain(t *testing.T) {
+	service, consentMock := newConsentServiceWithPerson()
+
+	revokedAt := time.Now().Add(-time.Minute)
+	consentMock.On("FindByPurpose", 1, person.ConsentMarketing).Return(storedConsent(7, 1, person.ConsentMarketing, &revokedAt), nil)
+	consentMock.On("Record", mock.MatchedBy(func(c *person.Consent) bool {
+		return c.ID == 7 && c.IsActive() && c.Channel == person.ChannelPaper
+	}), mock.Anything).Return(nil)
+
+	dto := marketingConsentDTO()
+	dto.Channel = person.ChannelPaper
+	consent, err := service.GrantConsent(1, dto, testActor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, consent.ID)
+	consentMock.AssertExpectations(t)
+}
+
+func TestConsentService_GrantConsent_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		personID int
+		existing *person.Consent
+		dto      func(*contract.ConsentDTO)
+		expected error
+	}{
+		{name: "person not found", personID: 2, expected: personError.ErrPersonNotFound},
+		{name: "already granted", personID: 1, existing: storedConsent(7, 1, person.ConsentMarketing, nil), expected: personError.ErrConsentAlreadyGranted},
+		{name: "missing evidence", personID: 1, dto: func(d *contract.ConsentDTO) { d.EvidenceRef = "" }, expected: personError.ErrConsentEvidenceRequired},
+		{name: "invalid legal basis", personID: 1, dto: func(d *contract.ConsentDTO) { d.LegalBasis = "vital_interest" }, expected: personError.ErrLegalBasisInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, consentMock := newConsentServiceWithPerson()
+			if tt.existing != nil {
+				consentMock.On("FindByPurpose", 1, person.ConsentMarketing).Return(tt.existing, nil)
+			} else {
+				consentMock.On("FindByPurpose", 1, person.ConsentMarketing).Return(nil, nil)
+			}
+
+			dto := marketingConsentDTO()
+			if tt.dto != nil {
+				tt.dto(&dto)
+			}
+			_, err := service.GrantConsent(tt.personID, dto, testActor)
+
+			assert.ErrorIs(t, err, tt.expected)
+			consentMock.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestConsentService_RevokeConsent(t *testing.T) {
+	assert := assert.New(t)
+	service, consentMock := newConsentServiceWithPerson()
+
+	consentMock.On("FindByPurpose", 1, person.ConsentMarketing).Return(storedConsent(7, 1, person.ConsentMarketing, nil), nil)
+	consentMock.On("Record", mock.MatchedBy(func(c *person.Consent) bool {
+		return c.ID == 7 && !c.IsActive() && c.Channel == person.ChannelWeb
+	}), mock.MatchedBy(func(e *person.ConsentEvent) bool {
+		return e.Action == person.ConsentActionRevoke && e.Channel == person.ChannelCallCenter && e.EvidenceRef == "call-77"
+	})).Return(nil)
+
+	consent, err := service.RevokeConsent(1, person.ConsentMarketing, contract.RevokeConsentDTO{Channel: person.ChannelCallCenter, EvidenceRef: "call-77"}, testActor)
+
+	assert.NoError(err)
+	assert.NotNil(consent.RevokedAt)
+	consentMock.AssertExpectations(t)
+}
+
+func TestConsentService_RevokeConsent_Errors(t *testing.T) {
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name     string
+		purpose  string
+		existing *person.Consent
+		expected error
+	}{
+		{"unknown purpose", "profiling", nil, personError.ErrConsentPurposeInvalid},
+		{"never granted", person.ConsentAnalytics, nil, personError.ErrConsentNotFound},
+		{"already revoked", person.ConsentAnalytics, storedConsent(7, 1, person.ConsentAnalytics, &revokedAt), personError.ErrConsentNotGranted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, consentMock := newConsentServiceWithPerson()
+			if tt.existing != nil {
+				consentMock.On("FindByPurpose", 1, tt.purpose).Return(tt.existing, nil)
+			} else {
+				consentMock.On("FindByPurpose", 1, tt.purpose).Return(nil, nil)
+			}
+
+			_, err := service.RevokeConsent(1, tt.purpose, contract.RevokeConsentDTO{Channel: person.ChannelWeb}, testActor)
+
+			assert.ErrorIs(t, err, tt.expected)
+			consentMock.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestConsentService_ConsentHistory(t *testing.T) {
+	service, consentMock := newConsentServiceWithPerson()
+
+	events := []*person.ConsentEvent{{ID: 2, PersonID: 1, Action: person.ConsentActionRevoke}, {ID: 1, PersonID: 1, Action: person.ConsentActionGrant}}
+	consentMock.On("FindEvents", []int{1}).Return(events, nil)
+
+	history, err := service.ConsentHistory(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, events, history)
+
+	_, err = service.ConsentHistory(2)
+	assert.ErrorIs(t, err, personError.ErrPersonNotFound)
+}
+
+func TestConsentService_GrantConsent_RecordFailure(t *testing.T) {
+	service, consentMock := newConsentServiceWithPerson()
+
+	consentMock.On("FindByPurpose", 1, person.ConsentMarketing).Return(nil, nil)
+	consentMock.On("Record", mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+	consent, err := service.GrantConsent(1, marketingConsentDTO(), testActor)
+
+	assert.Error(t, err)
+	assert.Nil(t, consent)
+}
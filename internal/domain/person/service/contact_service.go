@@ -0,0 +1,245 @@
+package person
+
+import (
+	"encoding/json"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// ContactServiceImpl implements the ports.ContactService interface.
+// Contacts belong to the person aggregate: they are only reachable through an
+// active person, a person always keeps at least one phone and one email, and
+// every write is recorded in the audit trail within its transaction. The
+// primary phone and email are part of the person, so writes that change a
+// primary contact require the person's version, as an update of the person
+// does, and are recorded in the person's audit trail as well.
+type ContactServiceImpl struct {
+	repository       ports.ContactRepository
+	personRepository ports.PersonRepository
+	transactor       ports.Transactor
+}
+
+// NewContactService creates a new instance of ContactServiceImpl.
+// It returns the implementation as the ContactService interface.
+func NewContactService(repository ports.ContactRepository, personRepository ports.PersonRepository, transactor ports.Transactor) ports.ContactService {
+	return &ContactServiceImpl{
+		repository:       repository,
+		personRepository: personRepository,
+		transactor:       transactor,
+	}
+}
+
+func (s *ContactServiceImpl) ListContacts(personID int) ([]*person.Contact, error) {
+	if _, err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.FindByPerson(personID)
+}
+
+func (s *ContactServiceImpl) FindContact(personID, id int) (*person.Contact, error) {
+	if _, err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return findContact(s.repository, personID, id)
+}
+
+func (s *ContactServiceImpl) AddContact(personID, version int, dto contract.ContactDTO, actor audit.Actor) (*person.Contact, error) {
+	owner, err := s.requirePerson(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := person.NewContact(personID, contactFields(dto))
+	if err != nil {
+		return nil, err
+	}
+
+	var saved *person.Contact
+	err = s.writeContacts(owner, version, contact.Primary, actor, func(tx ports.Repositories) error {
+		id, err := tx.Contacts.Save(contact)
+		if err != nil {
+			return err
+		}
+
+		saved, err = findContact(tx.Contacts, personID, id)
+		if err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityContact, id, audit.ActionCreate, actor, nil, contactSnapshot(saved))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// UpdateContact replaces a contact. Its type cannot change, and a primary
+// contact stays primary until another contact of its type is marked as primary.
+func (s *ContactServiceImpl) UpdateContact(personID, id, version int, dto contract.ContactDTO, actor audit.Actor) (*person.Contact, error) {
+	owner, err := s.requirePerson(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := findContact(s.repository, personID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *existing
+
+	fields := contactFields(dto)
+	fields.Primary = fields.Primary || existing.Primary
+
+	if err := existing.Replace(fields); err != nil {
+		return nil, err
+	}
+
+	var updated *person.Contact
+	err = s.writeContacts(owner, version, fields.Primary, actor, func(tx ports.Repositories) error {
+		if err := tx.Contacts.Update(existing); err != nil {
+			return err
+		}
+
+		updated, err = findContact(tx.Contacts, personID, id)
+		if err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityContact, id, audit.ActionUpdate, actor, contactSnapshot(&before), contactSnapshot(updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteContact removes a contact, unless it is the last one of its type.
+func (s *ContactServiceImpl) DeleteContact(personID, id, version int, actor audit.Actor) error {
+	owner, err := s.requirePerson(personID)
+	if err != nil {
+		return err
+	}
+
+	contacts, err := s.repository.FindByPerson(personID)
+	if err != nil {
+		return err
+	}
+
+	var existing *person.Contact
+	perType := make(map[string]int)
+	for _, contact := range contacts {
+		perType[contact.Type]++
+		if contact.ID == id {
+			existing = contact
+		}
+	}
+
+	if existing == nil {
+		return personError.ErrContactNotFound
+	}
+
+	if perType[existing.Type] == 1 {
+		return personError.ErrContactRequired
+	}
+
+	return s.writeContacts(owner, version, existing.Primary, actor, func(tx ports.Repositories) error {
+		if err := tx.Contacts.Delete(personID, id); err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityContact, id, audit.ActionDelete, actor, contactSnapshot(existing), nil)
+	})
+}
+
+// writeContacts runs a contact write in a transaction. A write that changes a
+// primary contact requires the version of the person, and any version given
+// is checked. Every contact write increments the version of the person once,
+// so after the write the person must be exactly one version ahead; otherwise
+// it was changed concurrently and the write is rolled back. Writes changing a
+// primary contact are also recorded in the person's audit trail, since they
+// change its phone or email.
+func (s *ContactServiceImpl) writeContacts(owner *person.Person, version int, changesPrimary bool, actor audit.Actor, write func(tx ports.Repositories) error) error {
+	if changesPrimary && version == 0 {
+		return personError.ErrVersionRequired
+	}
+
+	if version != 0 && version != owner.Version {
+		return personError.ErrVersionConflict
+	}
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := write(tx); err != nil {
+			return err
+		}
+
+		if version == 0 {
+			return nil
+		}
+
+		current, err := tx.Persons.FindByID(owner.ID)
+		if err != nil {
+			return err
+		}
+
+		if current == nil || current.Version != version+1 {
+			return personError.ErrVersionConflict
+		}
+
+		if !changesPrimary {
+			return nil
+		}
+
+		return recordChange(tx.Audits, owner.ID, audit.ActionUpdate, actor, owner, current)
+	})
+}
+
+// requirePerson loads the owner of the contacts, which must exist and not be
+// deleted.
+func (s *ContactServiceImpl) requirePerson(personID int) (*person.Person, error) {
+	owner, err := s.personRepository.FindByID(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner == nil {
+		return nil, personError.ErrPersonNotFound
+	}
+
+	return owner, nil
+}
+
+func findContact(contacts ports.ContactRepository, personID, id int) (*person.Contact, error) {
+	contact, err := contacts.FindByID(personID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if contact == nil {
+		return nil, personError.ErrContactNotFound
+	}
+
+	return contact, nil
+}
+
+func contactSnapshot(c *person.Contact) json.RawMessage {
+	if c == nil {
+		return nil
+	}
+
+	snapshot, err := json.Marshal(contract.NewContactResponseDTO(c))
+	if err != nil {
+		return nil
+	}
+
+	return snapshot
+}
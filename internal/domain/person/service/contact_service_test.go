@@ -0,0 +1,256 @@
+package person
+
+import (
+	"errors"
+	"testing"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type contactRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *contactRepositoryMock) Save(contact *person.Contact) (ID int, err error) {
+	args := r.Called(contact)
+	return args.Int(0), args.Error(1)
+}
+
+func (r *contactRepositoryMock) Update(contact *person.Contact) error {
+	args := r.Called(contact)
+	return args.Error(0)
+}
+
+func (r *contactRepositoryMock) Delete(personID, id int) error {
+	args := r.Called(personID, id)
+	return args.Error(0)
+}
+
+func (r *contactRepositoryMock) FindByID(personID, id int) (*person.Contact, error) {
+	args := r.Called(personID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Contact), args.Error(1)
+}
+
+func (r *contactRepositoryMock) FindByPerson(personID int) ([]*person.Contact, error) {
+	args := r.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Contact), args.Error(1)
+}
+
+// storedContact builds a contact as the repository would return it.
+func storedContact(id int, contactType, value string, primary bool) *person.Contact {
+	contact, _ := person.NewContact(1, person.ContactFields{Type: contactType, Value: value, Primary: primary})
+	contact.ID = id
+	return contact
+}
+
+// newContactServiceWithPerson wires a contact service whose person 1 exists.
+func newContactServiceWithPerson() (*ContactServiceImpl, *contactRepositoryMock, *auditRepositoryMock) {
+	personMock := new(repositoryMock)
+	personMock.On("FindByID", 1).Return(&person.Person{ID: 1, Version: 4}, nil)
+
+	return newContactService(personMock)
+}
+
+// newContactServiceWithWrite wires a contact service whose person 1 is read
+// at version 4 and then, after a contact write, at reloadedVersion.
+func newContactServiceWithWrite(reloadedVersion int) (*ContactServiceImpl, *contactRepositoryMock, *auditRepositoryMock) {
+	personMock := new(repositoryMock)
+	personMock.On("FindByID", 1).Return(&person.Person{ID: 1, Version: 4}, nil).Once()
+	personMock.On("FindByID", 1).Return(&person.Person{ID: 1, Version: reloadedVersion}, nil).Once()
+
+	return newContactService(personMock)
+}
+
+func newContactService(personMock *repositoryMock) (*ContactServiceImpl, *contactRepositoryMock, *auditRepositoryMock) {
+	contactMock := new(contactRepositoryMock)
+	auditMock := newAuditRepositoryMock()
+
+	service := NewContactService(contactMock, personMock, transactorMock{ports.Repositories{Persons: personMock, Contacts: contactMock, Audits: auditMock}}).(*ContactServiceImpl)
+	return service, contactMock, auditMock
+}
+
+func TestContactService_AddContact_Success(t *testing.T) {
+	assert := assert.New(t)
+	service, contactMock, auditMock := newContactServiceWithPerson()
+
+	contactMock.On("Save", mock.MatchedBy(func(c *person.Contact) bool {
+		return c.PersonID == 1 && c.Type == person.ContactPhone && c.Value == "+558132221234" && c.Label == "home"
+	})).Return(4, nil)
+	contactMock.On("FindByID", 1, 4).Return(storedContact(4, person.ContactPhone, "8132221234", false), nil)
+
+	contact, err := service.AddContact(1, 0, contract.ContactDTO{Type: "Phone", Value: "(81) 3222-1234", Label: " home "}, testActor)
+
+	assert.NoError(err)
+	assert.Equal(4, contact.ID)
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityContact && e.EntityID == 4 && e.Action == audit.ActionCreate && e.Before == nil
+	}))
+}
+
+func TestContactService_AddContact_AuditFailureFailsWrite(t *testing.T) {
+	contactMock := new(contactRepositoryMock)
+	personMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+	personMock.On("FindByID", 1).Return(&person.Person{ID: 1}, nil)
+	contactMock.On("Save", mock.Anything).Return(4, nil)
+	contactMock.On("FindByID", 1, 4).Return(storedContact(4, person.ContactPhone, "8132221234", false), nil)
+	auditMock.On("Save", mock.Anything).Return(errors.New("audit table unavailable"))
+	service := NewContactService(contactMock, personMock, transactorMock{ports.Repositories{Contacts: contactMock, Audits: auditMock}})
+
+	_, err := service.AddContact(1, 0, contract.ContactDTO{Type: "phone", Value: "8132221234"}, testActor)
+
+	assert.ErrorContains(t, err, "failed to record create in the audit trail", "the transaction is rolled back with the error")
+}
+
+func TestContactService_AddContact_ShouldReturnValidationError(t *testing.T) {
+	service, contactMock, _ := newContactServiceWithPerson()
+
+	_, typeErr := service.AddContact(1, 0, contract.ContactDTO{Type: "fax", Value: "8132221234"}, testActor)
+	_, emailErr := service.AddContact(1, 0, contract.ContactDTO{Type: "email", Value: "john@invalid"}, testActor)
+
+	assert.ErrorIs(t, typeErr, personError.ErrContactTypeInvalid)
+	assert.ErrorIs(t, emailErr, personError.ErrEmailInvalid)
+	contactMock.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestContactService_ShouldReturnPersonNotFound(t *testing.T) {
+	contactMock := new(contactRepositoryMock)
+	personMock := new(repositoryMock)
+	personMock.On("FindByID", 9).Return(nil, nil)
+	service := NewContactService(contactMock, personMock, transactorMock{ports.Repositories{Contacts: contactMock, Audits: newAuditRepositoryMock()}})
+
+	_, listErr := service.ListContacts(9)
+	_, addErr := service.AddContact(9, 0, contract.ContactDTO{Type: "phone", Value: "8132221234"}, testActor)
+	deleteErr := service.DeleteContact(9, 1, 0, testActor)
+
+	assert.ErrorIs(t, listErr, personError.ErrPersonNotFound)
+	assert.ErrorIs(t, addErr, personError.ErrPersonNotFound)
+	assert.ErrorIs(t, deleteErr, personError.ErrPersonNotFound)
+	contactMock.AssertNotCalled(t, "FindByPerson", mock.Anything)
+}
+
+func TestContactService_UpdateContact_ShouldKeepPrimary(t *testing.T) {
+	assert := assert.New(t)
+	service, contactMock, auditMock := newContactServiceWithWrite(5)
+
+	contactMock.On("FindByID", 1, 2).Return(storedContact(2, person.ContactEmail, "john@example.com", true), nil).Once()
+	contactMock.On("Update", mock.MatchedBy(func(c *person.Contact) bool {
+		return c.ID == 2 && c.Value == "john.doe@example.com" && c.Primary
+	})).Return(nil)
+	contactMock.On("FindByID", 1, 2).Return(storedContact(2, person.ContactEmail, "john.doe@example.com", true), nil).Once()
+
+	contact, err := service.UpdateContact(1, 2, 4, contract.ContactDTO{Type: "email", Value: "john.doe@example.com"}, testActor)
+
+	assert.NoError(err)
+	assert.Equal("john.doe@example.com", contact.Value)
+	contactMock.AssertExpectations(t)
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityContact && e.Action == audit.ActionUpdate && e.Before != nil && e.After != nil
+	}))
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityPerson && e.EntityID == 1 && e.Action == audit.ActionUpdate
+	}))
+}
+
+func TestContactService_UpdateContact_PrimaryRequiresVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		version         int
+		reloadedVersion int
+		expected        error
+	}{
+		{name: "no version", version: 0, expected: personError.ErrVersionRequired},
+		{name: "stale version", version: 3, expected: personError.ErrVersionConflict},
+		{name: "person changed during the write", version: 4, reloadedVersion: 6, expected: personError.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, contactMock, auditMock := newContactServiceWithWrite(tt.reloadedVersion)
+			contactMock.On("FindByID", 1, 2).Return(storedContact(2, person.ContactEmail, "john@example.com", true), nil)
+			contactMock.On("Update", mock.Anything).Return(nil).Maybe()
+
+			_, err := service.UpdateContact(1, 2, tt.version, contract.ContactDTO{Type: "email", Value: "john.doe@example.com"}, testActor)
+
+			assert.ErrorIs(t, err, tt.expected)
+			auditMock.AssertNotCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+				return e.EntityType == audit.EntityPerson
+			}))
+		})
+	}
+}
+
+func TestContactService_UpdateContact_SecondaryWithoutVersion(t *testing.T) {
+	service, contactMock, auditMock := newContactServiceWithPerson()
+	contactMock.On("FindByID", 1, 3).Return(storedContact(3, person.ContactPhone, "8132221234", false), nil)
+	contactMock.On("Update", mock.Anything).Return(nil)
+
+	_, err := service.UpdateContact(1, 3, 0, contract.ContactDTO{Type: "phone", Value: "8132221235", Label: "work"}, testActor)
+
+	assert.NoError(t, err)
+	auditMock.AssertNotCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityPerson
+	}))
+}
+
+func TestContactService_UpdateContact_ShouldNotChangeType(t *testing.T) {
+	service, contactMock, _ := newContactServiceWithPerson()
+	contactMock.On("FindByID", 1, 2).Return(storedContact(2, person.ContactEmail, "john@example.com", true), nil)
+
+	_, err := service.UpdateContact(1, 2, 4, contract.ContactDTO{Type: "phone", Value: "8132221234"}, testActor)
+
+	assert.ErrorIs(t, err, personError.ErrContactTypeImmutable)
+	contactMock.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestContactService_DeleteContact(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       int
+		expected error
+	}{
+		{name: "secondary phone", id: 3},
+		{name: "primary phone without version", id: 1, expected: personError.ErrVersionRequired},
+		{name: "last email", id: 2, expected: personError.ErrContactRequired},
+		{name: "unknown contact", id: 9, expected: personError.ErrContactNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, contactMock, auditMock := newContactServiceWithPerson()
+			contactMock.On("FindByPerson", 1).Return([]*person.Contact{
+				storedContact(1, person.ContactPhone, "81912345678", true),
+				storedContact(2, person.ContactEmail, "john@example.com", true),
+				storedContact(3, person.ContactPhone, "8132221234", false),
+			}, nil)
+			contactMock.On("Delete", 1, tt.id).Return(nil).Maybe()
+
+			err := service.DeleteContact(1, tt.id, 0, testActor)
+
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				contactMock.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+				auditMock.AssertNotCalled(t, "Save", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+				return e.Action == audit.ActionDelete && e.EntityID == tt.id && e.After == nil
+			}))
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package person
+
+import (
+	"encoding/json"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	personUtils "pessoas-api/internal/domain/person/utils"
+)
+
+// documentTypeCPF selects the CPF of the person record in a document lookup.
+// The CPF is not an identity document of its own: it lives on the person.
+const documentTypeCPF = "cpf"
+
+// maxExpiryWindowDays bounds how far ahead the expiring documents query looks.
+const maxExpiryWindowDays = 365
+
+// DocumentServiceImpl implements the ports.DocumentService interface.
+// Identity documents belong to the person aggregate: they are only reachable
+// through an active person, and every write is recorded in the audit trail
+// within its transaction. A document number is registered to a single person
+// per type and issuer.
+type DocumentServiceImpl struct {
+	repository       ports.DocumentRepository
+	personRepository ports.PersonRepository
+	transactor       ports.Transactor
+}
+
+// NewDocumentService creates a new instance of DocumentServiceImpl.
+// It returns the implementation as the DocumentService interface.
+func NewDocumentService(repository ports.DocumentRepository, personRepository ports.PersonRepository, transactor ports.Transactor) ports.DocumentService {
+	return &DocumentServiceImpl{
+		repository:       repository,
+		personRepository: personRepository,
+		transactor:       transactor,
+	}
+}
+
+func (s *DocumentServiceImpl) ListDocuments(personID int) ([]*person.Document, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.FindByPerson(personID)
+}
+
+func (s *DocumentServiceImpl) FindDocument(personID, id int) (*person.Document, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	return findDocument(s.repository, personID, id)
+}
+
+func (s *DocumentServiceImpl) AddDocument(personID int, dto contract.DocumentDTO, actor audit.Actor) (*person.Document, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	document, err := person.NewDocument(personID, documentFields(dto))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireAvailableNumber(document); err != nil {
+		return nil, err
+	}
+
+	var saved *person.Document
+	err = s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		id, err := tx.Documents.Save(document)
+		if err != nil {
+			return err
+		}
+
+		saved, err = findDocument(tx.Documents, personID, id)
+		if err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityDocument, id, audit.ActionCreate, actor, nil, documentSnapshot(saved))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// UpdateDocument replaces a document. Its type cannot change: a document of
+// another type is a new document.
+func (s *DocumentServiceImpl) UpdateDocument(personID, id int, dto contract.DocumentDTO, actor audit.Actor) (*person.Document, error) {
+	if err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	existing, err := findDocument(s.repository, personID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *existing
+
+	if err := existing.Replace(documentFields(dto)); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireAvailableNumber(existing); err != nil {
+		return nil, err
+	}
+
+	var updated *person.Document
+	err = s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Documents.Update(existing); err != nil {
+			return err
+		}
+
+		updated, err = findDocument(tx.Documents, personID, id)
+		if err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityDocument, id, audit.ActionUpdate, actor, documentSnapshot(&before), documentSnapshot(updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *DocumentServiceImpl) DeleteDocument(personID, id int, actor audit.Actor) error {
+	if err := s.requirePerson(personID); err != nil {
+		return err
+	}
+
+	existing, err := findDocument(s.repository, personID, id)
+	if err != nil {
+		return err
+	}
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Documents.Delete(personID, id); err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityDocument, id, audit.ActionDelete, actor, documentSnapshot(existing), nil)
+	})
+}
+
+// ListExpiringDocuments returns the documents of active persons that expire
+// within the next days, soonest first. With includeExpired, documents that have
+// already expired are listed too.
+func (s *DocumentServiceImpl) ListExpiringDocuments(days int, includeExpired bool, page, pageSize int) ([]*person.Document, int64, error) {
+	if days < 1 || days > maxExpiryWindowDays {
+		return nil, 0, personError.ErrExpiryWindowInvalid
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var from *time.Time
+	if !includeExpired {
+		from = &today
+	}
+
+	return s.repository.FindExpiring(from, today.AddDate(0, 0, days), page, pageSize)
+}
+
+// FindPersonsByDocument returns the active persons holding a document with the
+// given number, which may be formatted. An empty type searches every document
+// type and the CPF; "cpf" searches the CPF only.
+func (s *DocumentServiceImpl) FindPersonsByDocument(documentType, number string) ([]*person.Person, error) {
+	if documentType != "" && documentType != documentTypeCPF && !person.IsDocumentType(documentType) {
+		return nil, personError.ErrDocumentTypeInvalid
+	}
+
+	normalized := person.NormalizeDocumentNumber(number)
+	if normalized == "" {
+		return nil, personError.ErrDocumentNumberRequired
+	}
+
+	persons := []*person.Person{}
+	seen := map[int]bool{}
+
+	if documentType == "" || documentType == documentTypeCPF {
+		cpf := personUtils.OnlyDigits(number)
+		if person.ValidCPF(cpf) {
+			holder, err := s.personRepository.FindByCPF(cpf)
+			if err != nil {
+				return nil, err
+			}
+			if holder != nil {
+				seen[holder.ID] = true
+				persons = append(persons, holder)
+			}
+		}
+
+		if documentType == documentTypeCPF {
+			return persons, nil
+		}
+	}
+
+	documents, err := s.repository.FindByNumber(documentType, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, document := range documents {
+		if seen[document.PersonID] {
+			continue
+		}
+		seen[document.PersonID] = true
+
+		holder, err := s.personRepository.FindByID(document.PersonID)
+		if err != nil {
+			return nil, err
+		}
+		if holder != nil {
+			persons = append(persons, holder)
+		}
+	}
+
+	return persons, nil
+}
+
+// requireAvailableNumber rejects a document whose number is already registered
+// with the same type and issuer, to this or to another person.
+func (s *DocumentServiceImpl) requireAvailableNumber(document *person.Document) error {
+	registered, err := s.repository.FindByNumber(document.Type, document.Number)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range registered {
+		if other.ID != document.ID && other.IssuingState == document.IssuingState && other.Country == document.Country {
+			return personError.ErrDocumentAlreadyInUse
+		}
+	}
+
+	return nil
+}
+
+// requirePerson checks that the holder of the documents exists and is not deleted.
+func (s *DocumentServiceImpl) requirePerson(personID int) error {
+	holder, err := s.personRepository.FindByID(personID)
+	if err != nil {
+		return err
+	}
+
+	if holder == nil {
+		return personError.ErrPersonNotFound
+	}
+
+	return nil
+}
+
+func findDocument(documents ports.DocumentRepository, personID, id int) (*person.Document, error) {
+	document, err := documents.FindByID(personID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if document == nil {
+		return nil, personError.ErrDocumentNotFound
+	}
+
+	return document, nil
+}
+
+func documentFields(dto contract.DocumentDTO) person.DocumentFields {
+	return person.DocumentFields{
+		Type:          dto.Type,
+		Number:        dto.Number,
+		IssuingState:  dto.IssuingState,
+		IssuingAgency: dto.IssuingAgency,
+		Country:       dto.Country,
+		IssueDate:     dto.IssueDate,
+		ExpiryDate:    dto.ExpiryDate,
+	}
+}
+
+func documentSnapshot(d *person.Document) json.RawMessage {
+	if d == nil {
+		return nil
+	}
+
+	snapshot, err := json.Marshal(contract.NewDocumentResponseDTO(d))
+	if err != nil {
+		return nil
+	}
+
+	return snapshot
+}
@@ -0,0 +1,279 @@
+package person
+
+import (
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type documentRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *documentRepositoryMock) Save(document *person.Document) (int, error) {
+	args := r.Called(document)
+	return args.Int(0), args.Error(1)
+}
+
+func (r *documentRepositoryMock) Update(document *person.Document) error {
+	args := r.Called(document)
+	return args.Error(0)
+}
+
+func (r *documentRepositoryMock) Delete(personID, id int) error {
+	args := r.Called(personID, id)
+	return args.Error(0)
+}
+
+func (r *documentRepositoryMock) FindByID(personID, id int) (*person.Document, error) {
+	args := r.Called(personID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Document), args.Error(1)
+}
+
+func (r *documentRepositoryMock) FindByPerson(personID int) ([]*person.Document, error) {
+	args := r.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Document), args.Error(1)
+}
+
+func (r *documentRepositoryMock) FindByNumber(documentType, number string) ([]*person.Document, error) {
+	args := r.Called(documentType, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Document), args.Error(1)
+}
+
+func (r *documentRepositoryMock) FindExpiring(from *time.Time, until time.Time, page, pageSize int) ([]*person.Document, int64, error) {
+	args := r.Called(from, until, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*person.Document), args.Get(1).(int64), args.Error(2)
+}
+
+func validRGDTO() contract.DocumentDTO {
+	return contract.DocumentDTO{
+		Type:          "rg",
+		Number:        "12.345.678-X",
+		IssuingState:  "PE",
+		IssuingAgency: "SSP",
+	}
+}
+
+// storedRG builds an RG as the repository would return it.
+func storedRG(id, personID int) *person.Document {
+	document, _ := person.NewDocument(personID, person.DocumentFields{
+		Type:          person.DocumentRG,
+		Number:        "12345678X",
+		IssuingState:  "PE",
+		IssuingAgency: "SSP",
+	})
+	document.ID = id
+	return document
+}
+
+// newDocumentServiceWithPerson wires a document service whose person 1 exists.
+func newDocumentServiceWithPerson() (*DocumentServiceImpl, *documentRepositoryMock, *repositoryMock, *auditRepositoryMock) {
+	documentMock := new(documentRepositoryMock)
+	personMock := new(repositoryMock)
+	auditMock := newAuditRepositoryMock()
+	personMock.On("FindByID", 1).Return(&person.Person{ID: 1}, nil)
+
+	service := NewDocumentService(documentMock, personMock, transactorMock{ports.Repositories{Documents: documentMock, Audits: auditMock}}).(*DocumentServiceImpl)
+	return service, documentMock, personMock, auditMock
+}
+
+func TestDocumentService_AddDocument_Success(t *testing.T) {
+	assert := assert.New(t)
+	service, documentMock, _, auditMock := newDocumentServiceWithPerson()
+
+	documentMock.On("FindByNumber", person.DocumentRG, "12345678X").Return([]*person.Document{}, nil)
+	documentMock.On("Save", mock.MatchedBy(func(d *person.Document) bool {
+		return d.PersonID == 1 && d.Number == "12345678X" && d.IssuingState == "PE"
+	})).Return(4, nil)
+	documentMock.On("FindByID", 1, 4).Return(storedRG(4, 1), nil)
+
+	document, err := service.AddDocument(1, validRGDTO(), testActor)
+
+	assert.NoError(err)
+	assert.Equal(4, document.ID)
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityDocument && e.EntityID == 4 && e.Action == audit.ActionCreate && e.Before == nil
+	}))
+}
+
+func TestDocumentService_AddDocument_ShouldReturnValidationError(t *testing.T) {
+	service, documentMock, _, _ := newDocumentServiceWithPerson()
+
+	dto := validRGDTO()
+	dto.IssuingAgency = ""
+	_, err := service.AddDocument(1, dto, testActor)
+
+	assert.ErrorIs(t, err, personError.ErrDocumentIssuingAgencyRequired)
+	documentMock.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestDocumentService_AddDocument_ShouldRejectRegisteredNumber(t *testing.T) {
+	tests := []struct {
+		name        string
+		registered  *person.Document
+		expectedErr error
+	}{
+		{name: "same issuer", registered: storedRG(2, 5), expectedErr: personError.ErrDocumentAlreadyInUse},
+		{name: "other issuer", registered: func() *person.Document {
+			d := storedRG(2, 5)
+			d.IssuingState = "SP"
+			return d
+		}()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, documentMock, _, _ := newDocumentServiceWithPerson()
+			documentMock.On("FindByNumber", person.DocumentRG, "12345678X").Return([]*person.Document{tt.registered}, nil)
+			documentMock.On("Save", mock.Anything).Return(4, nil)
+			documentMock.On("FindByID", 1, 4).Return(storedRG(4, 1), nil)
+
+			_, err := service.AddDocument(1, validRGDTO(), testActor)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				documentMock.AssertNotCalled(t, "Save", mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDocumentService_UpdateDocument(t *testing.T) {
+	assert := assert.New(t)
+	service, documentMock, _, auditMock := newDocumentServiceWithPerson()
+
+	documentMock.On("FindByID", 1, 4).Return(storedRG(4, 1), nil).Once()
+	documentMock.On("FindByNumber", person.DocumentRG, "12345678X").Return([]*person.Document{storedRG(4, 1)}, nil)
+	documentMock.On("Update", mock.MatchedBy(func(d *person.Document) bool {
+		return d.ID == 4 && d.IssuingAgency == "SDS"
+	})).Return(nil)
+	updated := storedRG(4, 1)
+	updated.IssuingAgency = "SDS"
+	documentMock.On("FindByID", 1, 4).Return(updated, nil).Once()
+
+	dto := validRGDTO()
+	dto.IssuingAgency = "SDS"
+	document, err := service.UpdateDocument(1, 4, dto, testActor)
+
+	assert.NoError(err, "the document's own number is not a conflict")
+	assert.Equal("SDS", document.IssuingAgency)
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.Action == audit.ActionUpdate && e.Before != nil && e.After != nil
+	}))
+}
+
+func TestDocumentService_UpdateDocument_ShouldRejectTypeChange(t *testing.T) {
+	service, documentMock, _, _ := newDocumentServiceWithPerson()
+	documentMock.On("FindByID", 1, 4).Return(storedRG(4, 1), nil)
+
+	_, err := service.UpdateDocument(1, 4, contract.DocumentDTO{Type: "pis", Number: "123.45678.91-9"}, testActor)
+
+	assert.ErrorIs(t, err, personError.ErrDocumentTypeImmutable)
+	documentMock.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestDocumentService_DeleteDocument(t *testing.T) {
+	service, documentMock, _, auditMock := newDocumentServiceWithPerson()
+
+	documentMock.On("FindByID", 1, 4).Return(storedRG(4, 1), nil)
+	documentMock.On("Delete", 1, 4).Return(nil)
+
+	err := service.DeleteDocument(1, 4, testActor)
+
+	assert.NoError(t, err)
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.Action == audit.ActionDelete && e.EntityID == 4 && e.After == nil
+	}))
+}
+
+func TestDocumentService_ShouldReturnNotFound(t *testing.T) {
+	service, documentMock, personMock, _ := newDocumentServiceWithPerson()
+	personMock.On("FindByID", 9).Return(nil, nil)
+	documentMock.On("FindByID", 1, 5).Return(nil, nil)
+
+	_, listErr := service.ListDocuments(9)
+	_, findErr := service.FindDocument(1, 5)
+
+	assert.ErrorIs(t, listErr, personError.ErrPersonNotFound)
+	assert.ErrorIs(t, findErr, personError.ErrDocumentNotFound)
+	documentMock.AssertNotCalled(t, "FindByPerson", mock.Anything)
+}
+
+func TestDocumentService_ListExpiringDocuments(t *testing.T) {
+	assert := assert.New(t)
+	service, documentMock, _, _ := newDocumentServiceWithPerson()
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	documentMock.On("FindExpiring", &today, today.AddDate(0, 0, 30), 1, 10).Return([]*person.Document{storedRG(4, 1)}, int64(1), nil)
+	documentMock.On("FindExpiring", (*time.Time)(nil), today.AddDate(0, 0, 7), 2, 20).Return([]*person.Document{}, int64(0), nil)
+
+	documents, total, err := service.ListExpiringDocuments(30, false, 0, 0)
+	assert.NoError(err)
+	assert.Equal(int64(1), total)
+	assert.Len(documents, 1)
+
+	_, _, err = service.ListExpiringDocuments(7, true, 2, 20)
+	assert.NoError(err)
+
+	_, _, err = service.ListExpiringDocuments(0, false, 1, 10)
+	assert.ErrorIs(err, personError.ErrExpiryWindowInvalid)
+	_, _, err = service.ListExpiringDocuments(366, false, 1, 10)
+	assert.ErrorIs(err, personError.ErrExpiryWindowInvalid)
+	documentMock.AssertExpectations(t)
+}
+
+func TestDocumentService_FindPersonsByDocument(t *testing.T) {
+	assert := assert.New(t)
+	service, documentMock, personMock, _ := newDocumentServiceWithPerson()
+
+	personMock.On("FindByCPF", "11144477735").Return(&person.Person{ID: 1}, nil)
+	personMock.On("FindByID", 2).Return(&person.Person{ID: 2}, nil)
+	personMock.On("FindByID", 3).Return(nil, nil)
+	documentMock.On("FindByNumber", "", "11144477735").Return([]*person.Document{
+		{PersonID: 1}, {PersonID: 2}, {PersonID: 3},
+	}, nil)
+	documentMock.On("FindByNumber", person.DocumentRG, "12345678X").Return([]*person.Document{storedRG(4, 1)}, nil)
+
+	persons, err := service.FindPersonsByDocument("", "111.444.777-35")
+	assert.NoError(err)
+	assert.Len(persons, 2, "holders are listed once and deleted persons are left out")
+	assert.Equal(1, persons[0].ID)
+	assert.Equal(2, persons[1].ID)
+
+	persons, err = service.FindPersonsByDocument("cpf", "111.444.777-35")
+	assert.NoError(err)
+	assert.Len(persons, 1)
+
+	persons, err = service.FindPersonsByDocument("rg", "12.345.678-x")
+	assert.NoError(err)
+	assert.Len(persons, 1)
+	personMock.AssertNumberOfCalls(t, "FindByCPF", 2)
+
+	_, err = service.FindPersonsByDocument("ctps", "123")
+	assert.ErrorIs(err, personError.ErrDocumentTypeInvalid)
+	_, err = service.FindPersonsByDocument("", " -./ ")
+	assert.ErrorIs(err, personError.ErrDocumentNumberRequired)
+}
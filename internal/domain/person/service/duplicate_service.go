@@ -0,0 +1,151 @@
+package person
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// maxDuplicateCandidates bounds how many persons are scored in a single search.
+const maxDuplicateCandidates = 200
+
+// DuplicateServiceImpl implements the ports.DuplicateService interface.
+// Candidates are preselected by the repository and scored in the domain.
+// A merge is recorded in the audit trail of both persons within the merge
+// transaction.
+type DuplicateServiceImpl struct {
+	repository             ports.DuplicateRepository
+	personRepository       ports.PersonRepository
+	relationshipRepository ports.RelationshipRepository
+}
+
+// NewDuplicateService creates a new instance of DuplicateServiceImpl.
+// It returns the implementation as the DuplicateService interface.
+func NewDuplicateService(repository ports.DuplicateRepository, personRepository ports.PersonRepository, relationshipRepository ports.RelationshipRepository) ports.DuplicateService {
+	return &DuplicateServiceImpl{
+		repository:             repository,
+		personRepository:       personRepository,
+		relationshipRepository: relationshipRepository,
+	}
+}
+
+// FindDuplicates returns the persons scoring at least minScore against the
+// person, the most likely duplicates first.
+func (s *DuplicateServiceImpl) FindDuplicates(personID int, minScore float64) ([]person.DuplicateCandidate, error) {
+	if minScore < 0 || minScore > 1 {
+		return nil, personError.ErrMinScoreInvalid
+	}
+
+	subject, err := s.requirePerson(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	persons, err := s.repository.FindCandidates(person.NewDuplicateCriteria(subject), maxDuplicateCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []person.DuplicateCandidate{}
+	for _, candidate := range persons {
+		scored := person.ScoreDuplicate(subject, candidate)
+		if scored.Score > 0 && scored.Score >= minScore {
+			candidates = append(candidates, scored)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+
+	return candidates, nil
+}
+
+// MergePersons folds the duplicate into the survivor and returns the survivor
+// as persisted. Both persons must be active and at the expected versions.
+func (s *DuplicateServiceImpl) MergePersons(dto contract.MergeDTO, actor audit.Actor) (*person.Person, error) {
+	if dto.SurvivorID == dto.DuplicateID {
+		return nil, personError.ErrMergeSamePerson
+	}
+
+	survivor, err := s.requirePerson(dto.SurvivorID)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate, err := s.requirePerson(dto.DuplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if survivor.Version != dto.SurvivorVersion || duplicate.Version != dto.DuplicateVersion {
+		return nil, personError.ErrVersionConflict
+	}
+
+	relationships, err := s.relationshipRepository.FindByPersons([]int{survivor.ID, duplicate.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	merge, err := person.NewMerge(survivor, duplicate, relationships, actor, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var merged *person.Person
+	err = s.repository.Merge(merge, func(tx ports.Repositories) error {
+		merged, err = tx.Persons.FindByID(survivor.ID)
+		if err != nil {
+			return err
+		}
+
+		return recordMerge(tx, actor, survivor, merged, duplicate)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+// requirePerson loads a person that must exist and not be deleted.
+func (s *DuplicateServiceImpl) requirePerson(personID int) (*person.Person, error) {
+	found, err := s.personRepository.FindByID(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, personError.ErrPersonNotFound
+	}
+
+	return found, nil
+}
+
+// recordMerge writes the merge to the audit trail of both persons, within the
+// merge transaction. A failure is returned so that the merge is rolled back.
+func recordMerge(tx ports.Repositories, actor audit.Actor, survivorBefore, survivorAfter, duplicate *person.Person) error {
+	duplicateAfter, err := tx.Persons.FindByIDIncludingDeleted(duplicate.ID)
+	if err != nil {
+		return err
+	}
+
+	entries := []*audit.AuditEntry{
+		audit.NewAuditEntry(audit.EntityPerson, survivorBefore.ID, audit.ActionMerge, actor, personSnapshot(survivorBefore), personSnapshot(survivorAfter)),
+		audit.NewAuditEntry(audit.EntityPerson, duplicate.ID, audit.ActionMerge, actor, personSnapshot(duplicate), personSnapshot(duplicateAfter)),
+	}
+
+	if err := tx.Audits.SaveAll(entries); err != nil {
+		log.Printf("[ERROR] DuplicateService - Failed to record merge of person ID %d into %d by operator %d: %v", duplicate.ID, survivorBefore.ID, actor.OperatorID, err)
+		return fmt.Errorf("failed to record merge in the audit trail: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package person
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// duplicateRepositoryMock records the merge on the repositories in repos, as
+// the merge transaction would on the real ones.
+type duplicateRepositoryMock struct {
+	mock.Mock
+	repos ports.Repositories
+}
+
+func (r *duplicateRepositoryMock) FindCandidates(criteria person.DuplicateCriteria, limit int) ([]*person.Person, error) {
+	args := r.Called(criteria, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Person), args.Error(1)
+}
+
+func (r *duplicateRepositoryMock) Merge(merge *person.Merge, record func(tx ports.Repositories) error) error {
+	args := r.Called(merge)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return record(r.repos)
+}
+
+func namedPerson(id int, name, cpf, email string, version int) *person.Person {
+	return &person.Person{
+		ID:        id,
+		Name:      name,
+		CPF:       cpf,
+		BirthDate: time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC),
+		Contacts: []person.Contact{
+			{ContactFields: person.ContactFields{Type: person.ContactPhone, Value: "+5581912345678", Primary: true}},
+			{ContactFields: person.ContactFields{Type: person.ContactEmail, Value: email, Primary: true}},
+		},
+		Version: version,
+	}
+}
+
+// newDuplicateService wires a duplicate service whose persons are the given ones.
+func newDuplicateService(persons ...*person.Person) (*DuplicateServiceImpl, *duplicateRepositoryMock, *relationshipRepositoryMock, *repositoryMock, *auditRepositoryMock) {
+	duplicateMock := new(duplicateRepositoryMock)
+	relationshipMock := new(relationshipRepositoryMock)
+	personMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+	duplicateMock.repos = ports.Repositories{Persons: personMock, Audits: auditMock}
+	for _, p := range persons {
+		personMock.On("FindByID", p.ID).Return(p, nil)
+	}
+
+	service := NewDuplicateService(duplicateMock, personMock, relationshipMock).(*DuplicateServiceImpl)
+	return service, duplicateMock, relationshipMock, personMock, auditMock
+}
+
+func TestDuplicateService_FindDuplicates_SortsAndFiltersByScore(t *testing.T) {
+	assert := assert.New(t)
+	subject := namedPerson(1, "José da Silva", "11144477735", "jose@example.com", 1)
+	service, duplicateMock, _, _, _ := newDuplicateService(subject)
+
+	weak := namedPerson(2, "Maria Souza", "52998224725", "maria@example.com", 1)
+	strong := namedPerson(3, "Jose da Silva", "12144477752", "JOSE@example.com", 1)
+	medium := namedPerson(4, "José Silva", "39053344705", "js@example.com", 1)
+	duplicateMock.On("FindCandidates", person.NewDuplicateCriteria(subject), maxDuplicateCandidates).Return([]*person.Person{weak, strong, medium}, nil)
+
+	candidates, err := service.FindDuplicates(1, 0.5)
+
+	assert.NoError(err)
+	assert.Len(candidates, 2)
+	assert.Same(strong, candidates[0].Person)
+	assert.Equal(1.0, candidates[0].Score)
+	assert.Same(medium, candidates[1].Person)
+	assert.Equal([]string{person.MatchName, person.MatchBirthDate, person.MatchPhone}, candidates[1].Reasons)
+}
+
+func TestDuplicateService_FindDuplicates_InvalidScore(t *testing.T) {
+	service, _, _, _, _ := newDuplicateService()
+
+	_, err := service.FindDuplicates(1, 1.5)
+
+	assert.ErrorIs(t, err, personError.ErrMinScoreInvalid)
+}
+
+func TestDuplicateService_FindDuplicates_PersonNotFound(t *testing.T) {
+	service, _, _, personMock, _ := newDuplicateService()
+	personMock.On("FindByID", 1).Return(nil, nil)
+
+	_, err := service.FindDuplicates(1, 0.5)
+
+	assert.ErrorIs(t, err, personError.ErrPersonNotFound)
+}
+
+func TestDuplicateService_MergePersons_Success(t *testing.T) {
+	assert := assert.New(t)
+	survivor := namedPerson(1, "José da Silva", "11144477735", "jose@example.com", 3)
+	duplicate := namedPerson(2, "Jose da Silva", "12144477752", "jose.silva@example.com", 1)
+	service, duplicateMock, relationshipMock, personMock, auditMock := newDuplicateService(survivor, duplicate)
+
+	relationshipMock.On("FindByPersons", []int{1, 2}).Return([]*person.Relationship{link(8, 9, 2, person.RelationshipParent)}, nil)
+	duplicateMock.On("Merge", mock.MatchedBy(func(m *person.Merge) bool {
+		return m.SurvivorID == 1 && m.SurvivorVersion == 3 && m.DuplicateID == 2 && m.DuplicateVersion == 1 &&
+			len(m.Contacts) == 1 && len(m.Relationships) == 1 && m.Relationships[0].RelatedID == 1
+	})).Return(nil)
+	mergedAt := time.Now()
+	personMock.On("FindByIDIncludingDeleted", 2).Return(&person.Person{ID: 2, Version: 2, DeletedAt: &mergedAt, MergedIntoID: &survivor.ID}, nil)
+	auditMock.On("SaveAll", mock.MatchedBy(func(entries []*audit.AuditEntry) bool {
+		return len(entries) == 2 &&
+			entries[0].EntityID == 1 && entries[0].Action == audit.ActionMerge &&
+			entries[1].EntityID == 2 && entries[1].Action == audit.ActionMerge &&
+			entries[1].OperatorID == testActor.OperatorID
+	})).Return(nil)
+
+	merged, err := service.MergePersons(contract.MergeDTO{SurvivorID: 1, SurvivorVersion: 3, DuplicateID: 2, DuplicateVersion: 1}, testActor)
+
+	assert.NoError(err)
+	assert.Same(survivor, merged)
+	duplicateMock.AssertExpectations(t)
+	auditMock.AssertExpectations(t)
+}
+
+func TestDuplicateService_MergePersons_AuditFailureFailsMerge(t *testing.T) {
+	survivor := namedPerson(1, "José da Silva", "11144477735", "jose@example.com", 3)
+	duplicate := namedPerson(2, "Jose da Silva", "12144477752", "jose.silva@example.com", 1)
+	service, duplicateMock, relationshipMock, personMock, auditMock := newDuplicateService(survivor, duplicate)
+
+	relationshipMock.On("FindByPersons", []int{1, 2}).Return([]*person.Relationship{}, nil)
+	duplicateMock.On("Merge", mock.Anything).Return(nil)
+	personMock.On("FindByIDIncludingDeleted", 2).Return(&person.Person{ID: 2, Version: 2}, nil)
+	auditMock.On("SaveAll", mock.Anything).Return(errors.New("audit table unavailable"))
+
+	_, err := service.MergePersons(contract.MergeDTO{SurvivorID: 1, SurvivorVersion: 3, DuplicateID: 2, DuplicateVersion: 1}, testActor)
+
+	assert.ErrorContains(t, err, "failed to record merge in the audit trail", "the merge transaction is rolled back with the error")
+}
+
+func TestDuplicateService_MergePersons_Errors(t *testing.T) {
+	survivor := namedPerson(1, "José da Silva", "11144477735", "jose@example.com", 3)
+	duplicate := namedPerson(2, "Jose da Silva", "12144477752", "jose.silva@example.com", 1)
+
+	tests := []struct {
+		name     string
+		dto      contract.MergeDTO
+		expected error
+	}{
+		{"same person", contract.MergeDTO{SurvivorID: 1, SurvivorVersion: 3, DuplicateID: 1, DuplicateVersion: 3}, personError.ErrMergeSamePerson},
+		{"unknown duplicate", contract.MergeDTO{SurvivorID: 1, SurvivorVersion: 3, DuplicateID: 9, DuplicateVersion: 1}, personError.ErrPersonNotFound},
+		{"stale survivor", contract.MergeDTO{SurvivorID: 1, SurvivorVersion: 2, DuplicateID: 2, DuplicateVersion: 1}, personError.ErrVersionConflict},
+		{"stale duplicate", contract.MergeDTO{SurvivorID: 1, SurvivorVersion: 3, DuplicateID: 2, DuplicateVersion: 2}, personError.ErrVersionConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, duplicateMock, _, personMock, _ := newDuplicateService(survivor, duplicate)
+			personMock.On("FindByID", 9).Return(nil, nil)
+
+			_, err := service.MergePersons(tt.dto, testActor)
+
+			assert.ErrorIs(t, err, tt.expected)
+			duplicateMock.AssertNotCalled(t, "Merge", mock.Anything)
+		})
+	}
+}
+
+func TestDuplicateService_MergePersons_RepositoryError(t *testing.T) {
+	survivor := namedPerson(1, "José da Silva", "11144477735", "jose@example.com", 3)
+	duplicate := namedPerson(2, "Jose da Silva", "12144477752", "jose.silva@example.com", 1)
+	service, duplicateMock, relationshipMock, _, auditMock := newDuplicateService(survivor, duplicate)
+
+	relationshipMock.On("FindByPersons", []int{1, 2}).Return([]*person.Relationship{}, nil)
+	duplicateMock.On("Merge", mock.Anything).Return(errors.New("connection lost"))
+
+	_, err := service.MergePersons(contract.MergeDTO{SurvivorID: 1, SurvivorVersion: 3, DuplicateID: 2, DuplicateVersion: 1}, testActor)
+
+	assert.EqualError(t, err, "connection lost")
+	auditMock.AssertNotCalled(t, "SaveAll", mock.Anything)
+}
@@ -0,0 +1,239 @@
+package person
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// importBatchSize is the number of valid rows written per transaction.
+const importBatchSize = 500
+
+type pendingImport struct {
+	line   int
+	person *person.Person
+}
+
+// ImportPersons validates every row read from the file with the same rules as
+// CreatePerson and inserts the valid ones in batched transactions. Invalid rows
+// are reported and skipped; they never abort the import.
+func (s *PersonServiceImpl) ImportPersons(reader ports.PersonRowReader, dryRun bool, actor audit.Actor) (*person.ImportReport, error) {
+	report := &person.ImportReport{DryRun: dryRun, Rows: []person.ImportRowResult{}}
+	firstLineByCPF := make(map[string]int)
+	batch := make([]pendingImport, 0, importBatchSize)
+
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		report.TotalRows++
+
+		if row.Err != nil {
+			failImportRow(report, row.Line, row.Err)
+			continue
+		}
+
+		candidate, err := person.NewPerson(
+			row.Person.Name,
+			row.Person.CPF,
+			row.Person.BirthDate,
+			row.Person.PhoneNumber,
+			row.Person.Email,
+		)
+		if err != nil {
+			failImportRow(report, row.Line, err)
+			continue
+		}
+
+		if firstLine, seen := firstLineByCPF[candidate.CPF]; seen {
+			failImportRow(report, row.Line, fmt.Errorf("%w (first seen on line %d)", personError.ErrDuplicateInFile, firstLine))
+			continue
+		}
+		firstLineByCPF[candidate.CPF] = row.Line
+
+		batch = append(batch, pendingImport{line: row.Line, person: candidate})
+		if len(batch) == importBatchSize {
+			if err := s.importBatch(batch, report, actor); err != nil {
+				return nil, err
+			}
+			batch = batch[:0]
+		}
+	}
+
+	if err := s.importBatch(batch, report, actor); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(report.Rows, func(i, j int) bool {
+		return report.Rows[i].Line < report.Rows[j].Line
+	})
+
+	return report, nil
+}
+
+// importBatch writes one batch of valid rows. CPFs already registered are
+// rejected up front; if the transaction still fails (e.g. a concurrent insert
+// of the same CPF), the batch is retried row by row to isolate the culprit.
+// Each person is written along with its audit entry.
+func (s *PersonServiceImpl) importBatch(batch []pendingImport, report *person.ImportReport, actor audit.Actor) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	cpfs := make([]string, len(batch))
+	for i, pending := range batch {
+		cpfs[i] = pending.person.CPF
+	}
+
+	existing, err := s.repository.FindExistingCPFs(cpfs)
+	if err != nil {
+		return err
+	}
+
+	toSave := make([]pendingImport, 0, len(batch))
+	for _, pending := range batch {
+		if existing[pending.person.CPF] {
+			failImportRow(report, pending.line, personError.ErrCPFAlreadyInUse)
+			continue
+		}
+		toSave = append(toSave, pending)
+	}
+
+	if report.DryRun {
+		for _, pending := range toSave {
+			report.Succeed(pending.line, person.ImportStatusValid, 0)
+		}
+		return nil
+	}
+
+	if len(toSave) == 0 {
+		return nil
+	}
+
+	ids, err := s.saveImportBatch(toSave, actor)
+	if err != nil {
+		log.Printf("[WARN] PersonService - Import batch of %d rows failed, retrying row by row: %v", len(toSave), err)
+		ids = make([]int, len(toSave))
+		for i, pending := range toSave {
+			id, err := s.saveImportRow(pending.person, actor)
+			if err != nil {
+				failImportRow(report, pending.line, err)
+				continue
+			}
+			ids[i] = id
+		}
+	}
+
+	for i, pending := range toSave {
+		if ids[i] == 0 {
+			continue
+		}
+
+		pending.person.ID = ids[i]
+		report.Succeed(pending.line, person.ImportStatusImported, ids[i])
+	}
+
+	return nil
+}
+
+// saveImportBatch inserts a batch of persons and their audit entries in one
+// transaction, so no imported person is kept without its entry.
+func (s *PersonServiceImpl) saveImportBatch(batch []pendingImport, actor audit.Actor) ([]int, error) {
+	persons := make([]*person.Person, len(batch))
+	for i, pending := range batch {
+		persons[i] = pending.person
+	}
+
+	var ids []int
+	err := s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		var err error
+		ids, err = tx.Persons.SaveBatch(persons)
+		if err != nil {
+			return err
+		}
+
+		entries := make([]*audit.AuditEntry, len(persons))
+		for i, saved := range persons {
+			imported := *saved
+			imported.ID = ids[i]
+			entries[i] = audit.NewAuditEntry(audit.EntityPerson, ids[i], audit.ActionCreate, actor, nil, personSnapshot(&imported))
+		}
+
+		if err := tx.Audits.SaveAll(entries); err != nil {
+			log.Printf("[ERROR] PersonService - Failed to record import of %d persons by operator %d: %v", len(entries), actor.OperatorID, err)
+			return fmt.Errorf("failed to record import in the audit trail: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// saveImportRow inserts a single imported person and its audit entry in one
+// transaction.
+func (s *PersonServiceImpl) saveImportRow(candidate *person.Person, actor audit.Actor) (int, error) {
+	var id int
+	err := s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		var err error
+		id, err = tx.Persons.Save(candidate)
+		if err != nil {
+			return err
+		}
+
+		imported := *candidate
+		imported.ID = id
+		return recordChange(tx.Audits, id, audit.ActionCreate, actor, nil, &imported)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// importErrorCodes maps the errors a row can fail with to stable codes for the import report.
+var importErrorCodes = []struct {
+	err  error
+	code string
+}{
+	{personError.ErrMalformedRow, "malformed_row"},
+	{personError.ErrNameRequired, "name_required"},
+	{personError.ErrCPFRequired, "cpf_required"},
+	{personError.ErrCPFInvalid, "cpf_invalid"},
+	{personError.ErrPhoneRequired, "phone_required"},
+	{personError.ErrPhoneInvalid, "phone_invalid"},
+	{personError.ErrEmailRequired, "email_required"},
+	{personError.ErrEmailInvalid, "email_invalid"},
+	{personError.ErrBirthDateInvalid, "birth_date_invalid"},
+	{personError.ErrDuplicateInFile, "duplicate_in_file"},
+	{personError.ErrCPFAlreadyInUse, "cpf_already_registered"},
+}
+
+func failImportRow(report *person.ImportReport, line int, err error) {
+	report.Fail(line, importErrorCode(err), err.Error())
+}
+
+func importErrorCode(err error) string {
+	for _, known := range importErrorCodes {
+		if errors.Is(err, known.err) {
+			return known.code
+		}
+	}
+	return "insert_failed"
+}
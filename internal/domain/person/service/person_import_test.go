@@ -0,0 +1,267 @@
+package person
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	personDto "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// sliceRowReader serves import rows from memory.
+type sliceRowReader struct {
+	rows []personDto.ImportRow
+	err  error
+}
+
+func (r *sliceRowReader) Read() (personDto.ImportRow, error) {
+	if len(r.rows) == 0 {
+		if r.err != nil {
+			return personDto.ImportRow{}, r.err
+		}
+		return personDto.ImportRow{}, io.EOF
+	}
+
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+func importRow(line int, name, cpf string) personDto.ImportRow {
+	return personDto.ImportRow{
+		Line: line,
+		Person: personDto.NewPersonDTO{
+			Name:        name,
+			CPF:         cpf,
+			BirthDate:   time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+			PhoneNumber: "81912345678",
+			Email:       "test@example.com",
+		},
+	}
+}
+
+func TestPersonService_ImportPersons_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+	service := newTestPersonService(repoMock, auditMock)
+
+	reader := &sliceRowReader{rows: []personDto.ImportRow{
+		importRow(2, "Ana", "11144477735"),
+		importRow(3, "Bruno", "222.333.444-05"),
+	}}
+
+	repoMock.On("FindExistingCPFs", []string{"11144477735", "22233344405"}).Return(map[string]bool{}, nil)
+	repoMock.On("SaveBatch", mock.MatchedBy(func(persons []*person.Person) bool { return len(persons) == 2 })).Return([]int{10, 11}, nil)
+	auditMock.On("SaveAll", mock.MatchedBy(func(entries []*audit.AuditEntry) bool {
+		return len(entries) == 2 && entries[0].EntityID == 10 && entries[0].Action == audit.ActionCreate && entries[0].OperatorID == testActor.OperatorID
+	})).Return(nil)
+
+	report, err := service.ImportPersons(reader, false, testActor)
+
+	assert.NoError(err)
+	assert.Equal(2, report.TotalRows)
+	assert.Equal(2, report.Succeeded)
+	assert.Equal(0, report.Failed)
+	assert.Equal([]person.ImportRowResult{
+		{Line: 2, Status: person.ImportStatusImported, ID: 10},
+		{Line: 3, Status: person.ImportStatusImported, ID: 11},
+	}, report.Rows)
+	repoMock.AssertExpectations(t)
+	auditMock.AssertExpectations(t)
+}
+
+func TestPersonService_ImportPersons_ReportsInvalidRows(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	reader := &sliceRowReader{rows: []personDto.ImportRow{
+		importRow(2, "Ana", "11144477735"),
+		importRow(3, "", "22233344405"),
+		importRow(4, "Carla", "12345678900"),
+		{Line: 5, Err: personError.ErrMalformedRow},
+		importRow(6, "Ana de novo", "111.444.777-35"),
+		importRow(7, "Daniel", "52998224725"),
+	}}
+
+	repoMock.On("FindExistingCPFs", []string{"11144477735", "52998224725"}).Return(map[string]bool{"52998224725": true}, nil)
+	repoMock.On("SaveBatch", mock.MatchedBy(func(persons []*person.Person) bool { return len(persons) == 1 })).Return([]int{10}, nil)
+
+	report, err := service.ImportPersons(reader, false, testActor)
+
+	assert.NoError(err)
+	assert.Equal(6, report.TotalRows)
+	assert.Equal(1, report.Succeeded)
+	assert.Equal(5, report.Failed)
+
+	codes := make(map[int]string)
+	for _, row := range report.Rows {
+		codes[row.Line] = row.ErrorCode
+	}
+	assert.Equal(map[int]string{
+		2: "",
+		3: "name_required",
+		4: "cpf_invalid",
+		5: "malformed_row",
+		6: "duplicate_in_file",
+		7: "cpf_already_registered",
+	}, codes)
+	assert.Contains(report.Rows[4].Message, "line 2")
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_ImportPersons_DryRunDoesNotWrite(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+	service := newTestPersonService(repoMock, auditMock)
+
+	reader := &sliceRowReader{rows: []personDto.ImportRow{
+		importRow(2, "Ana", "11144477735"),
+		importRow(3, "Bruno", "22233344405"),
+	}}
+
+	repoMock.On("FindExistingCPFs", mock.Anything).Return(map[string]bool{"22233344405": true}, nil)
+
+	report, err := service.ImportPersons(reader, true, testActor)
+
+	assert.NoError(err)
+	assert.True(report.DryRun)
+	assert.Equal(1, report.Succeeded)
+	assert.Equal(1, report.Failed)
+	assert.Equal(person.ImportStatusValid, report.Rows[0].Status)
+	assert.Zero(report.Rows[0].ID)
+	assert.Equal("cpf_already_registered", report.Rows[1].ErrorCode)
+	repoMock.AssertNotCalled(t, "SaveBatch", mock.Anything)
+	repoMock.AssertNotCalled(t, "Save", mock.Anything)
+	auditMock.AssertNotCalled(t, "SaveAll", mock.Anything)
+}
+
+func TestPersonService_ImportPersons_FallsBackToRowByRow(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	reader := &sliceRowReader{rows: []personDto.ImportRow{
+		importRow(2, "Ana", "11144477735"),
+		importRow(3, "Bruno", "22233344405"),
+	}}
+
+	repoMock.On("FindExistingCPFs", mock.Anything).Return(map[string]bool{}, nil)
+	repoMock.On("SaveBatch", mock.Anything).Return(nil, errors.New("duplicate key"))
+	repoMock.On("Save", mock.MatchedBy(func(p *person.Person) bool { return p.Name == "Ana" })).Return(10, nil)
+	repoMock.On("Save", mock.MatchedBy(func(p *person.Person) bool { return p.Name == "Bruno" })).Return(0, errors.New("duplicate key"))
+
+	report, err := service.ImportPersons(reader, false, testActor)
+
+	assert.NoError(err)
+	assert.Equal(1, report.Succeeded)
+	assert.Equal(1, report.Failed)
+	assert.Equal(10, report.Rows[0].ID)
+	assert.Equal("insert_failed", report.Rows[1].ErrorCode)
+}
+
+func TestPersonService_ImportPersons_AuditFailureFailsRows(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+	service := newTestPersonService(repoMock, auditMock)
+
+	reader := &sliceRowReader{rows: []personDto.ImportRow{
+		importRow(2, "Ana", "11144477735"),
+	}}
+
+	repoMock.On("FindExistingCPFs", mock.Anything).Return(map[string]bool{}, nil)
+	repoMock.On("SaveBatch", mock.Anything).Return([]int{10}, nil)
+	repoMock.On("Save", mock.Anything).Return(10, nil)
+	auditMock.On("SaveAll", mock.Anything).Return(errors.New("audit table unavailable"))
+	auditMock.On("Save", mock.Anything).Return(errors.New("audit table unavailable"))
+
+	report, err := service.ImportPersons(reader, false, testActor)
+
+	assert.NoError(err)
+	assert.Equal(0, report.Succeeded, "a person is not imported without its audit entry")
+	assert.Equal(1, report.Failed)
+	assert.Equal("insert_failed", report.Rows[0].ErrorCode)
+	auditMock.AssertExpectations(t)
+}
+
+func TestPersonService_ImportPersons_BatchesLargeFiles(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	total := importBatchSize + 2
+	rows := make([]personDto.ImportRow, total)
+	for i := range rows {
+		rows[i] = importRow(i+2, "Pessoa", generateCPF(100000000+i))
+	}
+
+	batchIDs := func(size, first int) []int {
+		ids := make([]int, size)
+		for i := range ids {
+			ids[i] = first + i
+		}
+		return ids
+	}
+
+	repoMock.On("FindExistingCPFs", mock.Anything).Return(map[string]bool{}, nil).Twice()
+	repoMock.On("SaveBatch", mock.MatchedBy(func(persons []*person.Person) bool { return len(persons) == importBatchSize })).Return(batchIDs(importBatchSize, 1), nil).Once()
+	repoMock.On("SaveBatch", mock.MatchedBy(func(persons []*person.Person) bool { return len(persons) == 2 })).Return(batchIDs(2, importBatchSize+1), nil).Once()
+
+	report, err := service.ImportPersons(&sliceRowReader{rows: rows}, false, testActor)
+
+	assert.NoError(err)
+	assert.Equal(total, report.TotalRows)
+	assert.Equal(total, report.Succeeded)
+	assert.Equal(total, report.Rows[total-1].ID)
+	repoMock.AssertExpectations(t)
+}
+
+// generateCPF builds a valid CPF from a nine digit base by appending its check digits.
+func generateCPF(base int) string {
+	digits := make([]int, 0, 11)
+	for _, r := range fmt.Sprintf("%09d", base) {
+		digits = append(digits, int(r-'0'))
+	}
+
+	for len(digits) < 11 {
+		sum := 0
+		for i, d := range digits {
+			sum += d * (len(digits) + 1 - i)
+		}
+		check := 11 - sum%11
+		if check >= 10 {
+			check = 0
+		}
+		digits = append(digits, check)
+	}
+
+	cpf := make([]byte, len(digits))
+	for i, d := range digits {
+		cpf[i] = byte('0' + d)
+	}
+	return string(cpf)
+}
+
+func TestPersonService_ImportPersons_ReaderError(t *testing.T) {
+	repoMock := new(repositoryMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	reader := &sliceRowReader{err: errors.New("connection reset")}
+
+	report, err := service.ImportPersons(reader, false, testActor)
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+	repoMock.AssertNotCalled(t, "SaveBatch", mock.Anything)
+}
@@ -1,7 +1,16 @@
 package person
 
 import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	auditPorts "pessoas-api/internal/domain/audit/ports"
 	personError "pessoas-api/internal/domain/person/error"
 	person "pessoas-api/internal/domain/person/model"
 	"pessoas-api/internal/domain/person/ports"
@@ -10,19 +19,25 @@ import (
 
 // PersonServiceImpl implements the ports.PersonService interface.
 // This is the concrete implementation of the business logic for person operations.
+// Every write is recorded in the audit trail with the acting operator, in the
+// transaction of the write.
 type PersonServiceImpl struct {
-	repository ports.PersonRepository
+	repository      ports.PersonRepository
+	auditRepository auditPorts.AuditRepository
+	transactor      ports.Transactor
 }
 
 // NewPersonService creates a new instance of PersonServiceImpl.
 // It returns the implementation as the PersonService interface.
-func NewPersonService(repository ports.PersonRepository) ports.PersonService {
+func NewPersonService(repository ports.PersonRepository, auditRepository auditPorts.AuditRepository, transactor ports.Transactor) ports.PersonService {
 	return &PersonServiceImpl{
-		repository: repository,
+		repository:      repository,
+		auditRepository: auditRepository,
+		transactor:      transactor,
 	}
 }
 
-func (s *PersonServiceImpl) CreatePerson(newPersonDTO contract.NewPersonDTO) (ID int, err error) {
+func (s *PersonServiceImpl) CreatePerson(newPersonDTO contract.NewPersonDTO, actor audit.Actor) (ID int, err error) {
 	person, err := person.NewPerson(
 		newPersonDTO.Name,
 		newPersonDTO.CPF,
@@ -35,10 +50,29 @@ func (s *PersonServiceImpl) CreatePerson(newPersonDTO contract.NewPersonDTO) (ID
 		return 0, err
 	}
 
-	return s.repository.Save(person)
+	for _, contactDTO := range newPersonDTO.Contacts {
+		if err := person.AddContact(contactFields(contactDTO)); err != nil {
+			return 0, err
+		}
+	}
+
+	err = s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		id, err := tx.Persons.Save(person)
+		if err != nil {
+			return err
+		}
+
+		person.ID = id
+		return recordChange(tx.Audits, id, audit.ActionCreate, actor, nil, person)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return person.ID, nil
 }
 
-func (s *PersonServiceImpl) ListPersons(page, pageSize int, sort, order string) ([]*person.Person, int64, error) {
+func (s *PersonServiceImpl) ListPersons(page, pageSize int, sort, order string, filter person.PersonFilter) ([]*person.Person, int64, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -52,7 +86,109 @@ func (s *PersonServiceImpl) ListPersons(page, pageSize int, sort, order string)
 		order = "desc"
 	}
 
-	return s.repository.FindAll(page, pageSize, sort, order)
+	return s.repository.FindAll(page, pageSize, sort, order, filter)
+}
+
+// ExportPersons streams every person matching the filter to the writer and
+// returns how many were written. Unknown columns are rejected before anything
+// is written, so callers can still report the error to the client.
+func (s *PersonServiceImpl) ExportPersons(writer ports.PersonRowWriter, sort, order string, filter person.PersonFilter, options person.ExportOptions) (int, error) {
+	columns := options.Columns
+	if len(columns) == 0 {
+		columns = person.ExportColumns
+	}
+	for _, column := range columns {
+		if !person.IsExportColumn(column) {
+			return 0, fmt.Errorf("%w: %s", personError.ErrUnknownColumn, column)
+		}
+	}
+
+	if sort == "" {
+		sort = "id"
+	}
+	if order == "" {
+		order = "asc"
+	}
+
+	if err := writer.WriteHeader(columns); err != nil {
+		return 0, err
+	}
+
+	written := 0
+	err := s.repository.Stream(sort, order, filter, func(p *person.Person) error {
+		if err := writer.WriteRow(p.ExportValues(columns, options.Masked)); err != nil {
+			return err
+		}
+		written++
+		return nil
+	})
+	if err != nil {
+		return written, err
+	}
+
+	return written, writer.Close()
+}
+
+func (s *PersonServiceImpl) CountPersons(filter person.PersonFilter) (int64, error) {
+	return s.repository.Count(filter)
+}
+
+// ListPersonsByCursor returns one page of a keyset-paginated listing. A page is
+// read with one extra row to tell whether more persons follow in that direction.
+func (s *PersonServiceImpl) ListPersonsByCursor(cursor *person.Cursor, pageSize int, sort, order string, filter person.PersonFilter, withTotal bool) (*person.CursorPage, error) {
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+	if sort == "" {
+		sort = "id"
+	}
+	if order == "" {
+		order = "desc"
+	}
+
+	if cursor != nil && (cursor.Sort != sort || cursor.Order != order) {
+		return nil, personError.ErrInvalidCursor
+	}
+
+	persons, err := s.repository.FindAfter(cursor, pageSize+1, sort, order, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(persons) > pageSize
+	if hasMore {
+		persons = persons[:pageSize]
+	}
+
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		for i, j := 0, len(persons)-1; i < j; i, j = i+1, j-1 {
+			persons[i], persons[j] = persons[j], persons[i]
+		}
+	}
+
+	page := &person.CursorPage{Persons: persons}
+
+	if len(persons) > 0 {
+		first, last := persons[0], persons[len(persons)-1]
+
+		if (backward && hasMore) || (!backward && cursor != nil) {
+			page.Prev = person.NewCursor(first, sort, order, true)
+		}
+		if (!backward && hasMore) || backward {
+			page.Next = person.NewCursor(last, sort, order, false)
+		}
+	}
+
+	if withTotal {
+		total, err := s.repository.Count(filter)
+		if err != nil {
+			return nil, err
+		}
+		page.Total = &total
+	}
+
+	return page, nil
 }
 
 func (s *PersonServiceImpl) FindPersonByCPF(cpf string) (*person.Person, error) {
@@ -60,11 +196,70 @@ func (s *PersonServiceImpl) FindPersonByCPF(cpf string) (*person.Person, error)
 	return s.repository.FindByCPF(cpfDigits)
 }
 
-func (s *PersonServiceImpl) FindPersonByID(id int) (*person.Person, error) {
+// FindPersonsByContact returns the persons having a contact with the given
+// value: an email address when it contains "@", a phone number otherwise.
+func (s *PersonServiceImpl) FindPersonsByContact(value string) ([]*person.Person, error) {
+	if strings.Contains(value, "@") {
+		return s.repository.FindByContact(person.ContactEmail, strings.TrimSpace(value))
+	}
+
+	phone, err := person.ParsePhoneNumber(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repository.FindByContact(person.ContactPhone, phone.E164())
+}
+
+func (s *PersonServiceImpl) FindPersonByID(id int, includeDeleted bool) (*person.Person, error) {
+	if includeDeleted {
+		return s.repository.FindByIDIncludingDeleted(id)
+	}
+
 	return s.repository.FindByID(id)
 }
 
-func (s *PersonServiceImpl) UpdatePerson(id int, dto contract.UpdatePersonDTO) error {
+// maxRevealReasonLength is the size of the reason recorded for a reveal.
+const maxRevealReasonLength = 255
+
+// RevealPerson returns the person whose masked data the operator asked to see
+// in full. The reveal is recorded in the audit trail with its reason before
+// the data is returned, and unlike other changes it fails when the record
+// cannot be written: unaudited reveals are not allowed.
+func (s *PersonServiceImpl) RevealPerson(id int, dto contract.RevealPersonDTO, actor audit.Actor) (*person.Person, error) {
+	reason := strings.TrimSpace(dto.Reason)
+	if reason == "" {
+		return nil, personError.ErrRevealReasonRequired
+	}
+	if utf8.RuneCountInString(reason) > maxRevealReasonLength {
+		return nil, personError.ErrRevealReasonTooLong
+	}
+
+	existingPerson, err := s.repository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if existingPerson == nil {
+		return nil, personError.ErrPersonNotFound
+	}
+
+	snapshot, err := json.Marshal(struct {
+		ID     int    `json:"id"`
+		Reason string `json:"reason"`
+	}{id, reason})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := audit.NewAuditEntry(audit.EntityPerson, id, audit.ActionReveal, actor, nil, snapshot)
+	if err := s.auditRepository.Save(entry); err != nil {
+		return nil, fmt.Errorf("failed to record reveal: %w", err)
+	}
+
+	return existingPerson, nil
+}
+
+func (s *PersonServiceImpl) UpdatePerson(id int, version int, dto contract.UpdatePersonDTO, actor audit.Actor) error {
 	existingPerson, err := s.repository.FindByID(id)
 	if err != nil {
 		return err
@@ -74,25 +269,75 @@ func (s *PersonServiceImpl) UpdatePerson(id int, dto contract.UpdatePersonDTO) e
 		return personError.ErrPersonNotFound
 	}
 
-	updatedPerson, err := person.NewPerson(
-		dto.Name,
-		dto.CPF,
-		dto.BirthDate,
-		dto.PhoneNumber,
-		dto.Email,
-	)
+	if existingPerson.Version != version {
+		return personError.ErrVersionConflict
+	}
+
+	// Every field is replaced, but only the primary phone and email among the
+	// contacts: the others are managed through the contact endpoints.
+	updatedPerson := *existingPerson
+	if _, err := updatedPerson.ApplyChanges(person.PersonChanges{
+		Name:        &dto.Name,
+		CPF:         &dto.CPF,
+		BirthDate:   &dto.BirthDate,
+		PhoneNumber: &dto.PhoneNumber,
+		Email:       &dto.Email,
+	}); err != nil {
+		return err
+	}
+
+	updatedPerson.UpdatedAt = time.Now()
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Persons.Update(&updatedPerson); err != nil {
+			return err
+		}
 
+		return recordCurrentState(tx.Persons, tx.Audits, id, audit.ActionUpdate, actor, existingPerson)
+	})
+}
+
+func (s *PersonServiceImpl) PatchPerson(id int, version int, dto contract.PatchPersonDTO, actor audit.Actor) error {
+	existingPerson, err := s.repository.FindByID(id)
 	if err != nil {
 		return err
 	}
 
-	updatedPerson.ID = id
-	updatedPerson.CreatedAt = existingPerson.CreatedAt
+	if existingPerson == nil {
+		return personError.ErrPersonNotFound
+	}
+
+	if existingPerson.Version != version {
+		return personError.ErrVersionConflict
+	}
+
+	before := *existingPerson
 
-	return s.repository.Update(updatedPerson)
+	changedFields, err := existingPerson.ApplyChanges(person.PersonChanges{
+		Name:        dto.Name,
+		CPF:         dto.CPF,
+		BirthDate:   dto.BirthDate,
+		PhoneNumber: dto.PhoneNumber,
+		Email:       dto.Email,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(changedFields) == 0 {
+		return nil
+	}
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Persons.UpdateFields(existingPerson, changedFields); err != nil {
+			return err
+		}
+
+		return recordCurrentState(tx.Persons, tx.Audits, id, audit.ActionUpdate, actor, &before)
+	})
 }
 
-func (s *PersonServiceImpl) DeletePerson(id int) error {
+func (s *PersonServiceImpl) DeletePerson(id int, version int, actor audit.Actor) error {
 	existingPerson, err := s.repository.FindByID(id)
 	if err != nil {
 		return err
@@ -102,5 +347,199 @@ func (s *PersonServiceImpl) DeletePerson(id int) error {
 		return personError.ErrPersonNotFound
 	}
 
-	return s.repository.Delete(id)
+	if existingPerson.Version != version {
+		return personError.ErrVersionConflict
+	}
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Persons.Delete(id, version); err != nil {
+			return err
+		}
+
+		return recordCurrentState(tx.Persons, tx.Audits, id, audit.ActionDelete, actor, existingPerson)
+	})
+}
+
+func (s *PersonServiceImpl) RestorePerson(id int, actor audit.Actor) error {
+	existingPerson, err := s.repository.FindByIDIncludingDeleted(id)
+	if err != nil {
+		return err
+	}
+
+	if existingPerson == nil {
+		return personError.ErrPersonNotFound
+	}
+
+	if !existingPerson.IsDeleted() {
+		return personError.ErrPersonNotDeleted
+	}
+
+	if existingPerson.IsMerged() {
+		return personError.ErrPersonMerged
+	}
+
+	if existingPerson.IsAnonymized() {
+		return personError.ErrPersonAnonymized
+	}
+
+	activePerson, err := s.repository.FindByCPF(existingPerson.CPF)
+	if err != nil {
+		return err
+	}
+
+	if activePerson != nil {
+		return personError.ErrCPFAlreadyInUse
+	}
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Persons.Restore(id); err != nil {
+			return err
+		}
+
+		return recordCurrentState(tx.Persons, tx.Audits, id, audit.ActionRestore, actor, existingPerson)
+	})
+}
+
+func (s *PersonServiceImpl) PurgePerson(id int, version int, actor audit.Actor) error {
+	existingPerson, err := s.repository.FindByIDIncludingDeleted(id)
+	if err != nil {
+		return err
+	}
+
+	if existingPerson == nil {
+		return personError.ErrPersonNotFound
+	}
+
+	if existingPerson.Version != version {
+		return personError.ErrVersionConflict
+	}
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Persons.Purge(id, version); err != nil {
+			return err
+		}
+
+		return recordChange(tx.Audits, id, audit.ActionPurge, actor, existingPerson, nil)
+	})
+}
+
+func (s *PersonServiceImpl) PersonHistory(id int, page, pageSize int) ([]*audit.AuditEntry, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	ids, err := s.mergedHistoryIDs(id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries, total, err := s.auditRepository.FindByEntities(audit.EntityPerson, ids, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Purged persons keep their history, so only report not found when
+	// there is neither a record nor any recorded change.
+	if total == 0 {
+		existingPerson, err := s.repository.FindByIDIncludingDeleted(id)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if existingPerson == nil {
+			return nil, 0, personError.ErrPersonNotFound
+		}
+	}
+
+	return entries, total, nil
+}
+
+// mergedHistoryIDs returns the person ID followed by the IDs of every person
+// merged into it, directly or through other merges, whose history it inherits.
+func (s *PersonServiceImpl) mergedHistoryIDs(id int) ([]int, error) {
+	return withMergedPersons(s.repository, []int{id})
+}
+
+// withMergedPersons returns the given person IDs followed by the IDs of every
+// person merged into any of them, directly or through other merges.
+func withMergedPersons(repository ports.PersonRepository, personIDs []int) ([]int, error) {
+	ids := append([]int{}, personIDs...)
+	seen := make(map[int]bool, len(personIDs))
+	for _, id := range personIDs {
+		seen[id] = true
+	}
+
+	for generation := personIDs; len(generation) > 0; {
+		merged, err := repository.FindMergedInto(generation)
+		if err != nil {
+			return nil, err
+		}
+
+		generation = nil
+		for _, mergedID := range merged {
+			if !seen[mergedID] {
+				seen[mergedID] = true
+				ids = append(ids, mergedID)
+				generation = append(generation, mergedID)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// recordCurrentState reloads a person within the transaction of a write, so
+// the audit snapshot reflects what was actually persisted (new version,
+// timestamps, deletion mark), and records the change.
+func recordCurrentState(persons ports.PersonRepository, audits auditPorts.AuditRepository, id int, action string, actor audit.Actor, before *person.Person) error {
+	current, err := persons.FindByIDIncludingDeleted(id)
+	if err != nil {
+		return fmt.Errorf("failed to reload person for audit: %w", err)
+	}
+
+	return recordChange(audits, id, action, actor, before, current)
+}
+
+func recordChange(audits auditPorts.AuditRepository, id int, action string, actor audit.Actor, before, after *person.Person) error {
+	return recordEntry(audits, audit.EntityPerson, id, action, actor, personSnapshot(before), personSnapshot(after))
+}
+
+// recordEntry appends an entry to the audit trail within the transaction of
+// the write it describes. A failure is returned so that the write is rolled
+// back along with it.
+func recordEntry(audits auditPorts.AuditRepository, entityType string, id int, action string, actor audit.Actor, before, after json.RawMessage) error {
+	entry := audit.NewAuditEntry(entityType, id, action, actor, before, after)
+
+	if err := audits.Save(entry); err != nil {
+		log.Printf("[ERROR] PersonService - Failed to record %s of %s ID %d by operator %d: %v", action, entityType, id, actor.OperatorID, err)
+		return fmt.Errorf("failed to record %s in the audit trail: %w", action, err)
+	}
+
+	return nil
+}
+
+func contactFields(dto contract.ContactDTO) person.ContactFields {
+	return person.ContactFields{
+		Type:     dto.Type,
+		Value:    dto.Value,
+		Label:    dto.Label,
+		Primary:  dto.Primary,
+		Verified: dto.Verified,
+	}
+}
+
+func personSnapshot(p *person.Person) json.RawMessage {
+	if p == nil {
+		return nil
+	}
+
+	snapshot, err := json.Marshal(contract.NewPersonResponseDTO(p))
+	if err != nil {
+		return nil
+	}
+
+	return snapshot
 }
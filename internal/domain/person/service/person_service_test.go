@@ -2,11 +2,15 @@ package person
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	personDto "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
 	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,14 +25,47 @@ func (r *repositoryMock) Save(person *person.Person) (ID int, err error) {
 	return args.Int(0), args.Error(1)
 }
 
-func (r *repositoryMock) FindAll(page, pageSize int, sortBy, sortOrder string) ([]*person.Person, int64, error) {
-	args := r.Called(page, pageSize, sortBy, sortOrder)
+func (r *repositoryMock) SaveBatch(persons []*person.Person) (IDs []int, err error) {
+	args := r.Called(persons)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int), args.Error(1)
+}
+
+func (r *repositoryMock) FindAll(page, pageSize int, sortBy, sortOrder string, filter person.PersonFilter) ([]*person.Person, int64, error) {
+	args := r.Called(page, pageSize, sortBy, sortOrder, filter)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
 	}
 	return args.Get(0).([]*person.Person), args.Get(1).(int64), args.Error(2)
 }
 
+func (r *repositoryMock) FindAfter(cursor *person.Cursor, limit int, sortBy, sortOrder string, filter person.PersonFilter) ([]*person.Person, error) {
+	args := r.Called(cursor, limit, sortBy, sortOrder, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Person), args.Error(1)
+}
+
+func (r *repositoryMock) Count(filter person.PersonFilter) (int64, error) {
+	args := r.Called(filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (r *repositoryMock) Stream(sortBy, sortOrder string, filter person.PersonFilter, fn func(*person.Person) error) error {
+	args := r.Called(sortBy, sortOrder, filter, fn)
+	if persons, ok := args.Get(0).([]*person.Person); ok {
+		for _, p := range persons {
+			if err := fn(p); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
 func (r *repositoryMock) FindByCPF(cpf string) (*person.Person, error) {
 	args := r.Called(cpf)
 	if args.Get(0) == nil {
@@ -37,6 +74,30 @@ func (r *repositoryMock) FindByCPF(cpf string) (*person.Person, error) {
 	return args.Get(0).(*person.Person), args.Error(1)
 }
 
+func (r *repositoryMock) FindAllByCPF(cpf string) ([]*person.Person, error) {
+	args := r.Called(cpf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Person), args.Error(1)
+}
+
+func (r *repositoryMock) FindByContact(contactType, value string) ([]*person.Person, error) {
+	args := r.Called(contactType, value)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Person), args.Error(1)
+}
+
+func (r *repositoryMock) FindExistingCPFs(cpfs []string) (map[string]bool, error) {
+	args := r.Called(cpfs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
 func (r *repositoryMock) FindByID(id int) (*person.Person, error) {
 	args := r.Called(id)
 	if args.Get(0) == nil {
@@ -45,16 +106,119 @@ func (r *repositoryMock) FindByID(id int) (*person.Person, error) {
 	return args.Get(0).(*person.Person), args.Error(1)
 }
 
+func (r *repositoryMock) FindByIDIncludingDeleted(id int) (*person.Person, error) {
+	args := r.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Person), args.Error(1)
+}
+
+func (r *repositoryMock) FindMergedInto(ids []int) ([]int, error) {
+	args := r.Called(ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int), args.Error(1)
+}
+
 func (r *repositoryMock) Update(person *person.Person) error {
 	args := r.Called(person)
 	return args.Error(0)
 }
 
-func (r *repositoryMock) Delete(id int) error {
+func (r *repositoryMock) UpdateFields(person *person.Person, fields []string) error {
+	args := r.Called(person, fields)
+	return args.Error(0)
+}
+
+func (r *repositoryMock) Delete(id int, version int) error {
+	args := r.Called(id, version)
+	return args.Error(0)
+}
+
+func (r *repositoryMock) Restore(id int) error {
 	args := r.Called(id)
 	return args.Error(0)
 }
 
+func (r *repositoryMock) Purge(id int, version int) error {
+	args := r.Called(id, version)
+	return args.Error(0)
+}
+
+type auditRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *auditRepositoryMock) Save(entry *audit.AuditEntry) error {
+	args := r.Called(entry)
+	return args.Error(0)
+}
+
+func (r *auditRepositoryMock) SaveAll(entries []*audit.AuditEntry) error {
+	args := r.Called(entries)
+	return args.Error(0)
+}
+
+func (r *auditRepositoryMock) FindByEntity(entityType string, entityID int, page, size int) ([]*audit.AuditEntry, int64, error) {
+	args := r.Called(entityType, entityID, page, size)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*audit.AuditEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func (r *auditRepositoryMock) FindByEntities(entityType string, entityIDs []int, page, size int) ([]*audit.AuditEntry, int64, error) {
+	args := r.Called(entityType, entityIDs, page, size)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*audit.AuditEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func (r *auditRepositoryMock) FindBySubject(personIDs []int) ([]*audit.AuditEntry, error) {
+	args := r.Called(personIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*audit.AuditEntry), args.Error(1)
+}
+
+// newAuditRepositoryMock accepts any audit entry, for tests that do not assert on the trail.
+func newAuditRepositoryMock() *auditRepositoryMock {
+	auditMock := new(auditRepositoryMock)
+	auditMock.On("Save", mock.Anything).Return(nil).Maybe()
+	auditMock.On("SaveAll", mock.Anything).Return(nil).Maybe()
+	return auditMock
+}
+
+// transactorMock runs the writes on the mocked repositories, as the
+// transaction would on the real ones.
+type transactorMock struct {
+	repos ports.Repositories
+}
+
+func (t transactorMock) WithinTransaction(fn func(tx ports.Repositories) error) error {
+	return fn(t.repos)
+}
+
+// newTestPersonService creates the service with its transactions running on
+// the given mocks.
+func newTestPersonService(repoMock *repositoryMock, auditMock *auditRepositoryMock) ports.PersonService {
+	return NewPersonService(repoMock, auditMock, transactorMock{ports.Repositories{Persons: repoMock, Audits: auditMock}})
+}
+
+var testActor = audit.Actor{OperatorID: 7, RequestID: "req-123", ClientIP: "203.0.113.10"}
+
+// primaryContacts builds the primary phone and email of a person literal.
+func primaryContacts(phone, email string) []person.Contact {
+	return []person.Contact{
+		{ContactFields: person.ContactFields{Type: person.ContactPhone, Value: phone, Primary: true}},
+		{ContactFields: person.ContactFields{Type: person.ContactEmail, Value: email, Primary: true}},
+	}
+}
+
 func TestPersonService_CreatePerson_Success(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
@@ -68,8 +232,8 @@ func TestPersonService_CreatePerson_Success(t *testing.T) {
 
 		return person.Name == "Jane Doe" &&
 			person.CPF == "22233344405" &&
-			person.Email == "jane.doe@example.com" &&
-			person.PhoneNumber == "81998765432" &&
+			person.Email() == "jane.doe@example.com" &&
+			person.Phone() == "+5581998765432" &&
 			person.BirthDate.Equal(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)) &&
 			!person.CreatedAt.IsZero() &&
 			!person.UpdatedAt.IsZero() &&
@@ -78,7 +242,7 @@ func TestPersonService_CreatePerson_Success(t *testing.T) {
 			person.CreatedAt.After(now.Add(-time.Second))
 	})).Return(1, nil)
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "Jane Doe",
@@ -88,20 +252,83 @@ func TestPersonService_CreatePerson_Success(t *testing.T) {
 		Email:       "jane.doe@example.com",
 	}
 
-	id, err := service.CreatePerson(createPersonDto)
+	id, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Equal(1, id)
 	assert.NoError(err)
 	repoMock.AssertExpectations(t)
 }
 
+func TestPersonService_CreatePerson_WithExtraContacts(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("Save", mock.MatchedBy(func(p *person.Person) bool {
+		return len(p.Contacts) == 3 &&
+			p.Phone() == "+558132221234" &&
+			p.Email() == "jane.doe@example.com"
+	})).Return(1, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	createPersonDto := personDto.NewPersonDTO{
+		Name:        "Jane Doe",
+		CPF:         "222.333.444-05",
+		BirthDate:   time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+		PhoneNumber: "81 99876-5432",
+		Email:       "jane.doe@example.com",
+		Contacts:    []personDto.ContactDTO{{Type: "phone", Value: "(81) 3222-1234", Label: "home", Primary: true}},
+	}
+
+	_, err := service.CreatePerson(createPersonDto, testActor)
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_FindPersonsByContact(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		contactType string
+		normalized  string
+	}{
+		{name: "phone", value: "(81) 99876-5432", contactType: person.ContactPhone, normalized: "+5581998765432"},
+		{name: "email", value: " jane@example.com ", contactType: person.ContactEmail, normalized: "jane@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := new(repositoryMock)
+			repoMock.On("FindByContact", tt.contactType, tt.normalized).Return([]*person.Person{{ID: 5}}, nil)
+			service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+			persons, err := service.FindPersonsByContact(tt.value)
+
+			assert.NoError(t, err)
+			assert.Len(t, persons, 1)
+			repoMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPersonService_FindPersonsByContact_ShouldRejectEmptyValue(t *testing.T) {
+	repoMock := new(repositoryMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	_, err := service.FindPersonsByContact("---")
+
+	assert.ErrorIs(t, err, personError.ErrPhoneInvalid)
+	repoMock.AssertNotCalled(t, "FindByContact", mock.Anything, mock.Anything)
+}
+
 func TestPersonService_CreatePerson_RepoError(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
 
 	repoMock.On("Save", mock.Anything).Return(0, errors.New("repo error"))
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "Jane Doe",
@@ -111,7 +338,7 @@ func TestPersonService_CreatePerson_RepoError(t *testing.T) {
 		Email:       "jane.doe@example.com",
 	}
 
-	_, err := service.CreatePerson(createPersonDto)
+	_, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Error(err)
 	repoMock.AssertExpectations(t)
@@ -121,7 +348,7 @@ func TestPersonService_CreatePerson_InputName(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "",
@@ -131,7 +358,7 @@ func TestPersonService_CreatePerson_InputName(t *testing.T) {
 		Email:       "jane.doe@example.com",
 	}
 
-	_, err := service.CreatePerson(createPersonDto)
+	_, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Error(err)
 	repoMock.AssertExpectations(t)
@@ -141,7 +368,7 @@ func TestPersonService_CreatePerson_InputCPF(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "Jane Doe",
@@ -151,7 +378,7 @@ func TestPersonService_CreatePerson_InputCPF(t *testing.T) {
 		Email:       "jane.doe@example.com",
 	}
 
-	_, err := service.CreatePerson(createPersonDto)
+	_, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Error(err)
 	repoMock.AssertExpectations(t)
@@ -161,7 +388,7 @@ func TestPersonService_CreatePerson_InputPhoneNumber(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "Jane Doe",
@@ -171,7 +398,7 @@ func TestPersonService_CreatePerson_InputPhoneNumber(t *testing.T) {
 		Email:       "jane.doe@example.com",
 	}
 
-	_, err := service.CreatePerson(createPersonDto)
+	_, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Error(err)
 	repoMock.AssertExpectations(t)
@@ -181,7 +408,7 @@ func TestPersonService_CreatePerson_InputEmail(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "Jane Doe",
@@ -191,7 +418,7 @@ func TestPersonService_CreatePerson_InputEmail(t *testing.T) {
 		Email:       "",
 	}
 
-	_, err := service.CreatePerson(createPersonDto)
+	_, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Error(err)
 	repoMock.AssertExpectations(t)
@@ -201,7 +428,7 @@ func TestPersonService_CreatePerson_InvalidCPF(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "Jane Doe",
@@ -211,7 +438,7 @@ func TestPersonService_CreatePerson_InvalidCPF(t *testing.T) {
 		Email:       "jane.doe@example.com",
 	}
 
-	_, err := service.CreatePerson(createPersonDto)
+	_, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Error(err)
 	repoMock.AssertExpectations(t)
@@ -221,7 +448,7 @@ func TestPersonService_CreatePerson_InvalidEmail(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "Jane Doe",
@@ -231,7 +458,7 @@ func TestPersonService_CreatePerson_InvalidEmail(t *testing.T) {
 		Email:       "jane.doe@invalid",
 	}
 
-	_, err := service.CreatePerson(createPersonDto)
+	_, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Error(err)
 	repoMock.AssertExpectations(t)
@@ -241,7 +468,7 @@ func TestPersonService_CreatePerson_InvalidPhoneNumber(t *testing.T) {
 	assert := assert.New(t)
 	repoMock := new(repositoryMock)
 
-	service := NewPersonService(repoMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
 
 	createPersonDto := personDto.NewPersonDTO{
 		Name:        "Jane Doe",
@@ -251,8 +478,771 @@ func TestPersonService_CreatePerson_InvalidPhoneNumber(t *testing.T) {
 		Email:       "jane.doe@example.com",
 	}
 
-	_, err := service.CreatePerson(createPersonDto)
+	_, err := service.CreatePerson(createPersonDto, testActor)
 
 	assert.Error(err)
 	repoMock.AssertExpectations(t)
 }
+
+func TestPersonService_FindPersonByID_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	expected := &person.Person{ID: 5, Name: "Jane Doe", CPF: "22233344405"}
+	repoMock.On("FindByID", 5).Return(expected, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	found, err := service.FindPersonByID(5, false)
+
+	assert.NoError(err)
+	assert.Equal(expected, found)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_FindPersonByID_NotFound(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByID", 5).Return(nil, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	found, err := service.FindPersonByID(5, false)
+
+	assert.NoError(err)
+	assert.Nil(found)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_FindPersonByID_RepoError(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByID", 5).Return(nil, errors.New("repo error"))
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	found, err := service.FindPersonByID(5, false)
+
+	assert.Error(err)
+	assert.Nil(found)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_PatchPerson_PersistsChangedFields(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	existing, _ := person.NewPerson(
+		"Jane Doe",
+		"222.333.444-05",
+		time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"81 99876-5432",
+		"jane.doe@example.com",
+	)
+	existing.ID = 5
+
+	repoMock.On("FindByID", 5).Return(existing, nil)
+	repoMock.On("UpdateFields", mock.MatchedBy(func(p *person.Person) bool {
+		return p.ID == 5 && p.Email() == "jane@new.com" && p.Name == "Jane Doe"
+	}), []string{person.FieldEmail}).Return(nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(existing, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	newEmail := "jane@new.com"
+	err := service.PatchPerson(5, 1, personDto.PatchPersonDTO{Email: &newEmail}, testActor)
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_PatchPerson_NotFound(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByID", 5).Return(nil, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	newEmail := "jane@new.com"
+	err := service.PatchPerson(5, 1, personDto.PatchPersonDTO{Email: &newEmail}, testActor)
+
+	assert.ErrorIs(err, personError.ErrPersonNotFound)
+	repoMock.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestPersonService_PatchPerson_InvalidResult(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	existing, _ := person.NewPerson(
+		"Jane Doe",
+		"222.333.444-05",
+		time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"81 99876-5432",
+		"jane.doe@example.com",
+	)
+	existing.ID = 5
+
+	repoMock.On("FindByID", 5).Return(existing, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	invalidEmail := "not-an-email"
+	err := service.PatchPerson(5, 1, personDto.PatchPersonDTO{Email: &invalidEmail}, testActor)
+
+	assert.ErrorIs(err, personError.ErrEmailInvalid)
+	repoMock.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestPersonService_PatchPerson_NoChanges(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	existing, _ := person.NewPerson(
+		"Jane Doe",
+		"222.333.444-05",
+		time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"81 99876-5432",
+		"jane.doe@example.com",
+	)
+	existing.ID = 5
+
+	repoMock.On("FindByID", 5).Return(existing, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	sameName := "Jane Doe"
+	err := service.PatchPerson(5, 1, personDto.PatchPersonDTO{Name: &sameName}, testActor)
+
+	assert.NoError(err)
+	repoMock.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestPersonService_PatchPerson_VersionConflict(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	existing := &person.Person{ID: 5, Name: "Jane Doe", Version: 3}
+	repoMock.On("FindByID", 5).Return(existing, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	newEmail := "jane@new.com"
+	err := service.PatchPerson(5, 2, personDto.PatchPersonDTO{Email: &newEmail}, testActor)
+
+	assert.ErrorIs(err, personError.ErrVersionConflict)
+	repoMock.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestPersonService_UpdatePerson_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	createdAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	existing := &person.Person{ID: 5, Name: "Jane Doe", Version: 2, CreatedAt: createdAt}
+
+	repoMock.On("FindByID", 5).Return(existing, nil)
+	repoMock.On("Update", mock.MatchedBy(func(p *person.Person) bool {
+		return p.ID == 5 && p.Version == 2 && p.CreatedAt.Equal(createdAt) && p.Name == "Jane Smith"
+	})).Return(nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(existing, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.UpdatePerson(5, 2, personDto.UpdatePersonDTO{
+		Name:        "Jane Smith",
+		CPF:         "222.333.444-05",
+		BirthDate:   time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+		PhoneNumber: "81 99876-5432",
+		Email:       "jane.doe@example.com",
+	}, testActor)
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_UpdatePerson_VersionConflict(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	existing := &person.Person{ID: 5, Name: "Jane Doe", Version: 3}
+	repoMock.On("FindByID", 5).Return(existing, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.UpdatePerson(5, 2, personDto.UpdatePersonDTO{
+		Name:        "Jane Smith",
+		CPF:         "222.333.444-05",
+		BirthDate:   time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+		PhoneNumber: "81 99876-5432",
+		Email:       "jane.doe@example.com",
+	}, testActor)
+
+	assert.ErrorIs(err, personError.ErrVersionConflict)
+	repoMock.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestPersonService_DeletePerson_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByID", 5).Return(&person.Person{ID: 5, Version: 4}, nil)
+	repoMock.On("Delete", 5, 4).Return(nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, Version: 5}, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.DeletePerson(5, 4, testActor)
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_DeletePerson_VersionConflict(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByID", 5).Return(&person.Person{ID: 5, Version: 4}, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.DeletePerson(5, 3, testActor)
+
+	assert.ErrorIs(err, personError.ErrVersionConflict)
+	repoMock.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestPersonService_FindPersonByID_IncludingDeleted(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	deletedAt := time.Now()
+	expected := &person.Person{ID: 5, Name: "Jane Doe", DeletedAt: &deletedAt}
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(expected, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	found, err := service.FindPersonByID(5, true)
+
+	assert.NoError(err)
+	assert.Equal(expected, found)
+	repoMock.AssertNotCalled(t, "FindByID", mock.Anything)
+}
+
+func TestPersonService_ListPersons_IncludeDeleted(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindAll", 1, 10, "id", "desc", person.PersonFilter{IncludeDeleted: true}).Return([]*person.Person{}, int64(0), nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	_, _, err := service.ListPersons(0, 0, "", "", person.PersonFilter{IncludeDeleted: true})
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_RestorePerson_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	deletedAt := time.Now()
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, CPF: "22233344405", DeletedAt: &deletedAt}, nil)
+	repoMock.On("FindByCPF", "22233344405").Return(nil, nil)
+	repoMock.On("Restore", 5).Return(nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.RestorePerson(5, testActor)
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_RestorePerson_NotDeleted(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, CPF: "22233344405"}, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.RestorePerson(5, testActor)
+
+	assert.ErrorIs(err, personError.ErrPersonNotDeleted)
+	repoMock.AssertNotCalled(t, "Restore", mock.Anything)
+}
+
+func TestPersonService_RestorePerson_Merged(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	deletedAt := time.Now()
+	survivorID := 9
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, CPF: "22233344405", DeletedAt: &deletedAt, MergedIntoID: &survivorID}, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.RestorePerson(5, testActor)
+
+	assert.ErrorIs(err, personError.ErrPersonMerged)
+	repoMock.AssertNotCalled(t, "Restore", mock.Anything)
+}
+
+func TestPersonService_RestorePerson_Anonymized(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	anonymizedAt := time.Now()
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, CPF: person.AnonymizedCPF(5), DeletedAt: &anonymizedAt, AnonymizedAt: &anonymizedAt}, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.RestorePerson(5, testActor)
+
+	assert.ErrorIs(err, personError.ErrPersonAnonymized)
+	repoMock.AssertNotCalled(t, "Restore", mock.Anything)
+}
+
+func TestPersonService_RestorePerson_CPFReRegistered(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	deletedAt := time.Now()
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, CPF: "22233344405", DeletedAt: &deletedAt}, nil)
+	repoMock.On("FindByCPF", "22233344405").Return(&person.Person{ID: 9, CPF: "22233344405"}, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.RestorePerson(5, testActor)
+
+	assert.ErrorIs(err, personError.ErrCPFAlreadyInUse)
+	repoMock.AssertNotCalled(t, "Restore", mock.Anything)
+}
+
+func TestPersonService_PurgePerson_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	deletedAt := time.Now()
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, Version: 2, DeletedAt: &deletedAt}, nil)
+	repoMock.On("Purge", 5, 2).Return(nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.PurgePerson(5, 2, testActor)
+
+	assert.NoError(err)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_PurgePerson_NotFound(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(nil, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	err := service.PurgePerson(5, 2, testActor)
+
+	assert.ErrorIs(err, personError.ErrPersonNotFound)
+	repoMock.AssertNotCalled(t, "Purge", mock.Anything, mock.Anything)
+}
+
+func TestPersonService_CreatePerson_RecordsAudit(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	repoMock.On("Save", mock.Anything).Return(8, nil)
+	auditMock.On("Save", mock.MatchedBy(func(entry *audit.AuditEntry) bool {
+		return entry.EntityType == audit.EntityPerson &&
+			entry.EntityID == 8 &&
+			entry.Action == audit.ActionCreate &&
+			entry.OperatorID == 7 &&
+			entry.RequestID == "req-123" &&
+			entry.ClientIP == "203.0.113.10" &&
+			entry.Before == nil &&
+			strings.Contains(string(entry.After), `"id":8`) &&
+			strings.Contains(string(entry.After), `"cpf":"22233344405"`)
+	})).Return(nil)
+
+	service := newTestPersonService(repoMock, auditMock)
+
+	_, err := service.CreatePerson(personDto.NewPersonDTO{
+		Name:        "Jane Doe",
+		CPF:         "222.333.444-05",
+		BirthDate:   time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+		PhoneNumber: "81 99876-5432",
+		Email:       "jane.doe@example.com",
+	}, testActor)
+
+	assert.NoError(err)
+	auditMock.AssertExpectations(t)
+}
+
+func TestPersonService_PatchPerson_RecordsBeforeAndAfter(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	existing := &person.Person{ID: 5, Name: "Jane Doe", CPF: "22233344405", BirthDate: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), Contacts: primaryContacts("+5581998765432", "jane.doe@example.com"), Version: 1}
+	persisted := &person.Person{ID: 5, Name: "Jane Doe", CPF: "22233344405", Contacts: primaryContacts("+5581998765432", "jane@new.com"), Version: 2}
+
+	repoMock.On("FindByID", 5).Return(existing, nil)
+	repoMock.On("UpdateFields", mock.Anything, []string{person.FieldEmail}).Return(nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(persisted, nil)
+	auditMock.On("Save", mock.MatchedBy(func(entry *audit.AuditEntry) bool {
+		return entry.Action == audit.ActionUpdate &&
+			strings.Contains(string(entry.Before), `"email":"jane.doe@example.com"`) &&
+			strings.Contains(string(entry.Before), `"version":1`) &&
+			strings.Contains(string(entry.After), `"email":"jane@new.com"`) &&
+			strings.Contains(string(entry.After), `"version":2`)
+	})).Return(nil)
+
+	service := newTestPersonService(repoMock, auditMock)
+
+	newEmail := "jane@new.com"
+	err := service.PatchPerson(5, 1, personDto.PatchPersonDTO{Email: &newEmail}, testActor)
+
+	assert.NoError(err)
+	auditMock.AssertExpectations(t)
+}
+
+func TestPersonService_PurgePerson_RecordsWithoutAfter(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, Version: 2}, nil)
+	repoMock.On("Purge", 5, 2).Return(nil)
+	auditMock.On("Save", mock.MatchedBy(func(entry *audit.AuditEntry) bool {
+		return entry.Action == audit.ActionPurge && entry.Before != nil && entry.After == nil
+	})).Return(nil)
+
+	service := newTestPersonService(repoMock, auditMock)
+
+	err := service.PurgePerson(5, 2, testActor)
+
+	assert.NoError(err)
+	auditMock.AssertExpectations(t)
+}
+
+func TestPersonService_DeletePerson_AuditFailureFailsWrite(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	repoMock.On("FindByID", 5).Return(&person.Person{ID: 5, Version: 4}, nil)
+	repoMock.On("Delete", 5, 4).Return(nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, Version: 5}, nil)
+	auditMock.On("Save", mock.Anything).Return(errors.New("audit table unavailable"))
+
+	service := newTestPersonService(repoMock, auditMock)
+
+	err := service.DeletePerson(5, 4, testActor)
+
+	assert.ErrorContains(err, "failed to record delete in the audit trail", "the transaction is rolled back with the error")
+	auditMock.AssertExpectations(t)
+}
+
+func TestPersonService_DeletePerson_NoAuditOnFailedWrite(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	repoMock.On("FindByID", 5).Return(&person.Person{ID: 5, Version: 4}, nil)
+	repoMock.On("Delete", 5, 4).Return(personError.ErrVersionConflict)
+
+	service := newTestPersonService(repoMock, auditMock)
+
+	err := service.DeletePerson(5, 4, testActor)
+
+	assert.ErrorIs(err, personError.ErrVersionConflict)
+	auditMock.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestPersonService_PersonHistory_Success(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	entries := []*audit.AuditEntry{{ID: 1, EntityType: audit.EntityPerson, EntityID: 5, Action: audit.ActionCreate}}
+	repoMock.On("FindMergedInto", []int{5}).Return([]int{}, nil)
+	auditMock.On("FindByEntities", audit.EntityPerson, []int{5}, 1, 10).Return(entries, int64(1), nil)
+
+	service := newTestPersonService(repoMock, auditMock)
+
+	found, total, err := service.PersonHistory(5, 0, 0)
+
+	assert.NoError(err)
+	assert.Equal(entries, found)
+	assert.Equal(int64(1), total)
+	repoMock.AssertNotCalled(t, "FindByIDIncludingDeleted", mock.Anything)
+}
+
+func TestPersonService_PersonHistory_NotFound(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	repoMock.On("FindMergedInto", []int{5}).Return([]int{}, nil)
+	auditMock.On("FindByEntities", audit.EntityPerson, []int{5}, 1, 10).Return([]*audit.AuditEntry{}, int64(0), nil)
+	repoMock.On("FindByIDIncludingDeleted", 5).Return(nil, nil)
+
+	service := newTestPersonService(repoMock, auditMock)
+
+	_, _, err := service.PersonHistory(5, 1, 10)
+
+	assert.ErrorIs(err, personError.ErrPersonNotFound)
+}
+
+func TestPersonService_PersonHistory_IncludesMergedPersons(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+
+	entries := []*audit.AuditEntry{{ID: 3, EntityType: audit.EntityPerson, EntityID: 8, Action: audit.ActionMerge}}
+	repoMock.On("FindMergedInto", []int{5}).Return([]int{7}, nil)
+	repoMock.On("FindMergedInto", []int{7}).Return([]int{8}, nil)
+	repoMock.On("FindMergedInto", []int{8}).Return([]int{}, nil)
+	auditMock.On("FindByEntities", audit.EntityPerson, []int{5, 7, 8}, 1, 10).Return(entries, int64(1), nil)
+
+	service := newTestPersonService(repoMock, auditMock)
+
+	found, total, err := service.PersonHistory(5, 1, 10)
+
+	assert.NoError(err)
+	assert.Equal(entries, found)
+	assert.Equal(int64(1), total)
+}
+
+func personsWithIDs(ids ...int) []*person.Person {
+	persons := make([]*person.Person, len(ids))
+	for i, id := range ids {
+		persons[i] = &person.Person{ID: id}
+	}
+	return persons
+}
+
+func TestPersonService_ListPersonsByCursor_FirstPage(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	repoMock.On("FindAfter", (*person.Cursor)(nil), 3, "id", "desc", person.PersonFilter{}).Return(personsWithIDs(9, 8, 7), nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	page, err := service.ListPersonsByCursor(nil, 2, "", "", person.PersonFilter{}, false)
+
+	assert.NoError(err)
+	assert.Len(page.Persons, 2)
+	assert.Equal(9, page.Persons[0].ID)
+	assert.Equal(&person.Cursor{Sort: "id", Order: "desc", Value: "8", ID: 8}, page.Next)
+	assert.Nil(page.Prev)
+	assert.Nil(page.Total)
+	repoMock.AssertNotCalled(t, "Count", mock.Anything)
+}
+
+func TestPersonService_ListPersonsByCursor_LastPageWithTotal(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	cursor := &person.Cursor{Sort: "id", Order: "desc", Value: "8", ID: 8}
+	repoMock.On("FindAfter", cursor, 3, "id", "desc", person.PersonFilter{}).Return(personsWithIDs(7), nil)
+	repoMock.On("Count", person.PersonFilter{}).Return(int64(3), nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	page, err := service.ListPersonsByCursor(cursor, 2, "id", "desc", person.PersonFilter{}, true)
+
+	assert.NoError(err)
+	assert.Len(page.Persons, 1)
+	assert.Nil(page.Next)
+	assert.Equal(&person.Cursor{Sort: "id", Order: "desc", Value: "7", ID: 7, Backward: true}, page.Prev)
+	assert.Equal(int64(3), *page.Total)
+}
+
+func TestPersonService_ListPersonsByCursor_BackwardPageIsReversed(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	cursor := &person.Cursor{Sort: "id", Order: "desc", Value: "5", ID: 5, Backward: true}
+	repoMock.On("FindAfter", cursor, 3, "id", "desc", person.PersonFilter{}).Return(personsWithIDs(6, 7, 8), nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	page, err := service.ListPersonsByCursor(cursor, 2, "id", "desc", person.PersonFilter{}, false)
+
+	assert.NoError(err)
+	assert.Equal(7, page.Persons[0].ID)
+	assert.Equal(6, page.Persons[1].ID)
+	assert.Equal(&person.Cursor{Sort: "id", Order: "desc", Value: "7", ID: 7, Backward: true}, page.Prev)
+	assert.Equal(&person.Cursor{Sort: "id", Order: "desc", Value: "6", ID: 6}, page.Next)
+}
+
+func TestPersonService_ListPersonsByCursor_RejectsCursorOfOtherOrdering(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+
+	cursor := &person.Cursor{Sort: "name", Order: "asc", Value: "Ana", ID: 3}
+	_, err := service.ListPersonsByCursor(cursor, 10, "id", "desc", person.PersonFilter{}, false)
+
+	assert.ErrorIs(err, personError.ErrInvalidCursor)
+	repoMock.AssertNotCalled(t, "FindAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// recordingRowWriter keeps the exported rows in memory.
+type recordingRowWriter struct {
+	header []string
+	rows   [][]any
+	closed bool
+	err    error
+}
+
+func (w *recordingRowWriter) WriteHeader(columns []string) error {
+	w.header = columns
+	return nil
+}
+
+func (w *recordingRowWriter) WriteRow(values []any) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.rows = append(w.rows, values)
+	return nil
+}
+
+func (w *recordingRowWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestPersonService_ExportPersons_WritesEveryPerson(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	filter := person.PersonFilter{Name: "silva"}
+
+	repoMock.On("Stream", "id", "asc", filter, mock.Anything).Return(personsWithIDs(1, 2, 3), nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+	writer := &recordingRowWriter{}
+
+	written, err := service.ExportPersons(writer, "", "", filter, person.ExportOptions{Columns: []string{"id", "name"}})
+
+	assert.NoError(err)
+	assert.Equal(3, written)
+	assert.Equal([]string{"id", "name"}, writer.header)
+	assert.Len(writer.rows, 3)
+	assert.Equal(2, writer.rows[1][0])
+	assert.True(writer.closed)
+	repoMock.AssertExpectations(t)
+}
+
+func TestPersonService_ExportPersons_DefaultsToAllColumns(t *testing.T) {
+	repoMock := new(repositoryMock)
+	repoMock.On("Stream", "name", "desc", person.PersonFilter{}, mock.Anything).Return(nil, nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+	writer := &recordingRowWriter{}
+
+	written, err := service.ExportPersons(writer, "name", "desc", person.PersonFilter{}, person.ExportOptions{})
+
+	assert.NoError(t, err)
+	assert.Zero(t, written)
+	assert.Equal(t, person.ExportColumns, writer.header)
+}
+
+func TestPersonService_ExportPersons_RejectsUnknownColumn(t *testing.T) {
+	repoMock := new(repositoryMock)
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+	writer := &recordingRowWriter{}
+
+	_, err := service.ExportPersons(writer, "", "", person.PersonFilter{}, person.ExportOptions{Columns: []string{"name", "password"}})
+
+	assert.ErrorIs(t, err, personError.ErrUnknownColumn)
+	assert.Nil(t, writer.header)
+	repoMock.AssertNotCalled(t, "Stream", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPersonService_ExportPersons_StopsOnWriteError(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	repoMock.On("Stream", "id", "asc", person.PersonFilter{}, mock.Anything).Return(personsWithIDs(1, 2), nil)
+
+	service := newTestPersonService(repoMock, newAuditRepositoryMock())
+	writer := &recordingRowWriter{err: errors.New("broken pipe")}
+
+	written, err := service.ExportPersons(writer, "", "", person.PersonFilter{}, person.ExportOptions{})
+
+	assert.EqualError(err, "broken pipe")
+	assert.Zero(written)
+	assert.False(writer.closed)
+}
+
+func TestPersonService_RevealPerson_RecordsReason(t *testing.T) {
+	assert := assert.New(t)
+	repoMock := new(repositoryMock)
+	auditMock := new(auditRepositoryMock)
+	service := newTestPersonService(repoMock, auditMock)
+
+	found := &person.Person{ID: 1, Name: "John Doe", CPF: "11144477735"}
+	repoMock.On("FindByID", 1).Return(found, nil)
+	auditMock.On("Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityPerson && e.EntityID == 1 && e.Action == audit.ActionReveal &&
+			e.OperatorID == testActor.OperatorID && e.Before == nil &&
+			string(e.After) == `{"id":1,"reason":"identity check"}`
+	})).Return(nil)
+
+	revealed, err := service.RevealPerson(1, personDto.RevealPersonDTO{Reason: " identity check "}, testActor)
+
+	assert.NoError(err)
+	assert.Equal(found, revealed)
+	auditMock.AssertExpectations(t)
+}
+
+func TestPersonService_RevealPerson_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		reason   string
+		found    *person.Person
+		auditErr error
+		expected error
+	}{
+		{name: "blank reason", reason: "  ", expected: personError.ErrRevealReasonRequired},
+		{name: "reason too long", reason: strings.Repeat("x", 256), expected: personError.ErrRevealReasonTooLong},
+		{name: "person not found", reason: "identity check", expected: personError.ErrPersonNotFound},
+		{name: "audit failure", reason: "identity check", found: &person.Person{ID: 1}, auditErr: errors.New("database error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoMock := new(repositoryMock)
+			auditMock := new(auditRepositoryMock)
+			service := newTestPersonService(repoMock, auditMock)
+
+			if tt.found != nil {
+				repoMock.On("FindByID", 1).Return(tt.found, nil)
+			} else {
+				repoMock.On("FindByID", 1).Return(nil, nil)
+			}
+			auditMock.On("Save", mock.Anything).Return(tt.auditErr)
+
+			revealed, err := service.RevealPerson(1, personDto.RevealPersonDTO{Reason: tt.reason}, testActor)
+
+			assert.Error(t, err)
+			if tt.expected != nil {
+				assert.ErrorIs(t, err, tt.expected)
+				auditMock.AssertNotCalled(t, "Save", mock.Anything)
+			}
+			assert.Nil(t, revealed, "unaudited reveals return no data")
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package person
+
+import (
+	"encoding/json"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// maxGraphDepth bounds how many links FamilyGraph walks from a person.
+const maxGraphDepth = 5
+
+// RelationshipServiceImpl implements the ports.RelationshipService interface.
+// A relationship links two active persons and is stored once, in canonical
+// form; each end sees it with its own type (a guardian sees a ward). Every
+// write is recorded in the audit trail under the relationship ID, within its
+// transaction.
+type RelationshipServiceImpl struct {
+	repository       ports.RelationshipRepository
+	personRepository ports.PersonRepository
+	transactor       ports.Transactor
+}
+
+// NewRelationshipService creates a new instance of RelationshipServiceImpl.
+// It returns the implementation as the RelationshipService interface.
+func NewRelationshipService(repository ports.RelationshipRepository, personRepository ports.PersonRepository, transactor ports.Transactor) ports.RelationshipService {
+	return &RelationshipServiceImpl{
+		repository:       repository,
+		personRepository: personRepository,
+		transactor:       transactor,
+	}
+}
+
+func (s *RelationshipServiceImpl) ListRelationships(personID int) ([]*person.Relationship, error) {
+	if _, err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	relationships, err := s.repository.FindByPersons([]int{personID})
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]*person.Relationship, len(relationships))
+	for i, relationship := range relationships {
+		views[i] = relationship.From(personID)
+	}
+
+	return views, nil
+}
+
+func (s *RelationshipServiceImpl) FindRelationship(personID, id int) (*person.Relationship, error) {
+	if _, err := s.requirePerson(personID); err != nil {
+		return nil, err
+	}
+
+	relationship, err := findRelationship(s.repository, personID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return relationship.From(personID), nil
+}
+
+// AddRelationship links the related person to the person with the type given
+// as seen from the person. A link already stored in either direction is a
+// conflict, and a parent link may not make a person their own ancestor.
+func (s *RelationshipServiceImpl) AddRelationship(personID int, dto contract.RelationshipDTO, actor audit.Actor) (*person.Relationship, error) {
+	holder, err := s.requirePerson(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	related, err := s.personRepository.FindByID(dto.RelatedPersonID)
+	if err != nil {
+		return nil, err
+	}
+	if related == nil {
+		return nil, personError.ErrRelatedPersonNotFound
+	}
+
+	relationship, err := person.NewRelationship(holder, related, dto.Type, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repository.FindByPersons([]int{personID})
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range existing {
+		if other.SameLink(relationship) {
+			return nil, personError.ErrRelationshipExists
+		}
+	}
+
+	if relationship.Type == person.RelationshipParent {
+		if err := s.requireNoParentCycle(relationship.PersonID, relationship.RelatedID); err != nil {
+			return nil, err
+		}
+	}
+
+	var saved *person.Relationship
+	err = s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		id, err := tx.Relationships.Save(relationship)
+		if err != nil {
+			return err
+		}
+
+		saved, err = findRelationship(tx.Relationships, personID, id)
+		if err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityRelationship, id, audit.ActionCreate, actor, nil, relationshipSnapshot(saved))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved.From(personID), nil
+}
+
+// DeleteRelationship removes a relationship from both of its ends.
+func (s *RelationshipServiceImpl) DeleteRelationship(personID, id int, actor audit.Actor) error {
+	if _, err := s.requirePerson(personID); err != nil {
+		return err
+	}
+
+	existing, err := findRelationship(s.repository, personID, id)
+	if err != nil {
+		return err
+	}
+
+	return s.transactor.WithinTransaction(func(tx ports.Repositories) error {
+		if err := tx.Relationships.Delete(id); err != nil {
+			return err
+		}
+
+		return recordEntry(tx.Audits, audit.EntityRelationship, id, audit.ActionDelete, actor, relationshipSnapshot(existing), nil)
+	})
+}
+
+// FamilyGraph walks the relationships of a person breadth first, up to depth
+// links away, and returns the persons reached with the relationships among
+// them. Members are ordered by distance and then by the order they were reached.
+func (s *RelationshipServiceImpl) FamilyGraph(personID, depth int) (*person.FamilyGraph, error) {
+	if depth < 1 || depth > maxGraphDepth {
+		return nil, personError.ErrGraphDepthInvalid
+	}
+
+	root, err := s.requirePerson(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &person.FamilyGraph{
+		PersonID:      personID,
+		Depth:         depth,
+		Members:       []person.FamilyMember{{Person: root, Distance: 0}},
+		Relationships: []*person.Relationship{},
+	}
+
+	distances := map[int]int{personID: 0}
+	linked := map[int]bool{}
+	frontier := []int{personID}
+
+	// The walk goes one level past depth to pick up the relationships among the
+	// farthest members, without adding the persons they lead to.
+	for distance := 1; len(frontier) > 0; distance++ {
+		relationships, err := s.repository.FindByPersons(frontier)
+		if err != nil {
+			return nil, err
+		}
+
+		var next []int
+		for _, relationship := range relationships {
+			for _, id := range []int{relationship.PersonID, relationship.RelatedID} {
+				if _, reached := distances[id]; !reached && distance <= depth {
+					distances[id] = distance
+					next = append(next, id)
+				}
+			}
+
+			_, personReached := distances[relationship.PersonID]
+			_, relatedReached := distances[relationship.RelatedID]
+			if personReached && relatedReached && !linked[relationship.ID] {
+				linked[relationship.ID] = true
+				graph.Relationships = append(graph.Relationships, relationship)
+			}
+		}
+
+		for _, id := range next {
+			member, err := s.personRepository.FindByID(id)
+			if err != nil {
+				return nil, err
+			}
+			if member != nil {
+				graph.Members = append(graph.Members, person.FamilyMember{Person: member, Distance: distance})
+			}
+		}
+
+		frontier = next
+	}
+
+	return graph, nil
+}
+
+// requireNoParentCycle rejects making parentID a parent of childID when the
+// child is already an ancestor of the parent.
+func (s *RelationshipServiceImpl) requireNoParentCycle(childID, parentID int) error {
+	visited := map[int]bool{parentID: true}
+	generation := []int{parentID}
+
+	for len(generation) > 0 {
+		relationships, err := s.repository.FindByPersons(generation)
+		if err != nil {
+			return err
+		}
+
+		var parents []int
+		for _, relationship := range relationships {
+			if relationship.Type != person.RelationshipParent || !visited[relationship.PersonID] {
+				continue
+			}
+
+			ancestor := relationship.RelatedID
+			if ancestor == childID {
+				return personError.ErrParentCycle
+			}
+			if !visited[ancestor] {
+				visited[ancestor] = true
+				parents = append(parents, ancestor)
+			}
+		}
+
+		generation = parents
+	}
+
+	return nil
+}
+
+// requirePerson loads a person that must exist and not be deleted.
+func (s *RelationshipServiceImpl) requirePerson(personID int) (*person.Person, error) {
+	holder, err := s.personRepository.FindByID(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	if holder == nil {
+		return nil, personError.ErrPersonNotFound
+	}
+
+	return holder, nil
+}
+
+// findRelationship loads a relationship of the person, in canonical form.
+func findRelationship(relationships ports.RelationshipRepository, personID, id int) (*person.Relationship, error) {
+	relationship, err := relationships.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if relationship == nil || !relationship.Involves(personID) {
+		return nil, personError.ErrRelationshipNotFound
+	}
+
+	return relationship, nil
+}
+
+func relationshipSnapshot(r *person.Relationship) json.RawMessage {
+	if r == nil {
+		return nil
+	}
+
+	snapshot, err := json.Marshal(contract.NewRelationshipResponseDTO(r))
+	if err != nil {
+		return nil
+	}
+
+	return snapshot
+}
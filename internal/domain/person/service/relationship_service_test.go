@@ -0,0 +1,241 @@
+package person
+
+import (
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type relationshipRepositoryMock struct {
+	mock.Mock
+}
+
+func (r *relationshipRepositoryMock) Save(relationship *person.Relationship) (int, error) {
+	args := r.Called(relationship)
+	return args.Int(0), args.Error(1)
+}
+
+func (r *relationshipRepositoryMock) Delete(id int) error {
+	args := r.Called(id)
+	return args.Error(0)
+}
+
+func (r *relationshipRepositoryMock) FindByID(id int) (*person.Relationship, error) {
+	args := r.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Relationship), args.Error(1)
+}
+
+func (r *relationshipRepositoryMock) FindByPersons(personIDs []int) ([]*person.Relationship, error) {
+	args := r.Called(personIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Relationship), args.Error(1)
+}
+
+func (r *relationshipRepositoryMock) FindAllByPersons(personIDs []int) ([]*person.Relationship, error) {
+	args := r.Called(personIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Relationship), args.Error(1)
+}
+
+func bornIn(id, year int) *person.Person {
+	return &person.Person{ID: id, Name: "Person", BirthDate: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func link(id, personID, relatedID int, relationshipType string) *person.Relationship {
+	return &person.Relationship{ID: id, PersonID: personID, RelatedID: relatedID, Type: relationshipType}
+}
+
+// newRelationshipService wires a relationship service whose persons are the given ones.
+func newRelationshipService(persons ...*person.Person) (*RelationshipServiceImpl, *relationshipRepositoryMock, *repositoryMock, *auditRepositoryMock) {
+	relationshipMock := new(relationshipRepositoryMock)
+	personMock := new(repositoryMock)
+	auditMock := newAuditRepositoryMock()
+	for _, p := range persons {
+		personMock.On("FindByID", p.ID).Return(p, nil)
+	}
+
+	service := NewRelationshipService(relationshipMock, personMock, transactorMock{ports.Repositories{Relationships: relationshipMock, Audits: auditMock}}).(*RelationshipServiceImpl)
+	return service, relationshipMock, personMock, auditMock
+}
+
+func TestRelationshipService_AddRelationship_Success(t *testing.T) {
+	assert := assert.New(t)
+	service, relationshipMock, _, auditMock := newRelationshipService(bornIn(1, 1980), bornIn(2, 2015))
+
+	relationshipMock.On("FindByPersons", []int{1}).Return([]*person.Relationship{}, nil)
+	relationshipMock.On("Save", mock.MatchedBy(func(r *person.Relationship) bool {
+		return r.PersonID == 2 && r.RelatedID == 1 && r.Type == person.RelationshipGuardian
+	})).Return(3, nil)
+	relationshipMock.On("FindByID", 3).Return(link(3, 2, 1, person.RelationshipGuardian), nil)
+
+	relationship, err := service.AddRelationship(1, contract.RelationshipDTO{RelatedPersonID: 2, Type: "ward"}, testActor)
+
+	assert.NoError(err)
+	assert.Equal(&person.Relationship{ID: 3, PersonID: 1, RelatedID: 2, Type: person.RelationshipWard}, relationship, "returned as seen from the person")
+	auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+		return e.EntityType == audit.EntityRelationship && e.EntityID == 3 && e.Action == audit.ActionCreate && e.Before == nil
+	}))
+}
+
+func TestRelationshipService_AddRelationship_ShouldRejectInvalidLinks(t *testing.T) {
+	tests := []struct {
+		name        string
+		dto         contract.RelationshipDTO
+		existing    []*person.Relationship
+		expectedErr error
+	}{
+		{"related person not found", contract.RelationshipDTO{RelatedPersonID: 9, Type: "spouse"}, nil, personError.ErrRelatedPersonNotFound},
+		{"minor guardian", contract.RelationshipDTO{RelatedPersonID: 2, Type: "guardian"}, nil, personError.ErrGuardianNotAdult},
+		{"self link", contract.RelationshipDTO{RelatedPersonID: 1, Type: "spouse"}, nil, personError.ErrRelationshipSelf},
+		{"already linked from the other end", contract.RelationshipDTO{RelatedPersonID: 2, Type: "child"}, []*person.Relationship{link(5, 2, 1, person.RelationshipParent)}, personError.ErrRelationshipExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, relationshipMock, personMock, _ := newRelationshipService(bornIn(1, 1980), bornIn(2, 2015))
+			personMock.On("FindByID", 9).Return(nil, nil)
+			relationshipMock.On("FindByPersons", []int{1}).Return(tt.existing, nil)
+
+			_, err := service.AddRelationship(1, tt.dto, testActor)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			relationshipMock.AssertNotCalled(t, "Save", mock.Anything)
+		})
+	}
+}
+
+func TestRelationshipService_AddRelationship_ShouldRejectParentCycle(t *testing.T) {
+	// Birth dates were corrected after 3 became a child of 2 and 2 a child of 1,
+	// so only the ancestry walk stops 3 from becoming a parent of 1.
+	service, relationshipMock, _, _ := newRelationshipService(bornIn(1, 1950), bornIn(2, 1970), bornIn(3, 1940))
+
+	childOf2 := link(10, 3, 2, person.RelationshipParent)
+	childOf1 := link(11, 2, 1, person.RelationshipParent)
+	relationshipMock.On("FindByPersons", []int{1}).Return([]*person.Relationship{childOf1}, nil)
+	relationshipMock.On("FindByPersons", []int{3}).Return([]*person.Relationship{childOf2}, nil)
+	relationshipMock.On("FindByPersons", []int{2}).Return([]*person.Relationship{childOf2, childOf1}, nil)
+
+	_, err := service.AddRelationship(1, contract.RelationshipDTO{RelatedPersonID: 3, Type: "parent"}, testActor)
+
+	assert.ErrorIs(t, err, personError.ErrParentCycle)
+	relationshipMock.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestRelationshipService_ListRelationships(t *testing.T) {
+	service, relationshipMock, _, _ := newRelationshipService(bornIn(1, 1980))
+	relationshipMock.On("FindByPersons", []int{1}).Return([]*person.Relationship{
+		link(3, 2, 1, person.RelationshipParent),
+		link(4, 1, 5, person.RelationshipSpouse),
+	}, nil)
+
+	relationships, err := service.ListRelationships(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*person.Relationship{
+		link(3, 1, 2, person.RelationshipChild),
+		link(4, 1, 5, person.RelationshipSpouse),
+	}, relationships)
+}
+
+func TestRelationshipService_DeleteRelationship(t *testing.T) {
+	tests := []struct {
+		name        string
+		stored      *person.Relationship
+		expectedErr error
+	}{
+		{"from the related end", link(3, 2, 1, person.RelationshipParent), nil},
+		{"of other persons", link(3, 2, 4, person.RelationshipParent), personError.ErrRelationshipNotFound},
+		{"not found", nil, personError.ErrRelationshipNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, relationshipMock, _, auditMock := newRelationshipService(bornIn(1, 1980))
+			if tt.stored != nil {
+				relationshipMock.On("FindByID", 3).Return(tt.stored, nil)
+			} else {
+				relationshipMock.On("FindByID", 3).Return(nil, nil)
+			}
+			relationshipMock.On("Delete", 3).Return(nil)
+
+			err := service.DeleteRelationship(1, 3, testActor)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				relationshipMock.AssertNotCalled(t, "Delete", mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			auditMock.AssertCalled(t, "Save", mock.MatchedBy(func(e *audit.AuditEntry) bool {
+				return e.Action == audit.ActionDelete && e.EntityID == 3 && e.After == nil
+			}))
+		})
+	}
+}
+
+func TestRelationshipService_FamilyGraph(t *testing.T) {
+	// 2 is the parent of 1, 4 the spouse of 2 and 5 the parent of 2.
+	parentOf1 := link(1, 1, 2, person.RelationshipParent)
+	spouseOf2 := link(2, 2, 4, person.RelationshipSpouse)
+	parentOf2 := link(3, 2, 5, person.RelationshipParent)
+
+	tests := []struct {
+		name                  string
+		depth                 int
+		expectedMembers       []int
+		expectedDistances     []int
+		expectedRelationships int
+	}{
+		{"one link", 1, []int{1, 2}, []int{0, 1}, 1},
+		{"two links", 2, []int{1, 2, 4, 5}, []int{0, 1, 2, 2}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, relationshipMock, _, _ := newRelationshipService(bornIn(1, 2010), bornIn(2, 1980), bornIn(4, 1982), bornIn(5, 1950))
+			relationshipMock.On("FindByPersons", []int{1}).Return([]*person.Relationship{parentOf1}, nil)
+			relationshipMock.On("FindByPersons", []int{2}).Return([]*person.Relationship{parentOf1, spouseOf2, parentOf2}, nil)
+			relationshipMock.On("FindByPersons", []int{4, 5}).Return([]*person.Relationship{spouseOf2, parentOf2}, nil)
+
+			graph, err := service.FamilyGraph(1, tt.depth)
+
+			assert.NoError(t, err)
+			var members, distances []int
+			for _, member := range graph.Members {
+				members = append(members, member.Person.ID)
+				distances = append(distances, member.Distance)
+			}
+			assert.Equal(t, tt.expectedMembers, members)
+			assert.Equal(t, tt.expectedDistances, distances)
+			assert.Len(t, graph.Relationships, tt.expectedRelationships)
+		})
+	}
+}
+
+func TestRelationshipService_FamilyGraph_ShouldValidateDepth(t *testing.T) {
+	service, _, personMock, _ := newRelationshipService(bornIn(1, 1980))
+	personMock.On("FindByID", 9).Return(nil, nil)
+
+	_, zeroErr := service.FamilyGraph(1, 0)
+	_, deepErr := service.FamilyGraph(1, 6)
+	_, missingErr := service.FamilyGraph(9, 1)
+
+	assert.ErrorIs(t, zeroErr, personError.ErrGraphDepthInvalid)
+	assert.ErrorIs(t, deepErr, personError.ErrGraphDepthInvalid)
+	assert.ErrorIs(t, missingErr, personError.ErrPersonNotFound)
+}
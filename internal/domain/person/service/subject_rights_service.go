@@ -0,0 +1,238 @@
+package person
+
+import (
+	"fmt"
+	"log"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	auditPorts "pessoas-api/internal/domain/audit/ports"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	personUtils "pessoas-api/internal/domain/person/utils"
+)
+
+// SubjectRightsServiceImpl implements the ports.SubjectRightsService interface.
+// A data subject is every person registered with their CPF, deleted or not,
+// together with the persons merged into them. The request log is written
+// before any data is returned, so a request that cannot be logged fails.
+type SubjectRightsServiceImpl struct {
+	repository             ports.SubjectRightsRepository
+	personRepository       ports.PersonRepository
+	addressRepository      ports.AddressRepository
+	documentRepository     ports.DocumentRepository
+	relationshipRepository ports.RelationshipRepository
+	consentRepository      ports.ConsentRepository
+	auditRepository        auditPorts.AuditRepository
+}
+
+// NewSubjectRightsService creates a new instance of SubjectRightsServiceImpl.
+// It returns the implementation as the SubjectRightsService interface.
+func NewSubjectRightsService(repository ports.SubjectRightsRepository, personRepository ports.PersonRepository, addressRepository ports.AddressRepository, documentRepository ports.DocumentRepository, relationshipRepository ports.RelationshipRepository, consentRepository ports.ConsentRepository, auditRepository auditPorts.AuditRepository) ports.SubjectRightsService {
+	return &SubjectRightsServiceImpl{
+		repository:             repository,
+		personRepository:       personRepository,
+		addressRepository:      addressRepository,
+		documentRepository:     documentRepository,
+		relationshipRepository: relationshipRepository,
+		consentRepository:      consentRepository,
+		auditRepository:        auditRepository,
+	}
+}
+
+// SubjectReport gathers everything stored about the holder of the CPF for an
+// access or portability request. Requests matching no person are logged and
+// return ErrPersonNotFound.
+func (s *SubjectRightsServiceImpl) SubjectReport(cpf, requestType string, actor audit.Actor) (*person.SubjectReport, error) {
+	if requestType != person.SubjectRequestAccess && requestType != person.SubjectRequestPortability {
+		return nil, personError.ErrSubjectRequestTypeInvalid
+	}
+
+	cpfDigits, persons, err := s.subjectPersons(cpf)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(persons) == 0 {
+		return nil, s.recordNotFound(requestType, cpfDigits, actor)
+	}
+
+	report, err := s.buildReport(persons)
+	if err != nil {
+		return nil, err
+	}
+
+	request := person.NewSubjectRequest(requestType, cpfDigits, personIDs(persons), actor)
+	if _, err := s.repository.Save(request); err != nil {
+		return nil, err
+	}
+
+	report.RequestID = request.ID
+	report.CPF = cpfDigits
+	report.GeneratedAt = request.CreatedAt
+
+	return report, nil
+}
+
+// AnonymizeSubject irreversibly scrubs the personal data of the holder of the
+// CPF and returns the logged request. The anonymized persons keep their IDs,
+// so records referencing them stay valid, but their CPF is replaced and they
+// can no longer be found by it.
+func (s *SubjectRightsServiceImpl) AnonymizeSubject(cpf string, actor audit.Actor) (*person.SubjectRequest, error) {
+	cpfDigits, persons, err := s.subjectPersons(cpf)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(persons) == 0 {
+		return nil, s.recordNotFound(person.SubjectRequestAnonymization, cpfDigits, actor)
+	}
+
+	ids := personIDs(persons)
+	request := person.NewSubjectRequest(person.SubjectRequestAnonymization, cpfDigits, ids, actor)
+
+	err = s.repository.Anonymize(ids, request, func(tx ports.Repositories) error {
+		return recordAnonymization(tx, actor, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (s *SubjectRightsServiceImpl) ListSubjectRequests(page, pageSize int) ([]*person.SubjectRequest, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	return s.repository.FindRequests(page, pageSize)
+}
+
+// subjectPersons validates the CPF and loads the persons registered with it,
+// followed by the persons merged into them.
+func (s *SubjectRightsServiceImpl) subjectPersons(cpf string) (string, []*person.Person, error) {
+	cpfDigits := personUtils.OnlyDigits(cpf)
+	if !person.ValidCPF(cpfDigits) {
+		return "", nil, personError.ErrCPFInvalid
+	}
+
+	persons, err := s.personRepository.FindAllByCPF(cpfDigits)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(persons) == 0 {
+		return cpfDigits, persons, nil
+	}
+
+	ids, err := withMergedPersons(s.personRepository, personIDs(persons))
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, mergedID := range ids[len(persons):] {
+		merged, err := s.personRepository.FindByIDIncludingDeleted(mergedID)
+		if err != nil {
+			return "", nil, err
+		}
+		if merged != nil {
+			persons = append(persons, merged)
+		}
+	}
+
+	return cpfDigits, persons, nil
+}
+
+// buildReport loads the records owned by the persons, the relationships
+// involving them, their change history and their consent history.
+func (s *SubjectRightsServiceImpl) buildReport(persons []*person.Person) (*person.SubjectReport, error) {
+	report := &person.SubjectReport{
+		Records: make([]person.SubjectRecord, len(persons)),
+	}
+
+	for i, p := range persons {
+		addresses, err := s.addressRepository.FindByPerson(p.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		documents, err := s.documentRepository.FindByPerson(p.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		consents, err := s.consentRepository.FindByPerson(p.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Records[i] = person.SubjectRecord{Person: p, Addresses: addresses, Documents: documents, Consents: consents}
+	}
+
+	ids := personIDs(persons)
+
+	relationships, err := s.relationshipRepository.FindAllByPersons(ids)
+	if err != nil {
+		return nil, err
+	}
+	report.Relationships = relationships
+
+	history, err := s.auditRepository.FindBySubject(ids)
+	if err != nil {
+		return nil, err
+	}
+	report.History = history
+
+	consentHistory, err := s.consentRepository.FindEvents(ids)
+	if err != nil {
+		return nil, err
+	}
+	report.ConsentHistory = consentHistory
+
+	return report, nil
+}
+
+// recordNotFound logs a request matching no person and returns the error to
+// answer it with.
+func (s *SubjectRightsServiceImpl) recordNotFound(requestType, cpf string, actor audit.Actor) error {
+	if _, err := s.repository.Save(person.NewSubjectRequest(requestType, cpf, nil, actor)); err != nil {
+		return err
+	}
+
+	return personError.ErrPersonNotFound
+}
+
+// recordAnonymization writes the anonymization to the audit trail of every
+// person, within the anonymization transaction. Only the scrubbed state is
+// recorded, so the entry itself holds no personal data. A failure is returned
+// so that the anonymization is rolled back.
+func recordAnonymization(tx ports.Repositories, actor audit.Actor, ids []int) error {
+	entries := make([]*audit.AuditEntry, 0, len(ids))
+	for _, id := range ids {
+		anonymized, err := tx.Persons.FindByIDIncludingDeleted(id)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, audit.NewAuditEntry(audit.EntityPerson, id, audit.ActionAnonymize, actor, nil, personSnapshot(anonymized)))
+	}
+
+	if err := tx.Audits.SaveAll(entries); err != nil {
+		log.Printf("[ERROR] SubjectRightsService - Failed to record anonymization of persons %v by operator %d: %v", ids, actor.OperatorID, err)
+		return fmt.Errorf("failed to record anonymization in the audit trail: %w", err)
+	}
+
+	return nil
+}
+
+func personIDs(persons []*person.Person) []int {
+	ids := make([]int, len(persons))
+	for i, p := range persons {
+		ids[i] = p.ID
+	}
+	return ids
+}
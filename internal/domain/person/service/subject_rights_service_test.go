@@ -0,0 +1,243 @@
+package person
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// subjectRightsRepositoryMock records the anonymization on the repositories
+// in repos, as the anonymization transaction would on the real ones.
+type subjectRightsRepositoryMock struct {
+	mock.Mock
+	repos ports.Repositories
+}
+
+func (r *subjectRightsRepositoryMock) Save(request *person.SubjectRequest) (int, error) {
+	args := r.Called(request)
+	request.ID = args.Int(0)
+	return args.Int(0), args.Error(1)
+}
+
+func (r *subjectRightsRepositoryMock) FindRequests(page, size int) ([]*person.SubjectRequest, int64, error) {
+	args := r.Called(page, size)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*person.SubjectRequest), args.Get(1).(int64), args.Error(2)
+}
+
+func (r *subjectRightsRepositoryMock) Anonymize(personIDs []int, request *person.SubjectRequest, record func(tx ports.Repositories) error) error {
+	args := r.Called(personIDs, request)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return record(r.repos)
+}
+
+type subjectRightsMocks struct {
+	repository   *subjectRightsRepositoryMock
+	person       *repositoryMock
+	address      *addressRepositoryMock
+	document     *documentRepositoryMock
+	relationship *relationshipRepositoryMock
+	consent      *consentRepositoryMock
+	audit        *auditRepositoryMock
+}
+
+func newSubjectRightsService() (*SubjectRightsServiceImpl, subjectRightsMocks) {
+	mocks := subjectRightsMocks{
+		repository:   new(subjectRightsRepositoryMock),
+		person:       new(repositoryMock),
+		address:      new(addressRepositoryMock),
+		document:     new(documentRepositoryMock),
+		relationship: new(relationshipRepositoryMock),
+		consent:      new(consentRepositoryMock),
+		audit:        new(auditRepositoryMock),
+	}
+
+	mocks.repository.repos = ports.Repositories{Persons: mocks.person, Audits: mocks.audit}
+	service := NewSubjectRightsService(mocks.repository, mocks.person, mocks.address, mocks.document, mocks.relationship, mocks.consent, mocks.audit).(*SubjectRightsServiceImpl)
+	return service, mocks
+}
+
+func TestSubjectRightsService_SubjectReport_IncludesMergedPersons(t *testing.T) {
+	assert := assert.New(t)
+	service, mocks := newSubjectRightsService()
+
+	deletedAt := time.Now()
+	current := namedPerson(5, "José da Silva", "11144477735", "jose@example.com", 2)
+	previous := namedPerson(3, "José da Silva", "11144477735", "jose@example.com", 4)
+	previous.DeletedAt = &deletedAt
+	merged := namedPerson(8, "Jose da Silva", "12144477752", "jose.silva@example.com", 2)
+	merged.DeletedAt = &deletedAt
+	merged.MergedIntoID = &current.ID
+
+	mocks.person.On("FindAllByCPF", "11144477735").Return([]*person.Person{previous, current}, nil)
+	mocks.person.On("FindMergedInto", []int{3, 5}).Return([]int{8}, nil)
+	mocks.person.On("FindMergedInto", []int{8}).Return([]int{}, nil)
+	mocks.person.On("FindByIDIncludingDeleted", 8).Return(merged, nil)
+	mocks.address.On("FindByPerson", 3).Return([]*person.Address{}, nil)
+	mocks.address.On("FindByPerson", 5).Return([]*person.Address{{ID: 1, PersonID: 5}}, nil)
+	mocks.address.On("FindByPerson", 8).Return([]*person.Address{}, nil)
+	for _, id := range []int{3, 5, 8} {
+		mocks.document.On("FindByPerson", id).Return([]*person.Document{}, nil)
+	}
+	mocks.consent.On("FindByPerson", 3).Return([]*person.Consent{}, nil)
+	mocks.consent.On("FindByPerson", 5).Return([]*person.Consent{storedConsent(7, 5, person.ConsentMarketing, nil)}, nil)
+	mocks.consent.On("FindByPerson", 8).Return([]*person.Consent{}, nil)
+	consentHistory := []*person.ConsentEvent{{ID: 1, ConsentID: 7, PersonID: 5, Action: person.ConsentActionGrant}}
+	mocks.consent.On("FindEvents", []int{3, 5, 8}).Return(consentHistory, nil)
+	mocks.relationship.On("FindAllByPersons", []int{3, 5, 8}).Return([]*person.Relationship{link(2, 5, 9, person.RelationshipParent)}, nil)
+	history := []*audit.AuditEntry{audit.NewAuditEntry(audit.EntityPerson, 5, audit.ActionCreate, testActor, nil, nil)}
+	mocks.audit.On("FindBySubject", []int{3, 5, 8}).Return(history, nil)
+	mocks.repository.On("Save", mock.MatchedBy(func(r *person.SubjectRequest) bool {
+		return r.Type == person.SubjectRequestAccess && r.SubjectCPF == "***.444.777-**" &&
+			r.Status == person.SubjectRequestCompleted && len(r.PersonIDs) == 3 && r.OperatorID == testActor.OperatorID
+	})).Return(12, nil)
+
+	report, err := service.SubjectReport("111.444.777-35", person.SubjectRequestAccess, testActor)
+
+	assert.NoError(err)
+	assert.Equal(12, report.RequestID)
+	assert.Equal("11144477735", report.CPF)
+	assert.Len(report.Records, 3)
+	assert.Same(previous, report.Records[0].Person)
+	assert.Same(merged, report.Records[2].Person)
+	assert.Len(report.Records[1].Addresses, 1)
+	assert.Len(report.Records[1].Consents, 1)
+	assert.Equal(consentHistory, report.ConsentHistory)
+	assert.Len(report.Relationships, 1)
+	assert.Equal(history, report.History)
+	mocks.repository.AssertExpectations(t)
+}
+
+func TestSubjectRightsService_SubjectReport_NotFoundIsLogged(t *testing.T) {
+	service, mocks := newSubjectRightsService()
+
+	mocks.person.On("FindAllByCPF", "11144477735").Return([]*person.Person{}, nil)
+	mocks.repository.On("Save", mock.MatchedBy(func(r *person.SubjectRequest) bool {
+		return r.Type == person.SubjectRequestPortability && r.Status == person.SubjectRequestNotFound
+	})).Return(1, nil)
+
+	_, err := service.SubjectReport("11144477735", person.SubjectRequestPortability, testActor)
+
+	assert.ErrorIs(t, err, personError.ErrPersonNotFound)
+	mocks.repository.AssertExpectations(t)
+}
+
+func TestSubjectRightsService_SubjectReport_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		cpf         string
+		requestType string
+		expected    error
+	}{
+		{"invalid cpf", "11144477700", person.SubjectRequestAccess, personError.ErrCPFInvalid},
+		{"invalid type", "11144477735", person.SubjectRequestAnonymization, personError.ErrSubjectRequestTypeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mocks := newSubjectRightsService()
+
+			_, err := service.SubjectReport(tt.cpf, tt.requestType, testActor)
+
+			assert.ErrorIs(t, err, tt.expected)
+			mocks.repository.AssertNotCalled(t, "Save", mock.Anything)
+		})
+	}
+}
+
+func TestSubjectRightsService_SubjectReport_UnloggedRequestFails(t *testing.T) {
+	service, mocks := newSubjectRightsService()
+
+	mocks.person.On("FindAllByCPF", "11144477735").Return([]*person.Person{namedPerson(5, "José da Silva", "11144477735", "jose@example.com", 1)}, nil)
+	mocks.person.On("FindMergedInto", []int{5}).Return([]int{}, nil)
+	mocks.address.On("FindByPerson", 5).Return([]*person.Address{}, nil)
+	mocks.document.On("FindByPerson", 5).Return([]*person.Document{}, nil)
+	mocks.consent.On("FindByPerson", 5).Return([]*person.Consent{}, nil)
+	mocks.consent.On("FindEvents", []int{5}).Return([]*person.ConsentEvent{}, nil)
+	mocks.relationship.On("FindAllByPersons", []int{5}).Return([]*person.Relationship{}, nil)
+	mocks.audit.On("FindBySubject", []int{5}).Return([]*audit.AuditEntry{}, nil)
+	mocks.repository.On("Save", mock.Anything).Return(0, errors.New("database error"))
+
+	report, err := service.SubjectReport("11144477735", person.SubjectRequestAccess, testActor)
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func TestSubjectRightsService_AnonymizeSubject(t *testing.T) {
+	assert := assert.New(t)
+	service, mocks := newSubjectRightsService()
+
+	subject := namedPerson(5, "José da Silva", "11144477735", "jose@example.com", 2)
+	mocks.person.On("FindAllByCPF", "11144477735").Return([]*person.Person{subject}, nil)
+	mocks.person.On("FindMergedInto", []int{5}).Return([]int{}, nil)
+	mocks.repository.On("Anonymize", []int{5}, mock.MatchedBy(func(r *person.SubjectRequest) bool {
+		return r.Type == person.SubjectRequestAnonymization && r.Status == person.SubjectRequestCompleted
+	})).Return(nil)
+	anonymizedAt := time.Now()
+	mocks.person.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, Name: person.AnonymizedName, CPF: person.AnonymizedCPF(5), AnonymizedAt: &anonymizedAt, DeletedAt: &anonymizedAt}, nil)
+	mocks.audit.On("SaveAll", mock.MatchedBy(func(entries []*audit.AuditEntry) bool {
+		return len(entries) == 1 && entries[0].EntityID == 5 && entries[0].Action == audit.ActionAnonymize &&
+			entries[0].Before == nil && !strings.Contains(string(entries[0].After), "11144477735")
+	})).Return(nil)
+
+	request, err := service.AnonymizeSubject("11144477735", testActor)
+
+	assert.NoError(err)
+	assert.Equal([]int{5}, request.PersonIDs)
+	mocks.repository.AssertExpectations(t)
+	mocks.audit.AssertExpectations(t)
+}
+
+func TestSubjectRightsService_AnonymizeSubject_AuditFailureFailsAnonymization(t *testing.T) {
+	service, mocks := newSubjectRightsService()
+
+	subject := namedPerson(5, "José da Silva", "11144477735", "jose@example.com", 2)
+	mocks.person.On("FindAllByCPF", "11144477735").Return([]*person.Person{subject}, nil)
+	mocks.person.On("FindMergedInto", []int{5}).Return([]int{}, nil)
+	mocks.repository.On("Anonymize", []int{5}, mock.Anything).Return(nil)
+	mocks.person.On("FindByIDIncludingDeleted", 5).Return(&person.Person{ID: 5, Name: person.AnonymizedName}, nil)
+	mocks.audit.On("SaveAll", mock.Anything).Return(errors.New("audit table unavailable"))
+
+	_, err := service.AnonymizeSubject("11144477735", testActor)
+
+	assert.ErrorContains(t, err, "failed to record anonymization in the audit trail", "the anonymization is rolled back with the error")
+}
+
+func TestSubjectRightsService_AnonymizeSubject_NotFound(t *testing.T) {
+	service, mocks := newSubjectRightsService()
+
+	mocks.person.On("FindAllByCPF", "11144477735").Return([]*person.Person{}, nil)
+	mocks.repository.On("Save", mock.MatchedBy(func(r *person.SubjectRequest) bool {
+		return r.Type == person.SubjectRequestAnonymization && r.Status == person.SubjectRequestNotFound
+	})).Return(1, nil)
+
+	_, err := service.AnonymizeSubject("11144477735", testActor)
+
+	assert.ErrorIs(t, err, personError.ErrPersonNotFound)
+	mocks.repository.AssertNotCalled(t, "Anonymize", mock.Anything, mock.Anything)
+}
+
+func TestSubjectRightsService_ListSubjectRequests_ClampsPagination(t *testing.T) {
+	service, mocks := newSubjectRightsService()
+
+	mocks.repository.On("FindRequests", 1, 10).Return([]*person.SubjectRequest{}, int64(0), nil)
+
+	_, _, err := service.ListSubjectRequests(0, 500)
+
+	assert.NoError(t, err)
+	mocks.repository.AssertExpectations(t)
+}
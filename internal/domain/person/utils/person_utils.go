@@ -3,6 +3,10 @@ package person
 import (
 	"strings"
 	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 func OnlyDigits(input string) string {
@@ -16,3 +20,36 @@ func OnlyDigits(input string) string {
 
 	return b.String()
 }
+
+var accentRemover = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// NormalizeSearchText lowercases the input and strips diacritics, so that
+// "JOSÉ" and "jose" compare as equal in case- and accent-insensitive searches.
+func NormalizeSearchText(input string) string {
+	normalized, _, err := transform.String(accentRemover, input)
+	if err != nil {
+		normalized = input
+	}
+
+	return strings.ToLower(strings.TrimSpace(normalized))
+}
+
+// FormatCPF applies the 000.000.000-00 mask to an 11 digit CPF. Any other input is returned unchanged.
+func FormatCPF(cpf string) string {
+	if len(cpf) != 11 {
+		return cpf
+	}
+
+	return cpf[0:3] + "." + cpf[3:6] + "." + cpf[6:9] + "-" + cpf[9:11]
+}
+
+// FormatPhone applies the (00) 00000-0000 mask to a 10 or 11 digit phone number.
+// Any other input is returned unchanged.
+func FormatPhone(phone string) string {
+	if len(phone) != 10 && len(phone) != 11 {
+		return phone
+	}
+
+	split := len(phone) - 4
+	return "(" + phone[0:2] + ") " + phone[2:split] + "-" + phone[split:]
+}
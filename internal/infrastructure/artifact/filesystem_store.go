@@ -0,0 +1,92 @@
+package artifact
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	jobError "pessoas-api/internal/domain/job/error"
+	"pessoas-api/internal/domain/job/ports"
+)
+
+// refPattern matches the references generated by Create, which keeps Open and
+// Delete from ever reaching outside the store directory.
+var refPattern = regexp.MustCompile(`^[0-9a-f]{32}(\.[a-z0-9]{1,10})?$`)
+
+var extensionPattern = regexp.MustCompile(`^[a-z0-9]{1,10}$`)
+
+// FilesystemStore keeps job artifacts as files in a directory. When the API runs
+// with several replicas the directory must be shared between them (e.g. a
+// network volume), since any replica may run a job or serve its result.
+type FilesystemStore struct {
+	dir string
+}
+
+// NewFilesystemStore creates a FilesystemStore in dir, creating the directory if needed.
+// It returns the store as the ArtifactStore interface.
+func NewFilesystemStore(dir string) (ports.ArtifactStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create artifact directory: %w", err)
+	}
+
+	return &FilesystemStore{dir: dir}, nil
+}
+
+func (s *FilesystemStore) Create(extension string) (string, io.WriteCloser, error) {
+	if extension != "" && !extensionPattern.MatchString(extension) {
+		return "", nil, fmt.Errorf("invalid artifact extension %q", extension)
+	}
+
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", nil, err
+	}
+
+	ref := hex.EncodeToString(random)
+	if extension != "" {
+		ref += "." + extension
+	}
+
+	file, err := os.OpenFile(filepath.Join(s.dir, ref), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create artifact: %w", err)
+	}
+
+	return ref, file, nil
+}
+
+func (s *FilesystemStore) Open(ref string) (io.ReadCloser, int64, error) {
+	if !refPattern.MatchString(ref) {
+		return nil, 0, jobError.ErrArtifactInvalid
+	}
+
+	file, err := os.Open(filepath.Join(s.dir, ref))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open artifact: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, fmt.Errorf("failed to open artifact: %w", err)
+	}
+
+	return file, info.Size(), nil
+}
+
+func (s *FilesystemStore) Delete(ref string) error {
+	if !refPattern.MatchString(ref) {
+		return jobError.ErrArtifactInvalid
+	}
+
+	if err := os.Remove(filepath.Join(s.dir, ref)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete artifact: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package artifact
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	jobError "pessoas-api/internal/domain/job/error"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilesystemStore_CreateOpenDelete(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	store, err := NewFilesystemStore(filepath.Join(dir, "artifacts"))
+	assert.NoError(err)
+
+	ref, file, err := store.Create("csv")
+	assert.NoError(err)
+	assert.Regexp(`^[0-9a-f]{32}\.csv$`, ref)
+	file.Write([]byte("id,name\n"))
+	assert.NoError(file.Close())
+
+	opened, size, err := store.Open(ref)
+	assert.NoError(err)
+	content, _ := io.ReadAll(opened)
+	opened.Close()
+	assert.Equal("id,name\n", string(content))
+	assert.Equal(int64(8), size)
+
+	assert.NoError(store.Delete(ref))
+	_, err = os.Stat(filepath.Join(dir, "artifacts", ref))
+	assert.True(os.IsNotExist(err))
+	assert.NoError(store.Delete(ref), "deleting a missing artifact is not an error")
+}
+
+func TestFilesystemStore_Create_ShouldRejectInvalidExtension(t *testing.T) {
+	store, _ := NewFilesystemStore(t.TempDir())
+
+	_, _, err := store.Create("../csv")
+
+	assert.Error(t, err)
+}
+
+func TestFilesystemStore_ShouldRejectRefsOutsideTheStore(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewFilesystemStore(filepath.Join(dir, "artifacts"))
+	os.WriteFile(filepath.Join(dir, "secret.txt"), []byte("secret"), 0o600)
+
+	_, _, err := store.Open("../secret.txt")
+	assert.ErrorIs(t, err, jobError.ErrArtifactInvalid)
+
+	assert.ErrorIs(t, store.Delete("../secret.txt"), jobError.ErrArtifactInvalid)
+	_, err = os.Stat(filepath.Join(dir, "secret.txt"))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,164 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// Cipher implements envelope encryption: every row is encrypted with its own
+// data key, and the data key is stored wrapped (encrypted) by a
+// key-encryption key of the KeyProvider, along with the ID of that key.
+// Rotating the key-encryption key only requires rewrapping the data keys.
+// Both layers use AES-256-GCM.
+type Cipher struct {
+	keys KeyProvider
+}
+
+func NewCipher(keys KeyProvider) *Cipher {
+	return &Cipher{keys: keys}
+}
+
+// NewCipherFromEnv builds a Cipher over the LocalKeyProvider configured in the
+// environment. It returns nil when no key is configured.
+func NewCipherFromEnv() (*Cipher, error) {
+	provider, err := LoadLocalKeyProvider()
+	if err != nil || provider == nil {
+		return nil, err
+	}
+	return NewCipher(provider), nil
+}
+
+// CurrentKeyID returns the ID of the key new data keys are wrapped with.
+func (c *Cipher) CurrentKeyID() string {
+	return c.keys.CurrentKeyID()
+}
+
+// DataKey is the key a row is encrypted with. Wrapped and KeyID are what the
+// row stores; the plaintext key never leaves memory.
+type DataKey struct {
+	KeyID   string
+	Wrapped string
+	key     []byte
+}
+
+// NewDataKey generates a random data key wrapped by the current key.
+func (c *Cipher) NewDataKey() (*DataKey, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	return c.wrap(key)
+}
+
+// OpenDataKey unwraps a data key stored by a row.
+func (c *Cipher) OpenDataKey(keyID, wrapped string) (*DataKey, error) {
+	kek, err := c.keys.Key(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := open(kek, wrapped, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("data key wrapped by %q: %w", keyID, err)
+	}
+
+	return &DataKey{KeyID: keyID, Wrapped: wrapped, key: key}, nil
+}
+
+// Rewrap wraps the data key again with the current key. The values encrypted
+// with the data key stay valid.
+func (c *Cipher) Rewrap(key *DataKey) (*DataKey, error) {
+	return c.wrap(key.key)
+}
+
+func (c *Cipher) wrap(key []byte) (*DataKey, error) {
+	keyID := c.keys.CurrentKeyID()
+
+	kek, err := c.keys.Key(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := seal(kek, key, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return &DataKey{KeyID: keyID, Wrapped: wrapped, key: key}, nil
+}
+
+// BlindIndex returns a deterministic keyed hash of value, so that equal values
+// can be looked up without decrypting them. The purpose keeps the indexes of
+// different fields from being compared with each other.
+func (c *Cipher) BlindIndex(purpose, value string) string {
+	mac := hmac.New(sha256.New, c.keys.IndexKey())
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Seal encrypts the value of a field. The field name is authenticated along
+// with the value, so a ciphertext copied into another field fails to open.
+func (k *DataKey) Seal(field, value string) (string, error) {
+	return seal(k.key, []byte(value), []byte(field))
+}
+
+// Open decrypts a value sealed for the field.
+func (k *DataKey) Open(field, sealed string) (string, error) {
+	value, err := open(k.key, sealed, []byte(field))
+	if err != nil {
+		return "", fmt.Errorf("field %s: %w", field, err)
+	}
+	return string(value), nil
+}
+
+// seal encrypts plaintext with AES-GCM and returns the nonce followed by the
+// ciphertext, base64 encoded.
+func seal(key, plaintext, additionalData []byte) (string, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, plaintext, additionalData)), nil
+}
+
+func open(key []byte, sealed string, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(data) < aead.NonceSize() {
+		return nil, ErrDecryptFailed
+	}
+
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, ErrDecryptFailed
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
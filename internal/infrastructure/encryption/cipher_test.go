@@ -0,0 +1,103 @@
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCipher(t *testing.T, keys string) *Cipher {
+	provider, err := NewLocalKeyProvider(keys, testKey('i'))
+	if err != nil {
+		t.Fatalf("failed to create key provider: %v", err)
+	}
+	return NewCipher(provider)
+}
+
+func TestCipher_SealAndOpen(t *testing.T) {
+	assert := assert.New(t)
+	cipher := newTestCipher(t, "k1:"+testKey('a'))
+
+	key, err := cipher.NewDataKey()
+	assert.NoError(err)
+	assert.Equal("k1", key.KeyID)
+
+	sealed, err := key.Seal("cpf", "11144477735")
+	assert.NoError(err)
+	assert.NotContains(sealed, "11144477735")
+
+	again, err := key.Seal("cpf", "11144477735")
+	assert.NoError(err)
+	assert.NotEqual(sealed, again, "every seal uses a fresh nonce")
+
+	stored, err := cipher.OpenDataKey(key.KeyID, key.Wrapped)
+	assert.NoError(err)
+
+	value, err := stored.Open("cpf", sealed)
+	assert.NoError(err)
+	assert.Equal("11144477735", value)
+}
+
+func TestCipher_OpenShouldRejectTamperedValues(t *testing.T) {
+	assert := assert.New(t)
+	cipher := newTestCipher(t, "k1:"+testKey('a'))
+
+	key, err := cipher.NewDataKey()
+	assert.NoError(err)
+	sealed, err := key.Seal("cpf", "11144477735")
+	assert.NoError(err)
+
+	_, err = key.Open("email", sealed)
+	assert.ErrorIs(err, ErrDecryptFailed, "a value sealed for a field does not open as another one")
+
+	_, err = key.Open("cpf", "AAAA"+sealed[4:])
+	assert.ErrorIs(err, ErrDecryptFailed)
+
+	other, err := cipher.NewDataKey()
+	assert.NoError(err)
+	_, err = other.Open("cpf", sealed)
+	assert.ErrorIs(err, ErrDecryptFailed)
+}
+
+func TestCipher_RewrapKeepsValuesReadable(t *testing.T) {
+	assert := assert.New(t)
+	old := newTestCipher(t, "k1:"+testKey('a'))
+	rotated := newTestCipher(t, "k2:"+testKey('b')+",k1:"+testKey('a'))
+
+	key, err := old.NewDataKey()
+	assert.NoError(err)
+	sealed, err := key.Seal("email", "john@example.com")
+	assert.NoError(err)
+
+	stored, err := rotated.OpenDataKey(key.KeyID, key.Wrapped)
+	assert.NoError(err)
+	rewrapped, err := rotated.Rewrap(stored)
+	assert.NoError(err)
+	assert.Equal("k2", rewrapped.KeyID)
+
+	_, err = rotated.OpenDataKey("k1", rewrapped.Wrapped)
+	assert.ErrorIs(err, ErrDecryptFailed, "the key ID is bound to the wrapped key")
+
+	reopened, err := rotated.OpenDataKey(rewrapped.KeyID, rewrapped.Wrapped)
+	assert.NoError(err)
+	value, err := reopened.Open("email", sealed)
+	assert.NoError(err)
+	assert.Equal("john@example.com", value)
+
+	_, err = newTestCipher(t, "k2:"+testKey('b')).OpenDataKey(key.KeyID, key.Wrapped)
+	assert.ErrorIs(err, ErrUnknownKey, "retired keys can no longer open rows")
+}
+
+func TestCipher_BlindIndex(t *testing.T) {
+	assert := assert.New(t)
+	cipher := newTestCipher(t, "k1:"+testKey('a'))
+	rotated := newTestCipher(t, "k2:"+testKey('b'))
+
+	index := cipher.BlindIndex("person.cpf", "11144477735")
+
+	assert.Len(index, 64)
+	assert.Equal(index, cipher.BlindIndex("person.cpf", "11144477735"))
+	assert.Equal(index, rotated.BlindIndex("person.cpf", "11144477735"), "rotating the data keys keeps the indexes")
+	assert.NotEqual(index, cipher.BlindIndex("person.cpf", "52998224725"))
+	assert.NotEqual(index, cipher.BlindIndex("person.birth_date", "11144477735"))
+}
@@ -0,0 +1,161 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// KeySize is the size of every key, in bytes: keys are AES-256 and
+// HMAC-SHA256 keys.
+const KeySize = 32
+
+var (
+	ErrUnknownKey    = errors.New("encryption key not found")
+	ErrInvalidKey    = errors.New("encryption key is invalid")
+	ErrDecryptFailed = errors.New("ciphertext could not be decrypted")
+)
+
+// KeyProvider supplies the key-encryption keys, which wrap the data keys of
+// the rows, and the key of the blind indexes. Rotating means making a new
+// key current while the previous ones stay available to unwrap the data keys
+// written with them.
+type KeyProvider interface {
+	// CurrentKeyID returns the ID of the key new data keys are wrapped with.
+	CurrentKeyID() string
+	// Key returns the key-encryption key with the given ID, or ErrUnknownKey.
+	Key(id string) ([]byte, error)
+	// IndexKey returns the key of the blind indexes. It is not rotated, since
+	// changing it would require rebuilding every index at once.
+	IndexKey() []byte
+}
+
+// keyIDPattern keeps the key IDs short enough for the key_id column.
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// LocalKeyProvider holds keys read from the environment or from files, for
+// deployments without a key management service.
+type LocalKeyProvider struct {
+	currentID string
+	keys      map[string][]byte
+	indexKey  []byte
+}
+
+// NewLocalKeyProvider parses keys written as "id:base64" entries separated by
+// commas or line breaks; the first entry is the current key. indexKey is the
+// base64 blind index key. Every key must decode to KeySize bytes.
+func NewLocalKeyProvider(keys, indexKey string) (*LocalKeyProvider, error) {
+	provider := &LocalKeyProvider{keys: map[string][]byte{}}
+
+	entries := strings.FieldsFunc(keys, func(r rune) bool { return r == ',' || r == '\n' || r == '\r' })
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, encoded, found := strings.Cut(entry, ":")
+		if !found || !keyIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("%w: entries must be written as id:base64 with an ID of up to 32 letters, digits, _ or -", ErrInvalidKey)
+		}
+		if _, exists := provider.keys[id]; exists {
+			return nil, fmt.Errorf("%w: key %q is declared twice", ErrInvalidKey, id)
+		}
+
+		key, err := decodeKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+
+		provider.keys[id] = key
+		if provider.currentID == "" {
+			provider.currentID = id
+		}
+	}
+
+	if provider.currentID == "" {
+		return nil, fmt.Errorf("%w: no key given", ErrInvalidKey)
+	}
+
+	index, err := decodeKey(indexKey)
+	if err != nil {
+		return nil, fmt.Errorf("blind index key: %w", err)
+	}
+	provider.indexKey = index
+
+	return provider, nil
+}
+
+// LoadLocalKeyProvider reads the keys from PERSON_ENCRYPTION_KEYS and the
+// blind index key from PERSON_BLIND_INDEX_KEY, or from the files named by
+// PERSON_ENCRYPTION_KEYS_FILE and PERSON_BLIND_INDEX_KEY_FILE. It returns nil
+// when no key is configured, which leaves encryption disabled.
+func LoadLocalKeyProvider() (*LocalKeyProvider, error) {
+	keys, err := envOrFile("PERSON_ENCRYPTION_KEYS")
+	if err != nil {
+		return nil, err
+	}
+	if keys == "" {
+		return nil, nil
+	}
+
+	indexKey, err := envOrFile("PERSON_BLIND_INDEX_KEY")
+	if err != nil {
+		return nil, err
+	}
+	if indexKey == "" {
+		return nil, fmt.Errorf("PERSON_BLIND_INDEX_KEY is required when PERSON_ENCRYPTION_KEYS is set")
+	}
+
+	return NewLocalKeyProvider(keys, indexKey)
+}
+
+func (p *LocalKeyProvider) CurrentKeyID() string {
+	return p.currentID
+}
+
+func (p *LocalKeyProvider) Key(id string) ([]byte, error) {
+	key, exists := p.keys[id]
+	if !exists {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, id)
+	}
+	return key, nil
+}
+
+func (p *LocalKeyProvider) IndexKey() []byte {
+	return p.indexKey
+}
+
+// envOrFile reads the variable name, or the file named by name_FILE when the
+// variable is not set.
+func envOrFile(name string) (string, error) {
+	if value := os.Getenv(name); value != "" {
+		return value, nil
+	}
+
+	path := os.Getenv(name + "_FILE")
+	if path == "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s_FILE: %w", name, err)
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+func decodeKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("%w: not valid base64", ErrInvalidKey)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("%w: must have %d bytes, got %d", ErrInvalidKey, KeySize, len(key))
+	}
+	return key, nil
+}
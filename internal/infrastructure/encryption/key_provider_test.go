@@ -0,0 +1,97 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testKey returns a valid base64 key made of the given byte.
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(b), KeySize)))
+}
+
+func TestNewLocalKeyProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	provider, err := NewLocalKeyProvider("2024b:"+testKey('b')+",\n2024a:"+testKey('a'), testKey('i'))
+	assert.NoError(err)
+
+	assert.Equal("2024b", provider.CurrentKeyID())
+
+	key, err := provider.Key("2024a")
+	assert.NoError(err)
+	assert.Len(key, KeySize)
+
+	_, err = provider.Key("2023")
+	assert.ErrorIs(err, ErrUnknownKey)
+	assert.Len(provider.IndexKey(), KeySize)
+}
+
+func TestNewLocalKeyProvider_ShouldRejectInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     string
+		indexKey string
+	}{
+		{name: "no key", keys: " , ", indexKey: testKey('i')},
+		{name: "missing ID", keys: testKey('a'), indexKey: testKey('i')},
+		{name: "invalid ID", keys: "key one:" + testKey('a'), indexKey: testKey('i')},
+		{name: "duplicated ID", keys: "k1:" + testKey('a') + ",k1:" + testKey('b'), indexKey: testKey('i')},
+		{name: "short key", keys: "k1:" + base64.StdEncoding.EncodeToString([]byte("short")), indexKey: testKey('i')},
+		{name: "invalid base64", keys: "k1:***", indexKey: testKey('i')},
+		{name: "missing index key", keys: "k1:" + testKey('a'), indexKey: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLocalKeyProvider(tt.keys, tt.indexKey)
+
+			assert.ErrorIs(t, err, ErrInvalidKey)
+		})
+	}
+}
+
+func TestLoadLocalKeyProvider(t *testing.T) {
+	t.Run("disabled without keys", func(t *testing.T) {
+		t.Setenv("PERSON_ENCRYPTION_KEYS", "")
+		t.Setenv("PERSON_ENCRYPTION_KEYS_FILE", "")
+
+		provider, err := LoadLocalKeyProvider()
+
+		assert.NoError(t, err)
+		assert.Nil(t, provider)
+	})
+
+	t.Run("keys from files", func(t *testing.T) {
+		dir := t.TempDir()
+		keysPath := filepath.Join(dir, "keys")
+		indexPath := filepath.Join(dir, "index")
+		assert.NoError(t, os.WriteFile(keysPath, []byte("k2:"+testKey('b')+"\nk1:"+testKey('a')+"\n"), 0o600))
+		assert.NoError(t, os.WriteFile(indexPath, []byte(testKey('i')+"\n"), 0o600))
+
+		t.Setenv("PERSON_ENCRYPTION_KEYS", "")
+		t.Setenv("PERSON_ENCRYPTION_KEYS_FILE", keysPath)
+		t.Setenv("PERSON_BLIND_INDEX_KEY", "")
+		t.Setenv("PERSON_BLIND_INDEX_KEY_FILE", indexPath)
+
+		provider, err := LoadLocalKeyProvider()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "k2", provider.CurrentKeyID())
+	})
+
+	t.Run("index key required", func(t *testing.T) {
+		t.Setenv("PERSON_ENCRYPTION_KEYS", "k1:"+testKey('a'))
+		t.Setenv("PERSON_BLIND_INDEX_KEY", "")
+		t.Setenv("PERSON_BLIND_INDEX_KEY_FILE", "")
+
+		_, err := LoadLocalKeyProvider()
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,332 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// addressValidationErrors are the domain errors reported as 422 for an address.
+var addressValidationErrors = []error{
+	personError.ErrAddressTypeInvalid,
+	personError.ErrCEPRequired,
+	personError.ErrCEPInvalid,
+	personError.ErrStreetRequired,
+	personError.ErrAddressNumberRequired,
+	personError.ErrCityRequired,
+	personError.ErrStateInvalid,
+	personError.ErrCountryInvalid,
+}
+
+type AddressHandler struct {
+	service ports.AddressService
+}
+
+func NewAddressHandler(service ports.AddressService) *AddressHandler {
+	return &AddressHandler{
+		service: service,
+	}
+}
+
+// ListAddresses godoc
+// @Summary      List the addresses of a person
+// @Description  Returns every address of a person, the primary one first
+// @Tags         Addresses
+// @Produce      json
+// @Param        id   path      int  true  "Person ID"
+// @Success      200  {array}   contract.AddressResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/addresses [get]
+func (h *AddressHandler) ListAddresses(c *gin.Context) {
+	personID, ok := personIDParam(c, "ListAddresses")
+	if !ok {
+		return
+	}
+
+	addresses, err := h.service.ListAddresses(personID)
+	if err != nil {
+		respondAddressError(c, "ListAddresses", personID, 0, err)
+		return
+	}
+
+	response := make([]contract.AddressResponseDTO, len(addresses))
+	for i, address := range addresses {
+		response[i] = contract.NewAddressResponseDTO(address)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetAddress godoc
+// @Summary      Get an address
+// @Description  Returns one address of a person
+// @Tags         Addresses
+// @Produce      json
+// @Param        id         path      int  true  "Person ID"
+// @Param        addressId  path      int  true  "Address ID"
+// @Success      200  {object}  contract.AddressResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person or address not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/addresses/{addressId} [get]
+func (h *AddressHandler) GetAddress(c *gin.Context) {
+	personID, id, ok := addressIDParams(c, "GetAddress")
+	if !ok {
+		return
+	}
+
+	address, err := h.service.FindAddress(personID, id)
+	if err != nil {
+		respondAddressError(c, "GetAddress", personID, id, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewAddressResponseDTO(address))
+}
+
+// CreateAddress godoc
+// @Summary      Add an address to a person
+// @Description  Adds a residential, billing or work address. CEP and UF are validated for Brazilian addresses, and blank street, district, city and state are filled from the CEP. The first address of a person becomes primary; marking a new address as primary demotes the current one
+// @Tags         Addresses
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                  true  "Person ID"
+// @Param        address  body      contract.AddressDTO  true  "Address data"
+// @Success      201      {object}  contract.AddressResponseDTO
+// @Header       201      {string}  Location  "URI of the created address"
+// @Failure      400      {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404      {object}  contract.ErrorResponse  "Person not found"
+// @Failure      422      {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500      {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/addresses [post]
+func (h *AddressHandler) CreateAddress(c *gin.Context) {
+	personID, ok := personIDParam(c, "CreateAddress")
+	if !ok {
+		return
+	}
+
+	var dto contract.AddressDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] CreateAddress - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	address, err := h.service.AddAddress(personID, dto, requestActor(c))
+	if err != nil {
+		respondAddressError(c, "CreateAddress", personID, 0, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] CreateAddress - Address %d added to person ID %d", address.ID, personID)
+	c.Header("Location", addressLocation(address))
+	c.JSON(http.StatusCreated, contract.NewAddressResponseDTO(address))
+}
+
+// UpdateAddress godoc
+// @Summary      Replace an address
+// @Description  Replaces every field of an address, filling blank street, district, city and state from the CEP. The primary address stays primary until another address is marked as primary
+// @Tags         Addresses
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int                  true  "Person ID"
+// @Param        addressId  path      int                  true  "Address ID"
+// @Param        address    body      contract.AddressDTO  true  "Address data"
+// @Success      200        {object}  contract.AddressResponseDTO
+// @Failure      400        {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404        {object}  contract.ErrorResponse  "Person or address not found"
+// @Failure      422        {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500        {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/addresses/{addressId} [put]
+func (h *AddressHandler) UpdateAddress(c *gin.Context) {
+	personID, id, ok := addressIDParams(c, "UpdateAddress")
+	if !ok {
+		return
+	}
+
+	var dto contract.AddressDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] UpdateAddress - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	address, err := h.service.UpdateAddress(personID, id, dto, requestActor(c))
+	if err != nil {
+		respondAddressError(c, "UpdateAddress", personID, id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] UpdateAddress - Address %d of person ID %d updated", id, personID)
+	c.JSON(http.StatusOK, contract.NewAddressResponseDTO(address))
+}
+
+// DeleteAddress godoc
+// @Summary      Delete an address
+// @Description  Removes an address. When it was the primary address, the oldest remaining address becomes primary
+// @Tags         Addresses
+// @Param        id         path  int  true  "Person ID"
+// @Param        addressId  path  int  true  "Address ID"
+// @Success      204
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person or address not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/addresses/{addressId} [delete]
+func (h *AddressHandler) DeleteAddress(c *gin.Context) {
+	personID, id, ok := addressIDParams(c, "DeleteAddress")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteAddress(personID, id, requestActor(c)); err != nil {
+		respondAddressError(c, "DeleteAddress", personID, id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] DeleteAddress - Address %d of person ID %d deleted", id, personID)
+	c.Status(http.StatusNoContent)
+}
+
+// LookupPostalCode godoc
+// @Summary      Look up a CEP
+// @Description  Returns the street, district, city and state of a Brazilian CEP, as used to complete addresses
+// @Tags         Addresses
+// @Produce      json
+// @Param        cep  path      string  true  "CEP, with or without mask"
+// @Success      200  {object}  contract.PostalCodeDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid CEP"
+// @Failure      404  {object}  contract.ErrorResponse  "CEP not found"
+// @Failure      503  {object}  contract.ErrorResponse  "Lookup service unavailable"
+// @Router       /postal-codes/{cep} [get]
+func (h *AddressHandler) LookupPostalCode(c *gin.Context) {
+	cep := c.Param("cep")
+
+	postalCode, err := h.service.LookupPostalCode(cep)
+	if err != nil {
+		switch {
+		case errors.Is(err, personError.ErrCEPInvalid):
+			log.Printf("[ERROR] LookupPostalCode - Invalid CEP: %s", cep)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+		case errors.Is(err, personError.ErrPostalCodeNotFound):
+			log.Printf("[WARN] LookupPostalCode - CEP not found: %s", cep)
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "not_found",
+				"message": "CEP not found",
+			})
+		case errors.Is(err, personError.ErrPostalCodeUnavailable):
+			log.Printf("[ERROR] LookupPostalCode - Lookup of CEP %s failed: %v", cep, err)
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"error":   "service_unavailable",
+				"message": "CEP lookup is unavailable, try again later",
+			})
+		default:
+			log.Printf("[ERROR] LookupPostalCode - Failed to look up CEP %s: %v", cep, err)
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "internal_error",
+				"message": "Failed to look up CEP: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewPostalCodeDTO(postalCode))
+}
+
+// addressLocation builds the URI of an address resource, used in Location headers.
+func addressLocation(address *personModel.Address) string {
+	return fmt.Sprintf("%s/addresses/%d", personLocation(address.PersonID), address.ID)
+}
+
+// personIDParam reads the person ID from the path. When it is invalid it writes
+// the error response and returns false.
+func personIDParam(c *gin.Context, operation string) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] %s - Invalid ID parameter: %s", operation, c.Param("id"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid person ID",
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// addressIDParams reads the person and address IDs from the path. When either
+// is invalid it writes the error response and returns false.
+func addressIDParams(c *gin.Context, operation string) (int, int, bool) {
+	personID, ok := personIDParam(c, operation)
+	if !ok {
+		return 0, 0, false
+	}
+
+	id, err := strconv.Atoi(c.Param("addressId"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] %s - Invalid address ID parameter: %s", operation, c.Param("addressId"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid address ID",
+		})
+		return 0, 0, false
+	}
+
+	return personID, id, true
+}
+
+func respondAddressError(c *gin.Context, operation string, personID, id int, err error) {
+	switch {
+	case errors.Is(err, personError.ErrPersonNotFound):
+		log.Printf("[WARN] %s - Person not found with ID: %d", operation, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Person not found",
+		})
+	case errors.Is(err, personError.ErrAddressNotFound):
+		log.Printf("[WARN] %s - Address %d not found for person ID %d", operation, id, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Address not found",
+		})
+	case isAddressValidationError(err):
+		log.Printf("[ERROR] %s - Validation error for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process address: " + err.Error(),
+		})
+	}
+}
+
+func isAddressValidationError(err error) bool {
+	for _, validationErr := range addressValidationErrors {
+		if errors.Is(err, validationErr) {
+			return true
+		}
+	}
+	return false
+}
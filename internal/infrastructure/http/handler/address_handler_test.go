@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupAddressTest() (*gin.Engine, *mocks.MockAddressService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockAddressService)
+	handler := NewAddressHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Next()
+	})
+	router.GET("/persons/:id/addresses", handler.ListAddresses)
+	router.POST("/persons/:id/addresses", handler.CreateAddress)
+	router.GET("/persons/:id/addresses/:addressId", handler.GetAddress)
+	router.PUT("/persons/:id/addresses/:addressId", handler.UpdateAddress)
+	router.DELETE("/persons/:id/addresses/:addressId", handler.DeleteAddress)
+	router.GET("/postal-codes/:cep", handler.LookupPostalCode)
+
+	return router, mockService
+}
+
+func testAddressDTO() contract.AddressDTO {
+	return contract.AddressDTO{
+		Type:   "residential",
+		CEP:    "50030-230",
+		Street: "Rua da Aurora",
+		Number: "325",
+		City:   "Recife",
+		State:  "PE",
+	}
+}
+
+func testAddress(id int, primary bool) *person.Address {
+	return &person.Address{
+		AddressFields: person.AddressFields{
+			Type:    person.AddressResidential,
+			CEP:     "50030230",
+			Street:  "Rua da Aurora",
+			Number:  "325",
+			City:    "Recife",
+			State:   "PE",
+			Country: person.CountryBrazil,
+			Primary: primary,
+		},
+		ID:       id,
+		PersonID: 1,
+	}
+}
+
+func TestListAddresses_Success(t *testing.T) {
+	router, mockService := setupAddressTest()
+
+	mockService.On("ListAddresses", 1).Return([]*person.Address{testAddress(3, true), testAddress(4, false)}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/1/addresses", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []contract.AddressResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response, 2)
+	assert.Equal(t, 3, response[0].ID)
+	assert.True(t, response[0].Primary)
+	assert.Equal(t, "50030230", response[0].CEP)
+}
+
+func TestListAddresses_Empty(t *testing.T) {
+	router, mockService := setupAddressTest()
+
+	mockService.On("ListAddresses", 1).Return([]*person.Address{}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/1/addresses", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "[]", w.Body.String())
+}
+
+func TestCreateAddress_Success(t *testing.T) {
+	router, mockService := setupAddressTest()
+
+	mockService.On("AddAddress", 1, testAddressDTO(), testActor).Return(testAddress(3, true), nil)
+
+	body, _ := json.Marshal(testAddressDTO())
+	req, _ := http.NewRequest("POST", "/persons/1/addresses", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "/api/v1/persons/1/addresses/3", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"primary":true`)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateAddress_ShouldAcceptOnlyCEPAndNumber(t *testing.T) {
+	router, mockService := setupAddressTest()
+
+	dto := contract.AddressDTO{Type: "residential", CEP: "50030-230", Number: "325"}
+	mockService.On("AddAddress", 1, dto, testActor).Return(testAddress(3, true), nil)
+
+	req, _ := http.NewRequest("POST", "/persons/1/addresses", bytes.NewBufferString(`{"type":"residential","cep":"50030-230","number":"325"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"street":"Rua da Aurora"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateAddress_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		err          error
+		expectedCode int
+		expectedErr  string
+	}{
+		{"invalid person id", "/persons/abc/addresses", `{}`, nil, http.StatusBadRequest, "invalid_request"},
+		{"missing fields", "/persons/1/addresses", `{"type":"work"}`, nil, http.StatusBadRequest, "invalid_request"},
+		{"person not found", "/persons/1/addresses", "", personError.ErrPersonNotFound, http.StatusNotFound, "not_found"},
+		{"invalid cep", "/persons/1/addresses", "", personError.ErrCEPInvalid, http.StatusUnprocessableEntity, "validation_error"},
+		{"database error", "/persons/1/addresses", "", errors.New("database error"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupAddressTest()
+			mockService.On("AddAddress", 1, mock.Anything, testActor).Return(nil, tt.err)
+
+			body := tt.body
+			if body == "" {
+				encoded, _ := json.Marshal(testAddressDTO())
+				body = string(encoded)
+			}
+			req, _ := http.NewRequest("POST", tt.path, bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedErr)
+		})
+	}
+}
+
+func TestGetAddress(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		address      *person.Address
+		err          error
+		expectedCode int
+	}{
+		{"found", "/persons/1/addresses/3", testAddress(3, true), nil, http.StatusOK},
+		{"address not found", "/persons/1/addresses/3", nil, personError.ErrAddressNotFound, http.StatusNotFound},
+		{"invalid address id", "/persons/1/addresses/0", nil, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupAddressTest()
+			if tt.address != nil {
+				mockService.On("FindAddress", 1, 3).Return(tt.address, nil)
+			} else {
+				mockService.On("FindAddress", 1, 3).Return(nil, tt.err)
+			}
+
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestUpdateAddress_Success(t *testing.T) {
+	router, mockService := setupAddressTest()
+
+	dto := testAddressDTO()
+	dto.Number = "S/N"
+	updated := testAddress(3, true)
+	updated.Number = "S/N"
+	mockService.On("UpdateAddress", 1, 3, dto, testActor).Return(updated, nil)
+
+	body, _ := json.Marshal(dto)
+	req, _ := http.NewRequest("PUT", "/persons/1/addresses/3", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"number":"S/N"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestDeleteAddress(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"deleted", nil, http.StatusNoContent},
+		{"not found", personError.ErrAddressNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupAddressTest()
+			mockService.On("DeleteAddress", 1, 3, testActor).Return(tt.err)
+
+			req, _ := http.NewRequest("DELETE", "/persons/1/addresses/3", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLookupPostalCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		postalCode   *person.PostalCode
+		err          error
+		expectedCode int
+		expectedBody string
+	}{
+		{"found", &person.PostalCode{CEP: "50030230", Street: "Rua da Aurora", District: "Boa Vista", City: "Recife", State: "PE"}, nil, http.StatusOK, `"district":"Boa Vista"`},
+		{"invalid cep", nil, personError.ErrCEPInvalid, http.StatusBadRequest, "invalid_parameter"},
+		{"not found", nil, personError.ErrPostalCodeNotFound, http.StatusNotFound, "not_found"},
+		{"provider down", nil, personError.ErrPostalCodeUnavailable, http.StatusServiceUnavailable, "service_unavailable"},
+		{"unexpected error", nil, errors.New("boom"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupAddressTest()
+			mockService.On("LookupPostalCode", "50030-230").Return(tt.postalCode, tt.err)
+
+			req, _ := http.NewRequest("GET", "/postal-codes/50030-230", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"log"
 	"net/http"
 
 	authContract "pessoas-api/internal/contract/auth"
+	operator "pessoas-api/internal/domain/operator/model"
 	"pessoas-api/internal/domain/operator/ports"
 
 	"github.com/gin-gonic/gin"
@@ -56,7 +58,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	})
 }
 
-// Login authenticates an operator and returns a JWT token
+// Login authenticates an operator and returns an access token and a refresh token
 func (h *AuthHandler) Login(c *gin.Context) {
 	var dto authContract.LoginDTO
 
@@ -69,7 +71,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	token, err := h.authService.Login(dto.Username, dto.Password)
+	pair, err := h.authService.Login(dto.Username, dto.Password)
 	if err != nil {
 		log.Printf("[ERROR] Login - Authentication failed for username %s: %v", dto.Username, err)
 		c.JSON(http.StatusUnauthorized, gin.H{
@@ -80,8 +82,77 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	log.Printf("[SUCCESS] Login - Operator authenticated: %s", dto.Username)
-	c.JSON(http.StatusOK, gin.H{
-		"token":   token,
-		"message": "Login successful",
-	})
+	c.JSON(http.StatusOK, authContract.NewLoginResponseDTO(pair, "Login successful"))
+}
+
+// Refresh exchanges a refresh token for a new access token and refresh token.
+// The refresh token can only be used once
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var dto authContract.RefreshTokenDTO
+
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] Refresh - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	pair, err := h.authService.Refresh(dto.RefreshToken)
+	if err != nil {
+		if errors.Is(err, operator.ErrInvalidRefreshToken) || errors.Is(err, operator.ErrOperatorInactive) {
+			log.Printf("[WARN] Refresh - Refresh refused: %v", err)
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   "authentication_error",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		log.Printf("[ERROR] Refresh - Failed to refresh token: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] Refresh - Token refreshed")
+	c.JSON(http.StatusOK, authContract.NewLoginResponseDTO(pair, "Token refreshed"))
+}
+
+// Logout revokes the session of the access token used in the request: its
+// refresh token and the access tokens issued with it stop working
+func (h *AuthHandler) Logout(c *gin.Context) {
+	operatorID := c.GetInt("user_id")
+
+	if err := h.authService.Logout(operatorID, c.GetString("session_id")); err != nil {
+		log.Printf("[ERROR] Logout - Failed for operator ID %d: %v", operatorID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] Logout - Operator ID %d logged out", operatorID)
+	c.Status(http.StatusNoContent)
+}
+
+// LogoutAll revokes every session of the operator, on every device
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	operatorID := c.GetInt("user_id")
+
+	if err := h.authService.LogoutAll(operatorID); err != nil {
+		log.Printf("[ERROR] LogoutAll - Failed for operator ID %d: %v", operatorID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] LogoutAll - Operator ID %d logged out of every session", operatorID)
+	c.Status(http.StatusNoContent)
 }
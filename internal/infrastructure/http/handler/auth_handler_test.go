@@ -7,6 +7,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -22,9 +25,49 @@ func (m *MockAuthService) Register(username, email, password string) (int, error
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockAuthService) Login(username, password string) (string, error) {
+func (m *MockAuthService) Login(username, password string) (*operator.TokenPair, error) {
 	args := m.Called(username, password)
-	return args.String(0), args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*operator.TokenPair), args.Error(1)
+}
+
+func (m *MockAuthService) Refresh(refreshToken string) (*operator.TokenPair, error) {
+	args := m.Called(refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*operator.TokenPair), args.Error(1)
+}
+
+func (m *MockAuthService) Logout(operatorID int, sessionID string) error {
+	args := m.Called(operatorID, sessionID)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) LogoutAll(operatorID int) error {
+	args := m.Called(operatorID)
+	return args.Error(0)
+}
+
+func (m *MockAuthService) IsAccessTokenRevoked(tokenID string) (bool, error) {
+	args := m.Called(tokenID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAuthService) PurgeExpiredTokens() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func testTokenPair() *operator.TokenPair {
+	return &operator.TokenPair{
+		AccessToken:           "mock.jwt.token",
+		AccessTokenExpiresAt:  time.Date(2024, time.January, 1, 10, 15, 0, 0, time.UTC),
+		RefreshToken:          "mock-refresh-token",
+		RefreshTokenExpiresAt: time.Date(2024, time.January, 8, 10, 0, 0, 0, time.UTC),
+	}
 }
 
 func setupTestRouter() *gin.Engine {
@@ -246,7 +289,7 @@ func TestLogin_Success(t *testing.T) {
 
 	router.POST("/login", handler.Login)
 
-	mockService.On("Login", "testuser", "password123").Return("mock.jwt.token", nil)
+	mockService.On("Login", "testuser", "password123").Return(testTokenPair(), nil)
 
 	requestBody := map[string]string{
 		"username": "testuser",
@@ -266,6 +309,9 @@ func TestLogin_Success(t *testing.T) {
 	json.Unmarshal(w.Body.Bytes(), &response)
 
 	assert.Equal(t, "mock.jwt.token", response["token"])
+	assert.Equal(t, "Bearer", response["token_type"])
+	assert.Equal(t, "2024-01-01T10:15:00Z", response["expires_at"])
+	assert.Equal(t, "mock-refresh-token", response["refresh_token"])
 	assert.Equal(t, "Login successful", response["message"])
 	mockService.AssertExpectations(t)
 }
@@ -326,7 +372,7 @@ func TestLogin_InvalidCredentials(t *testing.T) {
 	router.POST("/login", handler.Login)
 
 	mockService.On("Login", "testuser", "wrongpassword").
-		Return("", errors.New("invalid credentials"))
+		Return(nil, errors.New("invalid credentials"))
 
 	requestBody := map[string]string{
 		"username": "testuser",
@@ -358,7 +404,7 @@ func TestLogin_UserNotFound(t *testing.T) {
 	router.POST("/login", handler.Login)
 
 	mockService.On("Login", "nonexistent", "password123").
-		Return("", errors.New("invalid credentials"))
+		Return(nil, errors.New("invalid credentials"))
 
 	requestBody := map[string]string{
 		"username": "nonexistent",
@@ -389,7 +435,7 @@ func TestLogin_InactiveAccount(t *testing.T) {
 	router.POST("/login", handler.Login)
 
 	mockService.On("Login", "inactiveuser", "password123").
-		Return("", errors.New("operator account is inactive"))
+		Return(nil, errors.New("operator account is inactive"))
 
 	requestBody := map[string]string{
 		"username": "inactiveuser",
@@ -421,7 +467,7 @@ func TestLogin_ServiceError(t *testing.T) {
 	router.POST("/login", handler.Login)
 
 	mockService.On("Login", "testuser", "password123").
-		Return("", errors.New("failed to generate authentication token"))
+		Return(nil, errors.New("failed to generate authentication token"))
 
 	requestBody := map[string]string{
 		"username": "testuser",
@@ -443,3 +489,107 @@ func TestLogin_ServiceError(t *testing.T) {
 	assert.Equal(t, "authentication_error", response["error"])
 	mockService.AssertExpectations(t)
 }
+
+func TestRefresh(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		pair         *operator.TokenPair
+		err          error
+		expected     int
+		errorCode    string
+		callsRefresh bool
+	}{
+		{"success", `{"refresh_token":"mock-refresh-token"}`, testTokenPair(), nil, http.StatusOK, "", true},
+		{"missing token", `{}`, nil, nil, http.StatusBadRequest, "invalid_request", false},
+		{"invalid token", `{"refresh_token":"mock-refresh-token"}`, nil, operator.ErrInvalidRefreshToken, http.StatusUnauthorized, "authentication_error", true},
+		{"inactive operator", `{"refresh_token":"mock-refresh-token"}`, nil, operator.ErrOperatorInactive, http.StatusUnauthorized, "authentication_error", true},
+		{"service error", `{"refresh_token":"mock-refresh-token"}`, nil, errors.New("failed to refresh authentication token"), http.StatusInternalServerError, "internal_error", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockAuthService)
+			router := setupTestRouter()
+			router.POST("/refresh", NewAuthHandler(mockService).Refresh)
+
+			if tt.callsRefresh {
+				if tt.pair != nil {
+					mockService.On("Refresh", "mock-refresh-token").Return(tt.pair, nil)
+				} else {
+					mockService.On("Refresh", "mock-refresh-token").Return(nil, tt.err)
+				}
+			}
+
+			req, _ := http.NewRequest("POST", "/refresh", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+
+			var response map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &response)
+			if tt.errorCode != "" {
+				assert.Equal(t, tt.errorCode, response["error"])
+			} else {
+				assert.Equal(t, "mock.jwt.token", response["token"])
+				assert.Equal(t, "mock-refresh-token", response["refresh_token"])
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// setupSessionTest authenticates the requests as operator 3 in session-1.
+func setupSessionTest() (*gin.Engine, *MockAuthService) {
+	mockService := new(MockAuthService)
+	handler := NewAuthHandler(mockService)
+
+	router := setupTestRouter()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", 3)
+		c.Set("session_id", "session-1")
+		c.Next()
+	})
+	router.POST("/logout", handler.Logout)
+	router.POST("/logout-all", handler.LogoutAll)
+
+	return router, mockService
+}
+
+func TestLogout(t *testing.T) {
+	router, mockService := setupSessionTest()
+	mockService.On("Logout", 3, "session-1").Return(nil)
+
+	req, _ := http.NewRequest("POST", "/logout", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestLogout_ServiceError(t *testing.T) {
+	router, mockService := setupSessionTest()
+	mockService.On("Logout", 3, "session-1").Return(errors.New("failed to revoke session"))
+
+	req, _ := http.NewRequest("POST", "/logout", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "failed to revoke session")
+}
+
+func TestLogoutAll(t *testing.T) {
+	router, mockService := setupSessionTest()
+	mockService.On("LogoutAll", 3).Return(nil)
+
+	req, _ := http.NewRequest("POST", "/logout-all", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	mockService.AssertExpectations(t)
+}
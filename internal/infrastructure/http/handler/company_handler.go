@@ -0,0 +1,328 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/company"
+	personContract "pessoas-api/internal/contract/person"
+	companyError "pessoas-api/internal/domain/company/error"
+	"pessoas-api/internal/domain/company/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// companyValidationErrors are the domain errors reported as 422 for a company.
+var companyValidationErrors = []error{
+	companyError.ErrCNPJRequired,
+	companyError.ErrCNPJInvalid,
+	companyError.ErrLegalNameRequired,
+	companyError.ErrFoundingDateInvalid,
+	companyError.ErrStateRegistrationInvalid,
+}
+
+type CompanyHandler struct {
+	service ports.CompanyService
+}
+
+func NewCompanyHandler(service ports.CompanyService) *CompanyHandler {
+	return &CompanyHandler{
+		service: service,
+	}
+}
+
+// CreateCompany godoc
+// @Summary      Create a new company
+// @Description  Creates a legal entity. The CNPJ may be numeric or alphanumeric, with or without mask
+// @Tags         Companies
+// @Accept       json
+// @Produce      json
+// @Param        company  body      contract.CompanyDTO  true  "Company data"
+// @Success      201      {object}  contract.CompanyResponseDTO
+// @Header       201      {string}  Location  "URI of the created company"
+// @Header       201      {string}  ETag      "Current version of the company"
+// @Failure      400      {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      409      {object}  contract.ErrorResponse  "CNPJ already registered"
+// @Failure      422      {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500      {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /companies [post]
+func (h *CompanyHandler) CreateCompany(c *gin.Context) {
+	var dto contract.CompanyDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] CreateCompany - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	company, err := h.service.CreateCompany(dto, requestActor(c))
+	if err != nil {
+		respondCompanyError(c, "CreateCompany", 0, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] CreateCompany - Company created with ID: %d, CNPJ: %s", company.ID, company.CNPJ)
+	c.Header("Location", companyLocation(company.ID))
+	c.Header("ETag", versionETag(company.Version))
+	c.JSON(http.StatusCreated, contract.NewCompanyResponseDTO(company))
+}
+
+// ListCompanies godoc
+// @Summary      List companies
+// @Description  Returns a paginated list of companies
+// @Tags         Companies
+// @Produce      json
+// @Param        page       query  int     false  "Page number"  default(1)  minimum(1)
+// @Param        page_size  query  int     false  "Items per page"  default(10)  minimum(1)  maximum(100)
+// @Param        sort       query  string  false  "Sort field"  default(id)  Enums(id, legal_name, trade_name, cnpj, founding_date, created_at)
+// @Param        order      query  string  false  "Sort order"  default(desc)  Enums(asc, desc)
+// @Success      200        {object}  contract.PaginatedResponse{data=[]contract.CompanyResponseDTO}
+// @Failure      400        {object}  contract.ErrorResponse  "Invalid query parameter"
+// @Failure      500        {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /companies [get]
+func (h *CompanyHandler) ListCompanies(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	sort := c.DefaultQuery("sort", "id")
+	order := c.DefaultQuery("order", "desc")
+
+	companies, total, err := h.service.ListCompanies(page, pageSize, sort, order)
+	if err != nil {
+		log.Printf("[ERROR] ListCompanies - Failed to retrieve companies: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to retrieve companies: " + err.Error(),
+		})
+		return
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+
+	log.Printf("[SUCCESS] ListCompanies - Retrieved %d companies (total: %d, pages: %d)", len(companies), total, totalPages)
+
+	c.JSON(http.StatusOK, personContract.PaginatedResponse{
+		Data:       contract.NewCompanyResponseDTOs(companies),
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: totalPages,
+	})
+}
+
+// GetCompany godoc
+// @Summary      Find company by ID
+// @Description  Returns company data based on the provided company ID
+// @Tags         Companies
+// @Produce      json
+// @Param        id   path      int  true  "Company ID"
+// @Success      200  {object}  contract.CompanyResponseDTO
+// @Header       200  {string}  ETag  "Current version of the company"
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Company not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /companies/{id} [get]
+func (h *CompanyHandler) GetCompany(c *gin.Context) {
+	id, ok := companyIDParam(c, "GetCompany")
+	if !ok {
+		return
+	}
+
+	company, err := h.service.FindCompanyByID(id)
+	if err != nil {
+		respondCompanyError(c, "GetCompany", id, err)
+		return
+	}
+
+	c.Header("ETag", versionETag(company.Version))
+	c.JSON(http.StatusOK, contract.NewCompanyResponseDTO(company))
+}
+
+// FindCompanyByCNPJ godoc
+// @Summary      Find company by CNPJ
+// @Description  Returns company data based on the provided CNPJ, numeric or alphanumeric. Dots and dashes of the mask are accepted, but not the /
+// @Tags         Companies
+// @Produce      json
+// @Param        cnpj  path      string  true  "Company's CNPJ"  example(12ABC34501DE35)
+// @Success      200   {object}  contract.CompanyResponseDTO
+// @Header       200   {string}  ETag  "Current version of the company"
+// @Failure      400   {object}  contract.ErrorResponse  "Invalid CNPJ"
+// @Failure      404   {object}  contract.ErrorResponse  "Company not found"
+// @Failure      500   {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /companies/cnpj/{cnpj} [get]
+func (h *CompanyHandler) FindCompanyByCNPJ(c *gin.Context) {
+	cnpj := c.Param("cnpj")
+
+	company, err := h.service.FindCompanyByCNPJ(cnpj)
+	if err != nil {
+		if errors.Is(err, companyError.ErrCNPJInvalid) {
+			log.Printf("[ERROR] FindCompanyByCNPJ - Invalid CNPJ: %s", cnpj)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		respondCompanyError(c, "FindCompanyByCNPJ", 0, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] FindCompanyByCNPJ - Found company with ID: %d", company.ID)
+	c.Header("ETag", versionETag(company.Version))
+	c.JSON(http.StatusOK, contract.NewCompanyResponseDTO(company))
+}
+
+// UpdateCompany godoc
+// @Summary      Replace a company
+// @Description  Replaces every field of a company
+// @Tags         Companies
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                  true  "Company ID"
+// @Param        If-Match  header    string               true  "ETag of the company version being updated"
+// @Param        company   body      contract.CompanyDTO  true  "Company data"
+// @Success      200       {object}  contract.CompanyResponseDTO
+// @Header       200       {string}  ETag  "New version of the company"
+// @Failure      400       {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404       {object}  contract.ErrorResponse  "Company not found"
+// @Failure      409       {object}  contract.ErrorResponse  "CNPJ already registered"
+// @Failure      412       {object}  contract.ErrorResponse  "Company was modified by another request"
+// @Failure      422       {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      428       {object}  contract.ErrorResponse  "If-Match header is required"
+// @Failure      500       {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /companies/{id} [put]
+func (h *CompanyHandler) UpdateCompany(c *gin.Context) {
+	id, ok := companyIDParam(c, "UpdateCompany")
+	if !ok {
+		return
+	}
+
+	version, ok := requireIfMatch(c, "UpdateCompany")
+	if !ok {
+		return
+	}
+
+	var dto contract.CompanyDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] UpdateCompany - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	company, err := h.service.UpdateCompany(id, version, dto, requestActor(c))
+	if err != nil {
+		respondCompanyError(c, "UpdateCompany", id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] UpdateCompany - Company ID %d updated", id)
+	c.Header("ETag", versionETag(company.Version))
+	c.JSON(http.StatusOK, contract.NewCompanyResponseDTO(company))
+}
+
+// DeleteCompany godoc
+// @Summary      Delete a company
+// @Description  Soft deletes a company. Its CNPJ can then be registered again
+// @Tags         Companies
+// @Param        id        path    int     true  "Company ID"
+// @Param        If-Match  header  string  true  "ETag of the company version being deleted"
+// @Success      204
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Company not found"
+// @Failure      412  {object}  contract.ErrorResponse  "Company was modified by another request"
+// @Failure      428  {object}  contract.ErrorResponse  "If-Match header is required"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /companies/{id} [delete]
+func (h *CompanyHandler) DeleteCompany(c *gin.Context) {
+	id, ok := companyIDParam(c, "DeleteCompany")
+	if !ok {
+		return
+	}
+
+	version, ok := requireIfMatch(c, "DeleteCompany")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteCompany(id, version, requestActor(c)); err != nil {
+		respondCompanyError(c, "DeleteCompany", id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] DeleteCompany - Company ID %d deleted", id)
+	c.Status(http.StatusNoContent)
+}
+
+// companyLocation builds the URI of a company resource, used in Location headers.
+func companyLocation(id int) string {
+	return fmt.Sprintf("/api/v1/companies/%d", id)
+}
+
+// companyIDParam reads the company ID from the path. When it is invalid it
+// writes the error response and returns false.
+func companyIDParam(c *gin.Context, operation string) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] %s - Invalid ID parameter: %s", operation, c.Param("id"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid company ID",
+		})
+		return 0, false
+	}
+
+	return id, true
+}
+
+func respondCompanyError(c *gin.Context, operation string, id int, err error) {
+	switch {
+	case errors.Is(err, companyError.ErrCompanyNotFound):
+		log.Printf("[WARN] %s - Company not found with ID: %d", operation, id)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Company not found",
+		})
+	case errors.Is(err, companyError.ErrVersionConflict):
+		log.Printf("[WARN] %s - Version conflict for company ID %d", operation, id)
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "precondition_failed",
+			"message": err.Error(),
+		})
+	case errors.Is(err, companyError.ErrCNPJAlreadyInUse):
+		log.Printf("[WARN] %s - %v", operation, err)
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "conflict",
+			"message": err.Error(),
+		})
+	case isCompanyValidationError(err):
+		log.Printf("[ERROR] %s - Validation error for company ID %d: %v", operation, id, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed for company ID %d: %v", operation, id, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process company: " + err.Error(),
+		})
+	}
+}
+
+func isCompanyValidationError(err error) bool {
+	for _, validationErr := range companyValidationErrors {
+		if errors.Is(err, validationErr) {
+			return true
+		}
+	}
+	return false
+}
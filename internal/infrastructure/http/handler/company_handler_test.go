@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/company"
+	companyError "pessoas-api/internal/domain/company/error"
+	company "pessoas-api/internal/domain/company/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupCompanyTest() (*gin.Engine, *mocks.MockCompanyService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockCompanyService)
+	handler := NewCompanyHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Next()
+	})
+	router.POST("/companies", handler.CreateCompany)
+	router.GET("/companies", handler.ListCompanies)
+	router.GET("/companies/:id", handler.GetCompany)
+	router.PUT("/companies/:id", handler.UpdateCompany)
+	router.DELETE("/companies/:id", handler.DeleteCompany)
+	router.GET("/companies/cnpj/:cnpj", handler.FindCompanyByCNPJ)
+
+	return router, mockService
+}
+
+func testCompanyDTO() contract.CompanyDTO {
+	return contract.CompanyDTO{
+		CNPJ:         "12.ABC.345/01DE-35",
+		LegalName:    "Padaria Pão Quente Ltda",
+		TradeName:    "Pão Quente",
+		FoundingDate: time.Date(2010, time.March, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func testCompany(id, version int) *company.Company {
+	return &company.Company{
+		ID:           id,
+		CNPJ:         "12ABC34501DE35",
+		LegalName:    "Padaria Pão Quente Ltda",
+		TradeName:    "Pão Quente",
+		FoundingDate: time.Date(2010, time.March, 1, 0, 0, 0, 0, time.UTC),
+		Version:      version,
+	}
+}
+
+func TestCreateCompany_Success(t *testing.T) {
+	router, mockService := setupCompanyTest()
+
+	dto := testCompanyDTO()
+	mockService.On("CreateCompany", dto, testActor).Return(testCompany(3, 1), nil)
+
+	body, _ := json.Marshal(dto)
+	req, _ := http.NewRequest("POST", "/companies", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "/api/v1/companies/3", w.Header().Get("Location"))
+	assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+
+	var response contract.CompanyResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, "12ABC34501DE35", response.CNPJ)
+	assert.Equal(t, "12.ABC.345/01DE-35", response.CNPJFormatted)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateCompany_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+		code     string
+	}{
+		{"invalid cnpj", companyError.ErrCNPJInvalid, http.StatusUnprocessableEntity, "validation_error"},
+		{"invalid state registration", companyError.ErrStateRegistrationInvalid, http.StatusUnprocessableEntity, "validation_error"},
+		{"cnpj already registered", companyError.ErrCNPJAlreadyInUse, http.StatusConflict, "conflict"},
+		{"repository failure", errors.New("connection refused"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupCompanyTest()
+
+			mockService.On("CreateCompany", mock.Anything, testActor).Return(nil, tt.err)
+
+			body, _ := json.Marshal(testCompanyDTO())
+			req, _ := http.NewRequest("POST", "/companies", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+			assert.Contains(t, w.Body.String(), tt.code)
+		})
+	}
+}
+
+func TestCreateCompany_InvalidBody(t *testing.T) {
+	router, mockService := setupCompanyTest()
+
+	req, _ := http.NewRequest("POST", "/companies", bytes.NewBufferString(`{"cnpj":"11222333000181"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "CreateCompany", mock.Anything, mock.Anything)
+}
+
+func TestListCompanies_Success(t *testing.T) {
+	router, mockService := setupCompanyTest()
+
+	mockService.On("ListCompanies", 2, 1, "legal_name", "asc").Return([]*company.Company{testCompany(3, 1)}, int64(3), nil)
+
+	req, _ := http.NewRequest("GET", "/companies?page=2&page_size=1&sort=legal_name&order=asc", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data       []contract.CompanyResponseDTO `json:"data"`
+		TotalPages int                           `json:"total_pages"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response.Data, 1)
+	assert.Equal(t, 3, response.TotalPages)
+}
+
+func TestGetCompany(t *testing.T) {
+	router, mockService := setupCompanyTest()
+
+	mockService.On("FindCompanyByID", 3).Return(testCompany(3, 2), nil)
+	mockService.On("FindCompanyByID", 4).Return(nil, companyError.ErrCompanyNotFound)
+
+	req, _ := http.NewRequest("GET", "/companies/3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+
+	req, _ = http.NewRequest("GET", "/companies/4", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("GET", "/companies/abc", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestFindCompanyByCNPJ(t *testing.T) {
+	router, mockService := setupCompanyTest()
+
+	mockService.On("FindCompanyByCNPJ", "12ABC34501DE35").Return(testCompany(3, 1), nil)
+	mockService.On("FindCompanyByCNPJ", "12ABC34501DE36").Return(nil, companyError.ErrCNPJInvalid)
+	mockService.On("FindCompanyByCNPJ", "11222333000181").Return(nil, companyError.ErrCompanyNotFound)
+
+	tests := []struct {
+		cnpj     string
+		expected int
+	}{
+		{"12ABC34501DE35", http.StatusOK},
+		{"12ABC34501DE36", http.StatusBadRequest},
+		{"11222333000181", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		req, _ := http.NewRequest("GET", "/companies/cnpj/"+tt.cnpj, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, tt.expected, w.Code, tt.cnpj)
+	}
+}
+
+func TestUpdateCompany_Success(t *testing.T) {
+	router, mockService := setupCompanyTest()
+
+	dto := testCompanyDTO()
+	mockService.On("UpdateCompany", 3, 2, dto, testActor).Return(testCompany(3, 3), nil)
+
+	body, _ := json.Marshal(dto)
+	req, _ := http.NewRequest("PUT", "/companies/3", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"2"`)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+	mockService.AssertExpectations(t)
+}
+
+func TestUpdateCompany_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		ifMatch  string
+		err      error
+		expected int
+	}{
+		{"missing If-Match", "", nil, http.StatusPreconditionRequired},
+		{"version conflict", `"1"`, companyError.ErrVersionConflict, http.StatusPreconditionFailed},
+		{"not found", `"1"`, companyError.ErrCompanyNotFound, http.StatusNotFound},
+		{"invalid founding date", `"1"`, companyError.ErrFoundingDateInvalid, http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupCompanyTest()
+
+			mockService.On("UpdateCompany", 3, 1, mock.Anything, testActor).Return(nil, tt.err)
+
+			body, _ := json.Marshal(testCompanyDTO())
+			req, _ := http.NewRequest("PUT", "/companies/3", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestDeleteCompany(t *testing.T) {
+	router, mockService := setupCompanyTest()
+
+	mockService.On("DeleteCompany", 3, 2, testActor).Return(nil)
+	mockService.On("DeleteCompany", 4, 2, testActor).Return(companyError.ErrVersionConflict)
+
+	req, _ := http.NewRequest("DELETE", "/companies/3", nil)
+	req.Header.Set("If-Match", `"2"`)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	req, _ = http.NewRequest("DELETE", "/companies/4", nil)
+	req.Header.Set("If-Match", `"2"`)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
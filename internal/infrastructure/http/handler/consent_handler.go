@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// consentValidationErrors are the domain errors reported as 422 for a consent.
+var consentValidationErrors = []error{
+	personError.ErrConsentPurposeInvalid,
+	personError.ErrLegalBasisInvalid,
+	personError.ErrConsentChannelInvalid,
+	personError.ErrConsentEvidenceRequired,
+	personError.ErrConsentEvidenceTooLong,
+}
+
+// ConsentHandler serves the consents of a person to the processing of their
+// data for marketing, analytics and data sharing with partners (LGPD).
+type ConsentHandler struct {
+	service ports.ConsentService
+}
+
+func NewConsentHandler(service ports.ConsentService) *ConsentHandler {
+	return &ConsentHandler{
+		service: service,
+	}
+}
+
+// ListConsents godoc
+// @Summary      List the consents of a person
+// @Description  Returns the current state of every purpose the person was ever asked about, active or revoked
+// @Tags         Consents
+// @Produce      json
+// @Param        id   path      int  true  "Person ID"
+// @Success      200  {array}   contract.ConsentResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/consents [get]
+func (h *ConsentHandler) ListConsents(c *gin.Context) {
+	personID, ok := personIDParam(c, "ListConsents")
+	if !ok {
+		return
+	}
+
+	consents, err := h.service.ListConsents(personID)
+	if err != nil {
+		respondConsentError(c, "ListConsents", personID, "", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewConsentResponseDTOs(consents))
+}
+
+// GetConsent godoc
+// @Summary      Get the consent of a person for a purpose
+// @Description  Returns the current state of the consent of a person for a purpose
+// @Tags         Consents
+// @Produce      json
+// @Param        id       path      int     true  "Person ID"
+// @Param        purpose  path      string  true  "Purpose"  Enums(marketing, analytics, data_sharing)
+// @Success      200  {object}  contract.ConsentResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found or purpose never granted"
+// @Failure      422  {object}  contract.ErrorResponse  "Unknown purpose"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/consents/{purpose} [get]
+func (h *ConsentHandler) GetConsent(c *gin.Context) {
+	personID, ok := personIDParam(c, "GetConsent")
+	if !ok {
+		return
+	}
+
+	purpose := c.Param("purpose")
+	consent, err := h.service.FindConsent(personID, purpose)
+	if err != nil {
+		respondConsentError(c, "GetConsent", personID, purpose, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewConsentResponseDTO(consent))
+}
+
+// GrantConsent godoc
+// @Summary      Grant a consent
+// @Description  Records that the person consented to a purpose, with the legal basis, the channel and a reference to the evidence of the grant, which is required when the legal basis is consent. A revoked purpose can be granted again; an active one must be revoked first. The grant is appended to the consent history
+// @Tags         Consents
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                  true  "Person ID"
+// @Param        consent  body      contract.ConsentDTO  true  "Consent data"
+// @Success      200      {object}  contract.ConsentResponseDTO
+// @Failure      400      {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404      {object}  contract.ErrorResponse  "Person not found"
+// @Failure      409      {object}  contract.ErrorResponse  "Consent already granted"
+// @Failure      422      {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500      {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/consents [post]
+func (h *ConsentHandler) GrantConsent(c *gin.Context) {
+	personID, ok := personIDParam(c, "GrantConsent")
+	if !ok {
+		return
+	}
+
+	var dto contract.ConsentDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] GrantConsent - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	consent, err := h.service.GrantConsent(personID, dto, requestActor(c))
+	if err != nil {
+		respondConsentError(c, "GrantConsent", personID, dto.Purpose, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] GrantConsent - Consent %d (%s) granted by person ID %d", consent.ID, consent.Purpose, personID)
+	c.JSON(http.StatusOK, contract.NewConsentResponseDTO(consent))
+}
+
+// RevokeConsent godoc
+// @Summary      Revoke a consent
+// @Description  Records that the person withdrew the consent to a purpose. The consent keeps the data of its grant; the channel and evidence of the revocation are appended to the consent history
+// @Tags         Consents
+// @Accept       json
+// @Produce      json
+// @Param        id          path      int                        true  "Person ID"
+// @Param        purpose     path      string                     true  "Purpose"  Enums(marketing, analytics, data_sharing)
+// @Param        revocation  body      contract.RevokeConsentDTO  true  "Revocation data"
+// @Success      200         {object}  contract.ConsentResponseDTO
+// @Failure      400         {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404         {object}  contract.ErrorResponse  "Person not found or purpose never granted"
+// @Failure      409         {object}  contract.ErrorResponse  "Consent already revoked"
+// @Failure      422         {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500         {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/consents/{purpose}/revoke [post]
+func (h *ConsentHandler) RevokeConsent(c *gin.Context) {
+	personID, ok := personIDParam(c, "RevokeConsent")
+	if !ok {
+		return
+	}
+
+	var dto contract.RevokeConsentDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] RevokeConsent - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	purpose := c.Param("purpose")
+	consent, err := h.service.RevokeConsent(personID, purpose, dto, requestActor(c))
+	if err != nil {
+		respondConsentError(c, "RevokeConsent", personID, purpose, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] RevokeConsent - Consent %d (%s) revoked by person ID %d", consent.ID, consent.Purpose, personID)
+	c.JSON(http.StatusOK, contract.NewConsentResponseDTO(consent))
+}
+
+// ConsentHistory godoc
+// @Summary      Get the consent history of a person
+// @Description  Returns every grant and revocation of consent of the person, newest first, with the channel, the evidence and the operator who recorded it. The history is append-only
+// @Tags         Consents
+// @Produce      json
+// @Param        id   path      int  true  "Person ID"
+// @Success      200  {array}   contract.ConsentEventResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/consents/history [get]
+func (h *ConsentHandler) ConsentHistory(c *gin.Context) {
+	personID, ok := personIDParam(c, "ConsentHistory")
+	if !ok {
+		return
+	}
+
+	events, err := h.service.ConsentHistory(personID)
+	if err != nil {
+		respondConsentError(c, "ConsentHistory", personID, "", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewConsentEventResponseDTOs(events))
+}
+
+func respondConsentError(c *gin.Context, operation string, personID int, purpose string, err error) {
+	switch {
+	case errors.Is(err, personError.ErrPersonNotFound):
+		log.Printf("[WARN] %s - Person not found with ID: %d", operation, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Person not found",
+		})
+	case errors.Is(err, personError.ErrConsentNotFound):
+		log.Printf("[WARN] %s - Consent %s not found for person ID %d", operation, purpose, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Consent not found",
+		})
+	case errors.Is(err, personError.ErrConsentAlreadyGranted), errors.Is(err, personError.ErrConsentNotGranted):
+		log.Printf("[WARN] %s - Consent %s of person ID %d: %v", operation, purpose, personID, err)
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "conflict",
+			"message": err.Error(),
+		})
+	case isConsentValidationError(err):
+		log.Printf("[ERROR] %s - Validation error for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process consent: " + err.Error(),
+		})
+	}
+}
+
+func isConsentValidationError(err error) bool {
+	for _, validationErr := range consentValidationErrors {
+		if errors.Is(err, validationErr) {
+			return true
+		}
+	}
+	return false
+}
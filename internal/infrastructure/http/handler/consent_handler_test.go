@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupConsentTest() (*gin.Engine, *mocks.MockConsentService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockConsentService)
+	handler := NewConsentHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Next()
+	})
+	router.GET("/persons/:id/consents", handler.ListConsents)
+	router.POST("/persons/:id/consents", handler.GrantConsent)
+	router.GET("/persons/:id/consents/history", handler.ConsentHistory)
+	router.GET("/persons/:id/consents/:purpose", handler.GetConsent)
+	router.POST("/persons/:id/consents/:purpose/revoke", handler.RevokeConsent)
+
+	return router, mockService
+}
+
+func testConsent(revokedAt *time.Time) *person.Consent {
+	return &person.Consent{
+		ConsentFields: person.ConsentFields{
+			Purpose:     person.ConsentMarketing,
+			LegalBasis:  person.LegalBasisConsent,
+			Channel:     person.ChannelWeb,
+			EvidenceRef: "form-1",
+		},
+		ID:        7,
+		PersonID:  1,
+		GrantedAt: time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC),
+		RevokedAt: revokedAt,
+	}
+}
+
+func TestGrantConsent_Success(t *testing.T) {
+	router, mockService := setupConsentTest()
+
+	dto := contract.ConsentDTO{Purpose: "marketing", LegalBasis: "consent", Channel: "web", EvidenceRef: "form-1"}
+	mockService.On("GrantConsent", 1, dto, testActor).Return(testConsent(nil), nil)
+
+	body, _ := json.Marshal(dto)
+	req, _ := http.NewRequest("POST", "/persons/1/consents", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response contract.ConsentResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, 7, response.ID)
+	assert.True(t, response.Active)
+	assert.Nil(t, response.RevokedAt)
+}
+
+func TestGrantConsent_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		err      error
+		expected int
+	}{
+		{"missing channel", `{"purpose": "marketing", "legal_basis": "consent"}`, nil, http.StatusBadRequest},
+		{"person not found", `{"purpose": "marketing", "legal_basis": "consent", "channel": "web"}`, personError.ErrPersonNotFound, http.StatusNotFound},
+		{"already granted", `{"purpose": "marketing", "legal_basis": "consent", "channel": "web"}`, personError.ErrConsentAlreadyGranted, http.StatusConflict},
+		{"missing evidence", `{"purpose": "marketing", "legal_basis": "consent", "channel": "web"}`, personError.ErrConsentEvidenceRequired, http.StatusUnprocessableEntity},
+		{"unknown purpose", `{"purpose": "profiling", "legal_basis": "consent", "channel": "web"}`, personError.ErrConsentPurposeInvalid, http.StatusUnprocessableEntity},
+		{"repository error", `{"purpose": "marketing", "legal_basis": "consent", "channel": "web"}`, errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupConsentTest()
+			var dto contract.ConsentDTO
+			json.Unmarshal([]byte(tt.body), &dto)
+			mockService.On("GrantConsent", 1, dto, testActor).Return(nil, tt.err)
+
+			req, _ := http.NewRequest("POST", "/persons/1/consents", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestRevokeConsent_Success(t *testing.T) {
+	router, mockService := setupConsentTest()
+
+	revokedAt := time.Date(2024, time.February, 1, 10, 0, 0, 0, time.UTC)
+	dto := contract.RevokeConsentDTO{Channel: "call_center", EvidenceRef: "call-77"}
+	mockService.On("RevokeConsent", 1, "marketing", dto, testActor).Return(testConsent(&revokedAt), nil)
+
+	req, _ := http.NewRequest("POST", "/persons/1/consents/marketing/revoke", bytes.NewBufferString(`{"channel": "call_center", "evidence_ref": "call-77"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response contract.ConsentResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.False(t, response.Active)
+	assert.Equal(t, revokedAt, *response.RevokedAt)
+}
+
+func TestRevokeConsent_NotGranted(t *testing.T) {
+	router, mockService := setupConsentTest()
+
+	dto := contract.RevokeConsentDTO{Channel: "web"}
+	mockService.On("RevokeConsent", 1, "analytics", dto, testActor).Return(nil, personError.ErrConsentNotGranted)
+
+	req, _ := http.NewRequest("POST", "/persons/1/consents/analytics/revoke", bytes.NewBufferString(`{"channel": "web"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGetConsent(t *testing.T) {
+	tests := []struct {
+		name     string
+		purpose  string
+		consent  *person.Consent
+		err      error
+		expected int
+	}{
+		{"found", "marketing", testConsent(nil), nil, http.StatusOK},
+		{"never granted", "analytics", nil, personError.ErrConsentNotFound, http.StatusNotFound},
+		{"unknown purpose", "profiling", nil, personError.ErrConsentPurposeInvalid, http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupConsentTest()
+			mockService.On("FindConsent", 1, tt.purpose).Return(tt.consent, tt.err)
+
+			req, _ := http.NewRequest("GET", "/persons/1/consents/"+tt.purpose, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestListConsents_InvalidID(t *testing.T) {
+	router, mockService := setupConsentTest()
+
+	req, _ := http.NewRequest("GET", "/persons/abc/consents", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ListConsents")
+}
+
+func TestConsentHistory_Success(t *testing.T) {
+	router, mockService := setupConsentTest()
+
+	events := []*person.ConsentEvent{
+		{ID: 2, ConsentID: 7, PersonID: 1, Purpose: person.ConsentMarketing, Action: person.ConsentActionRevoke, Channel: person.ChannelCallCenter, OperatorID: 3},
+		{ID: 1, ConsentID: 7, PersonID: 1, Purpose: person.ConsentMarketing, Action: person.ConsentActionGrant, Channel: person.ChannelWeb, OperatorID: 3},
+	}
+	mockService.On("ConsentHistory", 1).Return(events, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/1/consents/history", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []contract.ConsentEventResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response, 2)
+	assert.Equal(t, person.ConsentActionRevoke, response[0].Action)
+	mockService.AssertNotCalled(t, "FindConsent", 1, "history")
+}
@@ -0,0 +1,303 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// contactValidationErrors are the domain errors reported as 422 for a contact.
+var contactValidationErrors = []error{
+	personError.ErrContactTypeInvalid,
+	personError.ErrContactTypeImmutable,
+	personError.ErrContactLabelTooLong,
+	personError.ErrContactRequired,
+	personError.ErrPhoneRequired,
+	personError.ErrPhoneInvalid,
+	personError.ErrEmailRequired,
+	personError.ErrEmailInvalid,
+}
+
+type ContactHandler struct {
+	service ports.ContactService
+}
+
+func NewContactHandler(service ports.ContactService) *ContactHandler {
+	return &ContactHandler{
+		service: service,
+	}
+}
+
+// ListContacts godoc
+// @Summary      List the contacts of a person
+// @Description  Returns every phone number and email of a person, the primary ones first
+// @Tags         Contacts
+// @Produce      json
+// @Param        id   path      int  true  "Person ID"
+// @Success      200  {array}   contract.ContactResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/contacts [get]
+func (h *ContactHandler) ListContacts(c *gin.Context) {
+	personID, ok := personIDParam(c, "ListContacts")
+	if !ok {
+		return
+	}
+
+	contacts, err := h.service.ListContacts(personID)
+	if err != nil {
+		respondContactError(c, "ListContacts", personID, 0, err)
+		return
+	}
+
+	response := make([]contract.ContactResponseDTO, len(contacts))
+	for i, contact := range contacts {
+		response[i] = contactResponse(c, contact)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetContact godoc
+// @Summary      Get a contact
+// @Description  Returns one contact of a person
+// @Tags         Contacts
+// @Produce      json
+// @Param        id         path      int  true  "Person ID"
+// @Param        contactId  path      int  true  "Contact ID"
+// @Success      200  {object}  contract.ContactResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person or contact not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/contacts/{contactId} [get]
+func (h *ContactHandler) GetContact(c *gin.Context) {
+	personID, id, ok := contactIDParams(c, "GetContact")
+	if !ok {
+		return
+	}
+
+	contact, err := h.service.FindContact(personID, id)
+	if err != nil {
+		respondContactError(c, "GetContact", personID, id, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contactResponse(c, contact))
+}
+
+// CreateContact godoc
+// @Summary      Add a contact to a person
+// @Description  Adds a phone number or email. Marking it as primary demotes the current primary contact of the same type, which is the one reported in the phone and email fields of the person, so it requires the person's ETag in If-Match
+// @Tags         Contacts
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                  true   "Person ID"
+// @Param        If-Match  header    string               false  "ETag of the person, required when the contact is primary"
+// @Param        contact   body      contract.ContactDTO  true   "Contact data"
+// @Success      201       {object}  contract.ContactResponseDTO
+// @Header       201       {string}  Location  "URI of the created contact"
+// @Failure      400       {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404       {object}  contract.ErrorResponse  "Person not found"
+// @Failure      412       {object}  contract.ErrorResponse  "Person was modified by another request"
+// @Failure      422       {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      428       {object}  contract.ErrorResponse  "If-Match header is required for a primary contact"
+// @Failure      500       {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/contacts [post]
+func (h *ContactHandler) CreateContact(c *gin.Context) {
+	personID, ok := personIDParam(c, "CreateContact")
+	if !ok {
+		return
+	}
+
+	version, ok := optionalIfMatch(c, "CreateContact")
+	if !ok {
+		return
+	}
+
+	var dto contract.ContactDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] CreateContact - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	contact, err := h.service.AddContact(personID, version, dto, requestActor(c))
+	if err != nil {
+		respondContactError(c, "CreateContact", personID, 0, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] CreateContact - Contact %d added to person ID %d", contact.ID, personID)
+	c.Header("Location", contactLocation(contact))
+	c.JSON(http.StatusCreated, contactResponse(c, contact))
+}
+
+// UpdateContact godoc
+// @Summary      Replace a contact
+// @Description  Replaces the value, label and flags of a contact. Its type cannot change, and a primary contact stays primary until another contact of its type is marked as primary. Replacing a primary contact, or marking a contact as primary, requires the person's ETag in If-Match
+// @Tags         Contacts
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int                  true   "Person ID"
+// @Param        contactId  path      int                  true   "Contact ID"
+// @Param        If-Match   header    string               false  "ETag of the person, required when the contact is or becomes primary"
+// @Param        contact    body      contract.ContactDTO  true   "Contact data"
+// @Success      200        {object}  contract.ContactResponseDTO
+// @Failure      400        {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404        {object}  contract.ErrorResponse  "Person or contact not found"
+// @Failure      412        {object}  contract.ErrorResponse  "Person was modified by another request"
+// @Failure      422        {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      428        {object}  contract.ErrorResponse  "If-Match header is required for a primary contact"
+// @Failure      500        {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/contacts/{contactId} [put]
+func (h *ContactHandler) UpdateContact(c *gin.Context) {
+	personID, id, ok := contactIDParams(c, "UpdateContact")
+	if !ok {
+		return
+	}
+
+	version, ok := optionalIfMatch(c, "UpdateContact")
+	if !ok {
+		return
+	}
+
+	var dto contract.ContactDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] UpdateContact - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	contact, err := h.service.UpdateContact(personID, id, version, dto, requestActor(c))
+	if err != nil {
+		respondContactError(c, "UpdateContact", personID, id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] UpdateContact - Contact %d of person ID %d updated", id, personID)
+	c.JSON(http.StatusOK, contactResponse(c, contact))
+}
+
+// DeleteContact godoc
+// @Summary      Delete a contact
+// @Description  Removes a contact. The last phone number or email of a person cannot be removed. When it was the primary contact, the oldest remaining contact of the same type becomes primary, so removing a primary contact requires the person's ETag in If-Match
+// @Tags         Contacts
+// @Param        id         path    int     true   "Person ID"
+// @Param        contactId  path    int     true   "Contact ID"
+// @Param        If-Match   header  string  false  "ETag of the person, required when the contact is primary"
+// @Success      204
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person or contact not found"
+// @Failure      412  {object}  contract.ErrorResponse  "Person was modified by another request"
+// @Failure      422  {object}  contract.ErrorResponse  "Last contact of its type"
+// @Failure      428  {object}  contract.ErrorResponse  "If-Match header is required for a primary contact"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/contacts/{contactId} [delete]
+func (h *ContactHandler) DeleteContact(c *gin.Context) {
+	personID, id, ok := contactIDParams(c, "DeleteContact")
+	if !ok {
+		return
+	}
+
+	version, ok := optionalIfMatch(c, "DeleteContact")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteContact(personID, id, version, requestActor(c)); err != nil {
+		respondContactError(c, "DeleteContact", personID, id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] DeleteContact - Contact %d of person ID %d deleted", id, personID)
+	c.Status(http.StatusNoContent)
+}
+
+// contactLocation builds the URI of a contact resource, used in Location headers.
+func contactLocation(contact *personModel.Contact) string {
+	return fmt.Sprintf("%s/contacts/%d", personLocation(contact.PersonID), contact.ID)
+}
+
+// contactIDParams reads the person and contact IDs from the path. When either
+// is invalid it writes the error response and returns false.
+func contactIDParams(c *gin.Context, operation string) (int, int, bool) {
+	personID, ok := personIDParam(c, operation)
+	if !ok {
+		return 0, 0, false
+	}
+
+	id, err := strconv.Atoi(c.Param("contactId"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] %s - Invalid contact ID parameter: %s", operation, c.Param("contactId"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid contact ID",
+		})
+		return 0, 0, false
+	}
+
+	return personID, id, true
+}
+
+func respondContactError(c *gin.Context, operation string, personID, id int, err error) {
+	switch {
+	case errors.Is(err, personError.ErrPersonNotFound):
+		log.Printf("[WARN] %s - Person not found with ID: %d", operation, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Person not found",
+		})
+	case errors.Is(err, personError.ErrContactNotFound):
+		log.Printf("[WARN] %s - Contact %d not found for person ID %d", operation, id, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Contact not found",
+		})
+	case errors.Is(err, personError.ErrVersionRequired):
+		log.Printf("[ERROR] %s - Missing If-Match header to change a primary contact of person ID %d", operation, personID)
+		c.JSON(http.StatusPreconditionRequired, gin.H{
+			"error":   "precondition_required",
+			"message": "If-Match header with the person ETag is required to change a primary contact",
+		})
+	case errors.Is(err, personError.ErrVersionConflict):
+		log.Printf("[WARN] %s - Version conflict for person ID %d", operation, personID)
+		respondVersionConflict(c)
+	case isContactValidationError(err):
+		log.Printf("[ERROR] %s - Validation error for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process contact: " + err.Error(),
+		})
+	}
+}
+
+func isContactValidationError(err error) bool {
+	for _, validationErr := range contactValidationErrors {
+		if errors.Is(err, validationErr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupContactTest() (*gin.Engine, *mocks.MockContactService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockContactService)
+	handler := NewContactHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Set("roles", testRoles)
+		c.Next()
+	})
+	router.GET("/persons/:id/contacts", handler.ListContacts)
+	router.POST("/persons/:id/contacts", handler.CreateContact)
+	router.GET("/persons/:id/contacts/:contactId", handler.GetContact)
+	router.PUT("/persons/:id/contacts/:contactId", handler.UpdateContact)
+	router.DELETE("/persons/:id/contacts/:contactId", handler.DeleteContact)
+
+	return router, mockService
+}
+
+func testContactDTO() contract.ContactDTO {
+	return contract.ContactDTO{Type: "phone", Value: "(81) 3222-1234", Label: "home"}
+}
+
+func testContact(id int, primary bool) *person.Contact {
+	return &person.Contact{
+		ContactFields: person.ContactFields{
+			Type:    person.ContactPhone,
+			Value:   "8132221234",
+			Label:   "home",
+			Primary: primary,
+		},
+		ID:       id,
+		PersonID: 1,
+	}
+}
+
+func TestListContacts_Success(t *testing.T) {
+	router, mockService := setupContactTest()
+
+	mockService.On("ListContacts", 1).Return([]*person.Contact{testContact(3, true), testContact(4, false)}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/1/contacts", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []contract.ContactResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response, 2)
+	assert.Equal(t, 3, response[0].ID)
+	assert.True(t, response[0].Primary)
+	assert.Equal(t, "8132221234", response[0].Value)
+}
+
+func TestCreateContact_Success(t *testing.T) {
+	router, mockService := setupContactTest()
+
+	mockService.On("AddContact", 1, 0, testContactDTO(), testActor).Return(testContact(3, false), nil)
+
+	body, _ := json.Marshal(testContactDTO())
+	req, _ := http.NewRequest("POST", "/persons/1/contacts", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "/api/v1/persons/1/contacts/3", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"label":"home"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateContact_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		err          error
+		expectedCode int
+		expectedErr  string
+	}{
+		{"invalid person id", "/persons/abc/contacts", `{}`, nil, http.StatusBadRequest, "invalid_request"},
+		{"missing value", "/persons/1/contacts", `{"type":"phone"}`, nil, http.StatusBadRequest, "invalid_request"},
+		{"person not found", "/persons/1/contacts", "", personError.ErrPersonNotFound, http.StatusNotFound, "not_found"},
+		{"invalid type", "/persons/1/contacts", "", personError.ErrContactTypeInvalid, http.StatusUnprocessableEntity, "validation_error"},
+		{"invalid phone", "/persons/1/contacts", "", personError.ErrPhoneInvalid, http.StatusUnprocessableEntity, "validation_error"},
+		{"primary without If-Match", "/persons/1/contacts", "", personError.ErrVersionRequired, http.StatusPreconditionRequired, "precondition_required"},
+		{"database error", "/persons/1/contacts", "", errors.New("database error"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupContactTest()
+			mockService.On("AddContact", 1, 0, mock.Anything, testActor).Return(nil, tt.err)
+
+			body := tt.body
+			if body == "" {
+				encoded, _ := json.Marshal(testContactDTO())
+				body = string(encoded)
+			}
+			req, _ := http.NewRequest("POST", tt.path, bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedErr)
+		})
+	}
+}
+
+func TestGetContact(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		contact      *person.Contact
+		err          error
+		expectedCode int
+	}{
+		{"found", "/persons/1/contacts/3", testContact(3, true), nil, http.StatusOK},
+		{"contact not found", "/persons/1/contacts/3", nil, personError.ErrContactNotFound, http.StatusNotFound},
+		{"invalid contact id", "/persons/1/contacts/x", nil, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupContactTest()
+			mockService.On("FindContact", 1, 3).Return(tt.contact, tt.err)
+
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestUpdateContact(t *testing.T) {
+	tests := []struct {
+		name         string
+		contact      *person.Contact
+		err          error
+		expectedCode int
+	}{
+		{"updated", testContact(3, true), nil, http.StatusOK},
+		{"type changed", nil, personError.ErrContactTypeImmutable, http.StatusUnprocessableEntity},
+		{"stale person version", nil, personError.ErrVersionConflict, http.StatusPreconditionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupContactTest()
+			mockService.On("UpdateContact", 1, 3, 4, testContactDTO(), testActor).Return(tt.contact, tt.err)
+
+			body, _ := json.Marshal(testContactDTO())
+			req, _ := http.NewRequest("PUT", "/persons/1/contacts/3", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", `"4"`)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteContact(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"deleted", nil, http.StatusNoContent},
+		{"not found", personError.ErrContactNotFound, http.StatusNotFound},
+		{"last of its type", personError.ErrContactRequired, http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupContactTest()
+			mockService.On("DeleteContact", 1, 3, 0, testActor).Return(tt.err)
+
+			req, _ := http.NewRequest("DELETE", "/persons/1/contacts/3", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteContact_InvalidIfMatch(t *testing.T) {
+	router, mockService := setupContactTest()
+
+	req, _ := http.NewRequest("DELETE", "/persons/1/contacts/3", nil)
+	req.Header.Set("If-Match", "4")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "DeleteContact", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
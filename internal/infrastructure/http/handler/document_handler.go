@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	companyContract "pessoas-api/internal/contract/company"
+	contract "pessoas-api/internal/contract/document"
+	companyError "pessoas-api/internal/domain/company/error"
+	companyModel "pessoas-api/internal/domain/company/model"
+	companyPorts "pessoas-api/internal/domain/company/ports"
+	personModel "pessoas-api/internal/domain/person/model"
+	personPorts "pessoas-api/internal/domain/person/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DocumentHandler looks up the holder of a Brazilian tax ID, which is either a
+// person (CPF) or a company (CNPJ).
+type DocumentHandler struct {
+	persons   personPorts.PersonService
+	companies companyPorts.CompanyService
+}
+
+func NewDocumentHandler(persons personPorts.PersonService, companies companyPorts.CompanyService) *DocumentHandler {
+	return &DocumentHandler{
+		persons:   persons,
+		companies: companies,
+	}
+}
+
+// LookupDocument godoc
+// @Summary      Find the holder of a CPF or CNPJ
+// @Description  Accepts a CPF (11 digits) or a CNPJ (14 characters, numeric or alphanumeric), with or without mask, and returns the person or company it belongs to. The / of the CNPJ mask cannot be sent in the path
+// @Tags         Documents
+// @Produce      json
+// @Param        document  path      string  true  "CPF or CNPJ"  example(12ABC34501DE35)
+// @Success      200       {object}  contract.DocumentLookupDTO{person=contract.PersonResponseDTO,company=contract.CompanyResponseDTO}
+// @Header       200       {string}  ETag  "Current version of the person or company"
+// @Failure      400       {object}  contract.ErrorResponse  "Not a valid CPF or CNPJ"
+// @Failure      404       {object}  contract.ErrorResponse  "No person or company with this document"
+// @Failure      500       {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /documents/{document} [get]
+func (h *DocumentHandler) LookupDocument(c *gin.Context) {
+	document := companyModel.NormalizeCNPJ(c.Param("document"))
+
+	switch {
+	case len(document) == 11 && strings.Trim(document, "0123456789") == "":
+		h.lookupPerson(c, document)
+	case len(document) == companyModel.CNPJLength:
+		h.lookupCompany(c, document)
+	default:
+		log.Printf("[ERROR] LookupDocument - Not a CPF or CNPJ: %s", c.Param("document"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_parameter",
+			"message": "Document must be a CPF (11 digits) or a CNPJ (14 characters)",
+		})
+	}
+}
+
+func (h *DocumentHandler) lookupPerson(c *gin.Context, cpf string) {
+	if !personModel.ValidCPF(cpf) {
+		log.Printf("[ERROR] LookupDocument - Invalid CPF: %s", cpf)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_parameter",
+			"message": "cpf is invalid",
+		})
+		return
+	}
+
+	person, err := h.persons.FindPersonByCPF(cpf)
+	if err != nil {
+		log.Printf("[ERROR] LookupDocument - Failed to find person with CPF %s: %v", cpf, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to find person: " + err.Error(),
+		})
+		return
+	}
+
+	if person == nil {
+		respondDocumentNotFound(c, cpf)
+		return
+	}
+
+	log.Printf("[SUCCESS] LookupDocument - CPF belongs to person ID %d", person.ID)
+	c.Header("ETag", versionETag(person.Version))
+	c.JSON(http.StatusOK, contract.DocumentLookupDTO{Type: contract.DocumentPerson, Person: personResponse(c, person)})
+}
+
+func (h *DocumentHandler) lookupCompany(c *gin.Context, cnpj string) {
+	company, err := h.companies.FindCompanyByCNPJ(cnpj)
+	if err != nil {
+		switch {
+		case errors.Is(err, companyError.ErrCNPJInvalid):
+			log.Printf("[ERROR] LookupDocument - Invalid CNPJ: %s", cnpj)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+		case errors.Is(err, companyError.ErrCompanyNotFound):
+			respondDocumentNotFound(c, cnpj)
+		default:
+			log.Printf("[ERROR] LookupDocument - Failed to find company with CNPJ %s: %v", cnpj, err)
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "internal_error",
+				"message": "Failed to find company: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Printf("[SUCCESS] LookupDocument - CNPJ belongs to company ID %d", company.ID)
+	c.Header("ETag", versionETag(company.Version))
+	c.JSON(http.StatusOK, contract.DocumentLookupDTO{Type: contract.DocumentCompany, Company: companyContract.NewCompanyResponseDTO(company)})
+}
+
+func respondDocumentNotFound(c *gin.Context, document string) {
+	log.Printf("[WARN] LookupDocument - No holder found for document: %s", document)
+	c.JSON(http.StatusNotFound, gin.H{
+		"error":   "not_found",
+		"message": "No person or company found with the provided document",
+	})
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	companyContract "pessoas-api/internal/contract/company"
+	contract "pessoas-api/internal/contract/document"
+	personContract "pessoas-api/internal/contract/person"
+	companyError "pessoas-api/internal/domain/company/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupDocumentTest() (*gin.Engine, *mocks.MockPersonService, *mocks.MockCompanyService) {
+	gin.SetMode(gin.TestMode)
+	personService := new(mocks.MockPersonService)
+	companyService := new(mocks.MockCompanyService)
+	handler := NewDocumentHandler(personService, companyService)
+
+	router := gin.New()
+	router.GET("/documents/:document", handler.LookupDocument)
+
+	return router, personService, companyService
+}
+
+func lookupDocument(router *gin.Engine, document string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", "/documents/"+document, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestLookupDocument_CPF(t *testing.T) {
+	router, personService, companyService := setupDocumentTest()
+
+	personService.On("FindPersonByCPF", "11144477735").Return(&person.Person{ID: 5, CPF: "11144477735", Version: 2}, nil)
+
+	w := lookupDocument(router, "111.444.777-35")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+
+	var response struct {
+		Type    string                              `json:"type"`
+		Person  *personContract.PersonResponseDTO   `json:"person"`
+		Company *companyContract.CompanyResponseDTO `json:"company"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, contract.DocumentPerson, response.Type)
+	assert.Equal(t, 5, response.Person.ID)
+	assert.Nil(t, response.Company)
+	companyService.AssertNotCalled(t, "FindCompanyByCNPJ", mock.Anything)
+}
+
+func TestLookupDocument_CNPJ(t *testing.T) {
+	router, personService, companyService := setupDocumentTest()
+
+	companyService.On("FindCompanyByCNPJ", "12ABC34501DE35").Return(testCompany(3, 1), nil)
+
+	w := lookupDocument(router, "12.abc.34501de-35")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Type    string                              `json:"type"`
+		Person  *personContract.PersonResponseDTO   `json:"person"`
+		Company *companyContract.CompanyResponseDTO `json:"company"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, contract.DocumentCompany, response.Type)
+	assert.Equal(t, 3, response.Company.ID)
+	assert.Nil(t, response.Person)
+	personService.AssertNotCalled(t, "FindPersonByCPF", mock.Anything)
+}
+
+func TestLookupDocument_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		expected int
+	}{
+		{"neither cpf nor cnpj length", "123456", http.StatusBadRequest},
+		{"letters in a cpf", "1114447773A", http.StatusBadRequest},
+		{"invalid cpf", "11144477736", http.StatusBadRequest},
+		{"invalid cnpj", "12ABC34501DE36", http.StatusBadRequest},
+		{"unknown cpf", "52998224725", http.StatusNotFound},
+		{"unknown cnpj", "11222333000181", http.StatusNotFound},
+		{"company lookup failure", "AB123456000110", http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, personService, companyService := setupDocumentTest()
+
+			personService.On("FindPersonByCPF", "52998224725").Return(nil, nil)
+			companyService.On("FindCompanyByCNPJ", "12ABC34501DE36").Return(nil, companyError.ErrCNPJInvalid)
+			companyService.On("FindCompanyByCNPJ", "11222333000181").Return(nil, companyError.ErrCompanyNotFound)
+			companyService.On("FindCompanyByCNPJ", "AB123456000110").Return(nil, errors.New("connection refused"))
+
+			w := lookupDocument(router, tt.document)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
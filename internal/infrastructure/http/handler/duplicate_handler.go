@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DuplicateHandler struct {
+	service ports.DuplicateService
+}
+
+func NewDuplicateHandler(service ports.DuplicateService) *DuplicateHandler {
+	return &DuplicateHandler{
+		service: service,
+	}
+}
+
+// FindDuplicates godoc
+// @Summary      Find possible duplicates of a person
+// @Description  Scores the active persons sharing the birth date, the first name, a phone or an email with the person, or whose CPF is one typo away from its own. The score adds up name similarity (ignoring case, accents, misspellings and missing middle names) 0.35, birth date 0.25, CPF typo 0.10, shared phone 0.15 and shared email 0.15. Candidates are returned by decreasing score
+// @Tags         Duplicates
+// @Produce      json
+// @Param        id         path      int     true   "Person ID"
+// @Param        min_score  query     number  false  "Minimum score of the candidates returned"  default(0.5)  minimum(0)  maximum(1)
+// @Success      200        {array}   contract.DuplicateCandidateDTO
+// @Failure      400        {object}  contract.ErrorResponse  "Invalid ID or minimum score"
+// @Failure      404        {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500        {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/duplicates [get]
+func (h *DuplicateHandler) FindDuplicates(c *gin.Context) {
+	personID, ok := personIDParam(c, "FindDuplicates")
+	if !ok {
+		return
+	}
+
+	minScore := personModel.DefaultDuplicateScore
+	if raw := c.Query("min_score"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(parsed) {
+			respondInvalidMinScore(c, personError.ErrMinScoreInvalid)
+			return
+		}
+		minScore = parsed
+	}
+
+	candidates, err := h.service.FindDuplicates(personID, minScore)
+	if err != nil {
+		if errors.Is(err, personError.ErrMinScoreInvalid) {
+			respondInvalidMinScore(c, err)
+			return
+		}
+
+		respondDuplicateError(c, "FindDuplicates", personID, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] FindDuplicates - Found %d candidates for person ID %d (min score: %.2f)", len(candidates), personID, minScore)
+	c.JSON(http.StatusOK, duplicateCandidatesResponse(c, candidates))
+}
+
+// MergePersons godoc
+// @Summary      Merge a duplicate person into another
+// @Description  Keeps the survivor and removes the duplicate, which is soft deleted, marked with merged_into_id and can no longer be restored. The survivor takes over the addresses (as non-primary when it already has some), the documents and the relationships of the duplicate, and the duplicate contacts it lacks (as non-primary). Its history includes the history of the persons merged into it. Both versions must match the current ones
+// @Tags         Duplicates
+// @Accept       json
+// @Produce      json
+// @Param        merge  body      contract.MergeDTO  true  "Persons to merge"
+// @Success      200    {object}  contract.PersonResponseDTO
+// @Header       200    {string}  ETag  "Version of the survivor"
+// @Failure      400    {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404    {object}  contract.ErrorResponse  "Person not found"
+// @Failure      412    {object}  contract.ErrorResponse  "A person was modified since it was read"
+// @Failure      422    {object}  contract.ErrorResponse  "Survivor and duplicate are the same person"
+// @Failure      500    {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/merge [post]
+func (h *DuplicateHandler) MergePersons(c *gin.Context) {
+	var dto contract.MergeDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] MergePersons - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	survivor, err := h.service.MergePersons(dto, requestActor(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, personError.ErrVersionConflict):
+			log.Printf("[WARN] MergePersons - Version conflict merging person ID %d into %d", dto.DuplicateID, dto.SurvivorID)
+			respondVersionConflict(c)
+		case errors.Is(err, personError.ErrMergeSamePerson):
+			log.Printf("[ERROR] MergePersons - Validation error for person ID %d: %v", dto.SurvivorID, err)
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error":   "validation_error",
+				"message": err.Error(),
+			})
+		default:
+			respondDuplicateError(c, "MergePersons", dto.SurvivorID, err)
+		}
+		return
+	}
+
+	log.Printf("[SUCCESS] MergePersons - Person ID %d merged into %d", dto.DuplicateID, survivor.ID)
+	c.Header("ETag", versionETag(survivor.Version))
+	c.JSON(http.StatusOK, personResponse(c, survivor))
+}
+
+func respondInvalidMinScore(c *gin.Context, err error) {
+	log.Printf("[ERROR] FindDuplicates - Invalid min_score parameter: %s", c.Query("min_score"))
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error":   "invalid_parameter",
+		"message": err.Error(),
+	})
+}
+
+func respondDuplicateError(c *gin.Context, operation string, personID int, err error) {
+	if errors.Is(err, personError.ErrPersonNotFound) {
+		log.Printf("[WARN] %s - Person not found: %v", operation, err)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Person not found",
+		})
+		return
+	}
+
+	log.Printf("[ERROR] %s - Failed for person ID %d: %v", operation, personID, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error":   "internal_error",
+		"message": "Failed to process duplicates: " + err.Error(),
+	})
+}
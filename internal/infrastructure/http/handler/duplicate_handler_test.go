@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupDuplicateTest() (*gin.Engine, *mocks.MockDuplicateService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockDuplicateService)
+	handler := NewDuplicateHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Set("roles", testRoles)
+		c.Next()
+	})
+	router.POST("/persons/merge", handler.MergePersons)
+	router.GET("/persons/:id/duplicates", handler.FindDuplicates)
+
+	return router, mockService
+}
+
+func TestFindDuplicates_Success(t *testing.T) {
+	router, mockService := setupDuplicateTest()
+
+	candidates := []person.DuplicateCandidate{{
+		Person:  &person.Person{ID: 2, Name: "Jose da Silva"},
+		Score:   0.85,
+		Reasons: []string{person.MatchName, person.MatchBirthDate},
+	}}
+	mockService.On("FindDuplicates", 1, 0.7).Return(candidates, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/1/duplicates?min_score=0.7", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []contract.DuplicateCandidateDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response, 1)
+	assert.Equal(t, 0.85, response[0].Score)
+	assert.Equal(t, []string{"name", "birth_date"}, response[0].Reasons)
+	assert.Equal(t, 2, response[0].Person.ID)
+}
+
+func TestFindDuplicates_DefaultScore(t *testing.T) {
+	router, mockService := setupDuplicateTest()
+
+	mockService.On("FindDuplicates", 1, person.DefaultDuplicateScore).Return([]person.DuplicateCandidate{}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/1/duplicates", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "[]", w.Body.String())
+}
+
+func TestFindDuplicates_InvalidScore(t *testing.T) {
+	router, mockService := setupDuplicateTest()
+
+	mockService.On("FindDuplicates", 1, 2.0).Return(nil, personError.ErrMinScoreInvalid)
+
+	for _, query := range []string{"abc", "NaN", "2"} {
+		req, _ := http.NewRequest("GET", "/persons/1/duplicates?min_score="+query, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		assert.Contains(t, w.Body.String(), "invalid_parameter", query)
+	}
+}
+
+func TestFindDuplicates_PersonNotFound(t *testing.T) {
+	router, mockService := setupDuplicateTest()
+
+	mockService.On("FindDuplicates", 9, person.DefaultDuplicateScore).Return(nil, personError.ErrPersonNotFound)
+
+	req, _ := http.NewRequest("GET", "/persons/9/duplicates", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestMergePersons_Success(t *testing.T) {
+	router, mockService := setupDuplicateTest()
+
+	dto := contract.MergeDTO{SurvivorID: 1, SurvivorVersion: 3, DuplicateID: 2, DuplicateVersion: 1}
+	mockService.On("MergePersons", dto, testActor).Return(&person.Person{ID: 1, Name: "José da Silva", Version: 4}, nil)
+
+	body, _ := json.Marshal(dto)
+	req, _ := http.NewRequest("POST", "/persons/merge", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+	assert.Contains(t, w.Body.String(), `"id":1`)
+}
+
+func TestMergePersons_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"version conflict", personError.ErrVersionConflict, http.StatusPreconditionFailed},
+		{"same person", personError.ErrMergeSamePerson, http.StatusUnprocessableEntity},
+		{"person not found", personError.ErrPersonNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupDuplicateTest()
+			mockService.On("MergePersons", mock.Anything, testActor).Return(nil, tt.err)
+
+			body := `{"survivor_id":1,"survivor_version":3,"duplicate_id":2,"duplicate_version":1}`
+			req, _ := http.NewRequest("POST", "/persons/merge", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestMergePersons_InvalidBody(t *testing.T) {
+	router, mockService := setupDuplicateTest()
+
+	req, _ := http.NewRequest("POST", "/persons/merge", bytes.NewBufferString(`{"survivor_id":1}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "MergePersons", mock.Anything, mock.Anything)
+}
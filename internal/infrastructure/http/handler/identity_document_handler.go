@@ -0,0 +1,369 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// identityDocumentValidationErrors are the domain errors reported as 422 for an identity document.
+var identityDocumentValidationErrors = []error{
+	personError.ErrDocumentTypeInvalid,
+	personError.ErrDocumentTypeImmutable,
+	personError.ErrDocumentNumberRequired,
+	personError.ErrDocumentNumberInvalid,
+	personError.ErrDocumentIssuingStateInvalid,
+	personError.ErrDocumentIssuingAgencyRequired,
+	personError.ErrDocumentExpiryRequired,
+	personError.ErrDocumentDatesInvalid,
+	personError.ErrCountryInvalid,
+}
+
+// IdentityDocumentHandler serves the identity documents of a person: RG, CNH,
+// passport, voter ID and PIS/NIS.
+type IdentityDocumentHandler struct {
+	service ports.DocumentService
+}
+
+func NewIdentityDocumentHandler(service ports.DocumentService) *IdentityDocumentHandler {
+	return &IdentityDocumentHandler{
+		service: service,
+	}
+}
+
+// ListDocuments godoc
+// @Summary      List the identity documents of a person
+// @Description  Returns every identity document of a person, by type
+// @Tags         Identity Documents
+// @Produce      json
+// @Param        id   path      int  true  "Person ID"
+// @Success      200  {array}   contract.DocumentResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/documents [get]
+func (h *IdentityDocumentHandler) ListDocuments(c *gin.Context) {
+	personID, ok := personIDParam(c, "ListDocuments")
+	if !ok {
+		return
+	}
+
+	documents, err := h.service.ListDocuments(personID)
+	if err != nil {
+		respondDocumentError(c, "ListDocuments", personID, 0, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, documentResponses(c, documents))
+}
+
+// GetDocument godoc
+// @Summary      Get an identity document
+// @Description  Returns one identity document of a person
+// @Tags         Identity Documents
+// @Produce      json
+// @Param        id          path      int  true  "Person ID"
+// @Param        documentId  path      int  true  "Document ID"
+// @Success      200  {object}  contract.DocumentResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person or document not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/documents/{documentId} [get]
+func (h *IdentityDocumentHandler) GetDocument(c *gin.Context) {
+	personID, id, ok := documentIDParams(c, "GetDocument")
+	if !ok {
+		return
+	}
+
+	document, err := h.service.FindDocument(personID, id)
+	if err != nil {
+		respondDocumentError(c, "GetDocument", personID, id, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, documentResponse(c, document))
+}
+
+// CreateDocument godoc
+// @Summary      Add an identity document to a person
+// @Description  Adds an RG, CNH, passport, voter ID (título de eleitor) or PIS/NIS. Check digits are validated for CNH, voter ID and PIS/NIS; RG requires the issuing state and agency; passports require the issuing country and the expiry date. A number is registered to a single person per type and issuer
+// @Tags         Identity Documents
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                   true  "Person ID"
+// @Param        document  body      contract.DocumentDTO  true  "Document data"
+// @Success      201       {object}  contract.DocumentResponseDTO
+// @Header       201       {string}  Location  "URI of the created document"
+// @Failure      400       {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404       {object}  contract.ErrorResponse  "Person not found"
+// @Failure      409       {object}  contract.ErrorResponse  "Document already registered"
+// @Failure      422       {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500       {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/documents [post]
+func (h *IdentityDocumentHandler) CreateDocument(c *gin.Context) {
+	personID, ok := personIDParam(c, "CreateDocument")
+	if !ok {
+		return
+	}
+
+	var dto contract.DocumentDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] CreateDocument - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	document, err := h.service.AddDocument(personID, dto, requestActor(c))
+	if err != nil {
+		respondDocumentError(c, "CreateDocument", personID, 0, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] CreateDocument - Document %d (%s) added to person ID %d", document.ID, document.Type, personID)
+	c.Header("Location", documentLocation(document))
+	c.JSON(http.StatusCreated, documentResponse(c, document))
+}
+
+// UpdateDocument godoc
+// @Summary      Replace an identity document
+// @Description  Replaces every field of an identity document. The type cannot be changed
+// @Tags         Identity Documents
+// @Accept       json
+// @Produce      json
+// @Param        id          path      int                   true  "Person ID"
+// @Param        documentId  path      int                   true  "Document ID"
+// @Param        document    body      contract.DocumentDTO  true  "Document data"
+// @Success      200         {object}  contract.DocumentResponseDTO
+// @Failure      400         {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404         {object}  contract.ErrorResponse  "Person or document not found"
+// @Failure      409         {object}  contract.ErrorResponse  "Document already registered"
+// @Failure      422         {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500         {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/documents/{documentId} [put]
+func (h *IdentityDocumentHandler) UpdateDocument(c *gin.Context) {
+	personID, id, ok := documentIDParams(c, "UpdateDocument")
+	if !ok {
+		return
+	}
+
+	var dto contract.DocumentDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] UpdateDocument - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	document, err := h.service.UpdateDocument(personID, id, dto, requestActor(c))
+	if err != nil {
+		respondDocumentError(c, "UpdateDocument", personID, id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] UpdateDocument - Document %d of person ID %d updated", id, personID)
+	c.JSON(http.StatusOK, documentResponse(c, document))
+}
+
+// DeleteDocument godoc
+// @Summary      Delete an identity document
+// @Description  Removes an identity document of a person
+// @Tags         Identity Documents
+// @Param        id          path  int  true  "Person ID"
+// @Param        documentId  path  int  true  "Document ID"
+// @Success      204
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person or document not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/documents/{documentId} [delete]
+func (h *IdentityDocumentHandler) DeleteDocument(c *gin.Context) {
+	personID, id, ok := documentIDParams(c, "DeleteDocument")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteDocument(personID, id, requestActor(c)); err != nil {
+		respondDocumentError(c, "DeleteDocument", personID, id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] DeleteDocument - Document %d of person ID %d deleted", id, personID)
+	c.Status(http.StatusNoContent)
+}
+
+// ListExpiringDocuments godoc
+// @Summary      List identity documents expiring soon
+// @Description  Returns the documents of active persons whose expiry date falls within the next days, soonest first. Documents without an expiry date are never listed
+// @Tags         Identity Documents
+// @Produce      json
+// @Param        days             query     int   false  "Days ahead to look"                   default(30)  minimum(1)  maximum(365)
+// @Param        include_expired  query     bool  false  "Also list documents already expired"  default(false)
+// @Param        page             query     int   false  "Page number"                          default(1)   minimum(1)
+// @Param        page_size        query     int   false  "Items per page"                       default(10)  minimum(1)  maximum(100)
+// @Success      200  {object}  contract.PaginatedResponse{data=[]contract.DocumentResponseDTO}
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid query parameter"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/documents/expiring [get]
+func (h *IdentityDocumentHandler) ListExpiringDocuments(c *gin.Context) {
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+	includeExpired := c.Query("include_expired") == "true"
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	log.Printf("[INFO] ListExpiringDocuments - Fetching documents expiring within %d days, includeExpired: %t, page: %d, pageSize: %d", days, includeExpired, page, pageSize)
+
+	documents, total, err := h.service.ListExpiringDocuments(days, includeExpired, page, pageSize)
+	if err != nil {
+		if errors.Is(err, personError.ErrExpiryWindowInvalid) {
+			log.Printf("[ERROR] ListExpiringDocuments - Invalid days parameter: %s", c.Query("days"))
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		log.Printf("[ERROR] ListExpiringDocuments - Failed to retrieve documents: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to retrieve documents: " + err.Error(),
+		})
+		return
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+
+	log.Printf("[SUCCESS] ListExpiringDocuments - Retrieved %d documents (total: %d)", len(documents), total)
+
+	c.JSON(http.StatusOK, contract.PaginatedResponse{
+		Data:       documentResponses(c, documents),
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: totalPages,
+	})
+}
+
+// FindPersonsByDocument godoc
+// @Summary      Find persons by document number
+// @Description  Returns the persons holding a document with the given number. Without a type, every identity document type and the CPF are searched
+// @Tags         Identity Documents
+// @Produce      json
+// @Param        number  path      string  true   "Document number, with or without mask"  example(12.345.678-X)
+// @Param        type    query     string  false  "Document type"  Enums(cpf, rg, cnh, passport, voter_id, pis)
+// @Success      200     {array}   contract.PersonResponseDTO
+// @Failure      400     {object}  contract.ErrorResponse  "Invalid document type or number"
+// @Failure      500     {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/document/{number} [get]
+func (h *IdentityDocumentHandler) FindPersonsByDocument(c *gin.Context) {
+	number := c.Param("number")
+	documentType := c.Query("type")
+
+	log.Printf("[INFO] FindPersonsByDocument - Searching for document: %s, type: %s", number, documentType)
+
+	persons, err := h.service.FindPersonsByDocument(documentType, number)
+	if err != nil {
+		if errors.Is(err, personError.ErrDocumentTypeInvalid) || errors.Is(err, personError.ErrDocumentNumberRequired) {
+			log.Printf("[ERROR] FindPersonsByDocument - Invalid search for %s: %v", number, err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		log.Printf("[ERROR] FindPersonsByDocument - Failed to find persons with document %s: %v", number, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to find persons: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] FindPersonsByDocument - Found %d persons with document: %s", len(persons), number)
+	c.JSON(http.StatusOK, personResponses(c, persons))
+}
+
+// documentLocation builds the URI of an identity document resource, used in Location headers.
+func documentLocation(document *personModel.Document) string {
+	return fmt.Sprintf("%s/documents/%d", personLocation(document.PersonID), document.ID)
+}
+
+// documentIDParams reads the person and document IDs from the path. When either
+// is invalid it writes the error response and returns false.
+func documentIDParams(c *gin.Context, operation string) (int, int, bool) {
+	personID, ok := personIDParam(c, operation)
+	if !ok {
+		return 0, 0, false
+	}
+
+	id, err := strconv.Atoi(c.Param("documentId"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] %s - Invalid document ID parameter: %s", operation, c.Param("documentId"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid document ID",
+		})
+		return 0, 0, false
+	}
+
+	return personID, id, true
+}
+
+func respondDocumentError(c *gin.Context, operation string, personID, id int, err error) {
+	switch {
+	case errors.Is(err, personError.ErrPersonNotFound):
+		log.Printf("[WARN] %s - Person not found with ID: %d", operation, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Person not found",
+		})
+	case errors.Is(err, personError.ErrDocumentNotFound):
+		log.Printf("[WARN] %s - Document %d not found for person ID %d", operation, id, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Document not found",
+		})
+	case errors.Is(err, personError.ErrDocumentAlreadyInUse):
+		log.Printf("[WARN] %s - Document already registered, person ID %d", operation, personID)
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "conflict",
+			"message": err.Error(),
+		})
+	case isDocumentValidationError(err):
+		log.Printf("[ERROR] %s - Validation error for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process document: " + err.Error(),
+		})
+	}
+}
+
+func isDocumentValidationError(err error) bool {
+	for _, validationErr := range identityDocumentValidationErrors {
+		if errors.Is(err, validationErr) {
+			return true
+		}
+	}
+	return false
+}
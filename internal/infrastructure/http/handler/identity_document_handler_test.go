@@ -0,0 +1,338 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	operator "pessoas-api/internal/domain/operator/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupIdentityDocumentTest() (*gin.Engine, *mocks.MockDocumentService) {
+	return setupIdentityDocumentTestAs(testRoles)
+}
+
+func setupIdentityDocumentTestAs(roles []string) (*gin.Engine, *mocks.MockDocumentService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockDocumentService)
+	handler := NewIdentityDocumentHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Set("roles", roles)
+		c.Next()
+	})
+	router.GET("/persons/documents/expiring", handler.ListExpiringDocuments)
+	router.GET("/persons/document/:number", handler.FindPersonsByDocument)
+	router.GET("/persons/:id/documents", handler.ListDocuments)
+	router.POST("/persons/:id/documents", handler.CreateDocument)
+	router.GET("/persons/:id/documents/:documentId", handler.GetDocument)
+	router.PUT("/persons/:id/documents/:documentId", handler.UpdateDocument)
+	router.DELETE("/persons/:id/documents/:documentId", handler.DeleteDocument)
+
+	return router, mockService
+}
+
+func testDocumentDTO() contract.DocumentDTO {
+	return contract.DocumentDTO{
+		Type:          "rg",
+		Number:        "12.345.678-X",
+		IssuingState:  "PE",
+		IssuingAgency: "SSP",
+	}
+}
+
+func testDocument(id int) *person.Document {
+	return &person.Document{
+		DocumentFields: person.DocumentFields{
+			Type:          person.DocumentRG,
+			Number:        "12345678X",
+			IssuingState:  "PE",
+			IssuingAgency: "SSP",
+		},
+		ID:       id,
+		PersonID: 1,
+	}
+}
+
+func TestListIdentityDocuments_Success(t *testing.T) {
+	router, mockService := setupIdentityDocumentTest()
+
+	expiry := time.Now().AddDate(0, 0, -1)
+	passport := testDocument(5)
+	passport.DocumentFields = person.DocumentFields{Type: person.DocumentPassport, Number: "FZ123456", Country: "BR", ExpiryDate: &expiry}
+	mockService.On("ListDocuments", 1).Return([]*person.Document{passport, testDocument(4)}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/1/documents", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []contract.DocumentResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response, 2)
+	assert.True(t, response[0].Expired)
+	assert.Equal(t, "12345678X", response[1].Number)
+	assert.False(t, response[1].Expired, "documents without expiry date never expire")
+}
+
+func TestCreateIdentityDocument_Success(t *testing.T) {
+	router, mockService := setupIdentityDocumentTest()
+
+	mockService.On("AddDocument", 1, testDocumentDTO(), testActor).Return(testDocument(4), nil)
+
+	body, _ := json.Marshal(testDocumentDTO())
+	req, _ := http.NewRequest("POST", "/persons/1/documents", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "/api/v1/persons/1/documents/4", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"issuing_agency":"SSP"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateIdentityDocument_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		err          error
+		expectedCode int
+		expectedErr  string
+	}{
+		{"invalid person id", "/persons/abc/documents", `{}`, nil, http.StatusBadRequest, "invalid_request"},
+		{"missing number", "/persons/1/documents", `{"type":"rg"}`, nil, http.StatusBadRequest, "invalid_request"},
+		{"person not found", "/persons/1/documents", "", personError.ErrPersonNotFound, http.StatusNotFound, "not_found"},
+		{"already registered", "/persons/1/documents", "", personError.ErrDocumentAlreadyInUse, http.StatusConflict, "conflict"},
+		{"invalid check digit", "/persons/1/documents", "", personError.ErrCNHInvalid, http.StatusUnprocessableEntity, "validation_error"},
+		{"missing agency", "/persons/1/documents", "", personError.ErrDocumentIssuingAgencyRequired, http.StatusUnprocessableEntity, "validation_error"},
+		{"database error", "/persons/1/documents", "", errors.New("database error"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupIdentityDocumentTest()
+			mockService.On("AddDocument", 1, mock.Anything, testActor).Return(nil, tt.err)
+
+			body := tt.body
+			if body == "" {
+				encoded, _ := json.Marshal(testDocumentDTO())
+				body = string(encoded)
+			}
+			req, _ := http.NewRequest("POST", tt.path, bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedErr)
+		})
+	}
+}
+
+func TestGetIdentityDocument(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		document     *person.Document
+		err          error
+		expectedCode int
+	}{
+		{"found", "/persons/1/documents/4", testDocument(4), nil, http.StatusOK},
+		{"document not found", "/persons/1/documents/4", nil, personError.ErrDocumentNotFound, http.StatusNotFound},
+		{"invalid document id", "/persons/1/documents/x", nil, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupIdentityDocumentTest()
+			if tt.document != nil {
+				mockService.On("FindDocument", 1, 4).Return(tt.document, nil)
+			} else {
+				mockService.On("FindDocument", 1, 4).Return(nil, tt.err)
+			}
+
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestUpdateIdentityDocument_ShouldRejectTypeChange(t *testing.T) {
+	router, mockService := setupIdentityDocumentTest()
+
+	dto := testDocumentDTO()
+	dto.Type = "cnh"
+	mockService.On("UpdateDocument", 1, 4, dto, testActor).Return(nil, personError.ErrDocumentTypeImmutable)
+
+	body, _ := json.Marshal(dto)
+	req, _ := http.NewRequest("PUT", "/persons/1/documents/4", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDeleteIdentityDocument(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"deleted", nil, http.StatusNoContent},
+		{"not found", personError.ErrDocumentNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupIdentityDocumentTest()
+			mockService.On("DeleteDocument", 1, 4, testActor).Return(tt.err)
+
+			req, _ := http.NewRequest("DELETE", "/persons/1/documents/4", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDocuments_MaskNumbersWithoutPIIRead(t *testing.T) {
+	tests := []struct {
+		name  string
+		url   string
+		setup func(*mocks.MockDocumentService)
+	}{
+		{"list", "/persons/1/documents", func(m *mocks.MockDocumentService) {
+			m.On("ListDocuments", 1).Return([]*person.Document{testDocument(4)}, nil)
+		}},
+		{"get", "/persons/1/documents/4", func(m *mocks.MockDocumentService) {
+			m.On("FindDocument", 1, 4).Return(testDocument(4), nil)
+		}},
+		{"expiring", "/persons/documents/expiring", func(m *mocks.MockDocumentService) {
+			m.On("ListExpiringDocuments", 30, false, 1, 10).Return([]*person.Document{testDocument(4)}, int64(1), nil)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupIdentityDocumentTestAs([]string{operator.RoleViewer})
+			tt.setup(mockService)
+
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Contains(t, w.Body.String(), `"number":"******78X"`)
+			assert.Contains(t, w.Body.String(), `"masked":true`)
+			assert.NotContains(t, w.Body.String(), "12345678X")
+		})
+	}
+}
+
+func TestListExpiringDocuments(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		days           int
+		includeExpired bool
+		err            error
+		expectedCode   int
+	}{
+		{"defaults", "", 30, false, nil, http.StatusOK},
+		{"with expired", "?days=90&include_expired=true", 90, true, nil, http.StatusOK},
+		{"invalid window", "?days=400", 400, false, personError.ErrExpiryWindowInvalid, http.StatusBadRequest},
+		{"database error", "", 30, false, errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupIdentityDocumentTest()
+			if tt.err != nil {
+				mockService.On("ListExpiringDocuments", tt.days, tt.includeExpired, 1, 10).Return(nil, int64(0), tt.err)
+			} else {
+				mockService.On("ListExpiringDocuments", tt.days, tt.includeExpired, 1, 10).Return([]*person.Document{testDocument(4)}, int64(11), nil)
+			}
+
+			req, _ := http.NewRequest("GET", "/persons/documents/expiring"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.err == nil {
+				assert.Contains(t, w.Body.String(), `"total_pages":2`)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFindPersonsByDocument(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		documentType string
+		number       string
+		err          error
+		expectedCode int
+	}{
+		{"any type", "/persons/document/12.345.678-X", "", "12.345.678-X", nil, http.StatusOK},
+		{"by type", "/persons/document/12345678X?type=rg", "rg", "12345678X", nil, http.StatusOK},
+		{"invalid type", "/persons/document/123?type=ctps", "ctps", "123", personError.ErrDocumentTypeInvalid, http.StatusBadRequest},
+		{"database error", "/persons/document/123", "", "123", errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupIdentityDocumentTest()
+			if tt.err != nil {
+				mockService.On("FindPersonsByDocument", tt.documentType, tt.number).Return(nil, tt.err)
+			} else {
+				mockService.On("FindPersonsByDocument", tt.documentType, tt.number).Return([]*person.Person{{ID: 1, Name: "João Silva"}}, nil)
+			}
+
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.err == nil {
+				assert.Contains(t, w.Body.String(), `"name":"João Silva"`)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/job"
+	jobError "pessoas-api/internal/domain/job/error"
+	"pessoas-api/internal/domain/job/ports"
+	operator "pessoas-api/internal/domain/operator/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JobHandler struct {
+	service ports.JobService
+}
+
+func NewJobHandler(service ports.JobService) *JobHandler {
+	return &JobHandler{
+		service: service,
+	}
+}
+
+// GetJob godoc
+// @Summary      Get a job
+// @Description  Returns the status and progress of a background job
+// @Tags         Jobs
+// @Produce      json
+// @Param        id   path      int  true  "Job ID"
+// @Success      200  {object}  contract.JobDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Job not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /jobs/{id} [get]
+func (h *JobHandler) GetJob(c *gin.Context) {
+	id, ok := jobIDParam(c, "GetJob")
+	if !ok {
+		return
+	}
+
+	found, err := h.service.FindJob(id)
+	if err != nil {
+		respondJobError(c, "GetJob", id, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewJobDTO(found))
+}
+
+// CancelJob godoc
+// @Summary      Cancel a job
+// @Description  Cancels a queued or running job. A running job stops at the next heartbeat of its worker, within about 20 seconds; whatever it already wrote (e.g. imported rows) is kept
+// @Tags         Jobs
+// @Produce      json
+// @Param        id   path      int  true  "Job ID"
+// @Success      200  {object}  contract.JobDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Job not found"
+// @Failure      409  {object}  contract.ErrorResponse  "Job already finished"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /jobs/{id} [delete]
+func (h *JobHandler) CancelJob(c *gin.Context) {
+	id, ok := jobIDParam(c, "CancelJob")
+	if !ok {
+		return
+	}
+
+	log.Printf("[INFO] CancelJob - Cancelling job ID: %d", id)
+
+	cancelled, err := h.service.CancelJob(id)
+	if err != nil {
+		respondJobError(c, "CancelJob", id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] CancelJob - Job %d cancelled", id)
+	c.JSON(http.StatusOK, contract.NewJobDTO(cancelled))
+}
+
+// RetryJob godoc
+// @Summary      Retry a job
+// @Description  Queues a failed or cancelled job again, with a fresh set of attempts
+// @Tags         Jobs
+// @Produce      json
+// @Param        id   path      int  true  "Job ID"
+// @Success      200  {object}  contract.JobDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Job not found"
+// @Failure      409  {object}  contract.ErrorResponse  "Job is not failed or cancelled"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /jobs/{id}/retry [post]
+func (h *JobHandler) RetryJob(c *gin.Context) {
+	id, ok := jobIDParam(c, "RetryJob")
+	if !ok {
+		return
+	}
+
+	log.Printf("[INFO] RetryJob - Retrying job ID: %d", id)
+
+	queued, err := h.service.RetryJob(id)
+	if err != nil {
+		respondJobError(c, "RetryJob", id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] RetryJob - Job %d queued again", id)
+	c.JSON(http.StatusOK, contract.NewJobDTO(queued))
+}
+
+// GetJobResult godoc
+// @Summary      Download a job result
+// @Description  Downloads the file produced by a succeeded job: the exported file, or the report of an import. Requires pii:read, and only the operator who created the job or an admin can download it
+// @Tags         Jobs
+// @Produce      octet-stream
+// @Param        id   path      int  true  "Job ID"
+// @Success      200  {file}    file
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      403  {object}  contract.ErrorResponse  "Missing permission or job created by another operator"
+// @Failure      404  {object}  contract.ErrorResponse  "Job not found"
+// @Failure      409  {object}  contract.ErrorResponse  "Job has no result"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /jobs/{id}/result [get]
+func (h *JobHandler) GetJobResult(c *gin.Context) {
+	id, ok := jobIDParam(c, "GetJobResult")
+	if !ok {
+		return
+	}
+
+	found, err := h.service.FindJob(id)
+	if err != nil {
+		respondJobError(c, "GetJobResult", id, err)
+		return
+	}
+
+	// Results hold personal data, so they are only handed to the operator
+	// who asked for them, or to an admin
+	operatorID := c.GetInt("user_id")
+	if found.OperatorID != operatorID && !slices.Contains(c.GetStringSlice("roles"), operator.RoleAdmin) {
+		log.Printf("[WARN] GetJobResult - Operator %d is not the creator of job %d", operatorID, id)
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":   "forbidden",
+			"message": "Only the operator who created the job or an admin can download its result",
+		})
+		return
+	}
+
+	result, file, err := h.service.OpenJobResult(id)
+	if err != nil {
+		respondJobError(c, "GetJobResult", id, err)
+		return
+	}
+	defer file.Close()
+
+	c.Header("Content-Type", result.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", result.Name))
+	c.Status(http.StatusOK)
+
+	if _, err := io.Copy(c.Writer, file); err != nil {
+		log.Printf("[ERROR] GetJobResult - Failed to send result of job %d: %v", id, err)
+	}
+}
+
+// jobLocation builds the URI of a job resource, used in Location headers.
+func jobLocation(id int) string {
+	return fmt.Sprintf("/api/v1/jobs/%d", id)
+}
+
+// jobIDParam reads the job ID from the path. When it is invalid it writes the
+// error response and returns false.
+func jobIDParam(c *gin.Context, operation string) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] %s - Invalid ID parameter: %s", operation, c.Param("id"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid job ID",
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+func respondJobError(c *gin.Context, operation string, id int, err error) {
+	switch {
+	case errors.Is(err, jobError.ErrJobNotFound):
+		log.Printf("[WARN] %s - Job not found with ID: %d", operation, id)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Job not found",
+		})
+	case errors.Is(err, jobError.ErrJobNotActive), errors.Is(err, jobError.ErrJobNotRetryable), errors.Is(err, jobError.ErrNoResult):
+		log.Printf("[WARN] %s - Job %d: %v", operation, id, err)
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "conflict",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed on job %d: %v", operation, id, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process job: " + err.Error(),
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/job"
+	jobError "pessoas-api/internal/domain/job/error"
+	job "pessoas-api/internal/domain/job/model"
+	operator "pessoas-api/internal/domain/operator/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupJobTest() (*gin.Engine, *mocks.MockJobService) {
+	return setupJobTestAs(testActor.OperatorID, testRoles)
+}
+
+func setupJobTestAs(operatorID int, roles []string) (*gin.Engine, *mocks.MockJobService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockJobService)
+	handler := NewJobHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", operatorID)
+		c.Set("roles", roles)
+		c.Next()
+	})
+	router.GET("/jobs/:id", handler.GetJob)
+	router.DELETE("/jobs/:id", handler.CancelJob)
+	router.POST("/jobs/:id/retry", handler.RetryJob)
+	router.GET("/jobs/:id/result", handler.GetJobResult)
+
+	return router, mockService
+}
+
+func TestGetJob_Success(t *testing.T) {
+	router, mockService := setupJobTest()
+
+	started := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	finished := started.Add(time.Minute)
+	mockService.On("FindJob", 8).Return(&job.Job{
+		ID:          8,
+		Type:        "person_export",
+		Status:      job.StatusSucceeded,
+		Progress:    100,
+		Attempts:    1,
+		MaxAttempts: 3,
+		Result:      &job.Artifact{Ref: "abc.csv", Name: "persons-8.csv", ContentType: "text/csv"},
+		CreatedAt:   started,
+		StartedAt:   &started,
+		FinishedAt:  &finished,
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/jobs/8", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response contract.JobDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, 8, response.ID)
+	assert.Equal(t, job.StatusSucceeded, response.Status)
+	assert.Equal(t, 100, response.Progress)
+	assert.Equal(t, "/api/v1/jobs/8/result", *response.ResultURL)
+	assert.Nil(t, response.Error)
+	assert.NotContains(t, w.Body.String(), "abc.csv", "the artifact reference is internal")
+}
+
+func TestGetJob_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		err          error
+		expectedCode int
+		expectedErr  string
+	}{
+		{"invalid id", "/jobs/abc", nil, http.StatusBadRequest, "invalid_request"},
+		{"not found", "/jobs/9", jobError.ErrJobNotFound, http.StatusNotFound, "not_found"},
+		{"database error", "/jobs/9", errors.New("database error"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupJobTest()
+			mockService.On("FindJob", 9).Return(nil, tt.err)
+
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedErr)
+		})
+	}
+}
+
+func TestCancelJob(t *testing.T) {
+	tests := []struct {
+		name         string
+		result       *job.Job
+		err          error
+		expectedCode int
+	}{
+		{"cancelled", &job.Job{ID: 4, Status: job.StatusCancelled}, nil, http.StatusOK},
+		{"already finished", nil, jobError.ErrJobNotActive, http.StatusConflict},
+		{"not found", nil, jobError.ErrJobNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupJobTest()
+			if tt.result != nil {
+				mockService.On("CancelJob", 4).Return(tt.result, nil)
+			} else {
+				mockService.On("CancelJob", 4).Return(nil, tt.err)
+			}
+
+			req, _ := http.NewRequest("DELETE", "/jobs/4", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRetryJob(t *testing.T) {
+	tests := []struct {
+		name         string
+		result       *job.Job
+		err          error
+		expectedCode int
+	}{
+		{"queued again", &job.Job{ID: 5, Status: job.StatusQueued}, nil, http.StatusOK},
+		{"not retryable", nil, jobError.ErrJobNotRetryable, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupJobTest()
+			if tt.result != nil {
+				mockService.On("RetryJob", 5).Return(tt.result, nil)
+			} else {
+				mockService.On("RetryJob", 5).Return(nil, tt.err)
+			}
+
+			req, _ := http.NewRequest("POST", "/jobs/5/retry", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetJobResult_Success(t *testing.T) {
+	router, mockService := setupJobTest()
+
+	result := &job.Artifact{Ref: "abc.csv", Name: "persons-6.csv", ContentType: "text/csv; charset=utf-8"}
+	mockService.On("FindJob", 6).Return(&job.Job{ID: 6, OperatorID: testActor.OperatorID, Status: job.StatusSucceeded, Result: result}, nil)
+	mockService.On("OpenJobResult", 6).Return(result, io.NopCloser(strings.NewReader("id,name\n1,Ana\n")), nil)
+
+	req, _ := http.NewRequest("GET", "/jobs/6/result", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="persons-6.csv"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "id,name\n1,Ana\n", w.Body.String())
+}
+
+func TestGetJobResult_NoResult(t *testing.T) {
+	router, mockService := setupJobTest()
+
+	mockService.On("FindJob", 6).Return(&job.Job{ID: 6, OperatorID: testActor.OperatorID, Status: job.StatusRunning}, nil)
+	mockService.On("OpenJobResult", 6).Return(nil, nil, jobError.ErrNoResult)
+
+	req, _ := http.NewRequest("GET", "/jobs/6/result", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "conflict")
+}
+
+func TestGetJobResult_OnlyCreatorOrAdmin(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		expected int
+	}{
+		{"another operator", []string{operator.RoleEditor}, http.StatusForbidden},
+		{"admin", []string{operator.RoleAdmin}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupJobTestAs(99, tt.roles)
+
+			result := &job.Artifact{Ref: "abc.csv", Name: "persons-6.csv", ContentType: "text/csv; charset=utf-8"}
+			mockService.On("FindJob", 6).Return(&job.Job{ID: 6, OperatorID: testActor.OperatorID, Status: job.StatusSucceeded, Result: result}, nil)
+			mockService.On("OpenJobResult", 6).Return(result, io.NopCloser(strings.NewReader("id,name\n1,Ana\n")), nil)
+
+			req, _ := http.NewRequest("GET", "/jobs/6/result", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+			if tt.expected == http.StatusForbidden {
+				mockService.AssertNotCalled(t, "OpenJobResult", 6)
+			}
+		})
+	}
+}
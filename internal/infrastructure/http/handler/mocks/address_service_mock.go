@@ -0,0 +1,59 @@
+package mocks
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAddressService is a mock implementation of ports.AddressService
+type MockAddressService struct {
+	mock.Mock
+}
+
+func (m *MockAddressService) ListAddresses(personID int) ([]*person.Address, error) {
+	args := m.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Address), args.Error(1)
+}
+
+func (m *MockAddressService) FindAddress(personID, id int) (*person.Address, error) {
+	args := m.Called(personID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Address), args.Error(1)
+}
+
+func (m *MockAddressService) AddAddress(personID int, dto contract.AddressDTO, actor audit.Actor) (*person.Address, error) {
+	args := m.Called(personID, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Address), args.Error(1)
+}
+
+func (m *MockAddressService) UpdateAddress(personID, id int, dto contract.AddressDTO, actor audit.Actor) (*person.Address, error) {
+	args := m.Called(personID, id, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Address), args.Error(1)
+}
+
+func (m *MockAddressService) DeleteAddress(personID, id int, actor audit.Actor) error {
+	args := m.Called(personID, id, actor)
+	return args.Error(0)
+}
+
+func (m *MockAddressService) LookupPostalCode(cep string) (*person.PostalCode, error) {
+	args := m.Called(cep)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.PostalCode), args.Error(1)
+}
@@ -0,0 +1,59 @@
+package mocks
+
+import (
+	contract "pessoas-api/internal/contract/company"
+	audit "pessoas-api/internal/domain/audit/model"
+	company "pessoas-api/internal/domain/company/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCompanyService is a mock implementation of ports.CompanyService
+type MockCompanyService struct {
+	mock.Mock
+}
+
+func (m *MockCompanyService) CreateCompany(dto contract.CompanyDTO, actor audit.Actor) (*company.Company, error) {
+	args := m.Called(dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*company.Company), args.Error(1)
+}
+
+func (m *MockCompanyService) UpdateCompany(id int, version int, dto contract.CompanyDTO, actor audit.Actor) (*company.Company, error) {
+	args := m.Called(id, version, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*company.Company), args.Error(1)
+}
+
+func (m *MockCompanyService) DeleteCompany(id int, version int, actor audit.Actor) error {
+	args := m.Called(id, version, actor)
+	return args.Error(0)
+}
+
+func (m *MockCompanyService) ListCompanies(page, pageSize int, sort, order string) ([]*company.Company, int64, error) {
+	args := m.Called(page, pageSize, sort, order)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*company.Company), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockCompanyService) FindCompanyByID(id int) (*company.Company, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*company.Company), args.Error(1)
+}
+
+func (m *MockCompanyService) FindCompanyByCNPJ(cnpj string) (*company.Company, error) {
+	args := m.Called(cnpj)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*company.Company), args.Error(1)
+}
@@ -0,0 +1,54 @@
+package mocks
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockConsentService is a mock implementation of ports.ConsentService
+type MockConsentService struct {
+	mock.Mock
+}
+
+func (m *MockConsentService) ListConsents(personID int) ([]*person.Consent, error) {
+	args := m.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Consent), args.Error(1)
+}
+
+func (m *MockConsentService) FindConsent(personID int, purpose string) (*person.Consent, error) {
+	args := m.Called(personID, purpose)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Consent), args.Error(1)
+}
+
+func (m *MockConsentService) GrantConsent(personID int, dto contract.ConsentDTO, actor audit.Actor) (*person.Consent, error) {
+	args := m.Called(personID, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Consent), args.Error(1)
+}
+
+func (m *MockConsentService) RevokeConsent(personID int, purpose string, dto contract.RevokeConsentDTO, actor audit.Actor) (*person.Consent, error) {
+	args := m.Called(personID, purpose, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Consent), args.Error(1)
+}
+
+func (m *MockConsentService) ConsentHistory(personID int) ([]*person.ConsentEvent, error) {
+	args := m.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.ConsentEvent), args.Error(1)
+}
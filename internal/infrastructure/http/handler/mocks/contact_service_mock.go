@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockContactService is a mock implementation of ports.ContactService
+type MockContactService struct {
+	mock.Mock
+}
+
+func (m *MockContactService) ListContacts(personID int) ([]*person.Contact, error) {
+	args := m.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Contact), args.Error(1)
+}
+
+func (m *MockContactService) FindContact(personID, id int) (*person.Contact, error) {
+	args := m.Called(personID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Contact), args.Error(1)
+}
+
+func (m *MockContactService) AddContact(personID, version int, dto contract.ContactDTO, actor audit.Actor) (*person.Contact, error) {
+	args := m.Called(personID, version, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Contact), args.Error(1)
+}
+
+func (m *MockContactService) UpdateContact(personID, id, version int, dto contract.ContactDTO, actor audit.Actor) (*person.Contact, error) {
+	args := m.Called(personID, id, version, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Contact), args.Error(1)
+}
+
+func (m *MockContactService) DeleteContact(personID, id, version int, actor audit.Actor) error {
+	args := m.Called(personID, id, version, actor)
+	return args.Error(0)
+}
@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDocumentService is a mock implementation of ports.DocumentService
+type MockDocumentService struct {
+	mock.Mock
+}
+
+func (m *MockDocumentService) ListDocuments(personID int) ([]*person.Document, error) {
+	args := m.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Document), args.Error(1)
+}
+
+func (m *MockDocumentService) FindDocument(personID, id int) (*person.Document, error) {
+	args := m.Called(personID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Document), args.Error(1)
+}
+
+func (m *MockDocumentService) AddDocument(personID int, dto contract.DocumentDTO, actor audit.Actor) (*person.Document, error) {
+	args := m.Called(personID, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Document), args.Error(1)
+}
+
+func (m *MockDocumentService) UpdateDocument(personID, id int, dto contract.DocumentDTO, actor audit.Actor) (*person.Document, error) {
+	args := m.Called(personID, id, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Document), args.Error(1)
+}
+
+func (m *MockDocumentService) DeleteDocument(personID, id int, actor audit.Actor) error {
+	args := m.Called(personID, id, actor)
+	return args.Error(0)
+}
+
+func (m *MockDocumentService) ListExpiringDocuments(days int, includeExpired bool, page, pageSize int) ([]*person.Document, int64, error) {
+	args := m.Called(days, includeExpired, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*person.Document), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockDocumentService) FindPersonsByDocument(documentType, number string) ([]*person.Person, error) {
+	args := m.Called(documentType, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Person), args.Error(1)
+}
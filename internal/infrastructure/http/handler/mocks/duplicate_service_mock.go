@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDuplicateService is a mock implementation of ports.DuplicateService
+type MockDuplicateService struct {
+	mock.Mock
+}
+
+func (m *MockDuplicateService) FindDuplicates(personID int, minScore float64) ([]person.DuplicateCandidate, error) {
+	args := m.Called(personID, minScore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]person.DuplicateCandidate), args.Error(1)
+}
+
+func (m *MockDuplicateService) MergePersons(dto contract.MergeDTO, actor audit.Actor) (*person.Person, error) {
+	args := m.Called(dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Person), args.Error(1)
+}
@@ -0,0 +1,60 @@
+package mocks
+
+import (
+	"io"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	job "pessoas-api/internal/domain/job/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockJobService is a mock implementation of ports.JobService
+type MockJobService struct {
+	mock.Mock
+}
+
+func (m *MockJobService) Enqueue(jobType string, payload any, actor audit.Actor) (*job.Job, error) {
+	args := m.Called(jobType, payload, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) SaveArtifact(extension string, content io.Reader) (string, error) {
+	args := m.Called(extension, content)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockJobService) FindJob(id int) (*job.Job, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) CancelJob(id int) (*job.Job, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) RetryJob(id int) (*job.Job, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *MockJobService) OpenJobResult(id int) (*job.Artifact, io.ReadCloser, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*job.Artifact), args.Get(1).(io.ReadCloser), args.Error(2)
+}
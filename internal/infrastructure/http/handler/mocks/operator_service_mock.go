@@ -0,0 +1,53 @@
+package mocks
+
+import (
+	audit "pessoas-api/internal/domain/audit/model"
+	operator "pessoas-api/internal/domain/operator/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockOperatorService is a mock implementation of ports.OperatorService
+type MockOperatorService struct {
+	mock.Mock
+}
+
+func (m *MockOperatorService) ListOperators(page, pageSize int) ([]*operator.Operator, int64, error) {
+	args := m.Called(page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*operator.Operator), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockOperatorService) FindOperator(id int) (*operator.Operator, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*operator.Operator), args.Error(1)
+}
+
+func (m *MockOperatorService) AssignRoles(id int, roles []string, actor audit.Actor) (*operator.Operator, error) {
+	args := m.Called(id, roles, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*operator.Operator), args.Error(1)
+}
+
+func (m *MockOperatorService) SetActive(id int, active bool, actor audit.Actor) (*operator.Operator, error) {
+	args := m.Called(id, active, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*operator.Operator), args.Error(1)
+}
+
+func (m *MockOperatorService) BootstrapAdmin(username, email, password string) (*operator.Operator, error) {
+	args := m.Called(username, email, password)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*operator.Operator), args.Error(1)
+}
@@ -2,7 +2,9 @@ package mocks
 
 import (
 	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
 	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockPersonService struct {
 	mock.Mock
 }
 
-func (m *MockPersonService) CreatePerson(dto contract.NewPersonDTO) (int, error) {
-	args := m.Called(dto)
+func (m *MockPersonService) CreatePerson(dto contract.NewPersonDTO, actor audit.Actor) (int, error) {
+	args := m.Called(dto, actor)
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockPersonService) ListPersons(page, pageSize int, sort, order string) ([]*person.Person, int64, error) {
-	args := m.Called(page, pageSize, sort, order)
+func (m *MockPersonService) ListPersons(page, pageSize int, sort, order string, filter person.PersonFilter) ([]*person.Person, int64, error) {
+	args := m.Called(page, pageSize, sort, order, filter)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
 	}
@@ -33,20 +35,85 @@ func (m *MockPersonService) FindPersonByCPF(cpf string) (*person.Person, error)
 	return args.Get(0).(*person.Person), args.Error(1)
 }
 
-func (m *MockPersonService) FindPersonByID(id int) (*person.Person, error) {
-	args := m.Called(id)
+func (m *MockPersonService) FindPersonsByContact(value string) ([]*person.Person, error) {
+	args := m.Called(value)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Person), args.Error(1)
+}
+
+func (m *MockPersonService) FindPersonByID(id int, includeDeleted bool) (*person.Person, error) {
+	args := m.Called(id, includeDeleted)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Person), args.Error(1)
+}
+
+func (m *MockPersonService) RevealPerson(id int, dto contract.RevealPersonDTO, actor audit.Actor) (*person.Person, error) {
+	args := m.Called(id, dto, actor)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*person.Person), args.Error(1)
 }
 
-func (m *MockPersonService) UpdatePerson(id int, dto contract.UpdatePersonDTO) error {
-	args := m.Called(id, dto)
+func (m *MockPersonService) UpdatePerson(id int, version int, dto contract.UpdatePersonDTO, actor audit.Actor) error {
+	args := m.Called(id, version, dto, actor)
+	return args.Error(0)
+}
+
+func (m *MockPersonService) PatchPerson(id int, version int, dto contract.PatchPersonDTO, actor audit.Actor) error {
+	args := m.Called(id, version, dto, actor)
+	return args.Error(0)
+}
+
+func (m *MockPersonService) DeletePerson(id int, version int, actor audit.Actor) error {
+	args := m.Called(id, version, actor)
+	return args.Error(0)
+}
+
+func (m *MockPersonService) RestorePerson(id int, actor audit.Actor) error {
+	args := m.Called(id, actor)
 	return args.Error(0)
 }
 
-func (m *MockPersonService) DeletePerson(id int) error {
-	args := m.Called(id)
+func (m *MockPersonService) PurgePerson(id int, version int, actor audit.Actor) error {
+	args := m.Called(id, version, actor)
 	return args.Error(0)
 }
+
+func (m *MockPersonService) PersonHistory(id int, page, pageSize int) ([]*audit.AuditEntry, int64, error) {
+	args := m.Called(id, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*audit.AuditEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockPersonService) ListPersonsByCursor(cursor *person.Cursor, pageSize int, sort, order string, filter person.PersonFilter, withTotal bool) (*person.CursorPage, error) {
+	args := m.Called(cursor, pageSize, sort, order, filter, withTotal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.CursorPage), args.Error(1)
+}
+
+func (m *MockPersonService) ImportPersons(reader ports.PersonRowReader, dryRun bool, actor audit.Actor) (*person.ImportReport, error) {
+	args := m.Called(reader, dryRun, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.ImportReport), args.Error(1)
+}
+
+func (m *MockPersonService) ExportPersons(writer ports.PersonRowWriter, sort, order string, filter person.PersonFilter, options person.ExportOptions) (int, error) {
+	args := m.Called(writer, sort, order, filter, options)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPersonService) CountPersons(filter person.PersonFilter) (int64, error) {
+	args := m.Called(filter)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRelationshipService is a mock implementation of ports.RelationshipService
+type MockRelationshipService struct {
+	mock.Mock
+}
+
+func (m *MockRelationshipService) ListRelationships(personID int) ([]*person.Relationship, error) {
+	args := m.Called(personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*person.Relationship), args.Error(1)
+}
+
+func (m *MockRelationshipService) FindRelationship(personID, id int) (*person.Relationship, error) {
+	args := m.Called(personID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Relationship), args.Error(1)
+}
+
+func (m *MockRelationshipService) AddRelationship(personID int, dto contract.RelationshipDTO, actor audit.Actor) (*person.Relationship, error) {
+	args := m.Called(personID, dto, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.Relationship), args.Error(1)
+}
+
+func (m *MockRelationshipService) DeleteRelationship(personID, id int, actor audit.Actor) error {
+	args := m.Called(personID, id, actor)
+	return args.Error(0)
+}
+
+func (m *MockRelationshipService) FamilyGraph(personID, depth int) (*person.FamilyGraph, error) {
+	args := m.Called(personID, depth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.FamilyGraph), args.Error(1)
+}
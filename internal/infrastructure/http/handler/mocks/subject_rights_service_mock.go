@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	audit "pessoas-api/internal/domain/audit/model"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSubjectRightsService is a mock implementation of ports.SubjectRightsService
+type MockSubjectRightsService struct {
+	mock.Mock
+}
+
+func (m *MockSubjectRightsService) SubjectReport(cpf, requestType string, actor audit.Actor) (*person.SubjectReport, error) {
+	args := m.Called(cpf, requestType, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.SubjectReport), args.Error(1)
+}
+
+func (m *MockSubjectRightsService) AnonymizeSubject(cpf string, actor audit.Actor) (*person.SubjectRequest, error) {
+	args := m.Called(cpf, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.SubjectRequest), args.Error(1)
+}
+
+func (m *MockSubjectRightsService) ListSubjectRequests(page, pageSize int) ([]*person.SubjectRequest, int64, error) {
+	args := m.Called(page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*person.SubjectRequest), args.Get(1).(int64), args.Error(2)
+}
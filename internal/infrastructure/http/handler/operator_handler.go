@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/auth"
+	personContract "pessoas-api/internal/contract/person"
+	operator "pessoas-api/internal/domain/operator/model"
+	"pessoas-api/internal/domain/operator/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OperatorHandler serves the administration of the operators of the API and
+// of the roles that grant them permissions.
+type OperatorHandler struct {
+	service ports.OperatorService
+}
+
+func NewOperatorHandler(service ports.OperatorService) *OperatorHandler {
+	return &OperatorHandler{
+		service: service,
+	}
+}
+
+// ListOperators godoc
+// @Summary      List operators
+// @Description  Returns a paginated list of operators with their roles
+// @Tags         Operators
+// @Produce      json
+// @Param        page       query  int  false  "Page number"  default(1)  minimum(1)
+// @Param        page_size  query  int  false  "Items per page"  default(10)  minimum(1)  maximum(100)
+// @Success      200        {object}  contract.PaginatedResponse{data=[]contract.OperatorResponseDTO}
+// @Failure      400        {object}  contract.ErrorResponse  "Invalid query parameter"
+// @Failure      403        {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      500        {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /operators [get]
+func (h *OperatorHandler) ListOperators(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	operators, total, err := h.service.ListOperators(page, pageSize)
+	if err != nil {
+		log.Printf("[ERROR] ListOperators - Failed to retrieve operators: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to retrieve operators: " + err.Error(),
+		})
+		return
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+
+	data := make([]contract.OperatorResponseDTO, len(operators))
+	for i, op := range operators {
+		data[i] = contract.NewOperatorResponseDTO(op)
+	}
+
+	log.Printf("[SUCCESS] ListOperators - Retrieved %d operators (total: %d, pages: %d)", len(operators), total, totalPages)
+
+	c.JSON(http.StatusOK, personContract.PaginatedResponse{
+		Data:       data,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: totalPages,
+	})
+}
+
+// GetOperator godoc
+// @Summary      Find operator by ID
+// @Description  Returns the operator and its roles. The password hash is never returned
+// @Tags         Operators
+// @Produce      json
+// @Param        id   path      int  true  "Operator ID"
+// @Success      200  {object}  contract.OperatorResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      403  {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      404  {object}  contract.ErrorResponse  "Operator not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /operators/{id} [get]
+func (h *OperatorHandler) GetOperator(c *gin.Context) {
+	id, ok := operatorIDParam(c, "GetOperator")
+	if !ok {
+		return
+	}
+
+	op, err := h.service.FindOperator(id)
+	if err != nil {
+		respondOperatorError(c, "GetOperator", id, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewOperatorResponseDTO(op))
+}
+
+// AssignRoles godoc
+// @Summary      Assign roles to an operator
+// @Description  Replaces the roles of the operator. The change is audited and takes effect at the next login or token refresh of the operator. The last active admin cannot lose the admin role
+// @Tags         Operators
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                      true  "Operator ID"
+// @Param        roles  body      contract.AssignRolesDTO  true  "Roles to assign"
+// @Success      200    {object}  contract.OperatorResponseDTO
+// @Failure      400    {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      403    {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      404    {object}  contract.ErrorResponse  "Operator not found"
+// @Failure      409    {object}  contract.ErrorResponse  "Last active admin"
+// @Failure      422    {object}  contract.ErrorResponse  "Unknown role"
+// @Failure      500    {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /operators/{id}/roles [put]
+func (h *OperatorHandler) AssignRoles(c *gin.Context) {
+	id, ok := operatorIDParam(c, "AssignRoles")
+	if !ok {
+		return
+	}
+
+	var dto contract.AssignRolesDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] AssignRoles - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	op, err := h.service.AssignRoles(id, dto.Roles, requestActor(c))
+	if err != nil {
+		respondOperatorError(c, "AssignRoles", id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] AssignRoles - Operator ID %d now holds roles %v", id, op.Roles)
+	c.JSON(http.StatusOK, contract.NewOperatorResponseDTO(op))
+}
+
+// DeactivateOperator godoc
+// @Summary      Deactivate an operator
+// @Description  Prevents the operator from logging in and revokes all of its sessions: its access and refresh tokens stop working immediately. The change is audited. The last active admin cannot be deactivated
+// @Tags         Operators
+// @Produce      json
+// @Param        id   path      int  true  "Operator ID"
+// @Success      200  {object}  contract.OperatorResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      403  {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      404  {object}  contract.ErrorResponse  "Operator not found"
+// @Failure      409  {object}  contract.ErrorResponse  "Last active admin"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /operators/{id}/deactivate [post]
+func (h *OperatorHandler) DeactivateOperator(c *gin.Context) {
+	h.setActive(c, "DeactivateOperator", false)
+}
+
+// ActivateOperator godoc
+// @Summary      Activate an operator
+// @Description  Allows a deactivated operator to log in again. The sessions revoked at deactivation stay revoked. The change is audited
+// @Tags         Operators
+// @Produce      json
+// @Param        id   path      int  true  "Operator ID"
+// @Success      200  {object}  contract.OperatorResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      403  {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      404  {object}  contract.ErrorResponse  "Operator not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /operators/{id}/activate [post]
+func (h *OperatorHandler) ActivateOperator(c *gin.Context) {
+	h.setActive(c, "ActivateOperator", true)
+}
+
+func (h *OperatorHandler) setActive(c *gin.Context, operation string, active bool) {
+	id, ok := operatorIDParam(c, operation)
+	if !ok {
+		return
+	}
+
+	op, err := h.service.SetActive(id, active, requestActor(c))
+	if err != nil {
+		respondOperatorError(c, operation, id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] %s - Operator ID %d active: %t", operation, id, op.Active)
+	c.JSON(http.StatusOK, contract.NewOperatorResponseDTO(op))
+}
+
+// operatorIDParam reads the operator ID from the path. When it is invalid it
+// writes the error response and returns false.
+func operatorIDParam(c *gin.Context, operation string) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] %s - Invalid ID parameter: %s", operation, c.Param("id"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid operator ID",
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+func respondOperatorError(c *gin.Context, operation string, id int, err error) {
+	switch {
+	case errors.Is(err, operator.ErrOperatorNotFound):
+		log.Printf("[WARN] %s - Operator not found with ID: %d", operation, id)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Operator not found",
+		})
+	case errors.Is(err, operator.ErrLastAdminRequired), errors.Is(err, operator.ErrLastAdminActive):
+		log.Printf("[WARN] %s - Operator ID %d: %v", operation, id, err)
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "conflict",
+			"message": err.Error(),
+		})
+	case errors.Is(err, operator.ErrRoleInvalid), errors.Is(err, operator.ErrRolesRequired):
+		log.Printf("[ERROR] %s - Validation error for operator ID %d: %v", operation, id, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed for operator ID %d: %v", operation, id, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process operator: " + err.Error(),
+		})
+	}
+}
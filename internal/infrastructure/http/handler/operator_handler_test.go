@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	contract "pessoas-api/internal/contract/auth"
+	operator "pessoas-api/internal/domain/operator/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupOperatorTest() (*gin.Engine, *mocks.MockOperatorService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockOperatorService)
+	handler := NewOperatorHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Next()
+	})
+	router.GET("/operators", handler.ListOperators)
+	router.GET("/operators/:id", handler.GetOperator)
+	router.PUT("/operators/:id/roles", handler.AssignRoles)
+	router.POST("/operators/:id/deactivate", handler.DeactivateOperator)
+	router.POST("/operators/:id/activate", handler.ActivateOperator)
+
+	return router, mockService
+}
+
+func testOperator(roles ...string) *operator.Operator {
+	return &operator.Operator{ID: 3, Username: "john.doe", Email: "john.doe@company.com", PasswordHash: "hash", Active: true, Roles: roles}
+}
+
+func TestListOperators_Success(t *testing.T) {
+	router, mockService := setupOperatorTest()
+
+	mockService.On("ListOperators", 1, 10).Return([]*operator.Operator{testOperator(operator.RoleAdmin)}, int64(1), nil)
+
+	req, _ := http.NewRequest("GET", "/operators", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"roles":["admin"]`)
+	assert.NotContains(t, w.Body.String(), "hash")
+}
+
+func TestGetOperator(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		found    *operator.Operator
+		err      error
+		expected int
+	}{
+		{"found", "/operators/3", testOperator(operator.RoleViewer), nil, http.StatusOK},
+		{"not found", "/operators/3", nil, operator.ErrOperatorNotFound, http.StatusNotFound},
+		{"invalid ID", "/operators/abc", nil, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupOperatorTest()
+			if tt.found != nil {
+				mockService.On("FindOperator", 3).Return(tt.found, nil)
+			} else {
+				mockService.On("FindOperator", 3).Return(nil, tt.err)
+			}
+
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestAssignRoles_Success(t *testing.T) {
+	router, mockService := setupOperatorTest()
+
+	roles := []string{"editor", "auditor"}
+	mockService.On("AssignRoles", 3, roles, testActor).Return(testOperator(operator.RoleAuditor, operator.RoleEditor), nil)
+
+	body, _ := json.Marshal(contract.AssignRolesDTO{Roles: roles})
+	req, _ := http.NewRequest("PUT", "/operators/3/roles", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response contract.OperatorResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, []string{"auditor", "editor"}, response.Roles)
+}
+
+func TestAssignRoles_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		err      error
+		expected int
+	}{
+		{"missing roles", `{}`, nil, http.StatusBadRequest},
+		{"operator not found", `{"roles": ["editor"]}`, operator.ErrOperatorNotFound, http.StatusNotFound},
+		{"last admin", `{"roles": ["editor"]}`, operator.ErrLastAdminRequired, http.StatusConflict},
+		{"unknown role", `{"roles": ["root"]}`, operator.ErrRoleInvalid, http.StatusUnprocessableEntity},
+		{"empty roles", `{"roles": []}`, operator.ErrRolesRequired, http.StatusUnprocessableEntity},
+		{"repository error", `{"roles": ["editor"]}`, errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupOperatorTest()
+			var dto contract.AssignRolesDTO
+			json.Unmarshal([]byte(tt.body), &dto)
+			mockService.On("AssignRoles", 3, dto.Roles, testActor).Return(nil, tt.err)
+
+			req, _ := http.NewRequest("PUT", "/operators/3/roles", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestSetOperatorActive(t *testing.T) {
+	deactivated := testOperator(operator.RoleEditor)
+	deactivated.Active = false
+
+	tests := []struct {
+		name     string
+		path     string
+		active   bool
+		result   *operator.Operator
+		err      error
+		expected int
+	}{
+		{"deactivate", "/operators/3/deactivate", false, deactivated, nil, http.StatusOK},
+		{"activate", "/operators/3/activate", true, testOperator(operator.RoleEditor), nil, http.StatusOK},
+		{"last admin", "/operators/3/deactivate", false, nil, operator.ErrLastAdminActive, http.StatusConflict},
+		{"operator not found", "/operators/3/activate", true, nil, operator.ErrOperatorNotFound, http.StatusNotFound},
+		{"repository error", "/operators/3/deactivate", false, nil, errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupOperatorTest()
+			if tt.result != nil {
+				mockService.On("SetActive", 3, tt.active, testActor).Return(tt.result, nil)
+			} else {
+				mockService.On("SetActive", 3, tt.active, testActor).Return(nil, tt.err)
+			}
+
+			req, _ := http.NewRequest("POST", tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+			if tt.result != nil {
+				var response contract.OperatorResponseDTO
+				json.Unmarshal(w.Body.Bytes(), &response)
+				assert.Equal(t, tt.active, response.Active)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	person "pessoas-api/internal/domain/person/model"
+)
+
+// cursorToken is the wire format of a pagination cursor. Clients must treat the
+// encoded token as opaque; the short keys only keep it compact.
+type cursorToken struct {
+	Sort     string `json:"s"`
+	Order    string `json:"o"`
+	Value    string `json:"v"`
+	ID       int    `json:"i"`
+	Backward bool   `json:"b,omitempty"`
+}
+
+var errMalformedCursor = errors.New("malformed cursor")
+
+func encodeCursor(cursor *person.Cursor) *string {
+	if cursor == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(cursorToken(*cursor))
+	if err != nil {
+		return nil
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(data)
+	return &token
+}
+
+func decodeCursor(token string) (*person.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errMalformedCursor
+	}
+
+	var decoded cursorToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.ID < 1 {
+		return nil, errMalformedCursor
+	}
+
+	cursor := person.Cursor(decoded)
+	return &cursor, nil
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/personfile"
+	"pessoas-api/internal/infrastructure/personjob"
+
+	"github.com/gin-gonic/gin"
+)
+
+// importFormatNames maps the content types accepted by ImportPersons to import formats.
+var importFormatNames = map[string]string{
+	"text/csv":             "csv",
+	"application/x-ndjson": "ndjson",
+	"application/ndjson":   "ndjson",
+}
+
+// exportRequestFromQuery reads the parameters shared by the synchronous and
+// background exports. When one is invalid it writes the error response and
+// returns false. Filters are validated beforehand by middleware.ValidatePagination.
+func exportRequestFromQuery(c *gin.Context) (personjob.ExportPayload, bool) {
+	if !authorizeQuery(c, "ExportPersons") {
+		return personjob.ExportPayload{}, false
+	}
+
+	request := personjob.ExportPayload{
+		Format:  c.DefaultQuery("format", "csv"),
+		Sort:    c.DefaultQuery("sort", "id"),
+		Order:   c.DefaultQuery("order", "asc"),
+		Filter:  personFilterFromQuery(c),
+		Columns: exportColumnsFromQuery(c.Query("columns")),
+		Masked:  c.Query("mask") == "true",
+	}
+
+	if _, known := personfile.ExportFormats[request.Format]; !known {
+		log.Printf("[ERROR] ExportPersons - Unsupported format: %s", request.Format)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_parameter",
+			"message": "format must be 'csv', 'ndjson' or 'xlsx'",
+		})
+		return request, false
+	}
+
+	if mask := c.Query("mask"); mask != "" && mask != "true" && mask != "false" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_parameter",
+			"message": "mask must be 'true' or 'false'",
+		})
+		return request, false
+	}
+
+	for _, column := range request.Columns {
+		if !personModel.IsExportColumn(column) {
+			log.Printf("[ERROR] ExportPersons - Unknown column: %s", column)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": "Unknown column " + strconv.Quote(column) + ". Allowed: " + strings.Join(personModel.ExportColumns, ", "),
+			})
+			return request, false
+		}
+	}
+
+	return request, true
+}
+
+// exportColumnsFromQuery splits the comma separated columns parameter. An empty
+// parameter yields nil, which exports every column.
+func exportColumnsFromQuery(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	var columns []string
+	for _, column := range strings.Split(value, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+const dateLayout = "2006-01-02"
+
+// personFilterFromQuery builds the list filter from the query string.
+// Parameters are validated beforehand by middleware.ValidatePagination.
+func personFilterFromQuery(c *gin.Context) person.PersonFilter {
+	return person.PersonFilter{
+		Name:           c.Query("name"),
+		Email:          c.Query("email"),
+		EmailPrefix:    c.Query("email_match") == "prefix",
+		PhoneNumber:    c.Query("phone"),
+		PhonePrefix:    c.Query("phone_match") == "prefix",
+		CPFPrefix:      c.Query("cpf_prefix"),
+		BirthDateFrom:  parseDateBound(c.Query("birth_date_from"), false),
+		BirthDateTo:    parseDateBound(c.Query("birth_date_to"), false),
+		CreatedFrom:    parseDateBound(c.Query("created_from"), false),
+		CreatedTo:      parseDateBound(c.Query("created_to"), true),
+		UpdatedFrom:    parseDateBound(c.Query("updated_from"), false),
+		UpdatedTo:      parseDateBound(c.Query("updated_to"), true),
+		ConsentPurpose: c.Query("consent"),
+		IncludeDeleted: c.Query("include_deleted") == "true",
+	}
+}
+
+// restrictedQuery is a query parameter that needs a permission beyond the one
+// of its route when set to a value that applies.
+type restrictedQuery struct {
+	name       string
+	permission string
+	applies    func(value string) bool
+}
+
+func isTrue(value string) bool {
+	return value == "true"
+}
+
+func isPrefix(value string) bool {
+	return value == "prefix"
+}
+
+func isSet(value string) bool {
+	return value != ""
+}
+
+func isSensitiveSort(value string) bool {
+	return value == "cpf" || value == "email"
+}
+
+// restrictedQueries are checked by authorizeQuery on the person routes.
+// Prefix searches need pii:read because, repeated one character at a time,
+// they reveal the values that are masked for the caller. So does sorting by
+// cpf or email, whose cursors carry the value of the last person of the page.
+var restrictedQueries = []restrictedQuery{
+	{"include_deleted", operator.PermissionRegistryDeleted, isTrue},
+	{"purge", operator.PermissionRegistryDeleted, isTrue},
+	{"email_match", operator.PermissionPIIRead, isPrefix},
+	{"phone_match", operator.PermissionPIIRead, isPrefix},
+	{"cpf_prefix", operator.PermissionPIIRead, isSet},
+	{"sort", operator.PermissionPIIRead, isSensitiveSort},
+}
+
+// authorizeQuery rejects with 403 a query that uses a restricted parameter the
+// caller has no permission for, and returns false.
+func authorizeQuery(c *gin.Context, operation string) bool {
+	roles := c.GetStringSlice("roles")
+
+	for _, query := range restrictedQueries {
+		if !query.applies(c.Query(query.name)) || operator.HasPermission(roles, query.permission) {
+			continue
+		}
+
+		log.Printf("[WARN] %s - Operator %d lacks %s for the %s parameter", operation, c.GetInt("user_id"), query.permission, query.name)
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":   "forbidden",
+			"message": "Missing permission: " + query.permission + " (required by " + query.name + ")",
+		})
+		return false
+	}
+
+	return true
+}
+
+// parseDateBound accepts a date (YYYY-MM-DD) or an RFC 3339 timestamp.
+// A date used as an inclusive upper bound of a timestamp range covers the whole day.
+func parseDateBound(value string, endOfDay bool) *time.Time {
+	if value == "" {
+		return nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t
+	}
+
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return nil
+	}
+
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+
+	return &t
+}
@@ -1,24 +1,42 @@
 package handler
 
 import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	jobContract "pessoas-api/internal/contract/job"
 	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	jobPorts "pessoas-api/internal/domain/job/ports"
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
 	"pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/personfile"
+	"pessoas-api/internal/infrastructure/personjob"
 
 	"github.com/gin-gonic/gin"
 )
 
+// maxImportSize bounds the body of an import request.
+const maxImportSize = 50 << 20
+
 type PersonHandler struct {
 	service ports.PersonService
+	jobs    jobPorts.JobService
 }
 
-func NewPersonHandler(service ports.PersonService) *PersonHandler {
+func NewPersonHandler(service ports.PersonService, jobs jobPorts.JobService) *PersonHandler {
 	return &PersonHandler{
 		service: service,
+		jobs:    jobs,
 	}
 }
 
@@ -30,6 +48,7 @@ func NewPersonHandler(service ports.PersonService) *PersonHandler {
 // @Produce      json
 // @Param        person  body      contract.NewPersonDTO  true  "Person data to be created"
 // @Success      201     {object}  contract.SuccessResponse
+// @Header       201     {string}  Location  "URI of the created person"
 // @Failure      400     {object}  contract.ErrorResponse  "Invalid input data"
 // @Failure      422     {object}  contract.ErrorResponse  "Business validation error"
 // @Router       /persons [post]
@@ -45,7 +64,7 @@ func (h *PersonHandler) CreatePerson(c *gin.Context) {
 		return
 	}
 
-	id, err := h.service.CreatePerson(dto)
+	id, err := h.service.CreatePerson(dto, requestActor(c))
 	if err != nil {
 		log.Printf("[ERROR] CreatePerson - Validation error for CPF %s: %v", dto.CPF, err)
 		c.JSON(http.StatusUnprocessableEntity, gin.H{
@@ -56,6 +75,7 @@ func (h *PersonHandler) CreatePerson(c *gin.Context) {
 	}
 
 	log.Printf("[SUCCESS] CreatePerson - Person created with ID: %d, Name: %s", id, dto.Name)
+	c.Header("Location", personLocation(id))
 	c.JSON(http.StatusCreated, gin.H{
 		"id":      id,
 		"message": "Person created successfully",
@@ -64,7 +84,7 @@ func (h *PersonHandler) CreatePerson(c *gin.Context) {
 
 // ListPersons godoc
 // @Summary      List persons with pagination
-// @Description  Returns a paginated list of persons with sorting options
+// @Description  Returns a paginated list of persons with sorting and filtering options. Use pagination=cursor for keyset pagination, which stays fast on deep pages and stable under concurrent inserts. Without the pii:read permission the CPF, birth date, emails and phones are masked, and prefix searches and sorting by cpf or email are rejected with 403. When encryption at rest is enabled, sorting by cpf or email, birth date ranges and partial CPFs are rejected with 400
 // @Tags         Persons
 // @Accept       json
 // @Produce      json
@@ -72,19 +92,57 @@ func (h *PersonHandler) CreatePerson(c *gin.Context) {
 // @Param        page_size  query     int     false  "Items per page"           default(10)    minimum(1)  maximum(100)
 // @Param        sort       query     string  false  "Field to sort by"         default(id)    Enums(id, name, cpf, email, created_at, updated_at)
 // @Param        order      query     string  false  "Sort direction"           default(desc)  Enums(asc, desc)
-// @Success      200        {object}  contract.PaginatedResponse{data=[]contract.PersonResponseDTO}
+// @Param        name             query  string  false  "Name contains (case and accent insensitive)"
+// @Param        email            query  string  false  "Email (case insensitive)"
+// @Param        email_match      query  string  false  "How email is matched, prefix requires pii:read"  default(exact)  Enums(exact, prefix)
+// @Param        phone            query  string  false  "Phone number"
+// @Param        phone_match      query  string  false  "How phone is matched, prefix requires pii:read"  default(exact)  Enums(exact, prefix)
+// @Param        cpf_prefix       query  string  false  "CPF starts with (requires pii:read)"
+// @Param        birth_date_from  query  string  false  "Born on or after (YYYY-MM-DD)"
+// @Param        birth_date_to    query  string  false  "Born on or before (YYYY-MM-DD)"
+// @Param        created_from     query  string  false  "Created at or after (YYYY-MM-DD or RFC 3339)"
+// @Param        created_to       query  string  false  "Created at or before (YYYY-MM-DD or RFC 3339)"
+// @Param        updated_from     query  string  false  "Updated at or after (YYYY-MM-DD or RFC 3339)"
+// @Param        updated_to       query  string  false  "Updated at or before (YYYY-MM-DD or RFC 3339)"
+// @Param        consent          query  string  false  "Has an active consent for the purpose"  Enums(marketing, analytics, data_sharing)
+// @Param        include_deleted  query  bool    false  "Include soft deleted persons (requires registry:deleted)"  default(false)
+// @Param        pagination       query  string  false  "Pagination mode"  default(offset)  Enums(offset, cursor)
+// @Param        cursor           query  string  false  "Cursor returned as next_cursor or prev_cursor (implies pagination=cursor)"
+// @Param        with_total       query  bool    false  "Count total items in cursor mode"  default(true)
+// @Success      200        {object}  contract.PaginatedResponse{data=[]contract.PersonResponseDTO}  "Offset mode; cursor mode returns contract.CursorPaginatedResponse"
+// @Failure      400        {object}  contract.ErrorResponse  "Invalid query parameter"
+// @Failure      403        {object}  contract.ErrorResponse  "Missing permission"
 // @Failure      500        {object}  contract.ErrorResponse  "Internal server error"
 // @Router       /persons [get]
 func (h *PersonHandler) ListPersons(c *gin.Context) {
+	if !authorizeQuery(c, "ListPersons") {
+		return
+	}
+
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
 	sort := c.DefaultQuery("sort", "id")
 	order := c.DefaultQuery("order", "desc")
+	filter := personFilterFromQuery(c)
+
+	if c.Query("pagination") == "cursor" || c.Query("cursor") != "" {
+		h.listPersonsByCursor(c, pageSize, sort, order, filter)
+		return
+	}
 
-	log.Printf("[INFO] ListPersons - Fetching page: %d, pageSize: %d, sort: %s, order: %s", page, pageSize, sort, order)
+	log.Printf("[INFO] ListPersons - Fetching page: %d, pageSize: %d, sort: %s, order: %s, query: %s", page, pageSize, sort, order, c.Request.URL.RawQuery)
 
-	persons, total, err := h.service.ListPersons(page, pageSize, sort, order)
+	persons, total, err := h.service.ListPersons(page, pageSize, sort, order, filter)
 	if err != nil {
+		if errors.Is(err, personError.ErrEncryptedField) {
+			log.Printf("[ERROR] ListPersons - Query on encrypted field: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+			return
+		}
+
 		log.Printf("[ERROR] ListPersons - Failed to retrieve persons: %v", err)
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "internal_error",
@@ -98,7 +156,7 @@ func (h *PersonHandler) ListPersons(c *gin.Context) {
 	log.Printf("[SUCCESS] ListPersons - Retrieved %d persons (total: %d, pages: %d)", len(persons), total, totalPages)
 
 	response := contract.PaginatedResponse{
-		Data:       persons,
+		Data:       personResponses(c, persons),
 		Page:       page,
 		PageSize:   pageSize,
 		TotalItems: total,
@@ -108,14 +166,75 @@ func (h *PersonHandler) ListPersons(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// listPersonsByCursor serves ListPersons in keyset pagination mode.
+func (h *PersonHandler) listPersonsByCursor(c *gin.Context, pageSize int, sort, order string, filter personModel.PersonFilter) {
+	var cursor *personModel.Cursor
+
+	if token := c.Query("cursor"); token != "" {
+		decoded, err := decodeCursor(token)
+		if err != nil {
+			log.Printf("[ERROR] ListPersons - Invalid cursor: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": "Invalid cursor",
+			})
+			return
+		}
+		cursor = decoded
+	}
+
+	withTotal := c.DefaultQuery("with_total", "true") == "true"
+
+	log.Printf("[INFO] ListPersons - Fetching by cursor, pageSize: %d, sort: %s, order: %s, withTotal: %t", pageSize, sort, order, withTotal)
+
+	page, err := h.service.ListPersonsByCursor(cursor, pageSize, sort, order, filter, withTotal)
+	if err != nil {
+		if errors.Is(err, personError.ErrInvalidCursor) {
+			log.Printf("[ERROR] ListPersons - Cursor does not match the listing: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": "Invalid cursor: it was issued for a different sort or order",
+			})
+			return
+		}
+
+		if errors.Is(err, personError.ErrEncryptedField) {
+			log.Printf("[ERROR] ListPersons - Query on encrypted field: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		log.Printf("[ERROR] ListPersons - Failed to retrieve persons: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to retrieve persons: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] ListPersons - Retrieved %d persons by cursor", len(page.Persons))
+
+	c.JSON(http.StatusOK, contract.CursorPaginatedResponse{
+		Data:       personResponses(c, page.Persons),
+		PageSize:   pageSize,
+		NextCursor: encodeCursor(page.Next),
+		PrevCursor: encodeCursor(page.Prev),
+		TotalItems: page.Total,
+	})
+}
+
 // FindPersonByCPF godoc
 // @Summary      Find person by CPF
-// @Description  Returns person data based on the provided Brazilian CPF
+// @Description  Returns person data based on the provided Brazilian CPF. Without the pii:read permission the CPF, birth date, emails and phones are masked
 // @Tags         Persons
 // @Accept       json
 // @Produce      json
 // @Param        cpf  path      string  true  "Person's CPF (with or without formatting)"  example(111.444.777-35)
 // @Success      200  {object}  contract.PersonResponseDTO
+// @Header       200  {string}  ETag  "Current version of the person"
 // @Failure      400  {object}  contract.ErrorResponse  "CPF not provided"
 // @Failure      404  {object}  contract.ErrorResponse  "Person not found"
 // @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
@@ -154,7 +273,164 @@ func (h *PersonHandler) FindPersonByCPF(c *gin.Context) {
 	}
 
 	log.Printf("[SUCCESS] FindPersonByCPF - Found person with ID: %d, Name: %s", person.ID, person.Name)
-	c.JSON(http.StatusOK, person)
+	c.Header("ETag", versionETag(person.Version))
+	c.JSON(http.StatusOK, personResponse(c, person))
+}
+
+// FindPersonsByContact godoc
+// @Summary      Find persons by contact
+// @Description  Returns every person that has the given phone number or email among its contacts, primary or not. Values containing @ are looked up as emails, case-insensitively; other values as phone numbers, in any format accepted on creation
+// @Tags         Persons
+// @Produce      json
+// @Param        value  path      string  true  "Phone number or email"  example(joao.silva@email.com)
+// @Success      200    {array}   contract.PersonResponseDTO
+// @Failure      400    {object}  contract.ErrorResponse  "Invalid contact value"
+// @Failure      500    {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/contact/{value} [get]
+func (h *PersonHandler) FindPersonsByContact(c *gin.Context) {
+	value := c.Param("value")
+
+	log.Printf("[INFO] FindPersonsByContact - Searching for contact: %s", value)
+
+	persons, err := h.service.FindPersonsByContact(value)
+	if err != nil {
+		if errors.Is(err, personError.ErrPhoneInvalid) {
+			log.Printf("[ERROR] FindPersonsByContact - Invalid contact value %s: %v", value, err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		log.Printf("[ERROR] FindPersonsByContact - Failed to find persons with contact %s: %v", value, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to find persons: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] FindPersonsByContact - Found %d persons with contact: %s", len(persons), value)
+	c.JSON(http.StatusOK, personResponses(c, persons))
+}
+
+// GetPerson godoc
+// @Summary      Find person by ID
+// @Description  Returns person data based on the provided person ID. Without the pii:read permission the CPF, birth date, emails and phones are masked
+// @Tags         Persons
+// @Accept       json
+// @Produce      json
+// @Param        id               path      int   true   "Person ID"
+// @Param        include_deleted  query     bool  false  "Also find soft deleted persons (requires registry:deleted)"  default(false)
+// @Success      200  {object}  contract.PersonResponseDTO
+// @Header       200  {string}  ETag  "Current version of the person"
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      403  {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id} [get]
+func (h *PersonHandler) GetPerson(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] GetPerson - Invalid ID parameter: %s", c.Param("id"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid person ID",
+		})
+		return
+	}
+
+	if !authorizeQuery(c, "GetPerson") {
+		return
+	}
+
+	includeDeleted := c.Query("include_deleted") == "true"
+
+	log.Printf("[INFO] GetPerson - Searching for person ID: %d", id)
+
+	person, err := h.service.FindPersonByID(id, includeDeleted)
+	if err != nil {
+		log.Printf("[ERROR] GetPerson - Failed to find person ID %d: %v", id, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to find person: " + err.Error(),
+		})
+		return
+	}
+
+	if person == nil {
+		log.Printf("[WARN] GetPerson - Person not found with ID: %d", id)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Person not found",
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] GetPerson - Found person with ID: %d, Name: %s", person.ID, person.Name)
+	c.Header("ETag", versionETag(person.Version))
+	c.JSON(http.StatusOK, personResponse(c, person))
+}
+
+// RevealPerson godoc
+// @Summary      Reveal the personal data of a person
+// @Description  Returns the person with the CPF, birth date, emails and phones in full, for operators that see them masked elsewhere. Every reveal is recorded in the person history with the operator and the reason, and nothing is returned when it cannot be recorded
+// @Tags         Persons
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                       true  "Person ID"
+// @Param        reveal  body      contract.RevealPersonDTO  true  "Reason for the reveal"
+// @Success      200     {object}  contract.PersonResponseDTO
+// @Failure      400     {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      403     {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      404     {object}  contract.ErrorResponse  "Person not found"
+// @Failure      422     {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500     {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/reveal [post]
+func (h *PersonHandler) RevealPerson(c *gin.Context) {
+	id, ok := personIDParam(c, "RevealPerson")
+	if !ok {
+		return
+	}
+
+	var dto contract.RevealPersonDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] RevealPerson - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	person, err := h.service.RevealPerson(id, dto, requestActor(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, personError.ErrPersonNotFound):
+			log.Printf("[WARN] RevealPerson - Person not found with ID: %d", id)
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "not_found",
+				"message": "Person not found",
+			})
+		case errors.Is(err, personError.ErrRevealReasonRequired), errors.Is(err, personError.ErrRevealReasonTooLong):
+			log.Printf("[ERROR] RevealPerson - Validation error for person ID %d: %v", id, err)
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error":   "validation_error",
+				"message": err.Error(),
+			})
+		default:
+			log.Printf("[ERROR] RevealPerson - Failed for person ID %d: %v", id, err)
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "internal_error",
+				"message": "Failed to reveal person: " + err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Printf("[SUCCESS] RevealPerson - Personal data of person ID %d revealed to operator %d", id, c.GetInt("user_id"))
+	c.JSON(http.StatusOK, contract.NewPersonResponseDTO(person))
 }
 
 // UpdatePerson godoc
@@ -163,12 +439,16 @@ func (h *PersonHandler) FindPersonByCPF(c *gin.Context) {
 // @Tags         Persons
 // @Accept       json
 // @Produce      json
-// @Param        id      path      int                     true  "Person ID"
-// @Param        person  body      contract.UpdatePersonDTO  true  "Updated person data"
+// @Param        id        path      int                       true  "Person ID"
+// @Param        If-Match  header    string                    true  "ETag of the person version being updated"
+// @Param        person    body      contract.UpdatePersonDTO  true  "Updated person data"
 // @Success      200     {object}  contract.SuccessResponse
+// @Header       200     {string}  Location  "URI of the updated person"
 // @Failure      400     {object}  contract.ErrorResponse  "Invalid input data"
 // @Failure      404     {object}  contract.ErrorResponse  "Person not found"
+// @Failure      412     {object}  contract.ErrorResponse  "Person was modified by another request"
 // @Failure      422     {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      428     {object}  contract.ErrorResponse  "If-Match header is required"
 // @Router       /persons/{id} [put]
 func (h *PersonHandler) UpdatePerson(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
@@ -181,6 +461,11 @@ func (h *PersonHandler) UpdatePerson(c *gin.Context) {
 		return
 	}
 
+	version, ok := requireIfMatch(c, "UpdatePerson")
+	if !ok {
+		return
+	}
+
 	var dto contract.UpdatePersonDTO
 	if err := c.ShouldBindJSON(&dto); err != nil {
 		log.Printf("[ERROR] UpdatePerson - Invalid request body: %v", err)
@@ -193,9 +478,9 @@ func (h *PersonHandler) UpdatePerson(c *gin.Context) {
 
 	log.Printf("[INFO] UpdatePerson - Updating person ID: %d", id)
 
-	err = h.service.UpdatePerson(id, dto)
+	err = h.service.UpdatePerson(id, version, dto, requestActor(c))
 	if err != nil {
-		if err.Error() == "person not found" {
+		if errors.Is(err, personError.ErrPersonNotFound) {
 			log.Printf("[WARN] UpdatePerson - Person not found with ID: %d", id)
 			c.JSON(http.StatusNotFound, gin.H{
 				"error":   "not_found",
@@ -204,6 +489,12 @@ func (h *PersonHandler) UpdatePerson(c *gin.Context) {
 			return
 		}
 
+		if errors.Is(err, personError.ErrVersionConflict) {
+			log.Printf("[WARN] UpdatePerson - Version conflict for person ID %d (If-Match: %d)", id, version)
+			respondVersionConflict(c)
+			return
+		}
+
 		log.Printf("[ERROR] UpdatePerson - Failed to update person ID %d: %v", id, err)
 		c.JSON(http.StatusUnprocessableEntity, gin.H{
 			"error":   "validation_error",
@@ -213,6 +504,110 @@ func (h *PersonHandler) UpdatePerson(c *gin.Context) {
 	}
 
 	log.Printf("[SUCCESS] UpdatePerson - Person ID %d updated successfully", id)
+	c.Header("Location", personLocation(id))
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Person updated successfully",
+	})
+}
+
+// PatchPerson godoc
+// @Summary      Partially update a person
+// @Description  Applies a JSON Merge Patch (RFC 7396) or JSON Patch (RFC 6902) document to an existing person. Only the changed fields are persisted.
+// @Tags         Persons
+// @Accept       application/merge-patch+json,application/json-patch+json,json
+// @Produce      json
+// @Param        id        path      int                      true  "Person ID"
+// @Param        If-Match  header    string                   true  "ETag of the person version being updated"
+// @Param        patch     body      contract.PatchPersonDTO  true  "Merge patch document (or an array of contract.JSONPatchOperationDTO with application/json-patch+json)"
+// @Success      200    {object}  contract.SuccessResponse
+// @Header       200    {string}  Location  "URI of the updated person"
+// @Failure      400    {object}  contract.ErrorResponse  "Invalid patch document"
+// @Failure      404    {object}  contract.ErrorResponse  "Person not found"
+// @Failure      412    {object}  contract.ErrorResponse  "Person was modified by another request"
+// @Failure      415    {object}  contract.ErrorResponse  "Unsupported patch format"
+// @Failure      422    {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      428    {object}  contract.ErrorResponse  "If-Match header is required"
+// @Router       /persons/{id} [patch]
+func (h *PersonHandler) PatchPerson(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Printf("[ERROR] PatchPerson - Invalid ID parameter: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid person ID",
+		})
+		return
+	}
+
+	version, ok := requireIfMatch(c, "PatchPerson")
+	if !ok {
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		log.Printf("[ERROR] PatchPerson - Failed to read request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	var dto contract.PatchPersonDTO
+
+	switch c.ContentType() {
+	case mergePatchContentType, "application/json":
+		dto, err = parseMergePatch(body)
+	case jsonPatchContentType:
+		dto, err = parseJSONPatch(body)
+	default:
+		log.Printf("[ERROR] PatchPerson - Unsupported content type: %s", c.ContentType())
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{
+			"error":   "unsupported_media_type",
+			"message": "Content-Type must be " + mergePatchContentType + " or " + jsonPatchContentType,
+		})
+		return
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] PatchPerson - Invalid patch document: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid patch document: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[INFO] PatchPerson - Patching person ID: %d", id)
+
+	err = h.service.PatchPerson(id, version, dto, requestActor(c))
+	if err != nil {
+		if errors.Is(err, personError.ErrPersonNotFound) {
+			log.Printf("[WARN] PatchPerson - Person not found with ID: %d", id)
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "not_found",
+				"message": "Person not found",
+			})
+			return
+		}
+
+		if errors.Is(err, personError.ErrVersionConflict) {
+			log.Printf("[WARN] PatchPerson - Version conflict for person ID %d (If-Match: %d)", id, version)
+			respondVersionConflict(c)
+			return
+		}
+
+		log.Printf("[ERROR] PatchPerson - Failed to patch person ID %d: %v", id, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] PatchPerson - Person ID %d patched successfully", id)
+	c.Header("Location", personLocation(id))
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Person updated successfully",
 	})
@@ -220,14 +615,19 @@ func (h *PersonHandler) UpdatePerson(c *gin.Context) {
 
 // DeletePerson godoc
 // @Summary      Delete a person
-// @Description  Deletes a person from the system
+// @Description  Soft deletes a person, keeping the record for auditing. Use purge=true to remove it permanently
 // @Tags         Persons
 // @Accept       json
 // @Produce      json
-// @Param        id  path      int  true  "Person ID"
+// @Param        id        path      int     true   "Person ID"
+// @Param        If-Match  header    string  true   "ETag of the person version being deleted"
+// @Param        purge     query     bool    false  "Permanently delete the person (requires registry:deleted)"  default(false)
 // @Success      200 {object}  contract.SuccessResponse
 // @Failure      400 {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      403 {object}  contract.ErrorResponse  "Missing permission"
 // @Failure      404 {object}  contract.ErrorResponse  "Person not found"
+// @Failure      412 {object}  contract.ErrorResponse  "Person was modified by another request"
+// @Failure      428 {object}  contract.ErrorResponse  "If-Match header is required"
 // @Router       /persons/{id} [delete]
 func (h *PersonHandler) DeletePerson(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
@@ -240,11 +640,26 @@ func (h *PersonHandler) DeletePerson(c *gin.Context) {
 		return
 	}
 
-	log.Printf("[INFO] DeletePerson - Deleting person ID: %d", id)
+	if !authorizeQuery(c, "DeletePerson") {
+		return
+	}
+
+	version, ok := requireIfMatch(c, "DeletePerson")
+	if !ok {
+		return
+	}
+
+	purge := c.Query("purge") == "true"
+
+	log.Printf("[INFO] DeletePerson - Deleting person ID: %d (purge: %t)", id, purge)
 
-	err = h.service.DeletePerson(id)
+	if purge {
+		err = h.service.PurgePerson(id, version, requestActor(c))
+	} else {
+		err = h.service.DeletePerson(id, version, requestActor(c))
+	}
 	if err != nil {
-		if err.Error() == "person not found" {
+		if errors.Is(err, personError.ErrPersonNotFound) {
 			log.Printf("[WARN] DeletePerson - Person not found with ID: %d", id)
 			c.JSON(http.StatusNotFound, gin.H{
 				"error":   "not_found",
@@ -253,6 +668,12 @@ func (h *PersonHandler) DeletePerson(c *gin.Context) {
 			return
 		}
 
+		if errors.Is(err, personError.ErrVersionConflict) {
+			log.Printf("[WARN] DeletePerson - Version conflict for person ID %d (If-Match: %d)", id, version)
+			respondVersionConflict(c)
+			return
+		}
+
 		log.Printf("[ERROR] DeletePerson - Failed to delete person ID %d: %v", id, err)
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "internal_error",
@@ -266,3 +687,427 @@ func (h *PersonHandler) DeletePerson(c *gin.Context) {
 		"message": "Person deleted successfully",
 	})
 }
+
+// RestorePerson godoc
+// @Summary      Restore a deleted person
+// @Description  Restores a soft deleted person
+// @Tags         Persons
+// @Accept       json
+// @Produce      json
+// @Param        id  path      int  true  "Person ID"
+// @Success      200 {object}  contract.SuccessResponse
+// @Header       200 {string}  Location  "URI of the restored person"
+// @Failure      400 {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404 {object}  contract.ErrorResponse  "Person not found"
+// @Failure      409 {object}  contract.ErrorResponse  "Person is not deleted, was merged or anonymized, or its CPF is in use"
+// @Failure      500 {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/restore [post]
+func (h *PersonHandler) RestorePerson(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Printf("[ERROR] RestorePerson - Invalid ID parameter: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid person ID",
+		})
+		return
+	}
+
+	log.Printf("[INFO] RestorePerson - Restoring person ID: %d", id)
+
+	err = h.service.RestorePerson(id, requestActor(c))
+	if err != nil {
+		if errors.Is(err, personError.ErrPersonNotFound) {
+			log.Printf("[WARN] RestorePerson - Person not found with ID: %d", id)
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "not_found",
+				"message": "Person not found",
+			})
+			return
+		}
+
+		if errors.Is(err, personError.ErrPersonNotDeleted) || errors.Is(err, personError.ErrCPFAlreadyInUse) || errors.Is(err, personError.ErrPersonMerged) || errors.Is(err, personError.ErrPersonAnonymized) {
+			log.Printf("[WARN] RestorePerson - Cannot restore person ID %d: %v", id, err)
+			c.JSON(http.StatusConflict, gin.H{
+				"error":   "conflict",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		log.Printf("[ERROR] RestorePerson - Failed to restore person ID %d: %v", id, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to restore person: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] RestorePerson - Person ID %d restored successfully", id)
+	c.Header("Location", personLocation(id))
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Person restored successfully",
+	})
+}
+
+// ImportPersons godoc
+// @Summary      Bulk import persons
+// @Description  Imports persons from a CSV (header: name,cpf,birth_date,phone,email) or NDJSON file sent as the request body. Every row is validated like POST /persons; valid rows are inserted in batched transactions and invalid ones are reported with their line number, error code and message. Use dry_run=true to only validate, and async=true to run the import as a background job
+// @Tags         Persons
+// @Accept       text/csv,application/x-ndjson
+// @Produce      json
+// @Param        dry_run  query     bool    false  "Validate rows without writing"  default(false)
+// @Param        async    query     bool    false  "Run as a background job and return 202 with the job"  default(false)
+// @Param        file     body      string  true   "CSV or NDJSON content"
+// @Success      200      {object}  contract.ImportReportDTO
+// @Success      202      {object}  contract.JobDTO  "Import job queued"
+// @Header       202      {string}  Location  "URI of the job"
+// @Failure      400      {object}  contract.ErrorResponse  "Invalid file"
+// @Failure      413      {object}  contract.ErrorResponse  "File too large"
+// @Failure      415      {object}  contract.ErrorResponse  "Unsupported file format"
+// @Failure      500      {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/import [post]
+func (h *PersonHandler) ImportPersons(c *gin.Context) {
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	formatName, known := importFormatNames[c.ContentType()]
+	if !known {
+		log.Printf("[ERROR] ImportPersons - Unsupported content type: %s", c.ContentType())
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{
+			"error":   "unsupported_media_type",
+			"message": "Content-Type must be text/csv or " + personfile.NDJSONContentType,
+		})
+		return
+	}
+
+	dryRun := c.Query("dry_run") == "true"
+
+	if c.Query("async") == "true" {
+		h.importPersonsAsync(c, body, formatName, dryRun)
+		return
+	}
+
+	log.Printf("[INFO] ImportPersons - Importing %s file (dryRun: %t)", formatName, dryRun)
+
+	report, err := h.service.ImportPersons(personfile.ImportFormats[formatName](body), dryRun, requestActor(c))
+	if err != nil {
+		if respondFileTooLarge(c, "ImportPersons", err) {
+			return
+		}
+
+		if errors.Is(err, personfile.ErrInvalidHeader) || errors.Is(err, bufio.ErrTooLong) {
+			log.Printf("[ERROR] ImportPersons - Invalid file: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_file",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		log.Printf("[ERROR] ImportPersons - Failed to import persons: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to import persons: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] ImportPersons - Processed %d rows: %d succeeded, %d failed (dryRun: %t)", report.TotalRows, report.Succeeded, report.Failed, dryRun)
+	c.JSON(http.StatusOK, contract.NewImportReportDTO(report))
+}
+
+// importPersonsAsync stores the uploaded file and queues an import job for it.
+func (h *PersonHandler) importPersonsAsync(c *gin.Context, body io.Reader, formatName string, dryRun bool) {
+	ref, err := h.jobs.SaveArtifact(formatName, body)
+	if err != nil {
+		if respondFileTooLarge(c, "ImportPersons", err) {
+			return
+		}
+
+		log.Printf("[ERROR] ImportPersons - Failed to store import file: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to store import file: " + err.Error(),
+		})
+		return
+	}
+
+	payload := personjob.ImportPayload{InputRef: ref, Format: formatName, DryRun: dryRun}
+	h.enqueueJob(c, "ImportPersons", personjob.TypeImport, payload)
+}
+
+// ExportPersons godoc
+// @Summary      Export persons
+// @Description  Streams every person matching the list filters as a CSV, NDJSON or XLSX file. The rows are read from the database as they are written, so exports of any size run in constant memory. The file holds personal data in full and requires the pii:read permission. When encryption at rest is enabled, sorting by cpf or email, birth date ranges and partial CPFs are rejected with 400
+// @Tags         Persons
+// @Produce      text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        format           query     string  false  "File format"  Enums(csv, ndjson, xlsx)  default(csv)
+// @Param        columns          query     string  false  "Comma separated columns (id, name, cpf, birth_date, phone, email, version, created_at, updated_at, deleted_at); all by default"
+// @Param        mask             query     bool    false  "Format CPF as 000.000.000-00 and phone as (00) 00000-0000"  default(false)
+// @Param        sort             query     string  false  "Sort field"  Enums(id, name, cpf, email, created_at, updated_at)  default(id)
+// @Param        order            query     string  false  "Sort order"  Enums(asc, desc)  default(asc)
+// @Param        name             query     string  false  "Name contains (case and accent insensitive)"
+// @Param        email            query     string  false  "Email (case insensitive)"
+// @Param        email_match      query     string  false  "How email is matched"  Enums(exact, prefix)  default(exact)
+// @Param        phone            query     string  false  "Phone number"
+// @Param        phone_match      query     string  false  "How phone is matched"  Enums(exact, prefix)  default(exact)
+// @Param        cpf_prefix       query     string  false  "CPF starts with"
+// @Param        birth_date_from  query     string  false  "Born on or after (YYYY-MM-DD)"
+// @Param        birth_date_to    query     string  false  "Born on or before (YYYY-MM-DD)"
+// @Param        created_from     query     string  false  "Created at or after (YYYY-MM-DD or RFC 3339)"
+// @Param        created_to       query     string  false  "Created at or before (YYYY-MM-DD or RFC 3339)"
+// @Param        updated_from     query     string  false  "Updated at or after (YYYY-MM-DD or RFC 3339)"
+// @Param        updated_to       query     string  false  "Updated at or before (YYYY-MM-DD or RFC 3339)"
+// @Param        consent          query     string  false  "Has an active consent for the purpose"  Enums(marketing, analytics, data_sharing)
+// @Param        include_deleted  query     bool    false  "Include soft deleted persons (requires registry:deleted)"  default(false)
+// @Success      200  {file}    file
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid parameters"
+// @Failure      403  {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/export [get]
+func (h *PersonHandler) ExportPersons(c *gin.Context) {
+	request, ok := exportRequestFromQuery(c)
+	if !ok {
+		return
+	}
+
+	format := personfile.ExportFormats[request.Format]
+
+	log.Printf("[INFO] ExportPersons - Exporting %s, sort: %s, order: %s, query: %s", request.Format, request.Sort, request.Order, c.Request.URL.RawQuery)
+
+	filename := fmt.Sprintf("persons-%s.%s", time.Now().UTC().Format("20060102-150405"), request.Format)
+	c.Header("Content-Type", format.ContentType)
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+
+	options := personModel.ExportOptions{Columns: request.Columns, Masked: request.Masked}
+
+	written, err := h.service.ExportPersons(format.NewWriter(c.Writer), request.Sort, request.Order, request.Filter, options)
+	if err != nil {
+		if c.Writer.Written() {
+			// The status line is already out; all that is left is to cut the file short.
+			log.Printf("[ERROR] ExportPersons - Export aborted after %d persons: %v", written, err)
+			c.Abort()
+			return
+		}
+
+		c.Writer.Header().Del("Content-Disposition")
+
+		if errors.Is(err, personError.ErrEncryptedField) {
+			log.Printf("[ERROR] ExportPersons - Query on encrypted field: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		log.Printf("[ERROR] ExportPersons - Failed to export persons: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to export persons: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] ExportPersons - Exported %d persons as %s", written, request.Format)
+}
+
+// ExportPersonsAsync godoc
+// @Summary      Export persons in the background
+// @Description  Queues an export job with the same parameters as GET /persons/export. Follow the job at the returned Location and download the file from its result_url once it succeeds
+// @Tags         Persons
+// @Produce      json
+// @Param        format           query     string  false  "File format"  Enums(csv, ndjson, xlsx)  default(csv)
+// @Param        columns          query     string  false  "Comma separated columns (id, name, cpf, birth_date, phone, email, version, created_at, updated_at, deleted_at); all by default"
+// @Param        mask             query     bool    false  "Format CPF as 000.000.000-00 and phone as (00) 00000-0000"  default(false)
+// @Param        sort             query     string  false  "Sort field"  Enums(id, name, cpf, email, created_at, updated_at)  default(id)
+// @Param        order            query     string  false  "Sort order"  Enums(asc, desc)  default(asc)
+// @Param        name             query     string  false  "Name contains (case and accent insensitive)"
+// @Param        email            query     string  false  "Email (case insensitive)"
+// @Param        email_match      query     string  false  "How email is matched"  Enums(exact, prefix)  default(exact)
+// @Param        phone            query     string  false  "Phone number"
+// @Param        phone_match      query     string  false  "How phone is matched"  Enums(exact, prefix)  default(exact)
+// @Param        cpf_prefix       query     string  false  "CPF starts with"
+// @Param        birth_date_from  query     string  false  "Born on or after (YYYY-MM-DD)"
+// @Param        birth_date_to    query     string  false  "Born on or before (YYYY-MM-DD)"
+// @Param        created_from     query     string  false  "Created at or after (YYYY-MM-DD or RFC 3339)"
+// @Param        created_to       query     string  false  "Created at or before (YYYY-MM-DD or RFC 3339)"
+// @Param        updated_from     query     string  false  "Updated at or after (YYYY-MM-DD or RFC 3339)"
+// @Param        updated_to       query     string  false  "Updated at or before (YYYY-MM-DD or RFC 3339)"
+// @Param        consent          query     string  false  "Has an active consent for the purpose"  Enums(marketing, analytics, data_sharing)
+// @Param        include_deleted  query     bool    false  "Include soft deleted persons (requires registry:deleted)"  default(false)
+// @Success      202  {object}  contract.JobDTO
+// @Header       202  {string}  Location  "URI of the job"
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid parameters"
+// @Failure      403  {object}  contract.ErrorResponse  "Missing permission"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/export [post]
+func (h *PersonHandler) ExportPersonsAsync(c *gin.Context) {
+	request, ok := exportRequestFromQuery(c)
+	if !ok {
+		return
+	}
+
+	h.enqueueJob(c, "ExportPersons", personjob.TypeExport, request)
+}
+
+// enqueueJob queues a job and answers 202 with it.
+func (h *PersonHandler) enqueueJob(c *gin.Context, operation, jobType string, payload any) {
+	queued, err := h.jobs.Enqueue(jobType, payload, requestActor(c))
+	if err != nil {
+		log.Printf("[ERROR] %s - Failed to enqueue job: %v", operation, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to enqueue job: " + err.Error(),
+		})
+		return
+	}
+
+	log.Printf("[SUCCESS] %s - Queued %s job %d", operation, jobType, queued.ID)
+	c.Header("Location", jobLocation(queued.ID))
+	c.JSON(http.StatusAccepted, jobContract.NewJobDTO(queued))
+}
+
+// respondFileTooLarge answers 413 when err comes from exceeding maxImportSize.
+func respondFileTooLarge(c *gin.Context, operation string, err error) bool {
+	var tooLarge *http.MaxBytesError
+	if !errors.As(err, &tooLarge) {
+		return false
+	}
+
+	log.Printf("[ERROR] %s - File exceeds %d bytes", operation, tooLarge.Limit)
+	c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+		"error":   "file_too_large",
+		"message": fmt.Sprintf("Import file must not exceed %d bytes", tooLarge.Limit),
+	})
+	return true
+}
+
+// GetPersonHistory godoc
+// @Summary      Get the change history of a person
+// @Description  Returns the audit trail of a person, newest first, with before/after snapshots of every change. Without pii:read the snapshots are masked
+// @Tags         Persons
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int  true   "Person ID"
+// @Param        page       query     int  false  "Page number"     default(1)   minimum(1)
+// @Param        page_size  query     int  false  "Items per page"  default(10)  minimum(1)  maximum(100)
+// @Success      200  {object}  contract.PaginatedResponse{data=[]contract.AuditEntryDTO}
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/history [get]
+func (h *PersonHandler) GetPersonHistory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] GetPersonHistory - Invalid ID parameter: %s", c.Param("id"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid person ID",
+		})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	log.Printf("[INFO] GetPersonHistory - Fetching history of person ID: %d, page: %d, pageSize: %d", id, page, pageSize)
+
+	entries, total, err := h.service.PersonHistory(id, page, pageSize)
+	if err != nil {
+		if errors.Is(err, personError.ErrPersonNotFound) {
+			log.Printf("[WARN] GetPersonHistory - Person not found with ID: %d", id)
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":   "not_found",
+				"message": "Person not found",
+			})
+			return
+		}
+
+		log.Printf("[ERROR] GetPersonHistory - Failed to retrieve history of person ID %d: %v", id, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to retrieve person history: " + err.Error(),
+		})
+		return
+	}
+
+	data := personHistoryResponse(c, entries)
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+
+	log.Printf("[SUCCESS] GetPersonHistory - Retrieved %d entries for person ID %d (total: %d)", len(entries), id, total)
+
+	c.JSON(http.StatusOK, contract.PaginatedResponse{
+		Data:       data,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: totalPages,
+	})
+}
+
+// personLocation builds the URI of a person resource, used in Location headers.
+func personLocation(id int) string {
+	return fmt.Sprintf("/api/v1/persons/%d", id)
+}
+
+// versionETag renders a record version as a strong entity tag.
+func versionETag(version int) string {
+	return fmt.Sprintf("\"%d\"", version)
+}
+
+// requireIfMatch reads the record version expected by the client from the If-Match header.
+// When the header is missing or malformed it writes the error response and returns false.
+func requireIfMatch(c *gin.Context, operation string) (int, bool) {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+
+	if ifMatch == "" {
+		log.Printf("[ERROR] %s - Missing If-Match header", operation)
+		c.JSON(http.StatusPreconditionRequired, gin.H{
+			"error":   "precondition_required",
+			"message": "If-Match header with the resource ETag is required",
+		})
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(strings.Trim(ifMatch, "\""))
+	if err != nil || !strings.HasPrefix(ifMatch, "\"") || !strings.HasSuffix(ifMatch, "\"") || version < 1 {
+		log.Printf("[ERROR] %s - Invalid If-Match header: %s", operation, ifMatch)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid If-Match header. Use the ETag returned when reading the resource",
+		})
+		return 0, false
+	}
+
+	return version, true
+}
+
+// optionalIfMatch reads the record version from the If-Match header when the client
+// sent one, and returns 0 otherwise. When the header is malformed it writes the
+// error response and returns false.
+func optionalIfMatch(c *gin.Context, operation string) (int, bool) {
+	if strings.TrimSpace(c.GetHeader("If-Match")) == "" {
+		return 0, true
+	}
+
+	return requireIfMatch(c, operation)
+}
+
+// requestActor identifies the operator and request behind a write, for the audit trail.
+// user_id is set by JWTAuth and request_id by the RequestID middleware.
+func requestActor(c *gin.Context) audit.Actor {
+	return audit.Actor{
+		OperatorID: c.GetInt("user_id"),
+		RequestID:  c.GetString("request_id"),
+		ClientIP:   c.ClientIP(),
+	}
+}
+
+func respondVersionConflict(c *gin.Context) {
+	c.JSON(http.StatusPreconditionFailed, gin.H{
+		"error":   "precondition_failed",
+		"message": personError.ErrVersionConflict.Error(),
+	})
+}
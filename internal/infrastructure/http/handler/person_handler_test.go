@@ -4,31 +4,86 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	job "pessoas-api/internal/domain/job/model"
+	operator "pessoas-api/internal/domain/operator/model"
+	personError "pessoas-api/internal/domain/person/error"
 	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
 	"pessoas-api/internal/infrastructure/http/handler/mocks"
+	"pessoas-api/internal/infrastructure/personfile"
+	"pessoas-api/internal/infrastructure/personjob"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
+// testActor is the audit actor built from the context set up by setupTest.
+var testActor = audit.Actor{OperatorID: 7, RequestID: "req-123", ClientIP: "203.0.113.10"}
+
+// testRoles are the roles of that operator, who sees personal data in full.
+var testRoles = []string{operator.RoleEditor}
+
+// primaryContacts builds the primary phone and email of a person literal.
+func primaryContacts(phone, email string) []person.Contact {
+	return []person.Contact{
+		{ContactFields: person.ContactFields{Type: person.ContactPhone, Value: phone, Primary: true}},
+		{ContactFields: person.ContactFields{Type: person.ContactEmail, Value: email, Primary: true}},
+	}
+}
+
 func setupTest() (*gin.Engine, *mocks.MockPersonService) {
+	router, mockService, _ := setupJobsTest()
+	return router, mockService
+}
+
+// setupJobsTest is setupTest for the endpoints that queue background jobs.
+func setupJobsTest() (*gin.Engine, *mocks.MockPersonService, *mocks.MockJobService) {
+	return setupTestAs(testRoles)
+}
+
+// setupTestAs is setupJobsTest for an operator holding the given roles.
+func setupTestAs(roles []string) (*gin.Engine, *mocks.MockPersonService, *mocks.MockJobService) {
 	gin.SetMode(gin.TestMode)
 	mockService := new(mocks.MockPersonService)
-	handler := NewPersonHandler(mockService)
+	mockJobs := new(mocks.MockJobService)
+	handler := NewPersonHandler(mockService, mockJobs)
 
 	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Set("roles", roles)
+		c.Next()
+	})
 	router.POST("/persons", handler.CreatePerson)
 	router.GET("/persons", handler.ListPersons)
 	router.GET("/persons/cpf/:cpf", handler.FindPersonByCPF)
-
-	return router, mockService
+	router.GET("/persons/contact/:value", handler.FindPersonsByContact)
+	router.GET("/persons/:id", handler.GetPerson)
+	router.POST("/persons/:id/reveal", handler.RevealPerson)
+	router.PUT("/persons/:id", handler.UpdatePerson)
+	router.PATCH("/persons/:id", handler.PatchPerson)
+	router.DELETE("/persons/:id", handler.DeletePerson)
+	router.POST("/persons/:id/restore", handler.RestorePerson)
+	router.GET("/persons/:id/history", handler.GetPersonHistory)
+	router.POST("/persons/import", handler.ImportPersons)
+	router.GET("/persons/export", handler.ExportPersons)
+	router.POST("/persons/export", handler.ExportPersonsAsync)
+
+	return router, mockService, mockJobs
 }
 
 // ========== CreatePerson Tests ==========
@@ -44,7 +99,7 @@ func TestCreatePerson_Success(t *testing.T) {
 		Email:       "joao.silva@email.com",
 	}
 
-	mockService.On("CreatePerson", dto).Return(1, nil)
+	mockService.On("CreatePerson", dto, testActor).Return(1, nil)
 
 	body, _ := json.Marshal(dto)
 	req, _ := http.NewRequest("POST", "/persons", bytes.NewBuffer(body))
@@ -60,6 +115,7 @@ func TestCreatePerson_Success(t *testing.T) {
 
 	assert.Equal(t, float64(1), response["id"])
 	assert.Equal(t, "Person created successfully", response["message"])
+	assert.Equal(t, "/api/v1/persons/1", w.Header().Get("Location"))
 
 	mockService.AssertExpectations(t)
 }
@@ -117,7 +173,7 @@ func TestCreatePerson_ServiceValidationError(t *testing.T) {
 		Email:       "joao.silva@email.com",
 	}
 
-	mockService.On("CreatePerson", dto).Return(0, errors.New("invalid CPF"))
+	mockService.On("CreatePerson", dto, testActor).Return(0, errors.New("invalid CPF"))
 
 	body, _ := json.Marshal(dto)
 	req, _ := http.NewRequest("POST", "/persons", bytes.NewBuffer(body))
@@ -147,28 +203,26 @@ func TestListPersons_Success(t *testing.T) {
 
 	persons := []*person.Person{
 		{
-			ID:          1,
-			Name:        "João Silva",
-			CPF:         "11144477735",
-			BirthDate:   birthDate,
-			PhoneNumber: "81912345678",
-			Email:       "joao.silva@email.com",
-			CreatedAt:   createdAt,
-			UpdatedAt:   createdAt,
+			ID:        1,
+			Name:      "João Silva",
+			CPF:       "11144477735",
+			BirthDate: birthDate,
+			Contacts:  primaryContacts("81912345678", "joao.silva@email.com"),
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
 		},
 		{
-			ID:          2,
-			Name:        "Maria Santos",
-			CPF:         "22255588899",
-			BirthDate:   birthDate,
-			PhoneNumber: "81987654321",
-			Email:       "maria.santos@email.com",
-			CreatedAt:   createdAt,
-			UpdatedAt:   createdAt,
+			ID:        2,
+			Name:      "Maria Santos",
+			CPF:       "22255588899",
+			BirthDate: birthDate,
+			Contacts:  primaryContacts("81987654321", "maria.santos@email.com"),
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
 		},
 	}
 
-	mockService.On("ListPersons", 1, 10, "id", "desc").Return(persons, int64(2), nil)
+	mockService.On("ListPersons", 1, 10, "id", "desc", person.PersonFilter{}).Return(persons, int64(2), nil)
 
 	req, _ := http.NewRequest("GET", "/persons?page=1&page_size=10&sort=id&order=desc", nil)
 	w := httptest.NewRecorder()
@@ -190,7 +244,7 @@ func TestListPersons_Success(t *testing.T) {
 func TestListPersons_DefaultParameters(t *testing.T) {
 	router, mockService := setupTest()
 
-	mockService.On("ListPersons", 1, 10, "id", "desc").Return([]*person.Person{}, int64(0), nil)
+	mockService.On("ListPersons", 1, 10, "id", "desc", person.PersonFilter{}).Return([]*person.Person{}, int64(0), nil)
 
 	req, _ := http.NewRequest("GET", "/persons", nil)
 	w := httptest.NewRecorder()
@@ -212,7 +266,7 @@ func TestListPersons_DefaultParameters(t *testing.T) {
 func TestListPersons_CustomPagination(t *testing.T) {
 	router, mockService := setupTest()
 
-	mockService.On("ListPersons", 2, 5, "name", "asc").Return([]*person.Person{}, int64(15), nil)
+	mockService.On("ListPersons", 2, 5, "name", "asc", person.PersonFilter{}).Return([]*person.Person{}, int64(15), nil)
 
 	req, _ := http.NewRequest("GET", "/persons?page=2&page_size=5&sort=name&order=asc", nil)
 	w := httptest.NewRecorder()
@@ -234,7 +288,7 @@ func TestListPersons_CustomPagination(t *testing.T) {
 func TestListPersons_ServiceError(t *testing.T) {
 	router, mockService := setupTest()
 
-	mockService.On("ListPersons", 1, 10, "id", "desc").
+	mockService.On("ListPersons", 1, 10, "id", "desc", person.PersonFilter{}).
 		Return(nil, int64(0), errors.New("database connection error"))
 
 	req, _ := http.NewRequest("GET", "/persons", nil)
@@ -252,10 +306,31 @@ func TestListPersons_ServiceError(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestListPersons_EncryptedFieldQuery(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("ListPersons", 1, 10, "cpf", "desc", person.PersonFilter{}).
+		Return(nil, int64(0), fmt.Errorf("%w: cannot sort by cpf", personError.ErrEncryptedField))
+
+	req, _ := http.NewRequest("GET", "/persons?sort=cpf", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Equal(t, "invalid_parameter", response["error"])
+	assert.Contains(t, response["message"], "cannot sort by cpf")
+
+	mockService.AssertExpectations(t)
+}
+
 func TestListPersons_EmptyResult(t *testing.T) {
 	router, mockService := setupTest()
 
-	mockService.On("ListPersons", 1, 10, "id", "desc").Return([]*person.Person{}, int64(0), nil)
+	mockService.On("ListPersons", 1, 10, "id", "desc", person.PersonFilter{}).Return([]*person.Person{}, int64(0), nil)
 
 	req, _ := http.NewRequest("GET", "/persons", nil)
 	w := httptest.NewRecorder()
@@ -281,14 +356,13 @@ func TestFindPersonByCPF_Success(t *testing.T) {
 	createdAt := time.Now()
 
 	personObj := &person.Person{
-		ID:          1,
-		Name:        "João Silva",
-		CPF:         "11144477735",
-		BirthDate:   birthDate,
-		PhoneNumber: "81912345678",
-		Email:       "joao.silva@email.com",
-		CreatedAt:   createdAt,
-		UpdatedAt:   createdAt,
+		ID:        1,
+		Name:      "João Silva",
+		CPF:       "11144477735",
+		BirthDate: birthDate,
+		Contacts:  primaryContacts("81912345678", "joao.silva@email.com"),
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
 	}
 
 	mockService.On("FindPersonByCPF", "111.444.777-35").Return(personObj, nil)
@@ -388,14 +462,1265 @@ func TestFindPersonByCPF_WithUnformattedCPF(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+// ========== FindPersonsByContact Tests ==========
+
+func TestFindPersonsByContact_Success(t *testing.T) {
+	router, mockService := setupTest()
+
+	personObj := &person.Person{
+		ID:       1,
+		Name:     "João Silva",
+		CPF:      "11144477735",
+		Contacts: primaryContacts("+5581912345678", "joao.silva@email.com"),
+	}
+	personObj.Contacts = append(personObj.Contacts, person.Contact{
+		ContactFields: person.ContactFields{Type: person.ContactEmail, Value: "joao@empresa.com", Label: "work"},
+	})
+
+	mockService.On("FindPersonsByContact", "joao@empresa.com").Return([]*person.Person{personObj}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/contact/joao@empresa.com", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []contract.PersonResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Len(t, response, 1)
+	assert.Equal(t, "joao.silva@email.com", response[0].Email, "email keeps reporting the primary contact")
+	assert.Equal(t, "+5581912345678", response[0].PhoneNumber)
+	assert.Equal(t, "(81) 91234-5678", response[0].PhoneNational)
+	assert.Len(t, response[0].Contacts, 3)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindPersonsByContact_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+		expectedErr  string
+	}{
+		{"invalid value", personError.ErrPhoneInvalid, http.StatusBadRequest, "invalid_parameter"},
+		{"database error", errors.New("database error"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupTest()
+			mockService.On("FindPersonsByContact", "abc").Return(nil, tt.err)
+
+			req, _ := http.NewRequest("GET", "/persons/contact/abc", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedErr)
+		})
+	}
+}
+
+// ========== GetPerson Tests ==========
+
+func TestGetPerson_Success(t *testing.T) {
+	router, mockService := setupTest()
+
+	personObj := &person.Person{
+		ID:        7,
+		Name:      "João Silva",
+		CPF:       "11144477735",
+		BirthDate: time.Date(1990, 1, 15, 0, 0, 0, 0, time.UTC),
+		Contacts:  primaryContacts("81912345678", "joao.silva@email.com"),
+		Version:   4,
+	}
+
+	mockService.On("FindPersonByID", 7, false).Return(personObj, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/7", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response person.Person
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Equal(t, 7, response.ID)
+	assert.Equal(t, "João Silva", response.Name)
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+
+	mockService.AssertExpectations(t)
+}
+
+func TestGetPerson_MasksPersonalDataWithoutPIIRead(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockPersonService)
+	handler := NewPersonHandler(mockService, new(mocks.MockJobService))
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("roles", []string{operator.RoleViewer, operator.RoleAuditor})
+		c.Next()
+	})
+	router.GET("/persons/:id", handler.GetPerson)
+
+	mockService.On("FindPersonByID", 7, false).Return(&person.Person{
+		ID:        7,
+		Name:      "João Silva",
+		CPF:       "11144477735",
+		BirthDate: time.Date(1990, 1, 15, 0, 0, 0, 0, time.UTC),
+		Contacts:  primaryContacts("+5581912345678", "joao.silva@email.com"),
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/7", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response contract.PersonResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.True(t, response.Masked)
+	assert.Equal(t, "João Silva", response.Name)
+	assert.Equal(t, "***.444.777-**", response.CPF)
+	assert.Equal(t, "1990-**-**", response.BirthDate)
+	assert.Equal(t, "j***@email.com", response.Email)
+	assert.Equal(t, "+*********5678", response.PhoneNumber)
+	assert.Equal(t, "(**) *****-5678", response.PhoneNational)
+	assert.Equal(t, "+*********5678", response.Contacts[0].Value)
+	assert.Equal(t, "j***@email.com", response.Contacts[1].Value)
+	assert.NotContains(t, w.Body.String(), "11144477735")
+}
+
+func TestRevealPerson_Success(t *testing.T) {
+	router, mockService := setupTest()
+
+	dto := contract.RevealPersonDTO{Reason: "identity check"}
+	mockService.On("RevealPerson", 7, dto, testActor).Return(&person.Person{
+		ID:        7,
+		Name:      "João Silva",
+		CPF:       "11144477735",
+		BirthDate: time.Date(1990, 1, 15, 0, 0, 0, 0, time.UTC),
+		Contacts:  primaryContacts("+5581912345678", "joao.silva@email.com"),
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/persons/7/reveal", bytes.NewBufferString(`{"reason": "identity check"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response contract.PersonResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.False(t, response.Masked)
+	assert.Equal(t, "11144477735", response.CPF)
+	assert.Equal(t, "1990-01-15T00:00:00Z", response.BirthDate)
+	assert.Equal(t, "joao.silva@email.com", response.Email)
+	mockService.AssertExpectations(t)
+}
+
+func TestRevealPerson_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		err      error
+		expected int
+	}{
+		{"missing reason", `{}`, nil, http.StatusBadRequest},
+		{"person not found", `{"reason": "identity check"}`, personError.ErrPersonNotFound, http.StatusNotFound},
+		{"blank reason", `{"reason": "  "}`, personError.ErrRevealReasonRequired, http.StatusUnprocessableEntity},
+		{"audit failure", `{"reason": "identity check"}`, errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupTest()
+			var dto contract.RevealPersonDTO
+			json.Unmarshal([]byte(tt.body), &dto)
+			mockService.On("RevealPerson", 7, dto, testActor).Return(nil, tt.err)
+
+			req, _ := http.NewRequest("POST", "/persons/7/reveal", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+			assert.NotContains(t, w.Body.String(), "11144477735")
+		})
+	}
+}
+
+func TestGetPerson_InvalidID(t *testing.T) {
+	router, mockService := setupTest()
+
+	testCases := []string{"/persons/abc", "/persons/0", "/persons/-3"}
+
+	for _, path := range testCases {
+		t.Run(path, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var response map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &response)
+
+			assert.Equal(t, "invalid_request", response["error"])
+		})
+	}
+
+	mockService.AssertNotCalled(t, "FindPersonByID")
+}
+
+func TestGetPerson_NotFound(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("FindPersonByID", 99, false).Return(nil, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/99", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Equal(t, "not_found", response["error"])
+	assert.Equal(t, "Person not found", response["message"])
+
+	mockService.AssertExpectations(t)
+}
+
+func TestGetPerson_ServiceError(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("FindPersonByID", 1, false).Return(nil, errors.New("database error"))
+
+	req, _ := http.NewRequest("GET", "/persons/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Equal(t, "internal_error", response["error"])
+	assert.Contains(t, response["message"], "database error")
+
+	mockService.AssertExpectations(t)
+}
+
+// ========== UpdatePerson Tests ==========
+
+func TestUpdatePerson_SetsLocationHeader(t *testing.T) {
+	router, mockService := setupTest()
+
+	dto := contract.UpdatePersonDTO{
+		Name:        "João Silva",
+		CPF:         "111.444.777-35",
+		BirthDate:   time.Date(1990, 1, 15, 0, 0, 0, 0, time.UTC),
+		PhoneNumber: "81912345678",
+		Email:       "joao.silva@email.com",
+	}
+
+	mockService.On("UpdatePerson", 3, 2, dto, testActor).Return(nil)
+
+	body, _ := json.Marshal(dto)
+	req, _ := http.NewRequest("PUT", "/persons/3", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"2"`)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "/api/v1/persons/3", w.Header().Get("Location"))
+
+	mockService.AssertExpectations(t)
+}
+
+// ========== PatchPerson Tests ==========
+
+func TestPatchPerson_MergePatch_Success(t *testing.T) {
+	router, mockService := setupTest()
+
+	newEmail := "joao.novo@email.com"
+	mockService.On("PatchPerson", 3, 2, contract.PatchPersonDTO{Email: &newEmail}, testActor).Return(nil)
+
+	req, _ := http.NewRequest("PATCH", "/persons/3", bytes.NewBufferString(`{"email": "joao.novo@email.com"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", `"2"`)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "/api/v1/persons/3", w.Header().Get("Location"))
+
+	mockService.AssertExpectations(t)
+}
+
+func TestPatchPerson_JSONPatch_Success(t *testing.T) {
+	router, mockService := setupTest()
+
+	newName := "João Souza"
+	newPhone := "81987654321"
+	mockService.On("PatchPerson", 3, 2, contract.PatchPersonDTO{Name: &newName, PhoneNumber: &newPhone}, testActor).Return(nil)
+
+	body := `[
+		{"op": "replace", "path": "/name", "value": "João Souza"},
+		{"op": "add", "path": "/phone", "value": "81987654321"}
+	]`
+	req, _ := http.NewRequest("PATCH", "/persons/3", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	req.Header.Set("If-Match", `"2"`)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestPatchPerson_InvalidDocuments(t *testing.T) {
+	router, mockService := setupTest()
+
+	testCases := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{"merge patch removing field", "application/merge-patch+json", `{"email": null}`},
+		{"merge patch unknown field", "application/merge-patch+json", `{"id": 10}`},
+		{"merge patch not an object", "application/merge-patch+json", `["email"]`},
+		{"json patch remove op", "application/json-patch+json", `[{"op": "remove", "path": "/email"}]`},
+		{"json patch unsupported op", "application/json-patch+json", `[{"op": "move", "from": "/name", "path": "/email"}]`},
+		{"json patch unknown path", "application/json-patch+json", `[{"op": "replace", "path": "/created_at", "value": "x"}]`},
+		{"json patch not an array", "application/json-patch+json", `{"op": "replace"}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, _ := http.NewRequest("PATCH", "/persons/3", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+			req.Header.Set("If-Match", `"2"`)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
+	mockService.AssertNotCalled(t, "PatchPerson")
+}
+
+func TestPatchPerson_UnsupportedMediaType(t *testing.T) {
+	router, mockService := setupTest()
+
+	req, _ := http.NewRequest("PATCH", "/persons/3", bytes.NewBufferString(`email=x`))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("If-Match", `"2"`)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	mockService.AssertNotCalled(t, "PatchPerson")
+}
+
+func TestPatchPerson_NotFound(t *testing.T) {
+	router, mockService := setupTest()
+
+	newEmail := "joao.novo@email.com"
+	mockService.On("PatchPerson", 3, 2, contract.PatchPersonDTO{Email: &newEmail}, testActor).
+		Return(personError.ErrPersonNotFound)
+
+	req, _ := http.NewRequest("PATCH", "/persons/3", bytes.NewBufferString(`{"email": "joao.novo@email.com"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", `"2"`)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestPatchPerson_ValidationError(t *testing.T) {
+	router, mockService := setupTest()
+
+	newEmail := "invalid"
+	mockService.On("PatchPerson", 3, 2, contract.PatchPersonDTO{Email: &newEmail}, testActor).
+		Return(errors.New("email is invalid"))
+
+	req, _ := http.NewRequest("PATCH", "/persons/3", bytes.NewBufferString(`{"email": "invalid"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", `"2"`)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Equal(t, "validation_error", response["error"])
+	assert.Equal(t, "email is invalid", response["message"])
+
+	mockService.AssertExpectations(t)
+}
+
+// ========== Soft Delete Tests ==========
+
+func TestListPersons_IncludeDeleted(t *testing.T) {
+	router, mockService, _ := setupTestAs([]string{operator.RoleAdmin})
+
+	mockService.On("ListPersons", 1, 10, "id", "desc", person.PersonFilter{IncludeDeleted: true}).Return([]*person.Person{}, int64(0), nil)
+
+	req, _ := http.NewRequest("GET", "/persons?include_deleted=true", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestGetPerson_IncludeDeleted(t *testing.T) {
+	router, mockService, _ := setupTestAs([]string{operator.RoleAdmin})
+
+	deletedAt := time.Now()
+	mockService.On("FindPersonByID", 7, true).Return(&person.Person{ID: 7, Version: 2, DeletedAt: &deletedAt}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/7?include_deleted=true", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDeletePerson_Purge(t *testing.T) {
+	router, mockService, _ := setupTestAs([]string{operator.RoleAdmin})
+
+	mockService.On("PurgePerson", 3, 5, testActor).Return(nil)
+
+	req, _ := http.NewRequest("DELETE", "/persons/3?purge=true", nil)
+	req.Header.Set("If-Match", `"5"`)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+	mockService.AssertNotCalled(t, "DeletePerson", 3, 5)
+}
+
+func TestDeletedPersons_RequireRegistryDeleted(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		url    string
+	}{
+		{"list", "GET", "/persons?include_deleted=true"},
+		{"get", "GET", "/persons/7?include_deleted=true"},
+		{"purge", "DELETE", "/persons/3?purge=true"},
+		{"export", "GET", "/persons/export?include_deleted=true"},
+		{"background export", "POST", "/persons/export?include_deleted=true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, mockJobs := setupJobsTest()
+
+			req, _ := http.NewRequest(tt.method, tt.url, nil)
+			req.Header.Set("If-Match", `"5"`)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+			assert.Contains(t, w.Body.String(), "registry:deleted")
+			assert.Empty(t, mockService.Calls)
+			assert.Empty(t, mockJobs.Calls)
+		})
+	}
+}
+
+func TestPrefixFilters_RequirePIIRead(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"email prefix", "email=j&email_match=prefix"},
+		{"phone prefix", "phone=81&phone_match=prefix"},
+		{"cpf prefix", "cpf_prefix=111"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, _ := setupTestAs([]string{operator.RoleViewer})
+
+			req, _ := http.NewRequest("GET", "/persons?"+tt.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+			assert.Contains(t, w.Body.String(), "pii:read")
+			assert.Empty(t, mockService.Calls)
+		})
+	}
+}
+
+func TestRestorePerson_Success(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("RestorePerson", 3, testActor).Return(nil)
+
+	req, _ := http.NewRequest("POST", "/persons/3/restore", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "/api/v1/persons/3", w.Header().Get("Location"))
+	mockService.AssertExpectations(t)
+}
+
+func TestRestorePerson_Errors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"not found", personError.ErrPersonNotFound, http.StatusNotFound},
+		{"not deleted", personError.ErrPersonNotDeleted, http.StatusConflict},
+		{"cpf in use", personError.ErrCPFAlreadyInUse, http.StatusConflict},
+		{"unexpected", errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router, mockService := setupTest()
+
+			mockService.On("RestorePerson", 3, testActor).Return(tc.err)
+
+			req, _ := http.NewRequest("POST", "/persons/3/restore", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+// ========== Concurrency Control Tests ==========
+
+func TestWrites_RequireIfMatch(t *testing.T) {
+	router, mockService := setupTest()
+
+	testCases := []struct {
+		name   string
+		method string
+		body   string
+	}{
+		{"update", "PUT", `{"name": "João Silva", "cpf": "111.444.777-35", "birth_date": "1990-01-15T00:00:00Z", "phone": "81912345678", "email": "joao@email.com"}`},
+		{"patch", "PATCH", `{"email": "joao@email.com"}`},
+		{"delete", "DELETE", ``},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tc.method, "/persons/3", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+
+			var response map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &response)
+
+			assert.Equal(t, "precondition_required", response["error"])
+		})
+	}
+
+	mockService.AssertNotCalled(t, "UpdatePerson")
+	mockService.AssertNotCalled(t, "PatchPerson")
+	mockService.AssertNotCalled(t, "DeletePerson")
+}
+
+func TestWrites_InvalidIfMatch(t *testing.T) {
+	router, mockService := setupTest()
+
+	for _, ifMatch := range []string{"2", `W/"2"`, `"abc"`, `"0"`, "*"} {
+		t.Run(ifMatch, func(t *testing.T) {
+			req, _ := http.NewRequest("DELETE", "/persons/3", nil)
+			req.Header.Set("If-Match", ifMatch)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
+	mockService.AssertNotCalled(t, "DeletePerson")
+}
+
+func TestDeletePerson_Success(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("DeletePerson", 3, 5, testActor).Return(nil)
+
+	req, _ := http.NewRequest("DELETE", "/persons/3", nil)
+	req.Header.Set("If-Match", `"5"`)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWrites_VersionConflict(t *testing.T) {
+	router, mockService := setupTest()
+
+	newEmail := "joao@email.com"
+	mockService.On("PatchPerson", 3, 2, contract.PatchPersonDTO{Email: &newEmail}, testActor).Return(personError.ErrVersionConflict)
+	mockService.On("DeletePerson", 3, 2, testActor).Return(personError.ErrVersionConflict)
+
+	testCases := []struct {
+		method      string
+		contentType string
+		body        string
+	}{
+		{"PATCH", "application/merge-patch+json", `{"email": "joao@email.com"}`},
+		{"DELETE", "", ``},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			req, _ := http.NewRequest(tc.method, "/persons/3", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+			req.Header.Set("If-Match", `"2"`)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+			var response map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &response)
+
+			assert.Equal(t, "precondition_failed", response["error"])
+		})
+	}
+
+	mockService.AssertExpectations(t)
+}
+
+func TestListPersons_Filters(t *testing.T) {
+	router, mockService := setupTest()
+
+	birthFrom := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
+	birthTo := time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC)
+	createdFrom := time.Date(2024, time.January, 1, 12, 30, 0, 0, time.UTC)
+	createdTo := time.Date(2024, time.January, 31, 23, 59, 59, 999999999, time.UTC)
+
+	expectedFilter := person.PersonFilter{
+		Name:           "silva",
+		Email:          "gmail",
+		EmailPrefix:    true,
+		PhoneNumber:    "81",
+		CPFPrefix:      "111",
+		BirthDateFrom:  &birthFrom,
+		BirthDateTo:    &birthTo,
+		CreatedFrom:    &createdFrom,
+		CreatedTo:      &createdTo,
+		ConsentPurpose: "analytics",
+	}
+
+	mockService.On("ListPersons", 1, 10, "id", "desc", expectedFilter).Return([]*person.Person{}, int64(0), nil)
+
+	req, _ := http.NewRequest("GET", "/persons?name=silva&email=gmail&email_match=prefix&phone=81&cpf_prefix=111&birth_date_from=1990-01-01&birth_date_to=1999-12-31&created_from=2024-01-01T12:30:00Z&created_to=2024-01-31&consent=analytics", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestListPersons_CursorMode_FirstPage(t *testing.T) {
+	router, mockService := setupTest()
+
+	next := &person.Cursor{Sort: "id", Order: "desc", Value: "8", ID: 8}
+	mockService.On("ListPersonsByCursor", (*person.Cursor)(nil), 2, "id", "desc", person.PersonFilter{}, false).
+		Return(&person.CursorPage{Persons: []*person.Person{{ID: 9}, {ID: 8}}, Next: next}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons?pagination=cursor&page_size=2&with_total=false", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Len(t, response["data"], 2)
+	assert.NotEmpty(t, response["next_cursor"])
+	assert.Nil(t, response["prev_cursor"])
+	assert.NotContains(t, response, "total_items")
+	assert.NotContains(t, response, "page")
+
+	decoded, err := decodeCursor(response["next_cursor"].(string))
+	assert.NoError(t, err)
+	assert.Equal(t, next, decoded)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestListPersons_CursorMode_FollowsCursor(t *testing.T) {
+	router, mockService := setupTest()
+
+	cursor := &person.Cursor{Sort: "name", Order: "asc", Value: "Ana", ID: 3, Backward: true}
+	total := int64(42)
+	mockService.On("ListPersonsByCursor", cursor, 10, "name", "asc", person.PersonFilter{}, true).
+		Return(&person.CursorPage{Persons: []*person.Person{}, Total: &total}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons?sort=name&order=asc&cursor="+*encodeCursor(cursor), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Equal(t, float64(42), response["total_items"])
+	mockService.AssertExpectations(t)
+}
+
+func TestListPersons_CursorMode_InvalidCursor(t *testing.T) {
+	router, mockService := setupTest()
+
+	req, _ := http.NewRequest("GET", "/persons?cursor=bm90LWpzb24", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ListPersonsByCursor")
+}
+
+func TestListPersons_CursorMode_CursorOfOtherOrdering(t *testing.T) {
+	router, mockService := setupTest()
+
+	cursor := &person.Cursor{Sort: "name", Order: "asc", Value: "Ana", ID: 3}
+	mockService.On("ListPersonsByCursor", cursor, 10, "id", "desc", person.PersonFilter{}, true).
+		Return(nil, personError.ErrInvalidCursor)
+
+	req, _ := http.NewRequest("GET", "/persons?cursor="+*encodeCursor(cursor), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestListPersons_CursorMode_ViewerCursorHoldsNoPII(t *testing.T) {
+	for _, sort := range []string{"cpf", "email"} {
+		t.Run("sort by "+sort, func(t *testing.T) {
+			router, mockService, _ := setupTestAs([]string{operator.RoleViewer})
+
+			req, _ := http.NewRequest("GET", "/persons?pagination=cursor&page_size=1&sort="+sort, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+			assert.Contains(t, w.Body.String(), "pii:read")
+			assert.Empty(t, mockService.Calls)
+		})
+	}
+
+	router, mockService, _ := setupTestAs([]string{operator.RoleViewer})
+	last := &person.Person{ID: 8, Name: "Ana", CPF: "11144477735", BirthDate: time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC), Contacts: primaryContacts("+5581912345678", "ana@example.com")}
+	mockService.On("ListPersonsByCursor", (*person.Cursor)(nil), 1, "name", "asc", person.PersonFilter{}, false).
+		Return(&person.CursorPage{Persons: []*person.Person{last}, Next: person.NewCursor(last, "name", "asc", false)}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons?pagination=cursor&page_size=1&sort=name&order=asc&with_total=false", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	decoded, err := decodeCursor(response["next_cursor"].(string))
+	assert.NoError(t, err)
+	assert.Equal(t, "Ana", decoded.Value)
+	assert.NotContains(t, w.Body.String(), "11144477735")
+	assert.NotContains(t, w.Body.String(), "ana@example.com")
+	mockService.AssertExpectations(t)
+}
+
+// ========== GetPersonHistory Tests ==========
+
+func TestGetPersonHistory_Success(t *testing.T) {
+	router, mockService := setupTest()
+
+	entries := []*audit.AuditEntry{
+		{
+			ID:         2,
+			EntityType: audit.EntityPerson,
+			EntityID:   3,
+			Action:     audit.ActionUpdate,
+			OperatorID: 7,
+			RequestID:  "req-123",
+			ClientIP:   "203.0.113.10",
+			Before:     json.RawMessage(`{"email":"old@email.com"}`),
+			After:      json.RawMessage(`{"email":"new@email.com"}`),
+			CreatedAt:  time.Now(),
+		},
+		{
+			ID:         1,
+			EntityType: audit.EntityPerson,
+			EntityID:   3,
+			Action:     audit.ActionCreate,
+			OperatorID: 7,
+			After:      json.RawMessage(`{"email":"old@email.com"}`),
+			CreatedAt:  time.Now(),
+		},
+	}
+
+	mockService.On("PersonHistory", 3, 1, 2).Return(entries, int64(3), nil)
+
+	req, _ := http.NewRequest("GET", "/persons/3/history?page_size=2", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data []struct {
+			Action     string                 `json:"action"`
+			OperatorID int                    `json:"operator_id"`
+			Before     map[string]interface{} `json:"before"`
+			After      map[string]interface{} `json:"after"`
+		} `json:"data"`
+		TotalItems int64 `json:"total_items"`
+		TotalPages int   `json:"total_pages"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, "update", response.Data[0].Action)
+	assert.Equal(t, 7, response.Data[0].OperatorID)
+	assert.Equal(t, "old@email.com", response.Data[0].Before["email"])
+	assert.Equal(t, "new@email.com", response.Data[0].After["email"])
+	assert.Nil(t, response.Data[1].Before)
+	assert.Equal(t, int64(3), response.TotalItems)
+	assert.Equal(t, 2, response.TotalPages)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestGetPersonHistory_MasksSnapshotsWithoutPIIRead(t *testing.T) {
+	router, mockService, _ := setupTestAs([]string{operator.RoleAuditor})
+
+	entries := []*audit.AuditEntry{
+		{
+			ID:         2,
+			EntityType: audit.EntityPerson,
+			EntityID:   3,
+			Action:     audit.ActionUpdate,
+			Before:     json.RawMessage(`{"id":3,"cpf":"11144477735","birth_date":"1990-01-15T00:00:00Z","email":"joao.silva@email.com","phone":"+5581912345678","contacts":[{"type":"phone","value":"+5581912345678"}]}`),
+			After:      json.RawMessage(`{"id":3,"cpf":"52998224725","birth_date":"1990-01-15T00:00:00Z","email":"joao.silva@email.com","phone":"+5581912345678","contacts":[]}`),
+		},
+		{
+			ID:         1,
+			EntityType: audit.EntityPerson,
+			EntityID:   3,
+			Action:     audit.ActionReveal,
+			After:      json.RawMessage(`{"id":3,"reason":"identity check"}`),
+		},
+	}
+
+	mockService.On("PersonHistory", 3, 1, 10).Return(entries, int64(2), nil)
+
+	req, _ := http.NewRequest("GET", "/persons/3/history", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	body := w.Body.String()
+	assert.Contains(t, body, `"cpf":"***.444.777-**"`)
+	assert.Contains(t, body, `"cpf":"***.982.247-**"`)
+	assert.Contains(t, body, `"birth_date":"1990-**-**"`)
+	assert.Contains(t, body, `"email":"j***@email.com"`)
+	assert.Contains(t, body, `"reason":"identity check"`)
+	assert.NotContains(t, body, "11144477735")
+	assert.NotContains(t, body, "joao.silva")
+	assert.NotContains(t, body, "912345678")
+}
+
+func TestGetPersonHistory_NotFound(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("PersonHistory", 99, 1, 10).Return(nil, int64(0), personError.ErrPersonNotFound)
+
+	req, _ := http.NewRequest("GET", "/persons/99/history", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestGetPersonHistory_InvalidID(t *testing.T) {
+	router, mockService := setupTest()
+
+	req, _ := http.NewRequest("GET", "/persons/abc/history", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "PersonHistory")
+}
+
+func TestGetPersonHistory_ServiceError(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("PersonHistory", 3, 1, 10).Return(nil, int64(0), errors.New("database error"))
+
+	req, _ := http.NewRequest("GET", "/persons/3/history", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+// ========== ImportPersons Tests ==========
+
+func TestImportPersons_CSV(t *testing.T) {
+	router, mockService := setupTest()
+
+	report := &person.ImportReport{
+		TotalRows: 2,
+		Succeeded: 1,
+		Failed:    1,
+		Rows: []person.ImportRowResult{
+			{Line: 2, Status: person.ImportStatusImported, ID: 10},
+			{Line: 3, Status: person.ImportStatusFailed, ErrorCode: "cpf_invalid", Message: "invalid CPF"},
+		},
+	}
+
+	var readName string
+	mockService.On("ImportPersons", mock.AnythingOfType("*personfile.CSVReader"), false, testActor).
+		Run(func(args mock.Arguments) {
+			row, _ := args.Get(0).(ports.PersonRowReader).Read()
+			readName = row.Person.Name
+		}).
+		Return(report, nil)
+
+	body := "name,cpf,birth_date,phone,email\nAna,52998224725,1999-12-31,11987654321,ana@example.com\n"
+	req, _ := http.NewRequest("POST", "/persons/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv; charset=utf-8")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Ana", readName)
+
+	var response contract.ImportReportDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.False(t, response.DryRun)
+	assert.Equal(t, 2, response.TotalRows)
+	assert.Equal(t, 1, response.Failed)
+	assert.Len(t, response.Rows, 2)
+	assert.Equal(t, 10, response.Rows[0].ID)
+	assert.Equal(t, "cpf_invalid", response.Rows[1].ErrorCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestImportPersons_NDJSONDryRun(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("ImportPersons", mock.AnythingOfType("*personfile.NDJSONReader"), true, testActor).
+		Return(&person.ImportReport{DryRun: true, Rows: []person.ImportRowResult{}}, nil)
+
+	req, _ := http.NewRequest("POST", "/persons/import?dry_run=true", strings.NewReader(`{"name":"Ana"}`))
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"dry_run":true`)
+	mockService.AssertExpectations(t)
+}
+
+func TestImportPersons_Async(t *testing.T) {
+	router, mockService, mockJobs := setupJobsTest()
+
+	body := "name,cpf,birth_date,phone,email\nAna,52998224725,1999-12-31,11987654321,ana@example.com\n"
+	var stored string
+	mockJobs.On("SaveArtifact", "csv", mock.Anything).
+		Run(func(args mock.Arguments) {
+			content := new(bytes.Buffer)
+			content.ReadFrom(args.Get(1).(io.Reader))
+			stored = content.String()
+		}).
+		Return("abc.csv", nil)
+	mockJobs.On("Enqueue", personjob.TypeImport, personjob.ImportPayload{InputRef: "abc.csv", Format: "csv", DryRun: true}, testActor).
+		Return(&job.Job{ID: 15, Type: personjob.TypeImport, Status: job.StatusQueued, MaxAttempts: job.DefaultMaxAttempts}, nil)
+
+	req, _ := http.NewRequest("POST", "/persons/import?async=true&dry_run=true", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, "/api/v1/jobs/15", w.Header().Get("Location"))
+	assert.Equal(t, body, stored)
+	assert.Contains(t, w.Body.String(), `"status":"queued"`)
+	mockJobs.AssertExpectations(t)
+	mockService.AssertNotCalled(t, "ImportPersons", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportPersons_AsyncStoreError(t *testing.T) {
+	router, _, mockJobs := setupJobsTest()
+
+	mockJobs.On("SaveArtifact", "ndjson", mock.Anything).Return("", errors.New("disk full"))
+
+	req, _ := http.NewRequest("POST", "/persons/import?async=true", strings.NewReader(`{"name":"Ana"}`))
+	req.Header.Set("Content-Type", personfile.NDJSONContentType)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "internal_error")
+	mockJobs.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportPersons_UnsupportedContentType(t *testing.T) {
+	router, mockService := setupTest()
+
+	req, _ := http.NewRequest("POST", "/persons/import", strings.NewReader(`[]`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	assert.Contains(t, w.Body.String(), "unsupported_media_type")
+	mockService.AssertNotCalled(t, "ImportPersons", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportPersons_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+		expectedBody string
+	}{
+		{"invalid header", fmt.Errorf("%w: missing column \"cpf\"", personfile.ErrInvalidHeader), http.StatusBadRequest, "invalid_file"},
+		{"file too large", &http.MaxBytesError{Limit: maxImportSize}, http.StatusRequestEntityTooLarge, "file_too_large"},
+		{"repository failure", errors.New("database unavailable"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupTest()
+
+			mockService.On("ImportPersons", mock.Anything, false, testActor).Return(nil, tt.err)
+
+			req, _ := http.NewRequest("POST", "/persons/import", strings.NewReader("name\n"))
+			req.Header.Set("Content-Type", "text/csv")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+// ========== ExportPersons Tests ==========
+
+func TestExportPersons_CSV(t *testing.T) {
+	router, mockService := setupTest()
+
+	options := person.ExportOptions{Columns: []string{"id", "cpf"}, Masked: true}
+	mockService.On("ExportPersons", mock.AnythingOfType("*personfile.CSVWriter"), "name", "asc", person.PersonFilter{Name: "silva"}, options).
+		Run(func(args mock.Arguments) {
+			writer := args.Get(0).(ports.PersonRowWriter)
+			writer.WriteHeader(options.Columns)
+			writer.WriteRow([]any{1, "111.444.777-35"})
+			writer.Close()
+		}).
+		Return(1, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/export?columns=id,%20cpf&mask=true&sort=name&name=silva", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Regexp(t, `^attachment; filename="persons-\d{8}-\d{6}\.csv"$`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "id,cpf\n1,111.444.777-35\n", w.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestExportPersons_Formats(t *testing.T) {
+	tests := []struct {
+		format      string
+		writerType  string
+		contentType string
+	}{
+		{"ndjson", "*personfile.NDJSONWriter", "application/x-ndjson"},
+		{"xlsx", "*personfile.XLSXWriter", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			router, mockService := setupTest()
+
+			mockService.On("ExportPersons", mock.AnythingOfType(tt.writerType), "id", "asc", person.PersonFilter{}, person.ExportOptions{}).Return(0, nil)
+
+			req, _ := http.NewRequest("GET", "/persons/export?format="+tt.format, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"))
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExportPersons_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"unknown format", "format=pdf"},
+		{"unknown column", "columns=name,password"},
+		{"invalid mask", "mask=yes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupTest()
+
+			req, _ := http.NewRequest("GET", "/persons/export?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), "invalid_parameter")
+			mockService.AssertNotCalled(t, "ExportPersons", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestExportPersons_ServiceErrorBeforeStreaming(t *testing.T) {
+	router, mockService := setupTest()
+
+	mockService.On("ExportPersons", mock.Anything, "id", "asc", person.PersonFilter{}, person.ExportOptions{}).Return(0, errors.New("database unavailable"))
+
+	req, _ := http.NewRequest("GET", "/persons/export", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+	assert.Contains(t, w.Body.String(), "internal_error")
+}
+
+func TestExportPersonsAsync(t *testing.T) {
+	router, mockService, mockJobs := setupJobsTest()
+
+	expected := personjob.ExportPayload{
+		Format:  "xlsx",
+		Sort:    "name",
+		Order:   "desc",
+		Filter:  person.PersonFilter{Name: "silva"},
+		Columns: []string{"id", "name"},
+		Masked:  true,
+	}
+	mockJobs.On("Enqueue", personjob.TypeExport, expected, testActor).
+		Return(&job.Job{ID: 16, Type: personjob.TypeExport, Status: job.StatusQueued, MaxAttempts: job.DefaultMaxAttempts}, nil)
+
+	req, _ := http.NewRequest("POST", "/persons/export?format=xlsx&sort=name&order=desc&name=silva&columns=id,name&mask=true", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, "/api/v1/jobs/16", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"type":"person_export"`)
+	mockJobs.AssertExpectations(t)
+	mockService.AssertNotCalled(t, "ExportPersons", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExportPersonsAsync_Errors(t *testing.T) {
+	t.Run("invalid parameter", func(t *testing.T) {
+		router, _, mockJobs := setupJobsTest()
+
+		req, _ := http.NewRequest("POST", "/persons/export?columns=password", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockJobs.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("enqueue failure", func(t *testing.T) {
+		router, _, mockJobs := setupJobsTest()
+		mockJobs.On("Enqueue", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+
+		req, _ := http.NewRequest("POST", "/persons/export", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Empty(t, w.Header().Get("Location"))
+	})
+}
+
 // ========== Edge Cases ==========
 
 func TestNewPersonHandler(t *testing.T) {
 	mockService := new(mocks.MockPersonService)
-	handler := NewPersonHandler(mockService)
+	mockJobs := new(mocks.MockJobService)
+	handler := NewPersonHandler(mockService, mockJobs)
 
 	assert.NotNil(t, handler)
 	assert.Equal(t, mockService, handler.service)
+	assert.Equal(t, mockJobs, handler.jobs)
 }
 
 func TestListPersons_CalculatesTotalPagesCorrectly(t *testing.T) {
@@ -415,7 +1740,7 @@ func TestListPersons_CalculatesTotalPagesCorrectly(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			mockService.On("ListPersons", 1, tc.pageSize, "id", "desc").
+			mockService.On("ListPersons", 1, tc.pageSize, "id", "desc", person.PersonFilter{}).
 				Return([]*person.Person{}, tc.totalItems, nil).
 				Once()
 
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	contract "pessoas-api/internal/contract/person"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+)
+
+// patchableFields lists the members a patch document may touch, keyed by their JSON name.
+var patchableFields = map[string]bool{
+	"name":       true,
+	"cpf":        true,
+	"birth_date": true,
+	"phone":      true,
+	"email":      true,
+}
+
+// parseMergePatch converts an RFC 7396 JSON Merge Patch document into a PatchPersonDTO.
+// Every person field is required, so removing a member (null value) is rejected.
+func parseMergePatch(body []byte) (contract.PatchPersonDTO, error) {
+	var dto contract.PatchPersonDTO
+	var document map[string]json.RawMessage
+
+	if err := json.Unmarshal(body, &document); err != nil {
+		return dto, fmt.Errorf("merge patch must be a JSON object: %w", err)
+	}
+
+	for field, value := range document {
+		if !patchableFields[field] {
+			return dto, fmt.Errorf("field %q cannot be patched", field)
+		}
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			return dto, fmt.Errorf("field %q cannot be removed", field)
+		}
+	}
+
+	if err := json.Unmarshal(body, &dto); err != nil {
+		return dto, fmt.Errorf("invalid merge patch: %w", err)
+	}
+
+	return dto, nil
+}
+
+// parseJSONPatch converts an RFC 6902 JSON Patch document into a PatchPersonDTO.
+// Only "add" and "replace" operations on top-level person fields are supported.
+func parseJSONPatch(body []byte) (contract.PatchPersonDTO, error) {
+	var dto contract.PatchPersonDTO
+	var operations []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+
+	if err := json.Unmarshal(body, &operations); err != nil {
+		return dto, fmt.Errorf("json patch must be an array of operations: %w", err)
+	}
+
+	document := make(map[string]json.RawMessage, len(operations))
+	for i, operation := range operations {
+		field := strings.TrimPrefix(operation.Path, "/")
+
+		switch operation.Op {
+		case "add", "replace":
+		case "remove":
+			return dto, fmt.Errorf("operation %d: field %q cannot be removed", i, field)
+		default:
+			return dto, fmt.Errorf("operation %d: unsupported op %q", i, operation.Op)
+		}
+
+		if !strings.HasPrefix(operation.Path, "/") || !patchableFields[field] {
+			return dto, fmt.Errorf("operation %d: path %q cannot be patched", i, operation.Path)
+		}
+		if len(operation.Value) == 0 || bytes.Equal(bytes.TrimSpace(operation.Value), []byte("null")) {
+			return dto, fmt.Errorf("operation %d: value is required", i)
+		}
+
+		document[field] = operation.Value
+	}
+
+	merged, err := json.Marshal(document)
+	if err != nil {
+		return dto, fmt.Errorf("invalid json patch: %w", err)
+	}
+
+	if err := json.Unmarshal(merged, &dto); err != nil {
+		return dto, fmt.Errorf("invalid json patch: %w", err)
+	}
+
+	return dto, nil
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"encoding/json"
+
+	auditContract "pessoas-api/internal/contract/audit"
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	operator "pessoas-api/internal/domain/operator/model"
+	personModel "pessoas-api/internal/domain/person/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// The functions below project persons, contacts and identity documents to
+// their API representation for the caller: operators without the pii:read
+// permission get the CPF, the birth date, the emails, the phones and the
+// document numbers masked. Full values are only served by RevealPerson, which
+// audits every reveal. The same applies to the snapshots in the history of a
+// person.
+
+// canReadPII reports whether the caller may see personal data in full.
+func canReadPII(c *gin.Context) bool {
+	return operator.HasPermission(c.GetStringSlice("roles"), operator.PermissionPIIRead)
+}
+
+func personResponse(c *gin.Context, p *personModel.Person) contract.PersonResponseDTO {
+	response := contract.NewPersonResponseDTO(p)
+	if canReadPII(c) {
+		return response
+	}
+	return response.Mask()
+}
+
+func personResponses(c *gin.Context, persons []*personModel.Person) []contract.PersonResponseDTO {
+	response := make([]contract.PersonResponseDTO, len(persons))
+	for i, p := range persons {
+		response[i] = personResponse(c, p)
+	}
+	return response
+}
+
+func contactResponse(c *gin.Context, contact *personModel.Contact) contract.ContactResponseDTO {
+	response := contract.NewContactResponseDTO(contact)
+	if canReadPII(c) {
+		return response
+	}
+	return response.Mask()
+}
+
+func documentResponse(c *gin.Context, document *personModel.Document) contract.DocumentResponseDTO {
+	response := contract.NewDocumentResponseDTO(document)
+	if canReadPII(c) {
+		return response
+	}
+	return response.Mask()
+}
+
+func documentResponses(c *gin.Context, documents []*personModel.Document) []contract.DocumentResponseDTO {
+	response := make([]contract.DocumentResponseDTO, len(documents))
+	for i, document := range documents {
+		response[i] = documentResponse(c, document)
+	}
+	return response
+}
+
+func duplicateCandidatesResponse(c *gin.Context, candidates []personModel.DuplicateCandidate) []contract.DuplicateCandidateDTO {
+	response := contract.NewDuplicateCandidateDTOs(candidates)
+	if !canReadPII(c) {
+		for i := range response {
+			response[i].Person = response[i].Person.Mask()
+		}
+	}
+	return response
+}
+
+func familyGraphResponse(c *gin.Context, graph *personModel.FamilyGraph) contract.FamilyGraphDTO {
+	response := contract.NewFamilyGraphDTO(graph)
+	if !canReadPII(c) {
+		for i := range response.Members {
+			response.Members[i].Person = response.Members[i].Person.Mask()
+		}
+	}
+	return response
+}
+
+func personHistoryResponse(c *gin.Context, entries []*audit.AuditEntry) []auditContract.AuditEntryDTO {
+	mask := !canReadPII(c)
+	response := make([]auditContract.AuditEntryDTO, len(entries))
+	for i, entry := range entries {
+		response[i] = auditContract.NewAuditEntryDTO(entry)
+		if mask {
+			response[i].Before = maskPersonSnapshot(response[i].Before)
+			response[i].After = maskPersonSnapshot(response[i].After)
+		}
+	}
+	return response
+}
+
+// maskPersonSnapshot masks a snapshot holding a person. Other snapshots, such
+// as the reason of a reveal, hold no personal data and are kept as they are.
+func maskPersonSnapshot(snapshot json.RawMessage) json.RawMessage {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(snapshot, &fields); err != nil {
+		return snapshot
+	}
+	if _, ok := fields["cpf"]; !ok {
+		return snapshot
+	}
+
+	var person contract.PersonResponseDTO
+	if err := json.Unmarshal(snapshot, &person); err != nil {
+		return json.RawMessage("null")
+	}
+
+	masked, err := json.Marshal(person.Mask())
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	return masked
+}
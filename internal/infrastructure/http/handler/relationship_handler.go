@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// relationshipValidationErrors are the domain errors reported as 422 for a relationship.
+var relationshipValidationErrors = []error{
+	personError.ErrRelationshipTypeInvalid,
+	personError.ErrRelationshipSelf,
+	personError.ErrRelatedPersonNotFound,
+	personError.ErrGuardianNotAdult,
+	personError.ErrParentNotOlder,
+	personError.ErrParentCycle,
+}
+
+type RelationshipHandler struct {
+	service ports.RelationshipService
+}
+
+func NewRelationshipHandler(service ports.RelationshipService) *RelationshipHandler {
+	return &RelationshipHandler{
+		service: service,
+	}
+}
+
+// ListRelationships godoc
+// @Summary      List the relationships of a person
+// @Description  Returns every relationship of a person with another active person, as seen from the person: a relationship created as guardian on one end is listed as ward on the other
+// @Tags         Relationships
+// @Produce      json
+// @Param        id   path      int  true  "Person ID"
+// @Success      200  {array}   contract.RelationshipResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/relationships [get]
+func (h *RelationshipHandler) ListRelationships(c *gin.Context) {
+	personID, ok := personIDParam(c, "ListRelationships")
+	if !ok {
+		return
+	}
+
+	relationships, err := h.service.ListRelationships(personID)
+	if err != nil {
+		respondRelationshipError(c, "ListRelationships", personID, 0, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewRelationshipResponseDTOs(relationships))
+}
+
+// GetRelationship godoc
+// @Summary      Get a relationship
+// @Description  Returns one relationship of a person, as seen from the person
+// @Tags         Relationships
+// @Produce      json
+// @Param        id              path      int  true  "Person ID"
+// @Param        relationshipId  path      int  true  "Relationship ID"
+// @Success      200  {object}  contract.RelationshipResponseDTO
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person or relationship not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/relationships/{relationshipId} [get]
+func (h *RelationshipHandler) GetRelationship(c *gin.Context) {
+	personID, id, ok := relationshipIDParams(c, "GetRelationship")
+	if !ok {
+		return
+	}
+
+	relationship, err := h.service.FindRelationship(personID, id)
+	if err != nil {
+		respondRelationshipError(c, "GetRelationship", personID, id, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewRelationshipResponseDTO(relationship))
+}
+
+// CreateRelationship godoc
+// @Summary      Link a person to another
+// @Description  Links the related person to the person in the path; type says what the related person is to it. The link is visible from both ends with the inverse type (parent/child, guardian/ward, legal_representative/represented, emergency_contact/emergency_contact_for; spouse is symmetric). Guardians and legal representatives must be adults, parents must be older than their children and no person may become their own ancestor
+// @Tags         Relationships
+// @Accept       json
+// @Produce      json
+// @Param        id            path      int                       true  "Person ID"
+// @Param        relationship  body      contract.RelationshipDTO  true  "Relationship data"
+// @Success      201           {object}  contract.RelationshipResponseDTO
+// @Header       201           {string}  Location  "URI of the created relationship"
+// @Failure      400           {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404           {object}  contract.ErrorResponse  "Person not found"
+// @Failure      409           {object}  contract.ErrorResponse  "Relationship already exists"
+// @Failure      422           {object}  contract.ErrorResponse  "Business validation error"
+// @Failure      500           {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/relationships [post]
+func (h *RelationshipHandler) CreateRelationship(c *gin.Context) {
+	personID, ok := personIDParam(c, "CreateRelationship")
+	if !ok {
+		return
+	}
+
+	var dto contract.RelationshipDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] CreateRelationship - Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	relationship, err := h.service.AddRelationship(personID, dto, requestActor(c))
+	if err != nil {
+		respondRelationshipError(c, "CreateRelationship", personID, 0, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] CreateRelationship - Person ID %d linked to person ID %d as %s", relationship.RelatedID, personID, relationship.Type)
+	c.Header("Location", relationshipLocation(relationship))
+	c.JSON(http.StatusCreated, contract.NewRelationshipResponseDTO(relationship))
+}
+
+// DeleteRelationship godoc
+// @Summary      Delete a relationship
+// @Description  Removes a relationship from both of its ends
+// @Tags         Relationships
+// @Param        id              path  int  true  "Person ID"
+// @Param        relationshipId  path  int  true  "Relationship ID"
+// @Success      204
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid ID"
+// @Failure      404  {object}  contract.ErrorResponse  "Person or relationship not found"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/relationships/{relationshipId} [delete]
+func (h *RelationshipHandler) DeleteRelationship(c *gin.Context) {
+	personID, id, ok := relationshipIDParams(c, "DeleteRelationship")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteRelationship(personID, id, requestActor(c)); err != nil {
+		respondRelationshipError(c, "DeleteRelationship", personID, id, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] DeleteRelationship - Relationship %d of person ID %d deleted", id, personID)
+	c.Status(http.StatusNoContent)
+}
+
+// GetFamilyGraph godoc
+// @Summary      Get the family graph of a person
+// @Description  Walks the relationships of a person up to depth links away and returns the persons reached, with their distance, and the relationships among them
+// @Tags         Relationships
+// @Produce      json
+// @Param        id     path      int  true   "Person ID"
+// @Param        depth  query     int  false  "Maximum number of links to walk"  default(2)  minimum(1)  maximum(5)
+// @Success      200    {object}  contract.FamilyGraphDTO
+// @Failure      400    {object}  contract.ErrorResponse  "Invalid ID or depth"
+// @Failure      404    {object}  contract.ErrorResponse  "Person not found"
+// @Failure      500    {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /persons/{id}/family [get]
+func (h *RelationshipHandler) GetFamilyGraph(c *gin.Context) {
+	personID, ok := personIDParam(c, "GetFamilyGraph")
+	if !ok {
+		return
+	}
+
+	depth, _ := strconv.Atoi(c.DefaultQuery("depth", "2"))
+
+	graph, err := h.service.FamilyGraph(personID, depth)
+	if err != nil {
+		if errors.Is(err, personError.ErrGraphDepthInvalid) {
+			log.Printf("[ERROR] GetFamilyGraph - Invalid depth parameter: %s", c.Query("depth"))
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		respondRelationshipError(c, "GetFamilyGraph", personID, 0, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] GetFamilyGraph - Reached %d persons from person ID %d (depth: %d)", len(graph.Members), personID, depth)
+	c.JSON(http.StatusOK, familyGraphResponse(c, graph))
+}
+
+// relationshipLocation builds the URI of a relationship resource as seen from
+// its person, used in Location headers.
+func relationshipLocation(relationship *personModel.Relationship) string {
+	return fmt.Sprintf("%s/relationships/%d", personLocation(relationship.PersonID), relationship.ID)
+}
+
+// relationshipIDParams reads the person and relationship IDs from the path. When
+// either is invalid it writes the error response and returns false.
+func relationshipIDParams(c *gin.Context, operation string) (int, int, bool) {
+	personID, ok := personIDParam(c, operation)
+	if !ok {
+		return 0, 0, false
+	}
+
+	id, err := strconv.Atoi(c.Param("relationshipId"))
+	if err != nil || id < 1 {
+		log.Printf("[ERROR] %s - Invalid relationship ID parameter: %s", operation, c.Param("relationshipId"))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid relationship ID",
+		})
+		return 0, 0, false
+	}
+
+	return personID, id, true
+}
+
+func respondRelationshipError(c *gin.Context, operation string, personID, id int, err error) {
+	switch {
+	case errors.Is(err, personError.ErrPersonNotFound):
+		log.Printf("[WARN] %s - Person not found with ID: %d", operation, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Person not found",
+		})
+	case errors.Is(err, personError.ErrRelationshipNotFound):
+		log.Printf("[WARN] %s - Relationship %d not found for person ID %d", operation, id, personID)
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "Relationship not found",
+		})
+	case errors.Is(err, personError.ErrRelationshipExists):
+		log.Printf("[WARN] %s - Relationship already exists for person ID %d", operation, personID)
+		c.JSON(http.StatusConflict, gin.H{
+			"error":   "conflict",
+			"message": err.Error(),
+		})
+	case isRelationshipValidationError(err):
+		log.Printf("[ERROR] %s - Validation error for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed for person ID %d: %v", operation, personID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process relationship: " + err.Error(),
+		})
+	}
+}
+
+func isRelationshipValidationError(err error) bool {
+	for _, validationErr := range relationshipValidationErrors {
+		if errors.Is(err, validationErr) {
+			return true
+		}
+	}
+	return false
+}
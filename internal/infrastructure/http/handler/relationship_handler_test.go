@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupRelationshipTest() (*gin.Engine, *mocks.MockRelationshipService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockRelationshipService)
+	handler := NewRelationshipHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Set("roles", testRoles)
+		c.Next()
+	})
+	router.GET("/persons/:id/relationships", handler.ListRelationships)
+	router.POST("/persons/:id/relationships", handler.CreateRelationship)
+	router.GET("/persons/:id/relationships/:relationshipId", handler.GetRelationship)
+	router.DELETE("/persons/:id/relationships/:relationshipId", handler.DeleteRelationship)
+	router.GET("/persons/:id/family", handler.GetFamilyGraph)
+
+	return router, mockService
+}
+
+func testRelationship(id int, relationshipType string) *person.Relationship {
+	return &person.Relationship{ID: id, PersonID: 1, RelatedID: 2, Type: relationshipType}
+}
+
+func TestListRelationships_Success(t *testing.T) {
+	router, mockService := setupRelationshipTest()
+
+	mockService.On("ListRelationships", 1).Return([]*person.Relationship{testRelationship(3, person.RelationshipWard)}, nil)
+
+	req, _ := http.NewRequest("GET", "/persons/1/relationships", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []contract.RelationshipResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response, 1)
+	assert.Equal(t, 2, response[0].RelatedPersonID)
+	assert.Equal(t, "ward", response[0].Type)
+}
+
+func TestCreateRelationship_Success(t *testing.T) {
+	router, mockService := setupRelationshipTest()
+
+	dto := contract.RelationshipDTO{RelatedPersonID: 2, Type: "guardian"}
+	mockService.On("AddRelationship", 1, dto, testActor).Return(testRelationship(3, person.RelationshipGuardian), nil)
+
+	body, _ := json.Marshal(dto)
+	req, _ := http.NewRequest("POST", "/persons/1/relationships", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "/api/v1/persons/1/relationships/3", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"type":"guardian"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateRelationship_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		err          error
+		expectedCode int
+		expectedErr  string
+	}{
+		{"missing type", `{"related_person_id":2}`, nil, http.StatusBadRequest, "invalid_request"},
+		{"person not found", "", personError.ErrPersonNotFound, http.StatusNotFound, "not_found"},
+		{"already linked", "", personError.ErrRelationshipExists, http.StatusConflict, "conflict"},
+		{"minor guardian", "", personError.ErrGuardianNotAdult, http.StatusUnprocessableEntity, "validation_error"},
+		{"parent cycle", "", personError.ErrParentCycle, http.StatusUnprocessableEntity, "validation_error"},
+		{"related person not found", "", personError.ErrRelatedPersonNotFound, http.StatusUnprocessableEntity, "validation_error"},
+		{"database error", "", errors.New("database error"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupRelationshipTest()
+			mockService.On("AddRelationship", 1, mock.Anything, testActor).Return(nil, tt.err)
+
+			body := tt.body
+			if body == "" {
+				body = `{"related_person_id":2,"type":"guardian"}`
+			}
+			req, _ := http.NewRequest("POST", "/persons/1/relationships", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedErr)
+		})
+	}
+}
+
+func TestGetRelationship(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		relationship *person.Relationship
+		err          error
+		expectedCode int
+	}{
+		{"found", "/persons/1/relationships/3", testRelationship(3, person.RelationshipSpouse), nil, http.StatusOK},
+		{"not found", "/persons/1/relationships/3", nil, personError.ErrRelationshipNotFound, http.StatusNotFound},
+		{"invalid relationship id", "/persons/1/relationships/-1", nil, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupRelationshipTest()
+			if tt.relationship != nil {
+				mockService.On("FindRelationship", 1, 3).Return(tt.relationship, nil)
+			} else {
+				mockService.On("FindRelationship", 1, 3).Return(nil, tt.err)
+			}
+
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestDeleteRelationship(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"deleted", nil, http.StatusNoContent},
+		{"not found", personError.ErrRelationshipNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupRelationshipTest()
+			mockService.On("DeleteRelationship", 1, 3, testActor).Return(tt.err)
+
+			req, _ := http.NewRequest("DELETE", "/persons/1/relationships/3", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetFamilyGraph(t *testing.T) {
+	graph := &person.FamilyGraph{
+		PersonID: 1,
+		Depth:    2,
+		Members: []person.FamilyMember{
+			{Person: &person.Person{ID: 1, Name: "João Silva"}, Distance: 0},
+			{Person: &person.Person{ID: 2, Name: "Maria Silva"}, Distance: 1},
+		},
+		Relationships: []*person.Relationship{{ID: 3, PersonID: 1, RelatedID: 2, Type: person.RelationshipParent}},
+	}
+
+	tests := []struct {
+		name         string
+		query        string
+		depth        int
+		err          error
+		expectedCode int
+	}{
+		{"default depth", "", 2, nil, http.StatusOK},
+		{"invalid depth", "?depth=9", 9, personError.ErrGraphDepthInvalid, http.StatusBadRequest},
+		{"person not found", "?depth=1", 1, personError.ErrPersonNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupRelationshipTest()
+			if tt.err != nil {
+				mockService.On("FamilyGraph", 1, tt.depth).Return(nil, tt.err)
+			} else {
+				mockService.On("FamilyGraph", 1, tt.depth).Return(graph, nil)
+			}
+
+			req, _ := http.NewRequest("GET", "/persons/1/family"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.err == nil {
+				var response contract.FamilyGraphDTO
+				json.Unmarshal(w.Body.Bytes(), &response)
+				assert.Len(t, response.Members, 2)
+				assert.Equal(t, "Maria Silva", response.Members[1].Person.Name)
+				assert.Equal(t, 1, response.Members[1].Distance)
+				assert.Len(t, response.Relationships, 1)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
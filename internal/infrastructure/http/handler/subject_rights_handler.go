@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SubjectRightsHandler answers LGPD data subject requests. The CPF is sent in
+// the request body rather than in the path, so that it stays out of access
+// logs, and only its masked form is ever logged.
+type SubjectRightsHandler struct {
+	service ports.SubjectRightsService
+}
+
+func NewSubjectRightsHandler(service ports.SubjectRightsService) *SubjectRightsHandler {
+	return &SubjectRightsHandler{
+		service: service,
+	}
+}
+
+// AccessReport godoc
+// @Summary      Report everything stored about a data subject
+// @Description  Returns every person registered with the CPF, deleted or not, and the persons merged into them, with their contacts, addresses and identity documents, the relationships involving them and the history of changes to all of these records. The request is logged for the data protection officer, also when no person holds the CPF
+// @Tags         Data Subjects
+// @Accept       json
+// @Produce      json
+// @Param        subject  body      contract.SubjectDTO  true  "Data subject"
+// @Success      200      {object}  contract.SubjectReportDTO
+// @Failure      400      {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404      {object}  contract.ErrorResponse  "No person holds the CPF"
+// @Failure      422      {object}  contract.ErrorResponse  "Invalid CPF"
+// @Failure      500      {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /data-subjects/access [post]
+func (h *SubjectRightsHandler) AccessReport(c *gin.Context) {
+	report, ok := h.subjectReport(c, "AccessReport", personModel.SubjectRequestAccess)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, contract.NewSubjectReportDTO(report))
+}
+
+// PortabilityExport godoc
+// @Summary      Export the data of a data subject
+// @Description  Returns the same report as POST /data-subjects/access as a JSON file to download, for the data subject to take to another provider. The request is logged for the data protection officer, also when no person holds the CPF
+// @Tags         Data Subjects
+// @Accept       json
+// @Produce      json
+// @Param        subject  body      contract.SubjectDTO  true  "Data subject"
+// @Success      200      {object}  contract.SubjectReportDTO
+// @Header       200      {string}  Content-Disposition  "attachment; filename=\"titular-12.json\""
+// @Failure      400      {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404      {object}  contract.ErrorResponse  "No person holds the CPF"
+// @Failure      422      {object}  contract.ErrorResponse  "Invalid CPF"
+// @Failure      500      {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /data-subjects/portability [post]
+func (h *SubjectRightsHandler) PortabilityExport(c *gin.Context) {
+	report, ok := h.subjectReport(c, "PortabilityExport", personModel.SubjectRequestPortability)
+	if !ok {
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("titular-%d.json", report.RequestID)))
+	c.IndentedJSON(http.StatusOK, contract.NewSubjectReportDTO(report))
+}
+
+// AnonymizeSubject godoc
+// @Summary      Anonymize a data subject
+// @Description  Irreversibly scrubs the personal data of every person registered with the CPF and of the persons merged into them. The persons keep their IDs, so the records referencing them stay valid, and are soft deleted for good: name and CPF are replaced, the birth date is cut to the year, contacts and identity documents are removed and addresses keep only their type, city, state and country. Relationships are kept, and the history of the persons keeps only the references of each change. The request is logged for the data protection officer, also when no person holds the CPF
+// @Tags         Data Subjects
+// @Accept       json
+// @Produce      json
+// @Param        subject  body      contract.SubjectDTO  true  "Data subject"
+// @Success      200      {object}  contract.SubjectRequestResponseDTO
+// @Failure      400      {object}  contract.ErrorResponse  "Invalid input data"
+// @Failure      404      {object}  contract.ErrorResponse  "No person holds the CPF"
+// @Failure      422      {object}  contract.ErrorResponse  "Invalid CPF"
+// @Failure      500      {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /data-subjects/anonymize [post]
+func (h *SubjectRightsHandler) AnonymizeSubject(c *gin.Context) {
+	dto, ok := bindSubject(c, "AnonymizeSubject")
+	if !ok {
+		return
+	}
+
+	request, err := h.service.AnonymizeSubject(dto.CPF, requestActor(c))
+	if err != nil {
+		respondSubjectError(c, "AnonymizeSubject", dto.CPF, err)
+		return
+	}
+
+	log.Printf("[SUCCESS] AnonymizeSubject - Anonymized persons %v of CPF %s (request ID: %d)", request.PersonIDs, request.SubjectCPF, request.ID)
+	c.JSON(http.StatusOK, contract.NewSubjectRequestResponseDTO(request))
+}
+
+// ListSubjectRequests godoc
+// @Summary      List data subject requests
+// @Description  Returns the log of the LGPD requests answered, newest first, for the data protection officer. CPFs are masked
+// @Tags         Data Subjects
+// @Produce      json
+// @Param        page       query     int  false  "Page number"     default(1)   minimum(1)
+// @Param        page_size  query     int  false  "Items per page"  default(10)  minimum(1)  maximum(100)
+// @Success      200  {object}  contract.PaginatedResponse{data=[]contract.SubjectRequestResponseDTO}
+// @Failure      400  {object}  contract.ErrorResponse  "Invalid pagination parameters"
+// @Failure      500  {object}  contract.ErrorResponse  "Internal server error"
+// @Router       /data-subjects/requests [get]
+func (h *SubjectRightsHandler) ListSubjectRequests(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	requests, total, err := h.service.ListSubjectRequests(page, pageSize)
+	if err != nil {
+		log.Printf("[ERROR] ListSubjectRequests - Failed to list subject requests: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to list subject requests: " + err.Error(),
+		})
+		return
+	}
+
+	data := make([]contract.SubjectRequestResponseDTO, len(requests))
+	for i, request := range requests {
+		data[i] = contract.NewSubjectRequestResponseDTO(request)
+	}
+
+	log.Printf("[SUCCESS] ListSubjectRequests - Retrieved %d requests (total: %d)", len(requests), total)
+	c.JSON(http.StatusOK, contract.PaginatedResponse{
+		Data:       data,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: int(math.Ceil(float64(total) / float64(pageSize))),
+	})
+}
+
+// subjectReport reads the data subject from the body and builds their report.
+// When either fails it writes the error response and returns false.
+func (h *SubjectRightsHandler) subjectReport(c *gin.Context, operation, requestType string) (*personModel.SubjectReport, bool) {
+	dto, ok := bindSubject(c, operation)
+	if !ok {
+		return nil, false
+	}
+
+	report, err := h.service.SubjectReport(dto.CPF, requestType, requestActor(c))
+	if err != nil {
+		respondSubjectError(c, operation, dto.CPF, err)
+		return nil, false
+	}
+
+	log.Printf("[SUCCESS] %s - Reported %d persons of CPF %s (request ID: %d)", operation, len(report.Records), personModel.MaskCPF(report.CPF), report.RequestID)
+	return report, true
+}
+
+func bindSubject(c *gin.Context, operation string) (contract.SubjectDTO, bool) {
+	var dto contract.SubjectDTO
+	if err := c.ShouldBindJSON(&dto); err != nil {
+		log.Printf("[ERROR] %s - Invalid request body: %v", operation, err)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid_request",
+			"message": "Invalid request body: " + err.Error(),
+		})
+		return dto, false
+	}
+	return dto, true
+}
+
+func respondSubjectError(c *gin.Context, operation, cpf string, err error) {
+	switch {
+	case errors.Is(err, personError.ErrCPFInvalid):
+		log.Printf("[ERROR] %s - Validation error: %v", operation, err)
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	case errors.Is(err, personError.ErrPersonNotFound):
+		log.Printf("[WARN] %s - No person found with CPF %s", operation, personModel.MaskCPF(cpf))
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "not_found",
+			"message": "No person found with the provided CPF",
+		})
+	default:
+		log.Printf("[ERROR] %s - Failed for CPF %s: %v", operation, personModel.MaskCPF(cpf), err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "internal_error",
+			"message": "Failed to process data subject request: " + err.Error(),
+		})
+	}
+}
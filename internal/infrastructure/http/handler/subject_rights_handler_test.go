@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	audit "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupSubjectRightsTest() (*gin.Engine, *mocks.MockSubjectRightsService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.MockSubjectRightsService)
+	handler := NewSubjectRightsHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request.RemoteAddr = testActor.ClientIP + ":40000"
+		c.Set("user_id", testActor.OperatorID)
+		c.Set("request_id", testActor.RequestID)
+		c.Next()
+	})
+	router.POST("/data-subjects/access", handler.AccessReport)
+	router.POST("/data-subjects/portability", handler.PortabilityExport)
+	router.POST("/data-subjects/anonymize", handler.AnonymizeSubject)
+	router.GET("/data-subjects/requests", handler.ListSubjectRequests)
+
+	return router, mockService
+}
+
+func subjectRequest(method, url, body string) *http.Request {
+	req, _ := http.NewRequest(method, url, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func sampleSubjectReport() *person.SubjectReport {
+	return &person.SubjectReport{
+		RequestID:   12,
+		CPF:         "11144477735",
+		GeneratedAt: time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC),
+		Records: []person.SubjectRecord{{
+			Person:    &person.Person{ID: 5, Name: "José da Silva", CPF: "11144477735"},
+			Addresses: []*person.Address{{ID: 1, PersonID: 5}},
+		}},
+		Relationships: []*person.Relationship{{ID: 2, PersonID: 5, RelatedID: 9, Type: person.RelationshipParent}},
+		History:       []*audit.AuditEntry{audit.NewAuditEntry(audit.EntityAddress, 1, audit.ActionCreate, testActor, nil, json.RawMessage(`{"id":1,"person_id":5}`))},
+	}
+}
+
+func TestAccessReport_Success(t *testing.T) {
+	router, mockService := setupSubjectRightsTest()
+
+	mockService.On("SubjectReport", "111.444.777-35", person.SubjectRequestAccess, testActor).Return(sampleSubjectReport(), nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, subjectRequest("POST", "/data-subjects/access", `{"cpf": "111.444.777-35"}`))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+
+	var response contract.SubjectReportDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, 12, response.RequestID)
+	assert.Len(t, response.Persons, 1)
+	assert.Equal(t, "José da Silva", response.Persons[0].Person.Name)
+	assert.Len(t, response.Persons[0].Addresses, 1)
+	assert.Empty(t, response.Persons[0].Documents)
+	assert.Len(t, response.Relationships, 1)
+	assert.Equal(t, audit.EntityAddress, response.History[0].EntityType)
+	assert.Equal(t, 1, response.History[0].EntityID)
+}
+
+func TestPortabilityExport_Success(t *testing.T) {
+	router, mockService := setupSubjectRightsTest()
+
+	mockService.On("SubjectReport", "11144477735", person.SubjectRequestPortability, testActor).Return(sampleSubjectReport(), nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, subjectRequest("POST", "/data-subjects/portability", `{"cpf": "11144477735"}`))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `attachment; filename="titular-12.json"`, w.Header().Get("Content-Disposition"))
+
+	var response contract.SubjectReportDTO
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "11144477735", response.CPF)
+}
+
+func TestAccessReport_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		err      error
+		expected int
+	}{
+		{"missing cpf", `{}`, nil, http.StatusBadRequest},
+		{"invalid cpf", `{"cpf": "11144477700"}`, personError.ErrCPFInvalid, http.StatusUnprocessableEntity},
+		{"not found", `{"cpf": "11144477735"}`, personError.ErrPersonNotFound, http.StatusNotFound},
+		{"repository error", `{"cpf": "11144477735"}`, errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupSubjectRightsTest()
+			var dto contract.SubjectDTO
+			json.Unmarshal([]byte(tt.body), &dto)
+			mockService.On("SubjectReport", dto.CPF, person.SubjectRequestAccess, testActor).Return(nil, tt.err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, subjectRequest("POST", "/data-subjects/access", tt.body))
+
+			assert.Equal(t, tt.expected, w.Code)
+			assert.NotContains(t, w.Body.String(), "11144477735")
+		})
+	}
+}
+
+func TestAnonymizeSubject_Success(t *testing.T) {
+	router, mockService := setupSubjectRightsTest()
+
+	request := person.NewSubjectRequest(person.SubjectRequestAnonymization, "11144477735", []int{5, 8}, testActor)
+	request.ID = 13
+	mockService.On("AnonymizeSubject", "11144477735", testActor).Return(request, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, subjectRequest("POST", "/data-subjects/anonymize", `{"cpf": "11144477735"}`))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response contract.SubjectRequestResponseDTO
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, 13, response.ID)
+	assert.Equal(t, "***.444.777-**", response.SubjectCPF)
+	assert.Equal(t, []int{5, 8}, response.PersonIDs)
+	assert.Equal(t, person.SubjectRequestCompleted, response.Status)
+}
+
+func TestAnonymizeSubject_NotFound(t *testing.T) {
+	router, mockService := setupSubjectRightsTest()
+
+	mockService.On("AnonymizeSubject", "11144477735", testActor).Return(nil, personError.ErrPersonNotFound)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, subjectRequest("POST", "/data-subjects/anonymize", `{"cpf": "11144477735"}`))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestListSubjectRequests_Success(t *testing.T) {
+	router, mockService := setupSubjectRightsTest()
+
+	notFound := person.NewSubjectRequest(person.SubjectRequestAccess, "52998224725", nil, testActor)
+	mockService.On("ListSubjectRequests", 2, 5).Return([]*person.SubjectRequest{notFound}, int64(6), nil)
+
+	req, _ := http.NewRequest("GET", "/data-subjects/requests?page=2&page_size=5", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Data       []contract.SubjectRequestResponseDTO `json:"data"`
+		TotalPages int                                  `json:"total_pages"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response.Data, 1)
+	assert.Equal(t, person.SubjectRequestNotFound, response.Data[0].Status)
+	assert.Equal(t, []int{}, response.Data[0].PersonIDs)
+	assert.Equal(t, 2, response.TotalPages)
+}
@@ -1,22 +1,38 @@
 package middleware
 
 import (
+	"log"
 	"net/http"
-	"os"
 	"strings"
-	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// Claims are the claims of the access tokens issued to operators. Roles are
+// those the operator held when the token was issued: role changes take effect
+// on the next login or refresh. The token ID (jti) is what gets revoked, and
+// SessionID identifies the login the token was issued from.
 type Claims struct {
-	UserID   int    `json:"user_id"`
-	Username string `json:"username"`
+	UserID    int      `json:"user_id"`
+	Username  string   `json:"username"`
+	Roles     []string `json:"roles"`
+	SessionID string   `json:"sid"`
 	jwt.RegisteredClaims
 }
 
-func JWTAuth() gin.HandlerFunc {
+// RevocationList tells whether an access token was revoked before expiring, on
+// logout or when its operator was deactivated.
+type RevocationList interface {
+	IsAccessTokenRevoked(tokenID string) (bool, error)
+}
+
+// JWTAuth accepts the requests bearing an access token verified by keys that
+// is not in the revocation list. It stores the claims in the context as
+// "user_id", "username", "roles", "token_id" and "session_id".
+func JWTAuth(keys *TokenKeys, revocations RevocationList) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 
@@ -40,7 +56,7 @@ func JWTAuth() gin.HandlerFunc {
 		}
 
 		tokenString := parts[1]
-		token, err := validateToken(tokenString)
+		token, err := keys.validateToken(tokenString)
 
 		if err != nil || !token.Valid {
 			c.JSON(http.StatusUnauthorized, gin.H{
@@ -51,48 +67,59 @@ func JWTAuth() gin.HandlerFunc {
 			return
 		}
 
-		if claims, ok := token.Claims.(*Claims); ok {
-			c.Set("user_id", claims.UserID)
-			c.Set("username", claims.Username)
+		// Tokens without an ID or a session cannot be revoked, so they are refused
+		claims, ok := token.Claims.(*Claims)
+		if !ok || claims.ID == "" || claims.SessionID == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   "unauthorized",
+				"message": "Invalid or expired token",
+			})
+			c.Abort()
+			return
 		}
 
-		c.Next()
-	}
-}
-
-func validateToken(tokenString string) (*jwt.Token, error) {
-	secret := getJWTSecret()
+		revoked, err := revocations.IsAccessTokenRevoked(claims.ID)
+		if err != nil {
+			log.Printf("[ERROR] JWTAuth - Failed to check revocation of token %s: %v", claims.ID, err)
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "internal_error",
+				"message": "Failed to validate token",
+			})
+			c.Abort()
+			return
+		}
+		if revoked {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   "unauthorized",
+				"message": "Token has been revoked",
+			})
+			c.Abort()
+			return
+		}
 
-	return jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
-}
+		c.Set("user_id", claims.UserID)
+		c.Set("username", claims.Username)
+		c.Set("roles", claims.Roles)
+		c.Set("token_id", claims.ID)
+		c.Set("session_id", claims.SessionID)
 
-func GenerateToken(userID int, username string) (string, error) {
-	secret := getJWTSecret()
-	expirationTime := time.Now().Add(24 * time.Hour)
-
-	claims := &Claims{
-		UserID:   userID,
-		Username: username,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-		},
+		c.Next()
 	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
 }
 
-func getJWTSecret() string {
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		panic("CRITICAL: JWT_SECRET environment variable is not set")
-	}
-	if len(secret) < 32 {
-		panic("CRITICAL: JWT_SECRET must be at least 32 characters long")
+// RequirePermission rejects with 403 the requests of operators none of whose
+// roles grants the permission. It must run after JWTAuth.
+func RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !operator.HasPermission(c.GetStringSlice("roles"), permission) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "forbidden",
+				"message": "Missing permission: " + permission,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
 	}
-	return secret
 }
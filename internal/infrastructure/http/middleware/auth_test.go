@@ -1,15 +1,65 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
-	"os"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 )
 
+const testSecret = "test-secret-key-minimum-32-characters-long"
+
+// hmacKeys returns keys that sign and verify HS256 tokens with testSecret.
+func hmacKeys(t *testing.T) *TokenKeys {
+	keys, err := NewTokenKeys(TokenKeysConfig{Secret: testSecret})
+	if err != nil {
+		t.Fatalf("failed to create token keys: %v", err)
+	}
+	return keys
+}
+
+// issueToken signs an access token of operator 123 in session-1.
+func issueToken(t *testing.T, keys *TokenKeys) string {
+	token, err := keys.IssueAccessToken(123, "testuser", []string{"editor"}, "session-1")
+	if err != nil {
+		t.Fatalf("failed to issue token: %v", err)
+	}
+	return token.Token
+}
+
+// revocationList is a RevocationList holding the IDs of the revoked tokens.
+type revocationList map[string]bool
+
+func (l revocationList) IsAccessTokenRevoked(tokenID string) (bool, error) {
+	return l[tokenID], nil
+}
+
+// failingRevocationList is a RevocationList that cannot be read.
+type failingRevocationList struct{}
+
+func (failingRevocationList) IsAccessTokenRevoked(string) (bool, error) {
+	return false, errors.New("database error")
+}
+
+// authenticate runs JWTAuth on a request bearing the token.
+func authenticate(keys *TokenKeys, revocations RevocationList, token string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/test", nil)
+	c.Request.Header.Set("Authorization", "Bearer "+token)
+
+	JWTAuth(keys, revocations)(c)
+
+	return c, w
+}
+
 func TestJWTAuth_MissingAuthorizationHeader(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -17,7 +67,7 @@ func TestJWTAuth_MissingAuthorizationHeader(t *testing.T) {
 	c, _ := gin.CreateTestContext(w)
 	c.Request, _ = http.NewRequest("GET", "/test", nil)
 
-	JWTAuth()(c)
+	JWTAuth(hmacKeys(t), revocationList{})(c)
 
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 	assert.Contains(t, w.Body.String(), "Authorization header is required")
@@ -31,83 +81,150 @@ func TestJWTAuth_InvalidHeaderFormat(t *testing.T) {
 	c.Request, _ = http.NewRequest("GET", "/test", nil)
 	c.Request.Header.Set("Authorization", "InvalidFormat")
 
-	JWTAuth()(c)
+	JWTAuth(hmacKeys(t), revocationList{})(c)
 
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 	assert.Contains(t, w.Body.String(), "Invalid authorization header format")
 }
 
 func TestJWTAuth_InvalidToken(t *testing.T) {
-	gin.SetMode(gin.TestMode)
-	os.Setenv("JWT_SECRET", "test-secret-key-minimum-32-characters-long")
-	defer os.Unsetenv("JWT_SECRET")
-
-	w := httptest.NewRecorder()
-	c, _ := gin.CreateTestContext(w)
-	c.Request, _ = http.NewRequest("GET", "/test", nil)
-	c.Request.Header.Set("Authorization", "Bearer invalid.token.here")
-
-	JWTAuth()(c)
+	_, w := authenticate(hmacKeys(t), revocationList{}, "invalid.token.here")
 
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 	assert.Contains(t, w.Body.String(), "Invalid or expired token")
 }
 
 func TestJWTAuth_ValidToken(t *testing.T) {
-	gin.SetMode(gin.TestMode)
-	os.Setenv("JWT_SECRET", "test-secret-key-minimum-32-characters-long")
-	defer os.Unsetenv("JWT_SECRET")
-
-	token, err := GenerateToken(123, "testuser")
+	keys := hmacKeys(t)
+	token, err := keys.IssueAccessToken(123, "testuser", []string{"editor"}, "session-1")
 	assert.NoError(t, err)
 
-	w := httptest.NewRecorder()
-	c, _ := gin.CreateTestContext(w)
-	c.Request, _ = http.NewRequest("GET", "/test", nil)
-	c.Request.Header.Set("Authorization", "Bearer "+token)
+	c, _ := authenticate(keys, revocationList{}, token.Token)
 
-	var called bool
-	handler := func(c *gin.Context) {
-		called = true
-		userID, exists := c.Get("user_id")
-		assert.True(t, exists)
-		assert.Equal(t, 123, userID)
+	assert.False(t, c.IsAborted())
 
-		username, exists := c.Get("username")
-		assert.True(t, exists)
-		assert.Equal(t, "testuser", username)
-	}
+	userID, exists := c.Get("user_id")
+	assert.True(t, exists)
+	assert.Equal(t, 123, userID)
 
-	JWTAuth()(c)
-	if !c.IsAborted() {
-		handler(c)
-	}
+	username, exists := c.Get("username")
+	assert.True(t, exists)
+	assert.Equal(t, "testuser", username)
+
+	assert.Equal(t, []string{"editor"}, c.GetStringSlice("roles"))
+	assert.Equal(t, token.ID, c.GetString("token_id"))
+	assert.Equal(t, "session-1", c.GetString("session_id"))
+}
+
+func TestIssueAccessToken(t *testing.T) {
+	keys := hmacKeys(t)
+
+	before := time.Now()
+	token, err := keys.IssueAccessToken(456, "johndoe", nil, "session-1")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token.Token)
+	assert.Len(t, token.ID, 32)
+	assert.WithinDuration(t, before.Add(DefaultAccessTokenTTL), token.ExpiresAt, 2*time.Second)
+
+	parsed, err := keys.validateToken(token.Token)
+	assert.NoError(t, err)
+	claims := parsed.Claims.(*Claims)
+	assert.Equal(t, DefaultTokenIssuer, claims.Issuer)
+	assert.Equal(t, jwt.ClaimStrings{DefaultTokenAudience}, claims.Audience)
+	assert.Equal(t, "456", claims.Subject)
+	assert.Equal(t, "HS256", parsed.Header["alg"])
 
-	assert.True(t, called)
+	other, err := keys.IssueAccessToken(456, "johndoe", nil, "session-1")
+	assert.NoError(t, err)
+	assert.NotEqual(t, token.ID, other.ID, "every token has its own ID")
 }
 
-func TestGenerateToken(t *testing.T) {
-	os.Setenv("JWT_SECRET", "test-secret-key-minimum-32-characters-long")
-	defer os.Unsetenv("JWT_SECRET")
+func TestIssueAccessToken_AccessTokenTTL(t *testing.T) {
+	keys, err := NewTokenKeys(TokenKeysConfig{Secret: testSecret, AccessTokenTTL: 5 * time.Minute})
+	assert.NoError(t, err)
+
+	before := time.Now()
+	token, err := keys.IssueAccessToken(456, "johndoe", nil, "session-1")
+
+	assert.NoError(t, err)
+	assert.WithinDuration(t, before.Add(5*time.Minute), token.ExpiresAt, 2*time.Second)
+}
 
-	token, err := GenerateToken(456, "johndoe")
+func TestJWTAuth_RevokedToken(t *testing.T) {
+	keys := hmacKeys(t)
+	token, err := keys.IssueAccessToken(123, "testuser", nil, "session-1")
 	assert.NoError(t, err)
-	assert.NotEmpty(t, token)
+
+	c, w := authenticate(keys, revocationList{token.ID: true}, token.Token)
+
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "Token has been revoked")
+}
+
+func TestJWTAuth_RevocationListUnavailable(t *testing.T) {
+	keys := hmacKeys(t)
+
+	c, w := authenticate(keys, failingRevocationList{}, issueToken(t, keys))
+
+	assert.True(t, c.IsAborted(), "a token is not accepted when its revocation cannot be checked")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
 
-func TestGetJWTSecret_NotSet(t *testing.T) {
-	os.Unsetenv("JWT_SECRET")
+func TestJWTAuth_TokenWithoutID(t *testing.T) {
+	// A token as issued before tokens could be revoked
+	claims := &Claims{
+		UserID:   123,
+		Username: "testuser",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    DefaultTokenIssuer,
+			Audience:  jwt.ClaimStrings{DefaultTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	assert.NoError(t, err)
 
-	assert.Panics(t, func() {
-		getJWTSecret()
-	})
+	c, w := authenticate(hmacKeys(t), revocationList{}, token)
+
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
 }
 
-func TestGetJWTSecret_TooShort(t *testing.T) {
-	os.Setenv("JWT_SECRET", "short")
-	defer os.Unsetenv("JWT_SECRET")
+func TestRequirePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		roles    []string
+		expected int
+	}{
+		{"granted", []string{"viewer", "editor"}, http.StatusOK},
+		{"missing", []string{"viewer"}, http.StatusForbidden},
+		{"no roles", nil, http.StatusForbidden},
+	}
 
-	assert.Panics(t, func() {
-		getJWTSecret()
-	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				if tt.roles != nil {
+					c.Set("roles", tt.roles)
+				}
+				c.Next()
+			})
+			router.DELETE("/test", RequirePermission("registry:write"), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", "/test", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+			if tt.expected == http.StatusForbidden {
+				assert.Contains(t, w.Body.String(), "registry:write")
+			}
+		})
+	}
 }
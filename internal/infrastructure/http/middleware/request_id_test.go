@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID_Generated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/test", nil)
+
+	RequestID()(c)
+
+	requestID := c.GetString("request_id")
+	assert.Len(t, requestID, 32)
+	assert.Equal(t, requestID, w.Header().Get(RequestIDHeader))
+}
+
+func TestRequestID_ReusesClientValue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/test", nil)
+	c.Request.Header.Set(RequestIDHeader, "client-req-123")
+
+	RequestID()(c)
+
+	assert.Equal(t, "client-req-123", c.GetString("request_id"))
+	assert.Equal(t, "client-req-123", w.Header().Get(RequestIDHeader))
+}
+
+func TestRequestID_ReplacesMalformedValue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/test", nil)
+	c.Request.Header.Set(RequestIDHeader, "bad id\nwith newline")
+
+	RequestID()(c)
+
+	assert.NotEqual(t, "bad id\nwith newline", c.GetString("request_id"))
+	assert.Len(t, c.GetString("request_id"), 32)
+}
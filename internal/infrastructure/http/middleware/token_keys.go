@@ -0,0 +1,415 @@
+package middleware
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// DefaultAccessTokenTTL is how long access tokens last when ACCESS_TOKEN_TTL
+	// is not set. Operators keep their session past it with a refresh token.
+	DefaultAccessTokenTTL = 15 * time.Minute
+	// DefaultTokenIssuer and DefaultTokenAudience are the iss and aud claims of
+	// the access tokens when JWT_ISSUER and JWT_AUDIENCE are not set.
+	DefaultTokenIssuer   = "pessoas-api"
+	DefaultTokenAudience = "pessoas-api"
+
+	minSecretLength = 32
+	minRSAKeyBits   = 2048
+)
+
+var ErrUnknownKeyID = errors.New("token signed with an unknown key")
+
+// TokenKeysConfig holds the keys and claims of the access tokens.
+type TokenKeysConfig struct {
+	// Secret signs HS256 tokens when there is no SigningKey. With a
+	// SigningKey, HS256 tokens are still accepted while Secret is set, which
+	// lets the tokens issued before switching to asymmetric keys expire.
+	Secret string
+	// SigningKey is a PEM private key: RSA signs with RS256 and Ed25519 with
+	// EdDSA.
+	SigningKey []byte
+	// VerificationKeys are PEM public keys accepted besides the signing key,
+	// such as the previous key of a rotation.
+	VerificationKeys [][]byte
+	Issuer           string
+	Audience         string
+	AccessTokenTTL   time.Duration
+}
+
+// TokenKeys signs the access tokens and verifies the tokens of requests. The
+// asymmetric keys are identified by their RFC 7638 thumbprint, sent in the kid
+// header of the tokens and published in the JWKS so that other services can
+// verify the tokens without sharing a secret.
+type TokenKeys struct {
+	method           jwt.SigningMethod
+	signingKey       interface{}
+	keyID            string
+	verificationKeys map[string]verificationKey
+	jwks             []JSONWebKey
+	validMethods     []string
+	secret           []byte
+	issuer           string
+	audience         string
+	accessTokenTTL   time.Duration
+}
+
+type verificationKey struct {
+	method jwt.SigningMethod
+	key    crypto.PublicKey
+}
+
+// JSONWebKey is the public part of a verification key as published in the
+// JWKS (RFC 7517). RSA keys fill N and E, Ed25519 keys fill Crv and X.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet is the document served at /.well-known/jwks.json.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// LoadTokenKeys reads the token configuration from the environment:
+// JWT_SIGNING_KEY_FILE, JWT_VERIFICATION_KEY_FILES (comma separated),
+// JWT_SECRET, JWT_ISSUER, JWT_AUDIENCE and ACCESS_TOKEN_TTL.
+func LoadTokenKeys() (*TokenKeys, error) {
+	config := TokenKeysConfig{
+		Secret:   os.Getenv("JWT_SECRET"),
+		Issuer:   os.Getenv("JWT_ISSUER"),
+		Audience: os.Getenv("JWT_AUDIENCE"),
+	}
+
+	if path := os.Getenv("JWT_SIGNING_KEY_FILE"); path != "" {
+		key, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWT_SIGNING_KEY_FILE: %w", err)
+		}
+		config.SigningKey = key
+	}
+
+	for _, path := range strings.Split(os.Getenv("JWT_VERIFICATION_KEY_FILES"), ",") {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		key, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWT_VERIFICATION_KEY_FILES: %w", err)
+		}
+		config.VerificationKeys = append(config.VerificationKeys, key)
+	}
+
+	if value := os.Getenv("ACCESS_TOKEN_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl <= 0 {
+			return nil, errors.New("ACCESS_TOKEN_TTL must be a positive duration, such as 15m")
+		}
+		config.AccessTokenTTL = ttl
+	}
+
+	return NewTokenKeys(config)
+}
+
+// NewTokenKeys validates the configuration and parses its keys.
+func NewTokenKeys(config TokenKeysConfig) (*TokenKeys, error) {
+	keys := &TokenKeys{
+		verificationKeys: map[string]verificationKey{},
+		jwks:             []JSONWebKey{},
+		issuer:           config.Issuer,
+		audience:         config.Audience,
+		accessTokenTTL:   config.AccessTokenTTL,
+	}
+	if keys.issuer == "" {
+		keys.issuer = DefaultTokenIssuer
+	}
+	if keys.audience == "" {
+		keys.audience = DefaultTokenAudience
+	}
+	if keys.accessTokenTTL == 0 {
+		keys.accessTokenTTL = DefaultAccessTokenTTL
+	}
+
+	if config.Secret != "" {
+		if len(config.Secret) < minSecretLength {
+			return nil, fmt.Errorf("JWT_SECRET must be at least %d characters long", minSecretLength)
+		}
+		keys.secret = []byte(config.Secret)
+		keys.validMethods = append(keys.validMethods, jwt.SigningMethodHS256.Alg())
+	}
+
+	if len(config.SigningKey) > 0 {
+		private, public, err := parsePrivateKey(config.SigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing key: %w", err)
+		}
+		keyID, method, err := keys.addVerificationKey(public)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing key: %w", err)
+		}
+		keys.method = method
+		keys.signingKey = private
+		keys.keyID = keyID
+	} else {
+		if keys.secret == nil {
+			return nil, errors.New("JWT_SECRET is required when no signing key is configured")
+		}
+		keys.method = jwt.SigningMethodHS256
+		keys.signingKey = keys.secret
+	}
+
+	for i, data := range config.VerificationKeys {
+		public, err := parsePublicKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verification key %d: %w", i+1, err)
+		}
+		if _, _, err := keys.addVerificationKey(public); err != nil {
+			return nil, fmt.Errorf("invalid verification key %d: %w", i+1, err)
+		}
+	}
+
+	return keys, nil
+}
+
+// Algorithm returns the algorithm that signs the access tokens.
+func (k *TokenKeys) Algorithm() string {
+	return k.method.Alg()
+}
+
+// KeyID returns the kid of the signing key, empty with HS256.
+func (k *TokenKeys) KeyID() string {
+	return k.keyID
+}
+
+// JWKS returns the public verification keys.
+func (k *TokenKeys) JWKS() JSONWebKeySet {
+	return JSONWebKeySet{Keys: k.jwks}
+}
+
+// IssueAccessToken signs an access token of the session, valid for
+// ACCESS_TOKEN_TTL.
+func (k *TokenKeys) IssueAccessToken(operatorID int, username string, roles []string, sessionID string) (*operator.AccessToken, error) {
+	now := time.Now()
+
+	tokenID, err := generateTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &Claims{
+		UserID:    operatorID,
+		Username:  username,
+		Roles:     roles,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			Issuer:    k.issuer,
+			Subject:   strconv.Itoa(operatorID),
+			Audience:  jwt.ClaimStrings{k.audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(k.accessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	token := jwt.NewWithClaims(k.method, claims)
+	if k.keyID != "" {
+		token.Header["kid"] = k.keyID
+	}
+
+	signed, err := token.SignedString(k.signingKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return &operator.AccessToken{
+		Token:     signed,
+		ID:        tokenID,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+// validateToken verifies the signature, expiry, issuer and audience of a
+// token. Asymmetric tokens are verified with the key named by their kid, and
+// only with the algorithm of that key.
+func (k *TokenKeys) validateToken(tokenString string) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, &Claims{}, k.keyFunc,
+		jwt.WithValidMethods(k.validMethods),
+		jwt.WithIssuer(k.issuer),
+		jwt.WithAudience(k.audience),
+		jwt.WithExpirationRequired(),
+	)
+}
+
+func (k *TokenKeys) keyFunc(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() == jwt.SigningMethodHS256.Alg() {
+		return k.secret, nil
+	}
+
+	keyID, _ := token.Header["kid"].(string)
+	key, ok := k.verificationKeys[keyID]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	if key.method.Alg() != token.Method.Alg() {
+		return nil, fmt.Errorf("key %s does not sign %s tokens", keyID, token.Method.Alg())
+	}
+
+	return key.key, nil
+}
+
+// addVerificationKey accepts tokens signed by the private key of public and
+// publishes it in the JWKS. Adding a key twice is harmless.
+func (k *TokenKeys) addVerificationKey(public crypto.PublicKey) (string, jwt.SigningMethod, error) {
+	var jwk JSONWebKey
+	var method jwt.SigningMethod
+	var thumbprintInput string
+
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRSAKeyBits {
+			return "", nil, fmt.Errorf("RSA keys must have at least %d bits", minRSAKeyBits)
+		}
+		method = jwt.SigningMethodRS256
+		jwk = JSONWebKey{
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}
+		thumbprintInput = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, jwk.E, jwk.N)
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+		jwk = JSONWebKey{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+		}
+		thumbprintInput = fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`, jwk.X)
+	default:
+		return "", nil, fmt.Errorf("unsupported key type %T, use RSA or Ed25519", public)
+	}
+
+	thumbprint := sha256.Sum256([]byte(thumbprintInput))
+	jwk.Kid = base64.RawURLEncoding.EncodeToString(thumbprint[:])
+	jwk.Use = "sig"
+	jwk.Alg = method.Alg()
+
+	if _, exists := k.verificationKeys[jwk.Kid]; exists {
+		return jwk.Kid, method, nil
+	}
+
+	k.verificationKeys[jwk.Kid] = verificationKey{method: method, key: public}
+	k.jwks = append(k.jwks, jwk)
+	if !containsString(k.validMethods, method.Alg()) {
+		k.validMethods = append(k.validMethods, method.Alg())
+	}
+
+	return jwk.Kid, method, nil
+}
+
+// JWKS serves the public verification keys, for services that verify the
+// access tokens of the API.
+func JWKS(keys *TokenKeys) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, keys.JWKS())
+	}
+}
+
+// parsePrivateKey reads a PKCS #8 or PKCS #1 PEM private key.
+func parsePrivateKey(data []byte) (interface{}, crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("no PEM block found")
+	}
+
+	var private interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch key := private.(type) {
+	case *rsa.PrivateKey:
+		return key, &key.PublicKey, nil
+	case ed25519.PrivateKey:
+		return key, key.Public(), nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported key type %T, use RSA or Ed25519", private)
+	}
+}
+
+// parsePublicKey reads a PKIX or PKCS #1 PEM public key, or the key of a PEM
+// certificate.
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return certificate.PublicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+func generateTokenID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token ID: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
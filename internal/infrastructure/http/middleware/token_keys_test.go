@@ -0,0 +1,345 @@
+package middleware
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// testKeyPair is a generated key in PEM, as read from the key files.
+type testKeyPair struct {
+	private    interface{}
+	privatePEM []byte
+	publicPEM  []byte
+}
+
+func newEd25519KeyPair(t *testing.T) testKeyPair {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+	return encodeKeyPair(t, private, public)
+}
+
+func newRSAKeyPair(t *testing.T, bits int) testKeyPair {
+	private, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	return encodeKeyPair(t, private, &private.PublicKey)
+}
+
+func encodeKeyPair(t *testing.T, private, public interface{}) testKeyPair {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatalf("failed to encode private key: %v", err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		t.Fatalf("failed to encode public key: %v", err)
+	}
+
+	return testKeyPair{
+		private:    private,
+		privatePEM: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}),
+		publicPEM:  pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}),
+	}
+}
+
+// writeKeyFile writes data to a file of the test's temporary directory.
+func writeKeyFile(t *testing.T, name string, data []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+	return path
+}
+
+func TestNewTokenKeys_AsymmetricSigning(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyPair   func(t *testing.T) testKeyPair
+		algorithm string
+		keyType   string
+	}{
+		{"Ed25519", newEd25519KeyPair, "EdDSA", "OKP"},
+		{"RSA", func(t *testing.T) testKeyPair { return newRSAKeyPair(t, 2048) }, "RS256", "RSA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := NewTokenKeys(TokenKeysConfig{SigningKey: tt.keyPair(t).privatePEM})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.algorithm, keys.Algorithm())
+			assert.NotEmpty(t, keys.KeyID())
+
+			token, err := keys.IssueAccessToken(123, "testuser", []string{"admin"}, "session-1")
+			assert.NoError(t, err)
+
+			parsed, err := keys.validateToken(token.Token)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.algorithm, parsed.Header["alg"])
+			assert.Equal(t, keys.KeyID(), parsed.Header["kid"])
+
+			jwks := keys.JWKS()
+			assert.Len(t, jwks.Keys, 1)
+			assert.Equal(t, tt.keyType, jwks.Keys[0].Kty)
+			assert.Equal(t, keys.KeyID(), jwks.Keys[0].Kid)
+			assert.Equal(t, tt.algorithm, jwks.Keys[0].Alg)
+			assert.Equal(t, "sig", jwks.Keys[0].Use)
+		})
+	}
+}
+
+func TestNewTokenKeys_JWKSThumbprint(t *testing.T) {
+	// Ed25519 key of RFC 8037, appendix A.3, whose thumbprint is given there
+	public, err := jwt.ParseEdPublicKeyFromPEM([]byte("-----BEGIN PUBLIC KEY-----\nMCowBQYDK2VwAyEA11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=\n-----END PUBLIC KEY-----\n"))
+	assert.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	assert.NoError(t, err)
+
+	keys, err := NewTokenKeys(TokenKeysConfig{
+		Secret:           testSecret,
+		VerificationKeys: [][]byte{pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []JSONWebKey{{
+		Kty: "OKP",
+		Kid: "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k",
+		Use: "sig",
+		Alg: "EdDSA",
+		Crv: "Ed25519",
+		X:   "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo",
+	}}, keys.JWKS().Keys)
+}
+
+func TestNewTokenKeys_Rotation(t *testing.T) {
+	previous := newEd25519KeyPair(t)
+	current := newRSAKeyPair(t, 2048)
+
+	previousKeys, err := NewTokenKeys(TokenKeysConfig{SigningKey: previous.privatePEM})
+	assert.NoError(t, err)
+	issuedBefore, err := previousKeys.IssueAccessToken(123, "testuser", nil, "session-1")
+	assert.NoError(t, err)
+
+	keys, err := NewTokenKeys(TokenKeysConfig{
+		SigningKey:       current.privatePEM,
+		VerificationKeys: [][]byte{previous.publicPEM, current.publicPEM},
+	})
+	assert.NoError(t, err)
+
+	_, err = keys.validateToken(issuedBefore.Token)
+	assert.NoError(t, err, "tokens of the previous key are accepted until it is removed")
+
+	issuedAfter, err := keys.IssueAccessToken(123, "testuser", nil, "session-1")
+	assert.NoError(t, err)
+	_, err = keys.validateToken(issuedAfter.Token)
+	assert.NoError(t, err)
+
+	jwks := keys.JWKS()
+	assert.Len(t, jwks.Keys, 2, "the signing key is published once")
+	assert.Equal(t, keys.KeyID(), jwks.Keys[0].Kid)
+	assert.Equal(t, previousKeys.KeyID(), jwks.Keys[1].Kid)
+
+	withoutPrevious, err := NewTokenKeys(TokenKeysConfig{SigningKey: current.privatePEM})
+	assert.NoError(t, err)
+	_, err = withoutPrevious.validateToken(issuedBefore.Token)
+	assert.Error(t, err, "tokens of a removed key are rejected")
+}
+
+func TestValidateToken_Rejected(t *testing.T) {
+	keyPair := newEd25519KeyPair(t)
+	keys, err := NewTokenKeys(TokenKeysConfig{SigningKey: keyPair.privatePEM})
+	assert.NoError(t, err)
+
+	claims := func(issuer, audience string) *Claims {
+		return &Claims{
+			UserID:    123,
+			SessionID: "session-1",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "token-1",
+				Issuer:    issuer,
+				Audience:  jwt.ClaimStrings{audience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}
+	}
+	sign := func(method jwt.SigningMethod, kid string, claims *Claims, key interface{}) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		assert.NoError(t, err)
+		return signed
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"wrong issuer", sign(jwt.SigningMethodEdDSA, keys.KeyID(), claims("other-api", DefaultTokenAudience), keyPair.private)},
+		{"wrong audience", sign(jwt.SigningMethodEdDSA, keys.KeyID(), claims(DefaultTokenIssuer, "other-api"), keyPair.private)},
+		{"unknown kid", sign(jwt.SigningMethodEdDSA, "unknown", claims(DefaultTokenIssuer, DefaultTokenAudience), keyPair.private)},
+		{"without kid", sign(jwt.SigningMethodEdDSA, "", claims(DefaultTokenIssuer, DefaultTokenAudience), keyPair.private)},
+		{"other key", sign(jwt.SigningMethodEdDSA, keys.KeyID(), claims(DefaultTokenIssuer, DefaultTokenAudience), newEd25519KeyPair(t).private)},
+		{"HS256 without secret", sign(jwt.SigningMethodHS256, "", claims(DefaultTokenIssuer, DefaultTokenAudience), []byte(testSecret))},
+		{"HS256 signed with the public key", sign(jwt.SigningMethodHS256, keys.KeyID(), claims(DefaultTokenIssuer, DefaultTokenAudience), keyPair.publicPEM)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := keys.validateToken(tt.token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestValidateToken_HS256Fallback(t *testing.T) {
+	hmac := hmacKeys(t)
+	issuedWithSecret := issueToken(t, hmac)
+
+	keys, err := NewTokenKeys(TokenKeysConfig{
+		Secret:     testSecret,
+		SigningKey: newEd25519KeyPair(t).privatePEM,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "EdDSA", keys.Algorithm(), "a signing key takes over from the secret")
+
+	_, err = keys.validateToken(issuedWithSecret)
+	assert.NoError(t, err, "HS256 tokens are still accepted while JWT_SECRET is set")
+
+	token, err := keys.IssueAccessToken(123, "testuser", nil, "session-1")
+	assert.NoError(t, err)
+	_, err = hmac.validateToken(token.Token)
+	assert.Error(t, err)
+}
+
+func TestNewTokenKeys_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config TokenKeysConfig
+		error  string
+	}{
+		{"no key", TokenKeysConfig{}, "JWT_SECRET is required when no signing key is configured"},
+		{"short secret", TokenKeysConfig{Secret: "short-secret"}, "JWT_SECRET must be at least 32 characters long"},
+		{"not PEM", TokenKeysConfig{SigningKey: []byte("not a key")}, "invalid signing key: no PEM block found"},
+		{"small RSA key", TokenKeysConfig{SigningKey: newRSAKeyPair(t, 1024).privatePEM}, "RSA keys must have at least 2048 bits"},
+		{"private verification key", TokenKeysConfig{Secret: testSecret, VerificationKeys: [][]byte{newEd25519KeyPair(t).privatePEM}}, "invalid verification key 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := NewTokenKeys(tt.config)
+
+			assert.Nil(t, keys)
+			assert.ErrorContains(t, err, tt.error)
+		})
+	}
+}
+
+func TestLoadTokenKeys(t *testing.T) {
+	previous := newEd25519KeyPair(t)
+	current := newRSAKeyPair(t, 2048)
+
+	t.Setenv("JWT_SECRET", "")
+	t.Setenv("JWT_SIGNING_KEY_FILE", writeKeyFile(t, "current.pem", current.privatePEM))
+	t.Setenv("JWT_VERIFICATION_KEY_FILES", writeKeyFile(t, "previous.pub.pem", previous.publicPEM)+", ")
+	t.Setenv("JWT_ISSUER", "https://pessoas.example.com")
+	t.Setenv("JWT_AUDIENCE", "pessoas-web")
+	t.Setenv("ACCESS_TOKEN_TTL", "5m")
+
+	keys, err := LoadTokenKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, "RS256", keys.Algorithm())
+	assert.Len(t, keys.JWKS().Keys, 2)
+
+	before := time.Now()
+	token, err := keys.IssueAccessToken(123, "testuser", nil, "session-1")
+	assert.NoError(t, err)
+	assert.WithinDuration(t, before.Add(5*time.Minute), token.ExpiresAt, 2*time.Second)
+
+	parsed, err := keys.validateToken(token.Token)
+	assert.NoError(t, err)
+	claims := parsed.Claims.(*Claims)
+	assert.Equal(t, "https://pessoas.example.com", claims.Issuer)
+	assert.Equal(t, jwt.ClaimStrings{"pessoas-web"}, claims.Audience)
+}
+
+func TestLoadTokenKeys_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   map[string]string
+		error string
+	}{
+		{"missing key file", map[string]string{"JWT_SIGNING_KEY_FILE": "/nonexistent/key.pem"}, "failed to read JWT_SIGNING_KEY_FILE"},
+		{"missing verification file", map[string]string{"JWT_VERIFICATION_KEY_FILES": "/nonexistent/key.pub.pem"}, "failed to read JWT_VERIFICATION_KEY_FILES"},
+		{"invalid TTL", map[string]string{"ACCESS_TOKEN_TTL": "soon"}, "ACCESS_TOKEN_TTL must be a positive duration"},
+		{"negative TTL", map[string]string{"ACCESS_TOKEN_TTL": "-5m"}, "ACCESS_TOKEN_TTL must be a positive duration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JWT_SECRET", testSecret)
+			t.Setenv("JWT_SIGNING_KEY_FILE", "")
+			t.Setenv("JWT_VERIFICATION_KEY_FILES", "")
+			t.Setenv("ACCESS_TOKEN_TTL", "")
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			keys, err := LoadTokenKeys()
+
+			assert.Nil(t, keys)
+			assert.ErrorContains(t, err, tt.error)
+		})
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	keys, err := NewTokenKeys(TokenKeysConfig{SigningKey: newEd25519KeyPair(t).privatePEM})
+	assert.NoError(t, err)
+
+	router := gin.New()
+	router.GET("/.well-known/jwks.json", JWKS(keys))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/.well-known/jwks.json", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+
+	var body JSONWebKeySet
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, keys.JWKS(), body)
+}
+
+func TestJWKS_HS256Only(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/.well-known/jwks.json", nil)
+
+	JWKS(hmacKeys(t))(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"keys":[]}`, w.Body.String(), "the secret is never published")
+}
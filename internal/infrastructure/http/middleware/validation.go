@@ -2,7 +2,9 @@ package middleware
 
 import (
 	"net/http"
+	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -79,10 +81,154 @@ func ValidatePagination() gin.HandlerFunc {
 			}
 		}
 
+		if includeDeleted := c.Query("include_deleted"); includeDeleted != "" {
+			if includeDeleted != "true" && includeDeleted != "false" {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error":   "invalid_parameter",
+					"message": "include_deleted must be 'true' or 'false'",
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		if pagination := c.Query("pagination"); pagination != "" && pagination != "offset" && pagination != "cursor" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": "pagination must be 'offset' or 'cursor'",
+			})
+			c.Abort()
+			return
+		}
+
+		if cursor := c.Query("cursor"); cursor != "" {
+			if !cursorPattern.MatchString(cursor) || c.Query("pagination") == "offset" || c.Query("page") != "" {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error":   "invalid_parameter",
+					"message": "Invalid cursor. Use next_cursor or prev_cursor from a previous response, without page",
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		if withTotal := c.Query("with_total"); withTotal != "" {
+			if withTotal != "true" && withTotal != "false" {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error":   "invalid_parameter",
+					"message": "with_total must be 'true' or 'false'",
+				})
+				c.Abort()
+				return
+			}
+		}
+
+		if message := validateFilterParams(c); message != "" {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid_parameter",
+				"message": message,
+			})
+			c.Abort()
+			return
+		}
+
 		c.Next()
 	}
 }
 
+// dateRangeParams lists the filter ranges, and whether their bounds may be full
+// RFC 3339 timestamps or only dates.
+var dateRangeParams = []struct {
+	from, to       string
+	allowTimestamp bool
+}{
+	{"birth_date_from", "birth_date_to", false},
+	{"created_from", "created_to", true},
+	{"updated_from", "updated_to", true},
+}
+
+// cursorPattern matches the base64url alphabet of opaque pagination cursors.
+var cursorPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,512}$`)
+
+var cpfPrefixPattern = regexp.MustCompile(`^[0-9.\-]{1,14}$`)
+
+// consentPurposes are the purposes the consent filter accepts.
+var consentPurposes = map[string]bool{
+	"marketing":    true,
+	"analytics":    true,
+	"data_sharing": true,
+}
+
+// validateFilterParams checks the person list filters and returns a message
+// describing the first invalid one, or an empty string when all are valid.
+func validateFilterParams(c *gin.Context) string {
+	if name := c.Query("name"); len(name) > 255 {
+		return "name filter too long (max: 255 characters)"
+	}
+
+	for _, param := range []string{"email_match", "phone_match"} {
+		if match := c.Query(param); match != "" && match != "exact" && match != "prefix" {
+			return param + " must be 'exact' or 'prefix'"
+		}
+	}
+
+	if cpfPrefix := c.Query("cpf_prefix"); cpfPrefix != "" && !cpfPrefixPattern.MatchString(cpfPrefix) {
+		return "cpf_prefix must contain only digits"
+	}
+
+	if consent := c.Query("consent"); consent != "" && !consentPurposes[consent] {
+		return "consent must be 'marketing', 'analytics' or 'data_sharing'"
+	}
+
+	for _, param := range dateRangeParams {
+		from, ok := parseDateParam(c.Query(param.from), param.allowTimestamp)
+		if !ok {
+			return param.from + " " + dateFormatMessage(param.allowTimestamp)
+		}
+
+		to, ok := parseDateParam(c.Query(param.to), param.allowTimestamp)
+		if !ok {
+			return param.to + " " + dateFormatMessage(param.allowTimestamp)
+		}
+		if to != nil && param.allowTimestamp && len(c.Query(param.to)) == len("2006-01-02") {
+			endOfDay := to.Add(24*time.Hour - time.Nanosecond)
+			to = &endOfDay
+		}
+
+		if from != nil && to != nil && from.After(*to) {
+			return param.from + " must not be after " + param.to
+		}
+	}
+
+	return ""
+}
+
+func parseDateParam(value string, allowTimestamp bool) (*time.Time, bool) {
+	if value == "" {
+		return nil, true
+	}
+
+	if allowTimestamp {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return &t, true
+		}
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, false
+	}
+
+	return &t, true
+}
+
+func dateFormatMessage(allowTimestamp bool) string {
+	if allowTimestamp {
+		return "must be a date (YYYY-MM-DD) or an RFC 3339 timestamp"
+	}
+	return "must be a date (YYYY-MM-DD)"
+}
+
 // SecurityHeaders adds security-related HTTP headers
 func SecurityHeaders() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -103,6 +103,20 @@ func TestValidatePagination_InvalidOrder(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "Order must be 'asc' or 'desc'")
 }
 
+func TestValidatePagination_InvalidIncludeDeleted(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/test?include_deleted=yes", nil)
+
+	ValidatePagination()(c)
+
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "include_deleted")
+}
+
 func TestSecurityHeaders(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -118,3 +132,77 @@ func TestSecurityHeaders(t *testing.T) {
 	assert.Equal(t, "max-age=31536000; includeSubDomains", w.Header().Get("Strict-Transport-Security"))
 	assert.Equal(t, "default-src 'self'", w.Header().Get("Content-Security-Policy"))
 }
+
+func TestValidatePagination_ValidFilters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/test?name=silva&email=gmail&email_match=prefix&phone=81&phone_match=prefix&cpf_prefix=111.444&birth_date_from=1990-01-01&birth_date_to=1999-12-31&created_from=2024-01-01T00:00:00Z&created_to=2024-01-01&consent=marketing", nil)
+
+	ValidatePagination()(c)
+
+	assert.False(t, c.IsAborted())
+}
+
+func TestValidatePagination_InvalidFilters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{"invalid email match", "email=a&email_match=contains", "email_match"},
+		{"invalid phone match", "phone=81&phone_match=suffix", "phone_match"},
+		{"non digit cpf prefix", "cpf_prefix=abc", "cpf_prefix"},
+		{"unknown consent purpose", "consent=profiling", "consent"},
+		{"timestamp birth date", "birth_date_from=1990-01-01T00:00:00Z", "birth_date_from"},
+		{"invalid created date", "created_to=01/02/2024", "created_to"},
+		{"inverted range", "updated_from=2024-02-01&updated_to=2024-01-01", "updated_from must not be after updated_to"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest("GET", "/test?"+tc.query, nil)
+
+			ValidatePagination()(c)
+
+			assert.True(t, c.IsAborted())
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), tc.expected)
+		})
+	}
+}
+
+func TestValidatePagination_CursorParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name  string
+		query string
+		valid bool
+	}{
+		{"cursor mode", "pagination=cursor&with_total=false", true},
+		{"cursor token", "cursor=eyJzIjoiaWQifQ", true},
+		{"invalid mode", "pagination=keyset", false},
+		{"invalid with_total", "with_total=no", false},
+		{"cursor with invalid characters", "cursor=abc%2Bdef", false},
+		{"cursor with page", "cursor=eyJzIjoiaWQifQ&page=2", false},
+		{"cursor in offset mode", "cursor=eyJzIjoiaWQifQ&pagination=offset", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest("GET", "/test?"+tc.query, nil)
+
+			ValidatePagination()(c)
+
+			assert.Equal(t, !tc.valid, c.IsAborted())
+		})
+	}
+}
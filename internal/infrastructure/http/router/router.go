@@ -1,6 +1,7 @@
 package router
 
 import (
+	operator "pessoas-api/internal/domain/operator/model"
 	"pessoas-api/internal/infrastructure/http/handler"
 	"pessoas-api/internal/infrastructure/http/middleware"
 
@@ -9,7 +10,7 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func SetupRouter(personHandler *handler.PersonHandler, authHandler *handler.AuthHandler) *gin.Engine {
+func SetupRouter(personHandler *handler.PersonHandler, authHandler *handler.AuthHandler, jobHandler *handler.JobHandler, addressHandler *handler.AddressHandler, contactHandler *handler.ContactHandler, companyHandler *handler.CompanyHandler, documentHandler *handler.DocumentHandler, identityDocumentHandler *handler.IdentityDocumentHandler, relationshipHandler *handler.RelationshipHandler, duplicateHandler *handler.DuplicateHandler, subjectRightsHandler *handler.SubjectRightsHandler, consentHandler *handler.ConsentHandler, operatorHandler *handler.OperatorHandler, tokenKeys *middleware.TokenKeys, revocations middleware.RevocationList) *gin.Engine {
 	router := gin.New()
 
 	router.Use(gin.Recovery())
@@ -21,6 +22,8 @@ func SetupRouter(personHandler *handler.PersonHandler, authHandler *handler.Auth
 	rateLimiter := middleware.NewRateLimiter(60)
 	router.Use(rateLimiter.RateLimit())
 
+	router.Use(middleware.RequestID())
+
 	router.Use(middleware.LoggerMiddleware())
 
 	// Public routes
@@ -30,6 +33,9 @@ func SetupRouter(personHandler *handler.PersonHandler, authHandler *handler.Auth
 		})
 	})
 
+	// Public keys that verify the access tokens
+	router.GET("/.well-known/jwks.json", middleware.JWKS(tokenKeys))
+
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	api := router.Group("/api")
@@ -41,25 +47,125 @@ func SetupRouter(personHandler *handler.PersonHandler, authHandler *handler.Auth
 			{
 				auth.POST("/register", authHandler.Register)
 				auth.POST("/login", authHandler.Login)
+				auth.POST("/refresh", authHandler.Refresh)
 			}
 
 			// Protected routes (JWT required)
 			protected := v1.Group("")
-			protected.Use(middleware.JWTAuth())
+			protected.Use(middleware.JWTAuth(tokenKeys, revocations))
 			{
+				// Any operator can end its own sessions
+				protected.POST("/auth/logout", authHandler.Logout)
+				protected.POST("/auth/logout-all", authHandler.LogoutAll)
+
+				// Each route requires the permission granted by the roles of the operator
+				read := middleware.RequirePermission(operator.PermissionRegistryRead)
+				write := middleware.RequirePermission(operator.PermissionRegistryWrite)
+				auditRead := middleware.RequirePermission(operator.PermissionAuditRead)
+				report := middleware.RequirePermission(operator.PermissionSubjectReport)
+				anonymize := middleware.RequirePermission(operator.PermissionSubjectAnonymize)
+				manageOperators := middleware.RequirePermission(operator.PermissionOperatorManage)
+				// Exported files carry personal data in full
+				readPII := middleware.RequirePermission(operator.PermissionPIIRead)
+				revealPII := middleware.RequirePermission(operator.PermissionPIIReveal)
+
 				persons := protected.Group("/persons")
 				{
-					persons.POST("", personHandler.CreatePerson)
-					persons.PUT("/:id", personHandler.UpdatePerson)
-					persons.DELETE("/:id", personHandler.DeletePerson)
-					persons.GET("/cpf/:cpf", personHandler.FindPersonByCPF)
+					persons.POST("", write, personHandler.CreatePerson)
+					persons.POST("/import", write, personHandler.ImportPersons)
+					persons.POST("/merge", write, duplicateHandler.MergePersons)
+					persons.GET("/export", read, readPII, middleware.ValidatePagination(), personHandler.ExportPersons)
+					persons.POST("/export", read, readPII, middleware.ValidatePagination(), personHandler.ExportPersonsAsync)
+					persons.GET("/:id", read, personHandler.GetPerson)
+					persons.POST("/:id/reveal", revealPII, personHandler.RevealPerson)
+					persons.PUT("/:id", write, personHandler.UpdatePerson)
+					persons.PATCH("/:id", write, personHandler.PatchPerson)
+					persons.DELETE("/:id", write, personHandler.DeletePerson)
+					persons.POST("/:id/restore", write, personHandler.RestorePerson)
+					persons.GET("/:id/history", auditRead, middleware.ValidatePagination(), personHandler.GetPersonHistory)
+					persons.GET("/cpf/:cpf", read, personHandler.FindPersonByCPF)
+					persons.GET("/contact/:value", read, personHandler.FindPersonsByContact)
+					persons.GET("/document/:number", read, identityDocumentHandler.FindPersonsByDocument)
+					persons.GET("/documents/expiring", read, middleware.ValidatePagination(), identityDocumentHandler.ListExpiringDocuments)
+
+					persons.GET("/:id/addresses", read, addressHandler.ListAddresses)
+					persons.POST("/:id/addresses", write, addressHandler.CreateAddress)
+					persons.GET("/:id/addresses/:addressId", read, addressHandler.GetAddress)
+					persons.PUT("/:id/addresses/:addressId", write, addressHandler.UpdateAddress)
+					persons.DELETE("/:id/addresses/:addressId", write, addressHandler.DeleteAddress)
+
+					persons.GET("/:id/contacts", read, contactHandler.ListContacts)
+					persons.POST("/:id/contacts", write, contactHandler.CreateContact)
+					persons.GET("/:id/contacts/:contactId", read, contactHandler.GetContact)
+					persons.PUT("/:id/contacts/:contactId", write, contactHandler.UpdateContact)
+					persons.DELETE("/:id/contacts/:contactId", write, contactHandler.DeleteContact)
+
+					persons.GET("/:id/documents", read, identityDocumentHandler.ListDocuments)
+					persons.POST("/:id/documents", write, identityDocumentHandler.CreateDocument)
+					persons.GET("/:id/documents/:documentId", read, identityDocumentHandler.GetDocument)
+					persons.PUT("/:id/documents/:documentId", write, identityDocumentHandler.UpdateDocument)
+					persons.DELETE("/:id/documents/:documentId", write, identityDocumentHandler.DeleteDocument)
+
+					persons.GET("/:id/relationships", read, relationshipHandler.ListRelationships)
+					persons.POST("/:id/relationships", write, relationshipHandler.CreateRelationship)
+					persons.GET("/:id/relationships/:relationshipId", read, relationshipHandler.GetRelationship)
+					persons.DELETE("/:id/relationships/:relationshipId", write, relationshipHandler.DeleteRelationship)
+					persons.GET("/:id/family", read, relationshipHandler.GetFamilyGraph)
+
+					persons.GET("/:id/duplicates", read, duplicateHandler.FindDuplicates)
+
+					persons.GET("/:id/consents", read, consentHandler.ListConsents)
+					persons.POST("/:id/consents", write, consentHandler.GrantConsent)
+					persons.GET("/:id/consents/history", read, consentHandler.ConsentHistory)
+					persons.GET("/:id/consents/:purpose", read, consentHandler.GetConsent)
+					persons.POST("/:id/consents/:purpose/revoke", write, consentHandler.RevokeConsent)
 
 					personsList := persons.Group("")
 					personsList.Use(middleware.ValidatePagination())
 					{
-						personsList.GET("", personHandler.ListPersons)
+						personsList.GET("", read, personHandler.ListPersons)
 					}
 				}
+
+				companies := protected.Group("/companies")
+				{
+					companies.POST("", write, companyHandler.CreateCompany)
+					companies.GET("", read, middleware.ValidatePagination(), companyHandler.ListCompanies)
+					companies.GET("/:id", read, companyHandler.GetCompany)
+					companies.PUT("/:id", write, companyHandler.UpdateCompany)
+					companies.DELETE("/:id", write, companyHandler.DeleteCompany)
+					companies.GET("/cnpj/:cnpj", read, companyHandler.FindCompanyByCNPJ)
+				}
+
+				protected.GET("/documents/:document", read, documentHandler.LookupDocument)
+
+				dataSubjects := protected.Group("/data-subjects")
+				{
+					dataSubjects.POST("/access", report, subjectRightsHandler.AccessReport)
+					dataSubjects.POST("/portability", report, subjectRightsHandler.PortabilityExport)
+					dataSubjects.POST("/anonymize", anonymize, subjectRightsHandler.AnonymizeSubject)
+					dataSubjects.GET("/requests", auditRead, middleware.ValidatePagination(), subjectRightsHandler.ListSubjectRequests)
+				}
+
+				jobs := protected.Group("/jobs")
+				{
+					jobs.GET("/:id", read, jobHandler.GetJob)
+					jobs.DELETE("/:id", write, jobHandler.CancelJob)
+					jobs.POST("/:id/retry", write, jobHandler.RetryJob)
+					jobs.GET("/:id/result", read, readPII, jobHandler.GetJobResult)
+				}
+
+				protected.GET("/postal-codes/:cep", read, addressHandler.LookupPostalCode)
+
+				operators := protected.Group("/operators")
+				operators.Use(manageOperators)
+				{
+					operators.GET("", middleware.ValidatePagination(), operatorHandler.ListOperators)
+					operators.GET("/:id", operatorHandler.GetOperator)
+					operators.PUT("/:id/roles", operatorHandler.AssignRoles)
+					operators.POST("/:id/deactivate", operatorHandler.DeactivateOperator)
+					operators.POST("/:id/activate", operatorHandler.ActivateOperator)
+				}
 			}
 		}
 	}
@@ -0,0 +1,196 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	auditModel "pessoas-api/internal/domain/audit/model"
+	"pessoas-api/internal/infrastructure/encryption"
+)
+
+// AuditEntity is a row of people.audit_log. Snapshots hold personal data:
+// when encryption is enabled they are stored in BeforeEncrypted and
+// AfterEncrypted under the row's data key, while BeforeData and AfterData
+// keep only their references (see auditModel.RedactSnapshot), which is what
+// SubjectScope queries. Rows written before encryption was enabled keep the
+// snapshots in plaintext until they are re-encrypted.
+type AuditEntity struct {
+	ID              int       `gorm:"column:id;primaryKey;autoIncrement"`
+	EntityType      string    `gorm:"column:entity_type;type:varchar(50);not null;index:idx_audit_log_entity"`
+	EntityID        int       `gorm:"column:entity_id;not null;index:idx_audit_log_entity"`
+	Action          string    `gorm:"column:action;type:varchar(20);not null"`
+	OperatorID      int       `gorm:"column:operator_id;not null"`
+	RequestID       string    `gorm:"column:request_id;type:varchar(64)"`
+	ClientIP        string    `gorm:"column:client_ip;type:varchar(45)"`
+	BeforeData      *string   `gorm:"column:before_data;type:jsonb"`
+	AfterData       *string   `gorm:"column:after_data;type:jsonb"`
+	KeyID           *string   `gorm:"column:key_id;type:varchar(32);index"`
+	DataKey         *string   `gorm:"column:data_key;type:text"`
+	BeforeEncrypted *string   `gorm:"column:before_encrypted;type:text"`
+	AfterEncrypted  *string   `gorm:"column:after_encrypted;type:text"`
+	CreatedAt       time.Time `gorm:"column:created_at;type:timestamp;not null"`
+}
+
+// ErrEncryptionDisabled is returned when reading an encrypted row while no
+// encryption key is configured.
+var ErrEncryptionDisabled = errors.New("audit snapshots are encrypted but no encryption key is configured")
+
+// SnapshotColumns are the columns written by Seal, always together.
+var SnapshotColumns = []string{"before_data", "after_data", "key_id", "data_key", "before_encrypted", "after_encrypted"}
+
+// snapshotFields name the snapshots in their encryption, binding each
+// ciphertext to its column.
+var snapshotFields = [2]string{"before", "after"}
+
+func (AuditEntity) TableName() string {
+	return "people.audit_log"
+}
+
+// ToDomain converts the row, decrypting its snapshots with cipher. Rows in
+// plaintext are read without a cipher.
+func (e *AuditEntity) ToDomain(cipher *encryption.Cipher) (*auditModel.AuditEntry, error) {
+	before, after, err := e.Open(cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditModel.AuditEntry{
+		ID:         e.ID,
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		Action:     e.Action,
+		OperatorID: e.OperatorID,
+		RequestID:  e.RequestID,
+		ClientIP:   e.ClientIP,
+		Before:     before,
+		After:      after,
+		CreatedAt:  e.CreatedAt,
+	}, nil
+}
+
+// FromDomain converts the entry to a row. With a cipher its snapshots are
+// encrypted under a new data key; without one they are kept in plaintext.
+func FromDomain(a *auditModel.AuditEntry, cipher *encryption.Cipher) (*AuditEntity, error) {
+	entity := &AuditEntity{
+		ID:         a.ID,
+		EntityType: a.EntityType,
+		EntityID:   a.EntityID,
+		Action:     a.Action,
+		OperatorID: a.OperatorID,
+		RequestID:  a.RequestID,
+		ClientIP:   a.ClientIP,
+		CreatedAt:  a.CreatedAt,
+	}
+
+	if err := entity.Seal(cipher, a.Before, a.After); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// Seal writes the snapshots to the row. Without a cipher they go to the
+// plaintext columns. With one they are encrypted under a fresh data key
+// wrapped by the current key, and the plaintext columns keep only their
+// references, so the entry can still be found by person.
+func (e *AuditEntity) Seal(cipher *encryption.Cipher, before, after json.RawMessage) error {
+	if cipher == nil {
+		e.BeforeData, e.AfterData = columnFromRaw(before), columnFromRaw(after)
+		e.KeyID, e.DataKey, e.BeforeEncrypted, e.AfterEncrypted = nil, nil, nil, nil
+		return nil
+	}
+
+	key, err := cipher.NewDataKey()
+	if err != nil {
+		return err
+	}
+
+	var sealed [2]*string
+	for i, snapshot := range []json.RawMessage{before, after} {
+		if len(snapshot) == 0 {
+			continue
+		}
+		value, err := key.Seal(snapshotFields[i], string(snapshot))
+		if err != nil {
+			return fmt.Errorf("failed to encrypt audit snapshot: %w", err)
+		}
+		sealed[i] = &value
+	}
+
+	e.BeforeData = columnFromRaw(auditModel.RedactSnapshot(before))
+	e.AfterData = columnFromRaw(auditModel.RedactSnapshot(after))
+	e.KeyID, e.DataKey = &key.KeyID, &key.Wrapped
+	e.BeforeEncrypted, e.AfterEncrypted = sealed[0], sealed[1]
+	return nil
+}
+
+// Open reads back the snapshots of the row, decrypting them when the row
+// holds a data key.
+func (e *AuditEntity) Open(cipher *encryption.Cipher) (before, after json.RawMessage, err error) {
+	if e.DataKey == nil || e.KeyID == nil {
+		return rawFromColumn(e.BeforeData), rawFromColumn(e.AfterData), nil
+	}
+
+	if cipher == nil {
+		return nil, nil, fmt.Errorf("audit entry %d: %w", e.ID, ErrEncryptionDisabled)
+	}
+
+	key, err := cipher.OpenDataKey(*e.KeyID, *e.DataKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decrypt audit entry %d: %w", e.ID, err)
+	}
+
+	var opened [2]json.RawMessage
+	for i, sealed := range []*string{e.BeforeEncrypted, e.AfterEncrypted} {
+		if sealed == nil {
+			continue
+		}
+		value, err := key.Open(snapshotFields[i], *sealed)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decrypt audit entry %d: %w", e.ID, err)
+		}
+		opened[i] = json.RawMessage(value)
+	}
+
+	return opened[0], opened[1], nil
+}
+
+// SnapshotUpdates returns the snapshot columns of the row, as written by
+// Seal, for map updates.
+func (e *AuditEntity) SnapshotUpdates() map[string]interface{} {
+	return map[string]interface{}{
+		"before_data":      e.BeforeData,
+		"after_data":       e.AfterData,
+		"key_id":           e.KeyID,
+		"data_key":         e.DataKey,
+		"before_encrypted": e.BeforeEncrypted,
+		"after_encrypted":  e.AfterEncrypted,
+	}
+}
+
+// RedactedUpdates returns the updates stripping the snapshots of the row down
+// to their references, dropping the encrypted copies along with them.
+func (e *AuditEntity) RedactedUpdates() map[string]interface{} {
+	redacted := &AuditEntity{
+		BeforeData: columnFromRaw(auditModel.RedactSnapshot(rawFromColumn(e.BeforeData))),
+		AfterData:  columnFromRaw(auditModel.RedactSnapshot(rawFromColumn(e.AfterData))),
+	}
+	return redacted.SnapshotUpdates()
+}
+
+func rawFromColumn(value *string) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+	return json.RawMessage(*value)
+}
+
+func columnFromRaw(raw json.RawMessage) *string {
+	if len(raw) == 0 {
+		return nil
+	}
+	value := string(raw)
+	return &value
+}
@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"fmt"
+
+	auditModel "pessoas-api/internal/domain/audit/model"
+	"pessoas-api/internal/domain/audit/ports"
+	"pessoas-api/internal/infrastructure/encryption"
+
+	"gorm.io/gorm"
+)
+
+// AuditRepositoryImpl implements the ports.AuditRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type AuditRepositoryImpl struct {
+	db     *gorm.DB
+	cipher *encryption.Cipher
+}
+
+// NewAuditRepository creates a new instance of AuditRepositoryImpl.
+// It returns the implementation as the AuditRepository interface. With a
+// cipher the snapshots are encrypted at rest; a nil cipher keeps them in
+// plaintext.
+func NewAuditRepository(db *gorm.DB, cipher *encryption.Cipher) ports.AuditRepository {
+	return &AuditRepositoryImpl{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+func (r *AuditRepositoryImpl) Save(entry *auditModel.AuditEntry) error {
+	entity, err := FromDomain(entry, r.cipher)
+	if err != nil {
+		return fmt.Errorf("failed to save audit entry: %w", err)
+	}
+
+	result := r.db.Create(entity)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save audit entry: %w", result.Error)
+	}
+
+	entry.ID = entity.ID
+
+	return nil
+}
+
+func (r *AuditRepositoryImpl) SaveAll(entries []*auditModel.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	entities := make([]*AuditEntity, len(entries))
+	for i, entry := range entries {
+		entity, err := FromDomain(entry, r.cipher)
+		if err != nil {
+			return fmt.Errorf("failed to save audit entries: %w", err)
+		}
+		entities[i] = entity
+	}
+
+	result := r.db.CreateInBatches(entities, 500)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save audit entries: %w", result.Error)
+	}
+
+	for i, entity := range entities {
+		entries[i].ID = entity.ID
+	}
+
+	return nil
+}
+
+func (r *AuditRepositoryImpl) FindByEntity(entityType string, entityID int, page, pageSize int) ([]*auditModel.AuditEntry, int64, error) {
+	return r.FindByEntities(entityType, []int{entityID}, page, pageSize)
+}
+
+func (r *AuditRepositoryImpl) FindByEntities(entityType string, entityIDs []int, page, pageSize int) ([]*auditModel.AuditEntry, int64, error) {
+	var entities []AuditEntity
+	var total int64
+
+	query := r.db.Model(&AuditEntity{}).Where("entity_type = ? AND entity_id IN ?", entityType, entityIDs)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+
+	result := query.Order("created_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&entities)
+	if result.Error != nil {
+		return nil, 0, fmt.Errorf("failed to find audit entries: %w", result.Error)
+	}
+
+	entries, err := r.toDomain(entities)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+func (r *AuditRepositoryImpl) FindBySubject(personIDs []int) ([]*auditModel.AuditEntry, error) {
+	var entities []AuditEntity
+
+	if len(personIDs) == 0 {
+		return []*auditModel.AuditEntry{}, nil
+	}
+
+	result := SubjectScope(r.db.Model(&AuditEntity{}), personIDs).Order("created_at DESC, id DESC").Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find audit entries of subject: %w", result.Error)
+	}
+
+	entries, err := r.toDomain(entities)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// SubjectScope restricts a query to the audit entries about the given persons:
+// the changes to the persons themselves and to the records owned by or linking
+// them, found through the person_id and related_person_id of their snapshots.
+func SubjectScope(db *gorm.DB, personIDs []int) *gorm.DB {
+	owner := "CAST(COALESCE(after_data, before_data) ->> 'person_id' AS INTEGER)"
+	related := "CAST(COALESCE(after_data, before_data) ->> 'related_person_id' AS INTEGER)"
+	owned := []string{auditModel.EntityContact, auditModel.EntityAddress, auditModel.EntityDocument, auditModel.EntityRelationship}
+
+	return db.Where(
+		db.Session(&gorm.Session{NewDB: true}).
+			Where("entity_type = ? AND entity_id IN ?", auditModel.EntityPerson, personIDs).
+			Or("entity_type IN ? AND "+owner+" IN ?", owned, personIDs).
+			Or("entity_type = ? AND "+related+" IN ?", auditModel.EntityRelationship, personIDs),
+	)
+}
+
+func (r *AuditRepositoryImpl) toDomain(entities []AuditEntity) ([]*auditModel.AuditEntry, error) {
+	entries := make([]*auditModel.AuditEntry, len(entities))
+	for i := range entities {
+		entry, err := entities[i].ToDomain(r.cipher)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read audit entries: %w", err)
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
@@ -0,0 +1,216 @@
+package audit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	auditModel "pessoas-api/internal/domain/audit/model"
+	"pessoas-api/internal/infrastructure/encryption"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupAuditDB creates the people schema in memory with the audit_log table.
+func setupAuditDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database instance: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	statements := []string{
+		`ATTACH DATABASE ':memory:' AS people`,
+		`CREATE TABLE people.audit_log (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			entity_type VARCHAR(50) NOT NULL,
+			entity_id INTEGER NOT NULL,
+			action VARCHAR(20) NOT NULL,
+			operator_id INTEGER NOT NULL,
+			request_id VARCHAR(64),
+			client_ip VARCHAR(45),
+			before_data TEXT,
+			after_data TEXT,
+			key_id VARCHAR(32),
+			data_key TEXT,
+			before_encrypted TEXT,
+			after_encrypted TEXT,
+			created_at TIMESTAMP NOT NULL
+		)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare people schema: %v", err)
+		}
+	}
+
+	return db
+}
+
+func TestAuditRepositoryImpl_Save(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAuditRepository(setupAuditDB(t), nil)
+
+	entry := auditModel.NewAuditEntry(
+		auditModel.EntityPerson, 5, auditModel.ActionCreate,
+		auditModel.Actor{OperatorID: 7, RequestID: "req-123", ClientIP: "203.0.113.10"},
+		nil, json.RawMessage(`{"id":5}`),
+	)
+
+	err := repo.Save(entry)
+
+	assert.NoError(err)
+	assert.NotZero(entry.ID)
+
+	entries, total, err := repo.FindByEntity(auditModel.EntityPerson, 5, 1, 10)
+
+	assert.NoError(err)
+	assert.Equal(int64(1), total)
+	assert.Equal(7, entries[0].OperatorID)
+	assert.Equal("req-123", entries[0].RequestID)
+	assert.Equal("203.0.113.10", entries[0].ClientIP)
+	assert.Nil(entries[0].Before)
+	assert.JSONEq(`{"id":5}`, string(entries[0].After))
+}
+
+func TestAuditRepositoryImpl_FindByEntity_NewestFirstAndPaginated(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAuditRepository(setupAuditDB(t), nil)
+
+	base := time.Now()
+	for i, action := range []string{auditModel.ActionCreate, auditModel.ActionUpdate, auditModel.ActionDelete} {
+		entry := auditModel.NewAuditEntry(auditModel.EntityPerson, 5, action, auditModel.Actor{OperatorID: 1}, nil, nil)
+		entry.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		assert.NoError(repo.Save(entry))
+	}
+	assert.NoError(repo.Save(auditModel.NewAuditEntry(auditModel.EntityPerson, 6, auditModel.ActionCreate, auditModel.Actor{OperatorID: 1}, nil, nil)))
+
+	firstPage, total, err := repo.FindByEntity(auditModel.EntityPerson, 5, 1, 2)
+
+	assert.NoError(err)
+	assert.Equal(int64(3), total)
+	assert.Len(firstPage, 2)
+	assert.Equal(auditModel.ActionDelete, firstPage[0].Action)
+	assert.Equal(auditModel.ActionUpdate, firstPage[1].Action)
+
+	secondPage, _, err := repo.FindByEntity(auditModel.EntityPerson, 5, 2, 2)
+
+	assert.NoError(err)
+	assert.Len(secondPage, 1)
+	assert.Equal(auditModel.ActionCreate, secondPage[0].Action)
+}
+
+func TestAuditRepositoryImpl_FindByEntities_MergesTrails(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAuditRepository(setupAuditDB(t), nil)
+
+	base := time.Now()
+	for i, id := range []int{5, 6, 7, 5} {
+		entry := auditModel.NewAuditEntry(auditModel.EntityPerson, id, auditModel.ActionUpdate, auditModel.Actor{OperatorID: 1}, nil, nil)
+		entry.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		assert.NoError(repo.Save(entry))
+	}
+
+	entries, total, err := repo.FindByEntities(auditModel.EntityPerson, []int{5, 6}, 1, 10)
+
+	assert.NoError(err)
+	assert.Equal(int64(3), total)
+	assert.Len(entries, 3)
+	assert.Equal(5, entries[0].EntityID)
+	assert.Equal(6, entries[1].EntityID)
+	assert.Equal(5, entries[2].EntityID)
+}
+
+func TestAuditRepositoryImpl_FindBySubject(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAuditRepository(setupAuditDB(t), nil)
+	actor := auditModel.Actor{OperatorID: 1}
+
+	base := time.Now()
+	entries := []*auditModel.AuditEntry{
+		auditModel.NewAuditEntry(auditModel.EntityPerson, 5, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":5}`)),
+		auditModel.NewAuditEntry(auditModel.EntityPerson, 6, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":6}`)),
+		auditModel.NewAuditEntry(auditModel.EntityAddress, 10, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":10,"person_id":5}`)),
+		auditModel.NewAuditEntry(auditModel.EntityDocument, 11, auditModel.ActionDelete, actor, json.RawMessage(`{"id":11,"person_id":5}`), nil),
+		auditModel.NewAuditEntry(auditModel.EntityContact, 12, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":12,"person_id":6}`)),
+		auditModel.NewAuditEntry(auditModel.EntityRelationship, 13, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":13,"person_id":6,"related_person_id":5}`)),
+		auditModel.NewAuditEntry(auditModel.EntityCompany, 5, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":5}`)),
+	}
+	for i, entry := range entries {
+		entry.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		assert.NoError(repo.Save(entry))
+	}
+
+	found, err := repo.FindBySubject([]int{5})
+
+	assert.NoError(err)
+	assert.Len(found, 4)
+	assert.Equal(auditModel.EntityRelationship, found[0].EntityType)
+	assert.Equal(auditModel.EntityDocument, found[1].EntityType)
+	assert.Equal(auditModel.EntityAddress, found[2].EntityType)
+	assert.Equal(auditModel.EntityPerson, found[3].EntityType)
+
+	none, err := repo.FindBySubject(nil)
+
+	assert.NoError(err)
+	assert.Empty(none)
+}
+
+func newTestCipher(t *testing.T) *encryption.Cipher {
+	key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	provider, err := encryption.NewLocalKeyProvider("k1:"+key, key)
+	if err != nil {
+		t.Fatalf("failed to create key provider: %v", err)
+	}
+	return encryption.NewCipher(provider)
+}
+
+func TestAuditRepositoryImpl_Encrypted_StoresOnlyReferencesInPlaintext(t *testing.T) {
+	assert := assert.New(t)
+	db := setupAuditDB(t)
+	cipher := newTestCipher(t)
+	repo := NewAuditRepository(db, cipher)
+	actor := auditModel.Actor{OperatorID: 1}
+
+	before := json.RawMessage(`{"id":5,"cpf":"11144477735","email":"ana@example.com"}`)
+	after := json.RawMessage(`{"id":5,"cpf":"11144477735","email":"ana.souza@example.com"}`)
+	assert.NoError(repo.Save(auditModel.NewAuditEntry(auditModel.EntityPerson, 5, auditModel.ActionUpdate, actor, before, after)))
+	assert.NoError(repo.SaveAll([]*auditModel.AuditEntry{
+		auditModel.NewAuditEntry(auditModel.EntityContact, 12, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":12,"person_id":5,"value":"+5581912345678"}`)),
+	}))
+
+	var stored []AuditEntity
+	assert.NoError(db.Order("id").Find(&stored).Error)
+	assert.Len(stored, 2)
+	assert.JSONEq(`{"id":5}`, *stored[0].BeforeData)
+	assert.JSONEq(`{"id":5}`, *stored[0].AfterData)
+	assert.NotContains(*stored[0].AfterEncrypted, "11144477735")
+	assert.Equal("k1", *stored[0].KeyID)
+	assert.Nil(stored[1].BeforeData)
+	assert.Nil(stored[1].BeforeEncrypted)
+	assert.JSONEq(`{"id":12,"person_id":5}`, *stored[1].AfterData)
+
+	entries, _, err := repo.FindByEntity(auditModel.EntityPerson, 5, 1, 10)
+	assert.NoError(err)
+	assert.JSONEq(string(before), string(entries[0].Before))
+	assert.JSONEq(string(after), string(entries[0].After))
+
+	subject, err := repo.FindBySubject([]int{5})
+	assert.NoError(err)
+	assert.Len(subject, 2, "the references left in plaintext still scope the subject")
+	assert.JSONEq(`{"id":12,"person_id":5,"value":"+5581912345678"}`, string(subject[0].After))
+
+	_, _, err = NewAuditRepository(db, nil).FindByEntity(auditModel.EntityPerson, 5, 1, 10)
+	assert.ErrorIs(err, ErrEncryptionDisabled)
+}
@@ -0,0 +1,67 @@
+package company
+
+import (
+	"time"
+
+	companyModel "pessoas-api/internal/domain/company/model"
+
+	"gorm.io/gorm"
+)
+
+// CompanyEntity is a row of people.company.
+type CompanyEntity struct {
+	ID                int            `gorm:"column:id;primaryKey;autoIncrement"`
+	CNPJ              string         `gorm:"column:cnpj;type:varchar(14);not null;uniqueIndex:idx_company_cnpj_active,where:deleted_at IS NULL"`
+	LegalName         string         `gorm:"column:legal_name;type:varchar(255);not null"`
+	TradeName         string         `gorm:"column:trade_name;type:varchar(255);not null;default:''"`
+	FoundingDate      time.Time      `gorm:"column:founding_date;type:date;not null"`
+	StateRegistration string         `gorm:"column:state_registration;type:varchar(14);not null;default:''"`
+	Version           int            `gorm:"column:version;not null;default:1"`
+	CreatedAt         time.Time      `gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt         time.Time      `gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt         gorm.DeletedAt `gorm:"column:deleted_at;type:timestamp;index"`
+}
+
+func (CompanyEntity) TableName() string {
+	return "people.company"
+}
+
+func (e *CompanyEntity) ToDomain() *companyModel.Company {
+	var deletedAt *time.Time
+	if e.DeletedAt.Valid {
+		deletedAt = &e.DeletedAt.Time
+	}
+
+	return &companyModel.Company{
+		ID:                e.ID,
+		CNPJ:              e.CNPJ,
+		LegalName:         e.LegalName,
+		TradeName:         e.TradeName,
+		FoundingDate:      e.FoundingDate,
+		StateRegistration: e.StateRegistration,
+		Version:           e.Version,
+		CreatedAt:         e.CreatedAt,
+		UpdatedAt:         e.UpdatedAt,
+		DeletedAt:         deletedAt,
+	}
+}
+
+func FromDomain(c *companyModel.Company) *CompanyEntity {
+	var deletedAt gorm.DeletedAt
+	if c.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *c.DeletedAt, Valid: true}
+	}
+
+	return &CompanyEntity{
+		ID:                c.ID,
+		CNPJ:              c.CNPJ,
+		LegalName:         c.LegalName,
+		TradeName:         c.TradeName,
+		FoundingDate:      c.FoundingDate,
+		StateRegistration: c.StateRegistration,
+		Version:           c.Version,
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+		DeletedAt:         deletedAt,
+	}
+}
@@ -0,0 +1,161 @@
+package company
+
+import (
+	"fmt"
+	"time"
+
+	companyError "pessoas-api/internal/domain/company/error"
+	companyModel "pessoas-api/internal/domain/company/model"
+	"pessoas-api/internal/domain/company/ports"
+
+	"gorm.io/gorm"
+)
+
+// CompanyRepositoryImpl implements the ports.CompanyRepository interface.
+type CompanyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCompanyRepository creates a new instance of CompanyRepositoryImpl.
+// It returns the implementation as the CompanyRepository interface.
+func NewCompanyRepository(db *gorm.DB) ports.CompanyRepository {
+	return &CompanyRepositoryImpl{
+		db: db,
+	}
+}
+
+var sortableColumns = map[string]string{
+	"id":            "id",
+	"legal_name":    "legal_name",
+	"trade_name":    "trade_name",
+	"cnpj":          "cnpj",
+	"founding_date": "founding_date",
+	"created_at":    "created_at",
+}
+
+func (r *CompanyRepositoryImpl) Save(c *companyModel.Company) (int, error) {
+	entity := FromDomain(c)
+
+	result := r.db.Create(entity)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to save company: %w", result.Error)
+	}
+
+	return entity.ID, nil
+}
+
+func (r *CompanyRepositoryImpl) Update(c *companyModel.Company) error {
+	entity := FromDomain(c)
+	entity.Version = c.Version + 1
+
+	// Select every column so that emptied optional fields are written too.
+	result := r.db.Model(&CompanyEntity{}).Where("id = ? AND version = ?", entity.ID, c.Version).
+		Select("cnpj", "legal_name", "trade_name", "founding_date", "state_registration", "version", "updated_at").
+		Updates(entity)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update company: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return r.writeMissError(entity.ID)
+	}
+
+	c.Version = entity.Version
+
+	return nil
+}
+
+func (r *CompanyRepositoryImpl) Delete(id int, version int) error {
+	result := r.db.Model(&CompanyEntity{}).Where("id = ? AND version = ?", id, version).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"version":    version + 1,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete company: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return r.writeMissError(id)
+	}
+
+	return nil
+}
+
+func (r *CompanyRepositoryImpl) FindAll(page, pageSize int, sortBy, sortOrder string) ([]*companyModel.Company, int64, error) {
+	var entities []CompanyEntity
+	var total int64
+
+	offset := (page - 1) * pageSize
+
+	if err := r.db.Model(&CompanyEntity{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count companies: %w", err)
+	}
+
+	result := r.db.Offset(offset).Limit(pageSize).Order(buildOrderClause(sortBy, sortOrder)).Find(&entities)
+	if result.Error != nil {
+		return nil, 0, fmt.Errorf("failed to find companies: %w", result.Error)
+	}
+
+	companies := make([]*companyModel.Company, len(entities))
+	for i := range entities {
+		companies[i] = entities[i].ToDomain()
+	}
+
+	return companies, total, nil
+}
+
+func (r *CompanyRepositoryImpl) FindByID(id int) (*companyModel.Company, error) {
+	return r.findOne(r.db, "id = ?", id)
+}
+
+func (r *CompanyRepositoryImpl) FindByIDIncludingDeleted(id int) (*companyModel.Company, error) {
+	return r.findOne(r.db.Unscoped(), "id = ?", id)
+}
+
+func (r *CompanyRepositoryImpl) FindByCNPJ(cnpj string) (*companyModel.Company, error) {
+	return r.findOne(r.db, "cnpj = ?", cnpj)
+}
+
+func (r *CompanyRepositoryImpl) findOne(db *gorm.DB, query string, arg any) (*companyModel.Company, error) {
+	var entity CompanyEntity
+
+	result := db.Where(query, arg).First(&entity)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find company: %w", result.Error)
+	}
+
+	return entity.ToDomain(), nil
+}
+
+// writeMissError explains why a versioned write matched no row: either the
+// company does not exist (or was deleted) or its version has moved on.
+func (r *CompanyRepositoryImpl) writeMissError(id int) error {
+	var count int64
+
+	if err := r.db.Model(&CompanyEntity{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check company version: %w", err)
+	}
+
+	if count == 0 {
+		return companyError.ErrCompanyNotFound
+	}
+
+	return companyError.ErrVersionConflict
+}
+
+func buildOrderClause(sortBy, sortOrder string) string {
+	field, exists := sortableColumns[sortBy]
+	if !exists {
+		field = "id"
+	}
+
+	order := "DESC"
+	if sortOrder == "asc" || sortOrder == "ASC" {
+		order = "ASC"
+	}
+
+	return fmt.Sprintf("%s %s", field, order)
+}
@@ -0,0 +1,185 @@
+package company
+
+import (
+	"testing"
+	"time"
+
+	companyError "pessoas-api/internal/domain/company/error"
+	companyModel "pessoas-api/internal/domain/company/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupCompanyDB creates the people schema in memory with the company table.
+func setupCompanyDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database instance: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	statements := []string{
+		`ATTACH DATABASE ':memory:' AS people`,
+		`CREATE TABLE people.company (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			cnpj VARCHAR(14) NOT NULL,
+			legal_name VARCHAR(255) NOT NULL,
+			trade_name VARCHAR(255) NOT NULL DEFAULT '',
+			founding_date DATE NOT NULL,
+			state_registration VARCHAR(14) NOT NULL DEFAULT '',
+			version INTEGER NOT NULL DEFAULT 1,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			deleted_at TIMESTAMP
+		)`,
+		`CREATE UNIQUE INDEX people.idx_company_cnpj_active ON company (cnpj) WHERE deleted_at IS NULL`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare people schema: %v", err)
+		}
+	}
+
+	return db
+}
+
+// saveCompany stores a valid company with the given CNPJ and legal name.
+func saveCompany(t *testing.T, repo *CompanyRepositoryImpl, cnpj, legalName string) *companyModel.Company {
+	company, err := companyModel.NewCompany(companyModel.CompanyFields{
+		CNPJ:              cnpj,
+		LegalName:         legalName,
+		TradeName:         "Fantasia",
+		FoundingDate:      time.Date(2010, time.March, 1, 0, 0, 0, 0, time.UTC),
+		StateRegistration: "032141840",
+	})
+	if err != nil {
+		t.Fatalf("failed to create company: %v", err)
+	}
+
+	id, err := repo.Save(company)
+	if err != nil {
+		t.Fatalf("failed to save company: %v", err)
+	}
+	company.ID = id
+
+	return company
+}
+
+func TestCompanyRepositoryImpl_SaveAndFind(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewCompanyRepository(setupCompanyDB(t)).(*CompanyRepositoryImpl)
+
+	saved := saveCompany(t, repo, "12ABC34501DE35", "Padaria Pão Quente Ltda")
+
+	found, err := repo.FindByID(saved.ID)
+	assert.NoError(err)
+	assert.Equal("12ABC34501DE35", found.CNPJ)
+	assert.Equal("Padaria Pão Quente Ltda", found.LegalName)
+	assert.Equal("Fantasia", found.TradeName)
+	assert.Equal("032141840", found.StateRegistration)
+	assert.Equal(1, found.Version)
+
+	byCNPJ, err := repo.FindByCNPJ("12ABC34501DE35")
+	assert.NoError(err)
+	assert.Equal(saved.ID, byCNPJ.ID)
+
+	missing, err := repo.FindByCNPJ("11222333000181")
+	assert.NoError(err)
+	assert.Nil(missing)
+}
+
+func TestCompanyRepositoryImpl_Save_DuplicateActiveCNPJ(t *testing.T) {
+	repo := NewCompanyRepository(setupCompanyDB(t)).(*CompanyRepositoryImpl)
+
+	first := saveCompany(t, repo, "12ABC34501DE35", "Primeira Ltda")
+
+	duplicate, _ := companyModel.NewCompany(companyModel.CompanyFields{
+		CNPJ: "12ABC34501DE35", LegalName: "Segunda Ltda", FoundingDate: first.FoundingDate,
+	})
+	_, err := repo.Save(duplicate)
+	assert.Error(t, err)
+
+	assert.NoError(t, repo.Delete(first.ID, 1))
+	_, err = repo.Save(duplicate)
+	assert.NoError(t, err)
+}
+
+func TestCompanyRepositoryImpl_Update(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewCompanyRepository(setupCompanyDB(t)).(*CompanyRepositoryImpl)
+
+	saved := saveCompany(t, repo, "12ABC34501DE35", "Padaria Pão Quente Ltda")
+
+	saved.LegalName = "Padaria Nova Ltda"
+	saved.TradeName = ""
+	saved.StateRegistration = ""
+	assert.NoError(repo.Update(saved))
+	assert.Equal(2, saved.Version)
+
+	found, _ := repo.FindByID(saved.ID)
+	assert.Equal("Padaria Nova Ltda", found.LegalName)
+	assert.Equal("", found.TradeName)
+	assert.Equal("", found.StateRegistration)
+	assert.Equal(2, found.Version)
+
+	stale := *found
+	stale.Version = 1
+	assert.ErrorIs(repo.Update(&stale), companyError.ErrVersionConflict)
+
+	stale.ID = 999
+	assert.ErrorIs(repo.Update(&stale), companyError.ErrCompanyNotFound)
+}
+
+func TestCompanyRepositoryImpl_Delete(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewCompanyRepository(setupCompanyDB(t)).(*CompanyRepositoryImpl)
+
+	saved := saveCompany(t, repo, "12ABC34501DE35", "Padaria Pão Quente Ltda")
+
+	assert.ErrorIs(repo.Delete(saved.ID, 2), companyError.ErrVersionConflict)
+	assert.NoError(repo.Delete(saved.ID, 1))
+	assert.ErrorIs(repo.Delete(saved.ID, 2), companyError.ErrCompanyNotFound)
+
+	found, err := repo.FindByID(saved.ID)
+	assert.NoError(err)
+	assert.Nil(found)
+
+	deleted, err := repo.FindByIDIncludingDeleted(saved.ID)
+	assert.NoError(err)
+	assert.True(deleted.IsDeleted())
+	assert.Equal(2, deleted.Version)
+
+	byCNPJ, _ := repo.FindByCNPJ("12ABC34501DE35")
+	assert.Nil(byCNPJ)
+}
+
+func TestCompanyRepositoryImpl_FindAll(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewCompanyRepository(setupCompanyDB(t)).(*CompanyRepositoryImpl)
+
+	saveCompany(t, repo, "12ABC34501DE35", "Beta Ltda")
+	saveCompany(t, repo, "11222333000181", "Alfa Ltda")
+	deleted := saveCompany(t, repo, "AB123456000110", "Gama Ltda")
+	assert.NoError(repo.Delete(deleted.ID, 1))
+
+	companies, total, err := repo.FindAll(1, 10, "legal_name", "asc")
+	assert.NoError(err)
+	assert.Equal(int64(2), total)
+	assert.Len(companies, 2)
+	assert.Equal("Alfa Ltda", companies[0].LegalName)
+
+	companies, _, err = repo.FindAll(2, 1, "unknown; DROP TABLE", "desc")
+	assert.NoError(err)
+	assert.Len(companies, 1)
+	assert.Equal("12ABC34501DE35", companies[0].CNPJ)
+}
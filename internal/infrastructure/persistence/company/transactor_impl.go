@@ -0,0 +1,32 @@
+package company
+
+import (
+	auditPorts "pessoas-api/internal/domain/audit/ports"
+	"pessoas-api/internal/domain/company/ports"
+	"pessoas-api/internal/infrastructure/encryption"
+	auditPersistence "pessoas-api/internal/infrastructure/persistence/audit"
+
+	"gorm.io/gorm"
+)
+
+// TransactorImpl implements the ports.Transactor interface with a GORM
+// transaction shared by the company repository and the audit repository.
+type TransactorImpl struct {
+	db     *gorm.DB
+	cipher *encryption.Cipher
+}
+
+// NewTransactor creates a new instance of TransactorImpl. The audit snapshots
+// are encrypted with cipher, as by the audit repository.
+func NewTransactor(db *gorm.DB, cipher *encryption.Cipher) ports.Transactor {
+	return &TransactorImpl{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+func (t *TransactorImpl) WithinTransaction(fn func(companies ports.CompanyRepository, audits auditPorts.AuditRepository) error) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		return fn(NewCompanyRepository(tx), auditPersistence.NewAuditRepository(tx, t.cipher))
+	})
+}
@@ -0,0 +1,106 @@
+package job
+
+import (
+	"encoding/json"
+	"time"
+
+	jobModel "pessoas-api/internal/domain/job/model"
+)
+
+type JobEntity struct {
+	ID                int        `gorm:"column:id;primaryKey;autoIncrement"`
+	Type              string     `gorm:"column:type;type:varchar(50);not null"`
+	Status            string     `gorm:"column:status;type:varchar(20);not null"`
+	Payload           string     `gorm:"column:payload;type:jsonb;not null"`
+	Progress          int        `gorm:"column:progress;not null"`
+	ResultRef         *string    `gorm:"column:result_ref;type:varchar(255)"`
+	ResultName        *string    `gorm:"column:result_name;type:varchar(255)"`
+	ResultContentType *string    `gorm:"column:result_content_type;type:varchar(255)"`
+	Error             *string    `gorm:"column:error;type:text"`
+	Attempts          int        `gorm:"column:attempts;not null"`
+	MaxAttempts       int        `gorm:"column:max_attempts;not null"`
+	OperatorID        int        `gorm:"column:operator_id;not null"`
+	RequestID         string     `gorm:"column:request_id;type:varchar(64)"`
+	ClientIP          string     `gorm:"column:client_ip;type:varchar(45)"`
+	RunAt             time.Time  `gorm:"column:run_at;type:timestamp;not null"`
+	HeartbeatAt       *time.Time `gorm:"column:heartbeat_at;type:timestamp"`
+	CreatedAt         time.Time  `gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt         time.Time  `gorm:"column:updated_at;type:timestamp;not null"`
+	StartedAt         *time.Time `gorm:"column:started_at;type:timestamp"`
+	FinishedAt        *time.Time `gorm:"column:finished_at;type:timestamp"`
+}
+
+func (JobEntity) TableName() string {
+	return "people.job"
+}
+
+func (e *JobEntity) ToDomain() *jobModel.Job {
+	var result *jobModel.Artifact
+	if e.ResultRef != nil {
+		result = &jobModel.Artifact{Ref: *e.ResultRef}
+		if e.ResultName != nil {
+			result.Name = *e.ResultName
+		}
+		if e.ResultContentType != nil {
+			result.ContentType = *e.ResultContentType
+		}
+	}
+
+	var message string
+	if e.Error != nil {
+		message = *e.Error
+	}
+
+	return &jobModel.Job{
+		ID:          e.ID,
+		Type:        e.Type,
+		Status:      e.Status,
+		Payload:     json.RawMessage(e.Payload),
+		Progress:    e.Progress,
+		Result:      result,
+		Error:       message,
+		Attempts:    e.Attempts,
+		MaxAttempts: e.MaxAttempts,
+		OperatorID:  e.OperatorID,
+		RequestID:   e.RequestID,
+		ClientIP:    e.ClientIP,
+		RunAt:       e.RunAt,
+		HeartbeatAt: e.HeartbeatAt,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+		StartedAt:   e.StartedAt,
+		FinishedAt:  e.FinishedAt,
+	}
+}
+
+func FromDomain(j *jobModel.Job) *JobEntity {
+	entity := &JobEntity{
+		ID:          j.ID,
+		Type:        j.Type,
+		Status:      j.Status,
+		Payload:     string(j.Payload),
+		Progress:    j.Progress,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		OperatorID:  j.OperatorID,
+		RequestID:   j.RequestID,
+		ClientIP:    j.ClientIP,
+		RunAt:       j.RunAt,
+		HeartbeatAt: j.HeartbeatAt,
+		CreatedAt:   j.CreatedAt,
+		UpdatedAt:   j.UpdatedAt,
+		StartedAt:   j.StartedAt,
+		FinishedAt:  j.FinishedAt,
+	}
+
+	if j.Result != nil {
+		entity.ResultRef = &j.Result.Ref
+		entity.ResultName = &j.Result.Name
+		entity.ResultContentType = &j.Result.ContentType
+	}
+	if j.Error != "" {
+		entity.Error = &j.Error
+	}
+
+	return entity
+}
@@ -0,0 +1,237 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	jobError "pessoas-api/internal/domain/job/error"
+	jobModel "pessoas-api/internal/domain/job/model"
+	"pessoas-api/internal/domain/job/ports"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// abandonedMessage is the error recorded on a job whose worker stopped responding on its last attempt.
+const abandonedMessage = "worker stopped responding"
+
+// JobRepositoryImpl implements the ports.JobRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type JobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewJobRepository creates a new instance of JobRepositoryImpl.
+// It returns the implementation as the JobRepository interface.
+func NewJobRepository(db *gorm.DB) ports.JobRepository {
+	return &JobRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *JobRepositoryImpl) Save(job *jobModel.Job) (int, error) {
+	entity := FromDomain(job)
+
+	result := r.db.Create(entity)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to save job: %w", result.Error)
+	}
+
+	return entity.ID, nil
+}
+
+func (r *JobRepositoryImpl) FindByID(id int) (*jobModel.Job, error) {
+	var entity JobEntity
+
+	result := r.db.Where("id = ?", id).First(&entity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find job: %w", result.Error)
+	}
+
+	return entity.ToDomain(), nil
+}
+
+// Claim locks the next runnable job with SELECT ... FOR UPDATE SKIP LOCKED, so
+// concurrent workers on any replica skip rows another worker is claiming, and
+// marks it as running under a new attempt. Runnable jobs are queued jobs whose
+// run time has come and running jobs whose heartbeat is older than the lease;
+// abandoned jobs without attempts left are failed instead.
+func (r *JobRepositoryImpl) Claim(types []string, lease time.Duration) (*jobModel.Job, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	expired := now.Add(-lease)
+
+	var claimed *jobModel.Job
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		abandoned := tx.Model(&JobEntity{}).
+			Where("status = ? AND heartbeat_at < ? AND attempts >= max_attempts", jobModel.StatusRunning, expired).
+			Updates(map[string]interface{}{
+				"status":      jobModel.StatusFailed,
+				"error":       abandonedMessage,
+				"finished_at": now,
+				"updated_at":  now,
+			})
+		if abandoned.Error != nil {
+			return abandoned.Error
+		}
+
+		var entity JobEntity
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("type IN ?", types).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND heartbeat_at < ?)", jobModel.StatusQueued, now, jobModel.StatusRunning, expired).
+			Order("run_at, id").
+			Limit(1).
+			Find(&entity)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		entity.Status = jobModel.StatusRunning
+		entity.Attempts++
+		entity.HeartbeatAt = &now
+		entity.UpdatedAt = now
+		if entity.StartedAt == nil {
+			entity.StartedAt = &now
+		}
+
+		update := tx.Model(&JobEntity{}).Where("id = ?", entity.ID).Updates(map[string]interface{}{
+			"status":       entity.Status,
+			"attempts":     entity.Attempts,
+			"heartbeat_at": now,
+			"started_at":   entity.StartedAt,
+			"updated_at":   now,
+		})
+		if update.Error != nil {
+			return update.Error
+		}
+
+		claimed = entity.ToDomain()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim job: %w", err)
+	}
+
+	return claimed, nil
+}
+
+func (r *JobRepositoryImpl) Heartbeat(id, attempt, progress int) error {
+	now := time.Now()
+
+	return r.updateRunning(id, attempt, "record job heartbeat", map[string]interface{}{
+		"progress":     progress,
+		"heartbeat_at": now,
+		"updated_at":   now,
+	})
+}
+
+func (r *JobRepositoryImpl) Complete(id, attempt int, result *jobModel.Artifact) error {
+	now := time.Now()
+
+	fields := map[string]interface{}{
+		"status":      jobModel.StatusSucceeded,
+		"progress":    100,
+		"error":       nil,
+		"finished_at": now,
+		"updated_at":  now,
+	}
+	if result != nil {
+		fields["result_ref"] = result.Ref
+		fields["result_name"] = result.Name
+		fields["result_content_type"] = result.ContentType
+	}
+
+	return r.updateRunning(id, attempt, "complete job", fields)
+}
+
+// Fail records a failed attempt. With a retry time the job goes back to the
+// queue until then; without one it is failed for good.
+func (r *JobRepositoryImpl) Fail(id, attempt int, message string, retryAt *time.Time) error {
+	now := time.Now()
+
+	fields := map[string]interface{}{
+		"status":      jobModel.StatusFailed,
+		"error":       message,
+		"finished_at": now,
+		"updated_at":  now,
+	}
+	if retryAt != nil {
+		fields["status"] = jobModel.StatusQueued
+		fields["run_at"] = *retryAt
+		fields["finished_at"] = nil
+	}
+
+	return r.updateRunning(id, attempt, "fail job", fields)
+}
+
+func (r *JobRepositoryImpl) Cancel(id int) error {
+	now := time.Now()
+
+	result := r.db.Model(&JobEntity{}).
+		Where("id = ? AND status IN ?", id, []string{jobModel.StatusQueued, jobModel.StatusRunning}).
+		Updates(map[string]interface{}{
+			"status":      jobModel.StatusCancelled,
+			"finished_at": now,
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to cancel job: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return jobError.ErrJobNotActive
+	}
+
+	return nil
+}
+
+// Requeue puts a failed or cancelled job back in the queue with its attempts reset.
+func (r *JobRepositoryImpl) Requeue(id int) error {
+	now := time.Now()
+
+	result := r.db.Model(&JobEntity{}).
+		Where("id = ? AND status IN ?", id, []string{jobModel.StatusFailed, jobModel.StatusCancelled}).
+		Updates(map[string]interface{}{
+			"status":       jobModel.StatusQueued,
+			"progress":     0,
+			"error":        nil,
+			"attempts":     0,
+			"run_at":       now,
+			"heartbeat_at": nil,
+			"finished_at":  nil,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to requeue job: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return jobError.ErrJobNotRetryable
+	}
+
+	return nil
+}
+
+// updateRunning applies fields to a job only while it is running under the given attempt.
+func (r *JobRepositoryImpl) updateRunning(id, attempt int, operation string, fields map[string]interface{}) error {
+	result := r.db.Model(&JobEntity{}).
+		Where("id = ? AND status = ? AND attempts = ?", id, jobModel.StatusRunning, attempt).
+		Updates(fields)
+	if result.Error != nil {
+		return fmt.Errorf("failed to %s: %w", operation, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return jobError.ErrJobLost
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	jobError "pessoas-api/internal/domain/job/error"
+	jobModel "pessoas-api/internal/domain/job/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testLease = time.Minute
+
+// setupJobDB creates the people schema in memory with the job table.
+func setupJobDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database instance: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	statements := []string{
+		`ATTACH DATABASE ':memory:' AS people`,
+		`CREATE TABLE people.job (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			type VARCHAR(50) NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			payload TEXT NOT NULL,
+			progress INTEGER NOT NULL DEFAULT 0,
+			result_ref VARCHAR(255),
+			result_name VARCHAR(255),
+			result_content_type VARCHAR(255),
+			error TEXT,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			max_attempts INTEGER NOT NULL DEFAULT 3,
+			operator_id INTEGER NOT NULL,
+			request_id VARCHAR(64),
+			client_ip VARCHAR(45),
+			run_at TIMESTAMP NOT NULL,
+			heartbeat_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			started_at TIMESTAMP,
+			finished_at TIMESTAMP
+		)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare people schema: %v", err)
+		}
+	}
+
+	return db
+}
+
+// saveJob queues a job of the given type that becomes runnable at runAt.
+func saveJob(t *testing.T, repo *JobRepositoryImpl, jobType string, runAt time.Time) int {
+	queued, err := jobModel.NewJob(jobType, map[string]string{"format": "csv"}, audit.Actor{OperatorID: 7, RequestID: "req-123"})
+	if err != nil {
+		t.Fatalf("failed to create job: %v", err)
+	}
+	queued.RunAt = runAt
+
+	id, err := repo.Save(queued)
+	if err != nil {
+		t.Fatalf("failed to save job: %v", err)
+	}
+	return id
+}
+
+// expireHeartbeat makes a running job look abandoned by its worker.
+func expireHeartbeat(t *testing.T, db *gorm.DB, id int) {
+	stale := time.Now().Add(-2 * testLease)
+	if err := db.Model(&JobEntity{}).Where("id = ?", id).Update("heartbeat_at", stale).Error; err != nil {
+		t.Fatalf("failed to expire heartbeat: %v", err)
+	}
+}
+
+func TestJobRepositoryImpl_SaveAndFindByID(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewJobRepository(setupJobDB(t)).(*JobRepositoryImpl)
+
+	id := saveJob(t, repo, "person_export", time.Now())
+
+	found, err := repo.FindByID(id)
+	assert.NoError(err)
+	assert.Equal(id, found.ID)
+	assert.Equal(jobModel.StatusQueued, found.Status)
+	assert.JSONEq(`{"format":"csv"}`, string(found.Payload))
+	assert.Equal(7, found.OperatorID)
+	assert.Nil(found.Result)
+
+	missing, err := repo.FindByID(999)
+	assert.NoError(err)
+	assert.Nil(missing)
+}
+
+func TestJobRepositoryImpl_Claim(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewJobRepository(setupJobDB(t)).(*JobRepositoryImpl)
+	now := time.Now()
+
+	saveJob(t, repo, "person_import", now.Add(-time.Hour))
+	future := saveJob(t, repo, "person_export", now.Add(time.Hour))
+	second := saveJob(t, repo, "person_export", now.Add(-time.Minute))
+	first := saveJob(t, repo, "person_export", now.Add(-2*time.Minute))
+
+	claimed, err := repo.Claim([]string{"person_export"}, testLease)
+	assert.NoError(err)
+	assert.Equal(first, claimed.ID)
+	assert.Equal(jobModel.StatusRunning, claimed.Status)
+	assert.Equal(1, claimed.Attempts)
+	assert.NotNil(claimed.StartedAt)
+	assert.NotNil(claimed.HeartbeatAt)
+
+	claimed, err = repo.Claim([]string{"person_export"}, testLease)
+	assert.NoError(err)
+	assert.Equal(second, claimed.ID)
+
+	claimed, err = repo.Claim([]string{"person_export"}, testLease)
+	assert.NoError(err)
+	assert.Nil(claimed, "job %d is not due yet", future)
+}
+
+func TestJobRepositoryImpl_Claim_ShouldTakeOverAbandonedJob(t *testing.T) {
+	assert := assert.New(t)
+	db := setupJobDB(t)
+	repo := NewJobRepository(db).(*JobRepositoryImpl)
+	id := saveJob(t, repo, "person_export", time.Now())
+
+	claimed, _ := repo.Claim([]string{"person_export"}, testLease)
+	again, err := repo.Claim([]string{"person_export"}, testLease)
+	assert.NoError(err)
+	assert.Nil(again, "a job with a fresh heartbeat must not be claimed twice")
+
+	expireHeartbeat(t, db, id)
+
+	again, err = repo.Claim([]string{"person_export"}, testLease)
+	assert.NoError(err)
+	assert.Equal(id, again.ID)
+	assert.Equal(2, again.Attempts)
+
+	assert.ErrorIs(repo.Heartbeat(id, claimed.Attempts, 50), jobError.ErrJobLost)
+	assert.ErrorIs(repo.Complete(id, claimed.Attempts, nil), jobError.ErrJobLost)
+	assert.NoError(repo.Heartbeat(id, again.Attempts, 50))
+}
+
+func TestJobRepositoryImpl_Claim_ShouldFailAbandonedJobWithoutAttemptsLeft(t *testing.T) {
+	assert := assert.New(t)
+	db := setupJobDB(t)
+	repo := NewJobRepository(db).(*JobRepositoryImpl)
+	id := saveJob(t, repo, "person_export", time.Now())
+	db.Model(&JobEntity{}).Where("id = ?", id).Update("max_attempts", 1)
+
+	repo.Claim([]string{"person_export"}, testLease)
+	expireHeartbeat(t, db, id)
+
+	claimed, err := repo.Claim([]string{"person_export"}, testLease)
+	assert.NoError(err)
+	assert.Nil(claimed)
+
+	found, _ := repo.FindByID(id)
+	assert.Equal(jobModel.StatusFailed, found.Status)
+	assert.Equal(abandonedMessage, found.Error)
+	assert.NotNil(found.FinishedAt)
+}
+
+func TestJobRepositoryImpl_Complete(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewJobRepository(setupJobDB(t)).(*JobRepositoryImpl)
+	id := saveJob(t, repo, "person_export", time.Now())
+	claimed, _ := repo.Claim([]string{"person_export"}, testLease)
+
+	result := &jobModel.Artifact{Ref: "abc.csv", Name: "persons-1.csv", ContentType: "text/csv"}
+	assert.NoError(repo.Complete(id, claimed.Attempts, result))
+
+	found, _ := repo.FindByID(id)
+	assert.Equal(jobModel.StatusSucceeded, found.Status)
+	assert.Equal(100, found.Progress)
+	assert.Equal(result, found.Result)
+	assert.NotNil(found.FinishedAt)
+}
+
+func TestJobRepositoryImpl_Fail(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewJobRepository(setupJobDB(t)).(*JobRepositoryImpl)
+	id := saveJob(t, repo, "person_export", time.Now())
+
+	claimed, _ := repo.Claim([]string{"person_export"}, testLease)
+	retryAt := time.Now().Add(time.Hour)
+	assert.NoError(repo.Fail(id, claimed.Attempts, "connection reset", &retryAt))
+
+	found, _ := repo.FindByID(id)
+	assert.Equal(jobModel.StatusQueued, found.Status)
+	assert.Equal("connection reset", found.Error)
+	assert.WithinDuration(retryAt, found.RunAt, time.Second)
+	assert.Nil(found.FinishedAt)
+
+	db := repo.db
+	db.Model(&JobEntity{}).Where("id = ?", id).Update("run_at", time.Now().Add(-time.Second))
+	claimed, _ = repo.Claim([]string{"person_export"}, testLease)
+	assert.Equal(2, claimed.Attempts)
+	assert.NoError(repo.Fail(id, claimed.Attempts, "invalid column", nil))
+
+	found, _ = repo.FindByID(id)
+	assert.Equal(jobModel.StatusFailed, found.Status)
+	assert.Equal("invalid column", found.Error)
+	assert.NotNil(found.FinishedAt)
+}
+
+func TestJobRepositoryImpl_Cancel(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewJobRepository(setupJobDB(t)).(*JobRepositoryImpl)
+	id := saveJob(t, repo, "person_export", time.Now())
+	claimed, _ := repo.Claim([]string{"person_export"}, testLease)
+
+	assert.NoError(repo.Cancel(id))
+
+	found, _ := repo.FindByID(id)
+	assert.Equal(jobModel.StatusCancelled, found.Status)
+	assert.NotNil(found.FinishedAt)
+	assert.ErrorIs(repo.Heartbeat(id, claimed.Attempts, 10), jobError.ErrJobLost, "the worker must notice the cancellation")
+	assert.ErrorIs(repo.Cancel(id), jobError.ErrJobNotActive)
+}
+
+func TestJobRepositoryImpl_Requeue(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewJobRepository(setupJobDB(t)).(*JobRepositoryImpl)
+	id := saveJob(t, repo, "person_export", time.Now())
+
+	assert.ErrorIs(repo.Requeue(id), jobError.ErrJobNotRetryable)
+
+	claimed, _ := repo.Claim([]string{"person_export"}, testLease)
+	repo.Heartbeat(id, claimed.Attempts, 40)
+	repo.Fail(id, claimed.Attempts, "disk full", nil)
+
+	assert.NoError(repo.Requeue(id))
+
+	found, _ := repo.FindByID(id)
+	assert.Equal(jobModel.StatusQueued, found.Status)
+	assert.Zero(found.Attempts)
+	assert.Zero(found.Progress)
+	assert.Empty(found.Error)
+	assert.Nil(found.FinishedAt)
+
+	claimed, _ = repo.Claim([]string{"person_export"}, testLease)
+	assert.Equal(id, claimed.ID)
+	assert.Equal(1, claimed.Attempts)
+}
@@ -7,19 +7,29 @@ import (
 )
 
 type OperatorEntity struct {
-	ID           int       `gorm:"primaryKey;autoIncrement"`
-	Username     string    `gorm:"type:varchar(50);uniqueIndex;not null"`
-	Email        string    `gorm:"type:varchar(100);uniqueIndex;not null"`
-	PasswordHash string    `gorm:"column:password_hash;type:varchar(255);not null"`
-	Active       bool      `gorm:"default:true;not null"`
-	CreatedAt    time.Time `gorm:"autoCreateTime"`
-	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+	ID           int                  `gorm:"primaryKey;autoIncrement"`
+	Username     string               `gorm:"type:varchar(50);uniqueIndex;not null"`
+	Email        string               `gorm:"type:varchar(100);uniqueIndex;not null"`
+	PasswordHash string               `gorm:"column:password_hash;type:varchar(255);not null"`
+	Active       bool                 `gorm:"default:true;not null"`
+	Roles        []OperatorRoleEntity `gorm:"foreignKey:OperatorID"`
+	CreatedAt    time.Time            `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time            `gorm:"autoUpdateTime"`
 }
 
 func (OperatorEntity) TableName() string {
 	return "operators"
 }
 
+type OperatorRoleEntity struct {
+	OperatorID int    `gorm:"column:operator_id;primaryKey"`
+	Role       string `gorm:"column:role;type:varchar(20);primaryKey"`
+}
+
+func (OperatorRoleEntity) TableName() string {
+	return "operator_role"
+}
+
 func (e *OperatorEntity) ToDomain() *operator.Operator {
 	return &operator.Operator{
 		ID:           e.ID,
@@ -27,6 +37,7 @@ func (e *OperatorEntity) ToDomain() *operator.Operator {
 		Email:        e.Email,
 		PasswordHash: e.PasswordHash,
 		Active:       e.Active,
+		Roles:        roleNames(e.Roles),
 		CreatedAt:    e.CreatedAt,
 		UpdatedAt:    e.UpdatedAt,
 	}
@@ -39,7 +50,24 @@ func FromDomain(op *operator.Operator) *OperatorEntity {
 		Email:        op.Email,
 		PasswordHash: op.PasswordHash,
 		Active:       op.Active,
+		Roles:        roleEntities(op.ID, op.Roles),
 		CreatedAt:    op.CreatedAt,
 		UpdatedAt:    op.UpdatedAt,
 	}
 }
+
+func roleNames(entities []OperatorRoleEntity) []string {
+	roles := make([]string, len(entities))
+	for i, entity := range entities {
+		roles[i] = entity.Role
+	}
+	return roles
+}
+
+func roleEntities(operatorID int, roles []string) []OperatorRoleEntity {
+	entities := make([]OperatorRoleEntity, len(roles))
+	for i, role := range roles {
+		entities[i] = OperatorRoleEntity{OperatorID: operatorID, Role: role}
+	}
+	return entities
+}
@@ -2,7 +2,9 @@ package operator
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	operator "pessoas-api/internal/domain/operator/model"
 	"pessoas-api/internal/domain/operator/ports"
@@ -33,7 +35,7 @@ func (r *OperatorRepositoryImpl) Save(op *operator.Operator) (int, error) {
 func (r *OperatorRepositoryImpl) FindByUsername(username string) (*operator.Operator, error) {
 	var entity OperatorEntity
 
-	result := r.db.Where("username = ?", username).First(&entity)
+	result := withRoles(r.db).Where("username = ?", username).First(&entity)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -48,7 +50,7 @@ func (r *OperatorRepositoryImpl) FindByUsername(username string) (*operator.Oper
 func (r *OperatorRepositoryImpl) FindByEmail(email string) (*operator.Operator, error) {
 	var entity OperatorEntity
 
-	result := r.db.Where("email = ?", email).First(&entity)
+	result := withRoles(r.db).Where("email = ?", email).First(&entity)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -63,7 +65,7 @@ func (r *OperatorRepositoryImpl) FindByEmail(email string) (*operator.Operator,
 func (r *OperatorRepositoryImpl) FindByID(id int) (*operator.Operator, error) {
 	var entity OperatorEntity
 
-	result := r.db.First(&entity, id)
+	result := withRoles(r.db).First(&entity, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -74,3 +76,81 @@ func (r *OperatorRepositoryImpl) FindByID(id int) (*operator.Operator, error) {
 
 	return entity.ToDomain(), nil
 }
+
+func (r *OperatorRepositoryImpl) FindAll(page, pageSize int) ([]*operator.Operator, int64, error) {
+	var entities []OperatorEntity
+	var total int64
+
+	if err := r.db.Model(&OperatorEntity{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count operators: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+
+	if err := withRoles(r.db).Order("id").Offset(offset).Limit(pageSize).Find(&entities).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to find operators: %w", err)
+	}
+
+	operators := make([]*operator.Operator, len(entities))
+	for i := range entities {
+		operators[i] = entities[i].ToDomain()
+	}
+
+	return operators, total, nil
+}
+
+// UpdateRoles replaces the roles of the operator.
+func (r *OperatorRepositoryImpl) UpdateRoles(id int, roles []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&OperatorEntity{}).Where("id = ?", id).Update("updated_at", time.Now())
+		if result.Error != nil {
+			return fmt.Errorf("failed to update operator roles: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return operator.ErrOperatorNotFound
+		}
+
+		if err := tx.Where("operator_id = ?", id).Delete(&OperatorRoleEntity{}).Error; err != nil {
+			return fmt.Errorf("failed to update operator roles: %w", err)
+		}
+
+		if err := tx.Create(roleEntities(id, roles)).Error; err != nil {
+			return fmt.Errorf("failed to update operator roles: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *OperatorRepositoryImpl) UpdateActive(id int, active bool) error {
+	result := r.db.Model(&OperatorEntity{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"active":     active,
+		"updated_at": time.Now(),
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update operator: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return operator.ErrOperatorNotFound
+	}
+
+	return nil
+}
+
+func (r *OperatorRepositoryImpl) CountActiveWithRole(role string) (int64, error) {
+	var total int64
+
+	roleHolders := r.db.Session(&gorm.Session{NewDB: true}).Model(&OperatorRoleEntity{}).Select("operator_id").Where("role = ?", role)
+	if err := r.db.Model(&OperatorEntity{}).Where("active = ? AND id IN (?)", true, roleHolders).Count(&total).Error; err != nil {
+		return 0, fmt.Errorf("failed to count operators: %w", err)
+	}
+
+	return total, nil
+}
+
+// withRoles loads the roles of the operators read by db.
+func withRoles(db *gorm.DB) *gorm.DB {
+	return db.Preload("Roles", func(db *gorm.DB) *gorm.DB {
+		return db.Order("role")
+	})
+}
@@ -0,0 +1,64 @@
+package operator
+
+import (
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
+)
+
+type RefreshTokenEntity struct {
+	ID                   int        `gorm:"primaryKey;autoIncrement"`
+	OperatorID           int        `gorm:"column:operator_id;not null;index"`
+	SessionID            string     `gorm:"column:session_id;type:varchar(32);not null;index"`
+	TokenHash            string     `gorm:"column:token_hash;type:char(64);uniqueIndex;not null"`
+	AccessTokenID        string     `gorm:"column:access_token_id;type:varchar(32);not null"`
+	AccessTokenExpiresAt time.Time  `gorm:"column:access_token_expires_at;not null"`
+	ExpiresAt            time.Time  `gorm:"column:expires_at;not null"`
+	CreatedAt            time.Time  `gorm:"column:created_at;not null"`
+	RevokedAt            *time.Time `gorm:"column:revoked_at"`
+}
+
+func (RefreshTokenEntity) TableName() string {
+	return "operator_refresh_token"
+}
+
+// RevokedAccessTokenEntity is an entry of the revocation list. It is kept
+// until the access token expires.
+type RevokedAccessTokenEntity struct {
+	TokenID    string    `gorm:"column:token_id;type:varchar(32);primaryKey"`
+	OperatorID int       `gorm:"column:operator_id;not null"`
+	ExpiresAt  time.Time `gorm:"column:expires_at;not null;index"`
+	RevokedAt  time.Time `gorm:"column:revoked_at;not null"`
+}
+
+func (RevokedAccessTokenEntity) TableName() string {
+	return "revoked_access_token"
+}
+
+func (e *RefreshTokenEntity) ToDomain() *operator.RefreshToken {
+	return &operator.RefreshToken{
+		ID:                   e.ID,
+		OperatorID:           e.OperatorID,
+		SessionID:            e.SessionID,
+		TokenHash:            e.TokenHash,
+		AccessTokenID:        e.AccessTokenID,
+		AccessTokenExpiresAt: e.AccessTokenExpiresAt,
+		ExpiresAt:            e.ExpiresAt,
+		CreatedAt:            e.CreatedAt,
+		RevokedAt:            e.RevokedAt,
+	}
+}
+
+func RefreshTokenFromDomain(token *operator.RefreshToken) *RefreshTokenEntity {
+	return &RefreshTokenEntity{
+		ID:                   token.ID,
+		OperatorID:           token.OperatorID,
+		SessionID:            token.SessionID,
+		TokenHash:            token.TokenHash,
+		AccessTokenID:        token.AccessTokenID,
+		AccessTokenExpiresAt: token.AccessTokenExpiresAt,
+		ExpiresAt:            token.ExpiresAt,
+		CreatedAt:            token.CreatedAt,
+		RevokedAt:            token.RevokedAt,
+	}
+}
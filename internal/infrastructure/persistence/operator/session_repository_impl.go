@@ -0,0 +1,154 @@
+package operator
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
+	"pessoas-api/internal/domain/operator/ports"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SessionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) ports.SessionRepository {
+	return &SessionRepositoryImpl{db: db}
+}
+
+func (r *SessionRepositoryImpl) Save(token *operator.RefreshToken) error {
+	entity := RefreshTokenFromDomain(token)
+
+	if err := r.db.Create(entity).Error; err != nil {
+		return fmt.Errorf("failed to save refresh token: %w", err)
+	}
+
+	token.ID = entity.ID
+	return nil
+}
+
+func (r *SessionRepositoryImpl) FindByHash(tokenHash string) (*operator.RefreshToken, error) {
+	var entity RefreshTokenEntity
+
+	result := r.db.Where("token_hash = ?", tokenHash).First(&entity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find refresh token: %w", result.Error)
+	}
+
+	return entity.ToDomain(), nil
+}
+
+// Rotate revokes the used token only if no one revoked it in the meantime, so
+// that two refreshes racing with the same token cannot both succeed.
+func (r *SessionRepositoryImpl) Rotate(used, next *operator.RefreshToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&RefreshTokenEntity{}).
+			Where("id = ? AND revoked_at IS NULL", used.ID).
+			Update("revoked_at", next.CreatedAt)
+		if result.Error != nil {
+			return fmt.Errorf("failed to revoke refresh token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return operator.ErrRefreshTokenReused
+		}
+
+		entity := RefreshTokenFromDomain(next)
+		if err := tx.Create(entity).Error; err != nil {
+			return fmt.Errorf("failed to save refresh token: %w", err)
+		}
+
+		next.ID = entity.ID
+		return nil
+	})
+}
+
+func (r *SessionRepositoryImpl) RevokeSession(operatorID int, sessionID string, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return revoke(tx, now, "operator_id = ? AND session_id = ?", operatorID, sessionID)
+	})
+}
+
+func (r *SessionRepositoryImpl) RevokeAll(operatorID int, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return revoke(tx, now, "operator_id = ?", operatorID)
+	})
+}
+
+func (r *SessionRepositoryImpl) IsAccessTokenRevoked(tokenID string) (bool, error) {
+	var total int64
+
+	if err := r.db.Model(&RevokedAccessTokenEntity{}).Where("token_id = ?", tokenID).Count(&total).Error; err != nil {
+		return false, fmt.Errorf("failed to check access token: %w", err)
+	}
+
+	return total > 0, nil
+}
+
+// DeleteExpired removes the revoked access tokens that expired and the refresh
+// tokens that expired along with the access token issued with them. Used
+// refresh tokens are kept until then to detect their reuse.
+func (r *SessionRepositoryImpl) DeleteExpired(now time.Time) (int64, error) {
+	var deleted int64
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("expires_at <= ?", now).Delete(&RevokedAccessTokenEntity{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete revoked access tokens: %w", result.Error)
+		}
+		deleted += result.RowsAffected
+
+		result = tx.Where("expires_at <= ? AND access_token_expires_at <= ?", now, now).Delete(&RefreshTokenEntity{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete refresh tokens: %w", result.Error)
+		}
+		deleted += result.RowsAffected
+
+		return nil
+	})
+
+	return deleted, err
+}
+
+// revoke adds the unexpired access tokens issued with the refresh tokens
+// matching the condition to the revocation list, used refresh tokens
+// included, and revokes the refresh tokens still active.
+func revoke(tx *gorm.DB, now time.Time, condition string, args ...interface{}) error {
+	var issued []RefreshTokenEntity
+	err := tx.Select("operator_id", "access_token_id", "access_token_expires_at").
+		Where(condition, args...).Where("access_token_expires_at > ?", now).
+		Find(&issued).Error
+	if err != nil {
+		return fmt.Errorf("failed to find access tokens: %w", err)
+	}
+
+	if len(issued) > 0 {
+		revoked := make([]RevokedAccessTokenEntity, len(issued))
+		for i, token := range issued {
+			revoked[i] = RevokedAccessTokenEntity{
+				TokenID:    token.AccessTokenID,
+				OperatorID: token.OperatorID,
+				ExpiresAt:  token.AccessTokenExpiresAt,
+				RevokedAt:  now,
+			}
+		}
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&revoked).Error; err != nil {
+			return fmt.Errorf("failed to revoke access tokens: %w", err)
+		}
+	}
+
+	err = tx.Model(&RefreshTokenEntity{}).Where(condition, args...).Where("revoked_at IS NULL").
+		Update("revoked_at", now).Error
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	return nil
+}
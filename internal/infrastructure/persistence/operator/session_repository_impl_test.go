@@ -0,0 +1,188 @@
+package operator
+
+import (
+	"testing"
+	"time"
+
+	operator "pessoas-api/internal/domain/operator/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupSessionDB creates the refresh token and revocation list tables in memory.
+func setupSessionDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database instance: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	statements := []string{
+		`CREATE TABLE operator_refresh_token (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			operator_id INTEGER NOT NULL,
+			session_id VARCHAR(32) NOT NULL,
+			token_hash CHAR(64) NOT NULL UNIQUE,
+			access_token_id VARCHAR(32) NOT NULL,
+			access_token_expires_at TIMESTAMP NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP
+		)`,
+		`CREATE TABLE revoked_access_token (
+			token_id VARCHAR(32) PRIMARY KEY,
+			operator_id INTEGER NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP NOT NULL
+		)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare session tables: %v", err)
+		}
+	}
+
+	return db
+}
+
+var sessionNow = time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+// saveRefreshToken stores a refresh token of the session issued at issuedAt
+// with the access token accessTokenID.
+func saveRefreshToken(t *testing.T, repo *SessionRepositoryImpl, operatorID int, sessionID, accessTokenID string, issuedAt time.Time) *operator.RefreshToken {
+	token, _, err := operator.NewRefreshToken(operatorID, sessionID, accessTokenID, issuedAt.Add(15*time.Minute), 24*time.Hour, issuedAt)
+	if err != nil {
+		t.Fatalf("failed to create refresh token: %v", err)
+	}
+
+	if err := repo.Save(token); err != nil {
+		t.Fatalf("failed to save refresh token: %v", err)
+	}
+	return token
+}
+
+func isRevoked(t *testing.T, repo *SessionRepositoryImpl, accessTokenID string) bool {
+	revoked, err := repo.IsAccessTokenRevoked(accessTokenID)
+	if err != nil {
+		t.Fatalf("failed to check access token: %v", err)
+	}
+	return revoked
+}
+
+func TestSessionRepositoryImpl_SaveAndFindByHash(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewSessionRepository(setupSessionDB(t)).(*SessionRepositoryImpl)
+
+	saved := saveRefreshToken(t, repo, 3, "session-1", "access-1", sessionNow)
+
+	found, err := repo.FindByHash(saved.TokenHash)
+	assert.NoError(err)
+	assert.Equal(saved.ID, found.ID)
+	assert.Equal("session-1", found.SessionID)
+	assert.Equal("access-1", found.AccessTokenID)
+	assert.True(saved.ExpiresAt.Equal(found.ExpiresAt))
+	assert.False(found.IsRevoked())
+
+	missing, err := repo.FindByHash(operator.HashRefreshToken("unknown"))
+	assert.NoError(err)
+	assert.Nil(missing)
+}
+
+func TestSessionRepositoryImpl_Rotate(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewSessionRepository(setupSessionDB(t)).(*SessionRepositoryImpl)
+
+	used := saveRefreshToken(t, repo, 3, "session-1", "access-1", sessionNow)
+	next, _, _ := operator.NewRefreshToken(3, "session-1", "access-2", sessionNow.Add(20*time.Minute), 24*time.Hour, sessionNow.Add(5*time.Minute))
+
+	assert.NoError(repo.Rotate(used, next))
+	assert.NotZero(next.ID)
+
+	found, _ := repo.FindByHash(used.TokenHash)
+	assert.True(found.IsRevoked())
+
+	again, _, _ := operator.NewRefreshToken(3, "session-1", "access-3", sessionNow.Add(20*time.Minute), 24*time.Hour, sessionNow.Add(5*time.Minute))
+	assert.ErrorIs(repo.Rotate(used, again), operator.ErrRefreshTokenReused)
+
+	missing, _ := repo.FindByHash(again.TokenHash)
+	assert.Nil(missing, "a failed rotation saves no token")
+}
+
+func TestSessionRepositoryImpl_RevokeSession(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewSessionRepository(setupSessionDB(t)).(*SessionRepositoryImpl)
+
+	used := saveRefreshToken(t, repo, 3, "session-1", "access-1", sessionNow)
+	next, _, _ := operator.NewRefreshToken(3, "session-1", "access-2", sessionNow.Add(15*time.Minute), 24*time.Hour, sessionNow)
+	assert.NoError(repo.Rotate(used, next))
+	expired := saveRefreshToken(t, repo, 3, "session-1", "access-0", sessionNow.Add(-time.Hour))
+	other := saveRefreshToken(t, repo, 3, "session-2", "access-9", sessionNow)
+
+	assert.NoError(repo.RevokeSession(3, "session-1", sessionNow.Add(time.Minute)))
+
+	assert.True(isRevoked(t, repo, "access-1"), "access tokens issued before a rotation are revoked too")
+	assert.True(isRevoked(t, repo, "access-2"))
+	assert.False(isRevoked(t, repo, "access-0"), "expired access tokens are not listed")
+	assert.False(isRevoked(t, repo, "access-9"), "other sessions are kept")
+
+	found, _ := repo.FindByHash(next.TokenHash)
+	assert.True(found.IsRevoked())
+	found, _ = repo.FindByHash(expired.TokenHash)
+	assert.True(found.IsRevoked())
+	found, _ = repo.FindByHash(other.TokenHash)
+	assert.False(found.IsRevoked())
+
+	assert.NoError(repo.RevokeSession(4, "session-2", sessionNow.Add(time.Minute)))
+	assert.False(isRevoked(t, repo, "access-9"), "a session is only revoked by its operator")
+
+	assert.NoError(repo.RevokeSession(3, "session-1", sessionNow.Add(2*time.Minute)), "revoking twice is harmless")
+}
+
+func TestSessionRepositoryImpl_RevokeAll(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewSessionRepository(setupSessionDB(t)).(*SessionRepositoryImpl)
+
+	first := saveRefreshToken(t, repo, 3, "session-1", "access-1", sessionNow)
+	second := saveRefreshToken(t, repo, 3, "session-2", "access-2", sessionNow)
+	saveRefreshToken(t, repo, 4, "session-3", "access-3", sessionNow)
+
+	assert.NoError(repo.RevokeAll(3, sessionNow.Add(time.Minute)))
+
+	assert.True(isRevoked(t, repo, "access-1"))
+	assert.True(isRevoked(t, repo, "access-2"))
+	assert.False(isRevoked(t, repo, "access-3"))
+
+	found, _ := repo.FindByHash(first.TokenHash)
+	assert.True(found.IsRevoked())
+	found, _ = repo.FindByHash(second.TokenHash)
+	assert.True(found.IsRevoked())
+}
+
+func TestSessionRepositoryImpl_DeleteExpired(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewSessionRepository(setupSessionDB(t)).(*SessionRepositoryImpl)
+
+	old := saveRefreshToken(t, repo, 3, "session-1", "access-1", sessionNow.Add(-48*time.Hour))
+	current := saveRefreshToken(t, repo, 3, "session-2", "access-2", sessionNow)
+	assert.NoError(repo.RevokeAll(3, sessionNow))
+
+	deleted, err := repo.DeleteExpired(sessionNow.Add(time.Hour))
+
+	assert.NoError(err)
+	assert.Equal(int64(2), deleted, "the expired refresh token and the expired revocation")
+	missing, _ := repo.FindByHash(old.TokenHash)
+	assert.Nil(missing)
+	kept, _ := repo.FindByHash(current.TokenHash)
+	assert.NotNil(kept, "revoked refresh tokens are kept until they expire")
+	assert.False(isRevoked(t, repo, "access-2"), "revocations are dropped once the access token expired")
+}
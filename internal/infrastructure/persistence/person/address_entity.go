@@ -0,0 +1,68 @@
+package person
+
+import (
+	"time"
+
+	personModel "pessoas-api/internal/domain/person/model"
+)
+
+type AddressEntity struct {
+	ID         int       `gorm:"column:id;primaryKey;autoIncrement"`
+	PersonID   int       `gorm:"column:person_id;not null;index"`
+	Type       string    `gorm:"column:type;type:varchar(20);not null"`
+	CEP        string    `gorm:"column:cep;type:varchar(16);not null"`
+	Street     string    `gorm:"column:street;type:varchar(255);not null"`
+	Number     string    `gorm:"column:number;type:varchar(20);not null"`
+	Complement string    `gorm:"column:complement;type:varchar(255);not null;default:''"`
+	District   string    `gorm:"column:district;type:varchar(255);not null;default:''"`
+	City       string    `gorm:"column:city;type:varchar(255);not null"`
+	State      string    `gorm:"column:state;type:varchar(50);not null"`
+	Country    string    `gorm:"column:country;type:char(2);not null;default:'BR'"`
+	Primary    bool      `gorm:"column:is_primary;not null;default:false"`
+	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;type:timestamp;not null"`
+}
+
+func (AddressEntity) TableName() string {
+	return "people.person_address"
+}
+
+func (e *AddressEntity) ToDomain() *personModel.Address {
+	return &personModel.Address{
+		AddressFields: personModel.AddressFields{
+			Type:       e.Type,
+			CEP:        e.CEP,
+			Street:     e.Street,
+			Number:     e.Number,
+			Complement: e.Complement,
+			District:   e.District,
+			City:       e.City,
+			State:      e.State,
+			Country:    e.Country,
+			Primary:    e.Primary,
+		},
+		ID:        e.ID,
+		PersonID:  e.PersonID,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+func AddressFromDomain(a *personModel.Address) *AddressEntity {
+	return &AddressEntity{
+		ID:         a.ID,
+		PersonID:   a.PersonID,
+		Type:       a.Type,
+		CEP:        a.CEP,
+		Street:     a.Street,
+		Number:     a.Number,
+		Complement: a.Complement,
+		District:   a.District,
+		City:       a.City,
+		State:      a.State,
+		Country:    a.Country,
+		Primary:    a.Primary,
+		CreatedAt:  a.CreatedAt,
+		UpdatedAt:  a.UpdatedAt,
+	}
+}
@@ -0,0 +1,159 @@
+package person
+
+import (
+	"errors"
+	"fmt"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"gorm.io/gorm"
+)
+
+// AddressRepositoryImpl implements the ports.AddressRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type AddressRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAddressRepository creates a new instance of AddressRepositoryImpl.
+// It returns the implementation as the AddressRepository interface.
+func NewAddressRepository(db *gorm.DB) ports.AddressRepository {
+	return &AddressRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *AddressRepositoryImpl) Save(address *personModel.Address) (int, error) {
+	entity := AddressFromDomain(address)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if entity.Primary {
+			if err := demotePrimary(tx, entity.PersonID, 0); err != nil {
+				return err
+			}
+		} else {
+			var primaries int64
+			if err := tx.Model(&AddressEntity{}).Where("person_id = ? AND is_primary", entity.PersonID).Count(&primaries).Error; err != nil {
+				return err
+			}
+			entity.Primary = primaries == 0
+		}
+
+		return tx.Create(entity).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save address: %w", err)
+	}
+
+	return entity.ID, nil
+}
+
+func (r *AddressRepositoryImpl) Update(address *personModel.Address) error {
+	entity := AddressFromDomain(address)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if entity.Primary {
+			if err := demotePrimary(tx, entity.PersonID, entity.ID); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&AddressEntity{}).
+			Where("id = ? AND person_id = ?", entity.ID, entity.PersonID).
+			Select("type", "cep", "street", "number", "complement", "district", "city", "state", "country", "is_primary", "updated_at").
+			Updates(entity)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return personError.ErrAddressNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, personError.ErrAddressNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to update address: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AddressRepositoryImpl) Delete(personID, id int) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var entity AddressEntity
+		result := tx.Where("id = ? AND person_id = ?", id, personID).Limit(1).Find(&entity)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return personError.ErrAddressNotFound
+		}
+
+		if err := tx.Delete(&AddressEntity{}, id).Error; err != nil {
+			return err
+		}
+
+		if !entity.Primary {
+			return nil
+		}
+
+		var next AddressEntity
+		result = tx.Where("person_id = ?", personID).Order("created_at, id").Limit(1).Find(&next)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		return tx.Model(&AddressEntity{}).Where("id = ?", next.ID).Update("is_primary", true).Error
+	})
+	if err != nil {
+		if errors.Is(err, personError.ErrAddressNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete address: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AddressRepositoryImpl) FindByID(personID, id int) (*personModel.Address, error) {
+	var entity AddressEntity
+
+	result := r.db.Where("id = ? AND person_id = ?", id, personID).First(&entity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find address: %w", result.Error)
+	}
+
+	return entity.ToDomain(), nil
+}
+
+// FindByPerson lists the addresses of a person, the primary one first.
+func (r *AddressRepositoryImpl) FindByPerson(personID int) ([]*personModel.Address, error) {
+	var entities []AddressEntity
+
+	result := r.db.Where("person_id = ?", personID).Order("is_primary DESC, created_at, id").Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list addresses: %w", result.Error)
+	}
+
+	addresses := make([]*personModel.Address, len(entities))
+	for i := range entities {
+		addresses[i] = entities[i].ToDomain()
+	}
+
+	return addresses, nil
+}
+
+// demotePrimary clears the primary flag of the person's addresses other than keepID.
+func demotePrimary(tx *gorm.DB, personID, keepID int) error {
+	return tx.Model(&AddressEntity{}).
+		Where("person_id = ? AND is_primary AND id <> ?", personID, keepID).
+		Update("is_primary", false).Error
+}
@@ -0,0 +1,170 @@
+package person
+
+import (
+	"testing"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// setupAddressDB creates the people schema with the person and person_address tables.
+func setupAddressDB(t *testing.T) *gorm.DB {
+	db := setupPeopleSchemaDB(t)
+
+	statements := []string{
+		`CREATE TABLE people.person_address (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			type VARCHAR(20) NOT NULL,
+			cep VARCHAR(16) NOT NULL,
+			street VARCHAR(255) NOT NULL,
+			number VARCHAR(20) NOT NULL,
+			complement VARCHAR(255) NOT NULL DEFAULT '',
+			district VARCHAR(255) NOT NULL DEFAULT '',
+			city VARCHAR(255) NOT NULL,
+			state VARCHAR(50) NOT NULL,
+			country CHAR(2) NOT NULL DEFAULT 'BR',
+			is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE UNIQUE INDEX people.idx_person_address_primary ON person_address (person_id) WHERE is_primary`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare address table: %v", err)
+		}
+	}
+
+	return db
+}
+
+// saveAddress stores an address of the given type for the person.
+func saveAddress(t *testing.T, repo *AddressRepositoryImpl, personID int, addressType string, primary bool) int {
+	address, err := personModel.NewAddress(personID, personModel.AddressFields{
+		Type:    addressType,
+		CEP:     "50030230",
+		Street:  "Rua da Aurora",
+		Number:  "325",
+		City:    "Recife",
+		State:   "PE",
+		Primary: primary,
+	})
+	if err != nil {
+		t.Fatalf("failed to create address: %v", err)
+	}
+
+	id, err := repo.Save(address)
+	if err != nil {
+		t.Fatalf("failed to save address: %v", err)
+	}
+	return id
+}
+
+// primaryAddressID returns the ID of the person's primary address, or 0.
+func primaryAddressID(t *testing.T, repo *AddressRepositoryImpl, personID int) int {
+	addresses, err := repo.FindByPerson(personID)
+	if err != nil {
+		t.Fatalf("failed to list addresses: %v", err)
+	}
+
+	primaryID := 0
+	for _, address := range addresses {
+		if address.Primary {
+			if primaryID != 0 {
+				t.Fatalf("person %d has more than one primary address", personID)
+			}
+			primaryID = address.ID
+		}
+	}
+	return primaryID
+}
+
+func TestAddressRepositoryImpl_Save_FirstAddressBecomesPrimary(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAddressRepository(setupAddressDB(t)).(*AddressRepositoryImpl)
+
+	first := saveAddress(t, repo, 1, personModel.AddressResidential, false)
+	saveAddress(t, repo, 1, personModel.AddressWork, false)
+	otherPerson := saveAddress(t, repo, 2, personModel.AddressBilling, false)
+
+	assert.Equal(first, primaryAddressID(t, repo, 1))
+	assert.Equal(otherPerson, primaryAddressID(t, repo, 2))
+
+	found, err := repo.FindByID(1, first)
+	assert.NoError(err)
+	assert.Equal("Rua da Aurora", found.Street)
+	assert.Equal(personModel.CountryBrazil, found.Country)
+}
+
+func TestAddressRepositoryImpl_Save_PrimaryDemotesPrevious(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAddressRepository(setupAddressDB(t)).(*AddressRepositoryImpl)
+
+	saveAddress(t, repo, 1, personModel.AddressResidential, false)
+	billing := saveAddress(t, repo, 1, personModel.AddressBilling, true)
+
+	assert.Equal(billing, primaryAddressID(t, repo, 1))
+
+	addresses, _ := repo.FindByPerson(1)
+	assert.Len(addresses, 2)
+	assert.Equal(billing, addresses[0].ID, "the primary address is listed first")
+}
+
+func TestAddressRepositoryImpl_Update(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAddressRepository(setupAddressDB(t)).(*AddressRepositoryImpl)
+
+	saveAddress(t, repo, 1, personModel.AddressResidential, false)
+	work := saveAddress(t, repo, 1, personModel.AddressWork, false)
+
+	address, _ := repo.FindByID(1, work)
+	fields := address.AddressFields
+	fields.Number = "S/N"
+	fields.Complement = ""
+	fields.Primary = true
+	address.Replace(fields)
+
+	assert.NoError(repo.Update(address))
+
+	updated, _ := repo.FindByID(1, work)
+	assert.Equal("S/N", updated.Number)
+	assert.Empty(updated.Complement)
+	assert.Equal(work, primaryAddressID(t, repo, 1))
+
+	address.PersonID = 2
+	assert.ErrorIs(repo.Update(address), personError.ErrAddressNotFound, "an address is only updated within its person")
+}
+
+func TestAddressRepositoryImpl_Delete_PromotesOldestRemaining(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAddressRepository(setupAddressDB(t)).(*AddressRepositoryImpl)
+
+	primary := saveAddress(t, repo, 1, personModel.AddressResidential, false)
+	oldest := saveAddress(t, repo, 1, personModel.AddressWork, false)
+	saveAddress(t, repo, 1, personModel.AddressBilling, false)
+
+	assert.ErrorIs(repo.Delete(2, primary), personError.ErrAddressNotFound)
+	assert.NoError(repo.Delete(1, primary))
+
+	deleted, err := repo.FindByID(1, primary)
+	assert.NoError(err)
+	assert.Nil(deleted)
+	assert.Equal(oldest, primaryAddressID(t, repo, 1))
+}
+
+func TestAddressRepositoryImpl_Delete_LastAddress(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewAddressRepository(setupAddressDB(t)).(*AddressRepositoryImpl)
+
+	id := saveAddress(t, repo, 1, personModel.AddressResidential, false)
+
+	assert.NoError(repo.Delete(1, id))
+
+	addresses, err := repo.FindByPerson(1)
+	assert.NoError(err)
+	assert.Empty(addresses)
+}
@@ -0,0 +1,108 @@
+package person
+
+import (
+	"time"
+
+	personModel "pessoas-api/internal/domain/person/model"
+)
+
+type ConsentEntity struct {
+	ID          int        `gorm:"column:id;primaryKey;autoIncrement"`
+	PersonID    int        `gorm:"column:person_id;not null;uniqueIndex:idx_person_consent_purpose"`
+	Purpose     string     `gorm:"column:purpose;type:varchar(20);not null;uniqueIndex:idx_person_consent_purpose"`
+	LegalBasis  string     `gorm:"column:legal_basis;type:varchar(30);not null"`
+	Channel     string     `gorm:"column:channel;type:varchar(20);not null"`
+	EvidenceRef string     `gorm:"column:evidence_ref;type:varchar(255);not null;default:''"`
+	GrantedAt   time.Time  `gorm:"column:granted_at;type:timestamp;not null"`
+	RevokedAt   *time.Time `gorm:"column:revoked_at;type:timestamp"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;type:timestamp;not null"`
+}
+
+func (ConsentEntity) TableName() string {
+	return "people.person_consent"
+}
+
+func (e *ConsentEntity) ToDomain() *personModel.Consent {
+	return &personModel.Consent{
+		ConsentFields: personModel.ConsentFields{
+			Purpose:     e.Purpose,
+			LegalBasis:  e.LegalBasis,
+			Channel:     e.Channel,
+			EvidenceRef: e.EvidenceRef,
+		},
+		ID:        e.ID,
+		PersonID:  e.PersonID,
+		GrantedAt: e.GrantedAt,
+		RevokedAt: e.RevokedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+func ConsentFromDomain(c *personModel.Consent) *ConsentEntity {
+	return &ConsentEntity{
+		ID:          c.ID,
+		PersonID:    c.PersonID,
+		Purpose:     c.Purpose,
+		LegalBasis:  c.LegalBasis,
+		Channel:     c.Channel,
+		EvidenceRef: c.EvidenceRef,
+		GrantedAt:   c.GrantedAt,
+		RevokedAt:   c.RevokedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// ConsentEventEntity is a row of the append-only consent history. It has no
+// foreign key to the person, so that the history outlives purged persons.
+type ConsentEventEntity struct {
+	ID          int       `gorm:"column:id;primaryKey;autoIncrement"`
+	ConsentID   int       `gorm:"column:consent_id;not null"`
+	PersonID    int       `gorm:"column:person_id;not null;index"`
+	Purpose     string    `gorm:"column:purpose;type:varchar(20);not null"`
+	Action      string    `gorm:"column:action;type:varchar(10);not null"`
+	LegalBasis  string    `gorm:"column:legal_basis;type:varchar(30);not null"`
+	Channel     string    `gorm:"column:channel;type:varchar(20);not null"`
+	EvidenceRef string    `gorm:"column:evidence_ref;type:varchar(255);not null;default:''"`
+	OperatorID  int       `gorm:"column:operator_id;not null"`
+	RequestID   string    `gorm:"column:request_id;type:varchar(64);not null;default:''"`
+	ClientIP    string    `gorm:"column:client_ip;type:varchar(45);not null;default:''"`
+	OccurredAt  time.Time `gorm:"column:occurred_at;type:timestamp;not null"`
+}
+
+func (ConsentEventEntity) TableName() string {
+	return "people.person_consent_event"
+}
+
+func (e *ConsentEventEntity) ToDomain() *personModel.ConsentEvent {
+	return &personModel.ConsentEvent{
+		ID:          e.ID,
+		ConsentID:   e.ConsentID,
+		PersonID:    e.PersonID,
+		Purpose:     e.Purpose,
+		Action:      e.Action,
+		LegalBasis:  e.LegalBasis,
+		Channel:     e.Channel,
+		EvidenceRef: e.EvidenceRef,
+		OperatorID:  e.OperatorID,
+		RequestID:   e.RequestID,
+		ClientIP:    e.ClientIP,
+		OccurredAt:  e.OccurredAt,
+	}
+}
+
+func ConsentEventFromDomain(e *personModel.ConsentEvent) *ConsentEventEntity {
+	return &ConsentEventEntity{
+		ID:          e.ID,
+		ConsentID:   e.ConsentID,
+		PersonID:    e.PersonID,
+		Purpose:     e.Purpose,
+		Action:      e.Action,
+		LegalBasis:  e.LegalBasis,
+		Channel:     e.Channel,
+		EvidenceRef: e.EvidenceRef,
+		OperatorID:  e.OperatorID,
+		RequestID:   e.RequestID,
+		ClientIP:    e.ClientIP,
+		OccurredAt:  e.OccurredAt,
+	}
+}
@@ -0,0 +1,101 @@
+package person
+
+import (
+	"errors"
+	"fmt"
+
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"gorm.io/gorm"
+)
+
+// ConsentRepositoryImpl implements the ports.ConsentRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type ConsentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewConsentRepository creates a new instance of ConsentRepositoryImpl.
+// It returns the implementation as the ConsentRepository interface.
+func NewConsentRepository(db *gorm.DB) ports.ConsentRepository {
+	return &ConsentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *ConsentRepositoryImpl) Record(consent *personModel.Consent, event *personModel.ConsentEvent) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		entity := ConsentFromDomain(consent)
+
+		if entity.ID == 0 {
+			if err := tx.Create(entity).Error; err != nil {
+				return fmt.Errorf("failed to save consent: %w", err)
+			}
+		} else {
+			err := tx.Model(&ConsentEntity{}).
+				Where("id = ? AND person_id = ?", entity.ID, entity.PersonID).
+				Select("legal_basis", "channel", "evidence_ref", "granted_at", "revoked_at", "updated_at").
+				Updates(entity).Error
+			if err != nil {
+				return fmt.Errorf("failed to update consent: %w", err)
+			}
+		}
+
+		event.ConsentID = entity.ID
+		eventEntity := ConsentEventFromDomain(event)
+		if err := tx.Create(eventEntity).Error; err != nil {
+			return fmt.Errorf("failed to save consent event: %w", err)
+		}
+
+		consent.ID = entity.ID
+		event.ID = eventEntity.ID
+
+		return nil
+	})
+}
+
+func (r *ConsentRepositoryImpl) FindByPerson(personID int) ([]*personModel.Consent, error) {
+	var entities []ConsentEntity
+
+	if err := r.db.Where("person_id = ?", personID).Order("purpose").Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("failed to find consents: %w", err)
+	}
+
+	consents := make([]*personModel.Consent, len(entities))
+	for i := range entities {
+		consents[i] = entities[i].ToDomain()
+	}
+
+	return consents, nil
+}
+
+func (r *ConsentRepositoryImpl) FindByPurpose(personID int, purpose string) (*personModel.Consent, error) {
+	var entity ConsentEntity
+
+	result := r.db.Where("person_id = ? AND purpose = ?", personID, purpose).First(&entity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find consent: %w", result.Error)
+	}
+
+	return entity.ToDomain(), nil
+}
+
+func (r *ConsentRepositoryImpl) FindEvents(personIDs []int) ([]*personModel.ConsentEvent, error) {
+	var entities []ConsentEventEntity
+
+	result := r.db.Where("person_id IN ?", personIDs).Order("occurred_at DESC, id DESC").Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find consent events: %w", result.Error)
+	}
+
+	events := make([]*personModel.ConsentEvent, len(entities))
+	for i := range entities {
+		events[i] = entities[i].ToDomain()
+	}
+
+	return events, nil
+}
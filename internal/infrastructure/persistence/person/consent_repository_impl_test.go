@@ -0,0 +1,166 @@
+package person
+
+import (
+	"testing"
+	"time"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	personModel "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// setupConsentTest creates the consent tables, saves John Doe and returns the
+// repositories with his ID.
+func setupConsentTest(t *testing.T) (*ConsentRepositoryImpl, *PersonRepositoryImpl, int) {
+	db := setupPeopleSchemaDB(t)
+	createConsentTables(t, db)
+
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+	id, err := personRepo.Save(createValidPerson(t))
+	if err != nil {
+		t.Fatalf("failed to save person: %v", err)
+	}
+
+	return NewConsentRepository(db).(*ConsentRepositoryImpl), personRepo, id
+}
+
+func createConsentTables(t *testing.T, db *gorm.DB) {
+	statements := []string{
+		`CREATE TABLE people.person_consent (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			purpose VARCHAR(20) NOT NULL,
+			legal_basis VARCHAR(30) NOT NULL,
+			channel VARCHAR(20) NOT NULL,
+			evidence_ref VARCHAR(255) NOT NULL DEFAULT '',
+			granted_at TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE UNIQUE INDEX people.idx_person_consent_purpose ON person_consent (person_id, purpose)`,
+		`CREATE TABLE people.person_consent_event (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			consent_id INTEGER NOT NULL,
+			person_id INTEGER NOT NULL,
+			purpose VARCHAR(20) NOT NULL,
+			action VARCHAR(10) NOT NULL,
+			legal_basis VARCHAR(30) NOT NULL,
+			channel VARCHAR(20) NOT NULL,
+			evidence_ref VARCHAR(255) NOT NULL DEFAULT '',
+			operator_id INTEGER NOT NULL,
+			request_id VARCHAR(64) NOT NULL DEFAULT '',
+			client_ip VARCHAR(45) NOT NULL DEFAULT '',
+			occurred_at TIMESTAMP NOT NULL
+		)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare consent tables: %v", err)
+		}
+	}
+}
+
+// grantConsent records a first grant of the purpose to the person.
+func grantConsent(t *testing.T, repo *ConsentRepositoryImpl, personID int, purpose string) *personModel.Consent {
+	consent, err := personModel.NewConsent(personID, personModel.ConsentFields{
+		Purpose:     purpose,
+		LegalBasis:  personModel.LegalBasisConsent,
+		Channel:     personModel.ChannelWeb,
+		EvidenceRef: "form-" + purpose,
+	})
+	if err != nil {
+		t.Fatalf("failed to create consent: %v", err)
+	}
+
+	event := consent.Event(personModel.ConsentActionGrant, consent.Channel, consent.EvidenceRef, audit.Actor{OperatorID: 1})
+	if err := repo.Record(consent, event); err != nil {
+		t.Fatalf("failed to record consent: %v", err)
+	}
+	return consent
+}
+
+func TestConsentRepositoryImpl_RecordAndFind(t *testing.T) {
+	assert := assert.New(t)
+	repo, _, personID := setupConsentTest(t)
+
+	marketing := grantConsent(t, repo, personID, personModel.ConsentMarketing)
+	grantConsent(t, repo, personID, personModel.ConsentAnalytics)
+
+	assert.NotZero(marketing.ID)
+
+	found, err := repo.FindByPurpose(personID, personModel.ConsentMarketing)
+	assert.NoError(err)
+	assert.Equal(marketing.ID, found.ID)
+	assert.Equal("form-marketing", found.EvidenceRef)
+	assert.True(found.IsActive())
+
+	missing, err := repo.FindByPurpose(personID, personModel.ConsentDataSharing)
+	assert.NoError(err)
+	assert.Nil(missing)
+
+	consents, err := repo.FindByPerson(personID)
+	assert.NoError(err)
+	assert.Len(consents, 2)
+	assert.Equal(personModel.ConsentAnalytics, consents[0].Purpose)
+}
+
+func TestConsentRepositoryImpl_Record_RevocationKeepsHistory(t *testing.T) {
+	assert := assert.New(t)
+	repo, _, personID := setupConsentTest(t)
+
+	consent := grantConsent(t, repo, personID, personModel.ConsentMarketing)
+
+	assert.NoError(consent.Revoke(personModel.ChannelCallCenter, "call-77"))
+	event := consent.Event(personModel.ConsentActionRevoke, personModel.ChannelCallCenter, "call-77", audit.Actor{OperatorID: 2})
+	assert.NoError(repo.Record(consent, event))
+	assert.Equal(consent.ID, event.ConsentID)
+
+	found, err := repo.FindByPurpose(personID, personModel.ConsentMarketing)
+	assert.NoError(err)
+	assert.False(found.IsActive())
+	assert.Equal(personModel.ChannelWeb, found.Channel)
+
+	events, err := repo.FindEvents([]int{personID})
+	assert.NoError(err)
+	assert.Len(events, 2)
+	assert.Equal(personModel.ConsentActionRevoke, events[0].Action)
+	assert.Equal(personModel.ChannelCallCenter, events[0].Channel)
+	assert.Equal(2, events[0].OperatorID)
+	assert.Equal(personModel.ConsentActionGrant, events[1].Action)
+
+	none, err := repo.FindEvents([]int{personID + 1})
+	assert.NoError(err)
+	assert.Empty(none)
+}
+
+func TestPersonRepositoryImpl_FindAll_FilterByActiveConsent(t *testing.T) {
+	assert := assert.New(t)
+	consentRepo, personRepo, johnID := setupConsentTest(t)
+
+	other, _ := personModel.NewPerson("Maria Souza", "52998224725", time.Date(1985, time.July, 1, 0, 0, 0, 0, time.UTC), "81998765432", "maria@example.com")
+	mariaID, err := personRepo.Save(other)
+	assert.NoError(err)
+
+	grantConsent(t, consentRepo, johnID, personModel.ConsentMarketing)
+	revoked := grantConsent(t, consentRepo, mariaID, personModel.ConsentMarketing)
+	grantConsent(t, consentRepo, mariaID, personModel.ConsentAnalytics)
+
+	revoked.Revoke(personModel.ChannelWeb, "")
+	assert.NoError(consentRepo.Record(revoked, revoked.Event(personModel.ConsentActionRevoke, personModel.ChannelWeb, "", audit.Actor{OperatorID: 1})))
+
+	persons, total, err := personRepo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{ConsentPurpose: personModel.ConsentMarketing})
+	assert.NoError(err)
+	assert.Equal(int64(1), total)
+	assert.Equal(johnID, persons[0].ID)
+
+	persons, _, err = personRepo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{ConsentPurpose: personModel.ConsentAnalytics})
+	assert.NoError(err)
+	assert.Len(persons, 1)
+	assert.Equal(mariaID, persons[0].ID)
+
+	persons, _, err = personRepo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{ConsentPurpose: personModel.ConsentDataSharing})
+	assert.NoError(err)
+	assert.Empty(persons)
+}
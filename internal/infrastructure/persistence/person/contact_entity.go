@@ -0,0 +1,197 @@
+package person
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/infrastructure/encryption"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ContactEntity is a row of people.person_contact. The value is sensitive:
+// when encryption is enabled it is stored in ValueEncrypted under the row's
+// data key, Value is left NULL and ValueIndex holds its blind index, which is
+// what lookups by contact match (see seal). Rows written before encryption
+// was enabled keep the value in plaintext until they are rewritten or
+// re-encrypted.
+type ContactEntity struct {
+	ID             int       `gorm:"column:id;primaryKey;autoIncrement"`
+	PersonID       int       `gorm:"column:person_id;not null;index"`
+	Type           string    `gorm:"column:type;type:varchar(20);not null"`
+	Value          *string   `gorm:"column:value;type:varchar(255)"`
+	KeyID          *string   `gorm:"column:key_id;type:varchar(32);index"`
+	DataKey        *string   `gorm:"column:data_key;type:text"`
+	ValueEncrypted *string   `gorm:"column:value_encrypted;type:text"`
+	ValueIndex     *string   `gorm:"column:value_index;type:char(64);index"`
+	Label          string    `gorm:"column:label;type:varchar(50);not null;default:''"`
+	Primary        bool      `gorm:"column:is_primary;not null;default:false"`
+	Verified       bool      `gorm:"column:verified;not null;default:false"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;type:timestamp;not null"`
+}
+
+// contactSensitiveColumns are the columns written by seal, always together.
+var contactSensitiveColumns = []string{"value", "key_id", "data_key", "value_encrypted", "value_index"}
+
+func (ContactEntity) TableName() string {
+	return "people.person_contact"
+}
+
+// ToDomain converts the row, decrypting its value with cipher. Rows in
+// plaintext are read without a cipher.
+func (e *ContactEntity) ToDomain(cipher *encryption.Cipher) (*personModel.Contact, error) {
+	value, err := e.open(cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &personModel.Contact{
+		ContactFields: personModel.ContactFields{
+			Type:     e.Type,
+			Value:    value,
+			Label:    e.Label,
+			Primary:  e.Primary,
+			Verified: e.Verified,
+		},
+		ID:        e.ID,
+		PersonID:  e.PersonID,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}, nil
+}
+
+// ContactFromDomain converts the contact to a row. With a cipher its value is
+// encrypted under a new data key; without one it is kept in plaintext.
+func ContactFromDomain(c *personModel.Contact, cipher *encryption.Cipher) (*ContactEntity, error) {
+	entity := &ContactEntity{
+		ID:        c.ID,
+		PersonID:  c.PersonID,
+		Type:      c.Type,
+		Label:     c.Label,
+		Primary:   c.Primary,
+		Verified:  c.Verified,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+
+	if err := entity.seal(cipher, c.Value); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// seal writes the value to the row. Without a cipher it goes to the plaintext
+// column. With one it is encrypted under a fresh data key wrapped by the
+// current key, the plaintext column is cleared and the value gets a blind
+// index, so it can still be looked up.
+func (e *ContactEntity) seal(cipher *encryption.Cipher, value string) error {
+	if cipher == nil {
+		e.Value = &value
+		e.KeyID, e.DataKey, e.ValueEncrypted, e.ValueIndex = nil, nil, nil, nil
+		return nil
+	}
+
+	key, err := cipher.NewDataKey()
+	if err != nil {
+		return err
+	}
+
+	sealed, err := key.Seal("value", value)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt contact value: %w", err)
+	}
+
+	index := contactIndex(cipher, e.Type, value)
+
+	e.Value = nil
+	e.KeyID, e.DataKey = &key.KeyID, &key.Wrapped
+	e.ValueEncrypted, e.ValueIndex = &sealed, &index
+	return nil
+}
+
+// open reads back the value of the row, decrypting it when the row holds a
+// data key.
+func (e *ContactEntity) open(cipher *encryption.Cipher) (string, error) {
+	if e.DataKey == nil || e.KeyID == nil {
+		if e.Value == nil {
+			return "", nil
+		}
+		return *e.Value, nil
+	}
+
+	if cipher == nil {
+		return "", fmt.Errorf("contact %d: %w", e.ID, ErrEncryptionDisabled)
+	}
+
+	key, err := cipher.OpenDataKey(*e.KeyID, *e.DataKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt contact %d: %w", e.ID, err)
+	}
+
+	if e.ValueEncrypted == nil {
+		return "", nil
+	}
+
+	value, err := key.Open("value", *e.ValueEncrypted)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt contact %d: %w", e.ID, err)
+	}
+
+	return value, nil
+}
+
+// sensitiveUpdates returns the sensitive columns of the row, as written by
+// seal, for map updates.
+func (e *ContactEntity) sensitiveUpdates() map[string]interface{} {
+	return map[string]interface{}{
+		"value":           e.Value,
+		"key_id":          e.KeyID,
+		"data_key":        e.DataKey,
+		"value_encrypted": e.ValueEncrypted,
+		"value_index":     e.ValueIndex,
+	}
+}
+
+// contactIndex returns the blind index of a contact value. Emails are indexed
+// in lowercase, since they are looked up without case; the type is part of
+// the purpose, so a phone never matches an email.
+func contactIndex(cipher *encryption.Cipher, contactType, value string) string {
+	return cipher.BlindIndex("person_contact."+contactType, contactSearchValue(contactType, value))
+}
+
+func contactSearchValue(contactType, value string) string {
+	if contactType == personModel.ContactEmail {
+		return strings.ToLower(value)
+	}
+	return value
+}
+
+// contactValueCondition matches the contacts of the type holding any of the
+// values. With a cipher the blind index is matched as well as the plaintext
+// column, so that rows not yet re-encrypted are still found.
+func contactValueCondition(cipher *encryption.Cipher, contactType string, values ...string) clause.Expr {
+	column := "value"
+	searched := make([]string, len(values))
+	for i, value := range values {
+		searched[i] = contactSearchValue(contactType, value)
+	}
+	if contactType == personModel.ContactEmail {
+		column = "LOWER(value)"
+	}
+
+	if cipher == nil {
+		return gorm.Expr(column+" IN ?", searched)
+	}
+
+	indexes := make([]string, len(values))
+	for i, value := range values {
+		indexes[i] = contactIndex(cipher, contactType, value)
+	}
+
+	return gorm.Expr("("+column+" IN ? OR value_index IN ?)", searched, indexes)
+}
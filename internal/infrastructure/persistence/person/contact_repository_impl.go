@@ -0,0 +1,233 @@
+package person
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/encryption"
+
+	"gorm.io/gorm"
+)
+
+// ContactRepositoryImpl implements the ports.ContactRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type ContactRepositoryImpl struct {
+	db     *gorm.DB
+	cipher *encryption.Cipher
+}
+
+// NewContactRepository creates a new instance of ContactRepositoryImpl.
+// Contact values, and the primary phone and email mirrored on the person row,
+// are encrypted with cipher, as in NewPersonRepository.
+// It returns the implementation as the ContactRepository interface.
+func NewContactRepository(db *gorm.DB, cipher *encryption.Cipher) ports.ContactRepository {
+	return &ContactRepositoryImpl{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+func (r *ContactRepositoryImpl) Save(contact *personModel.Contact) (int, error) {
+	entity, err := ContactFromDomain(contact, r.cipher)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save contact: %w", err)
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if entity.Primary {
+			if err := demotePrimaryContact(tx, entity.PersonID, entity.Type, 0); err != nil {
+				return err
+			}
+		} else {
+			var primaries int64
+			if err := tx.Model(&ContactEntity{}).Where("person_id = ? AND type = ? AND is_primary", entity.PersonID, entity.Type).Count(&primaries).Error; err != nil {
+				return err
+			}
+			entity.Primary = primaries == 0
+		}
+
+		if err := tx.Create(entity).Error; err != nil {
+			return err
+		}
+
+		return refreshPrimaryContacts(tx, r.cipher, entity.PersonID)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save contact: %w", err)
+	}
+
+	return entity.ID, nil
+}
+
+func (r *ContactRepositoryImpl) Update(contact *personModel.Contact) error {
+	entity, err := ContactFromDomain(contact, r.cipher)
+	if err != nil {
+		return fmt.Errorf("failed to update contact: %w", err)
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if entity.Primary {
+			if err := demotePrimaryContact(tx, entity.PersonID, entity.Type, entity.ID); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&ContactEntity{}).
+			Where("id = ? AND person_id = ?", entity.ID, entity.PersonID).
+			Select(append([]string{"label", "is_primary", "verified", "updated_at"}, contactSensitiveColumns...)).
+			Updates(entity)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return personError.ErrContactNotFound
+		}
+
+		return refreshPrimaryContacts(tx, r.cipher, entity.PersonID)
+	})
+	if err != nil {
+		if errors.Is(err, personError.ErrContactNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to update contact: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ContactRepositoryImpl) Delete(personID, id int) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var entity ContactEntity
+		result := tx.Where("id = ? AND person_id = ?", id, personID).Limit(1).Find(&entity)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return personError.ErrContactNotFound
+		}
+
+		if err := tx.Delete(&ContactEntity{}, id).Error; err != nil {
+			return err
+		}
+
+		if entity.Primary {
+			var next ContactEntity
+			result = tx.Where("person_id = ? AND type = ?", personID, entity.Type).Order("created_at, id").Limit(1).Find(&next)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				if err := tx.Model(&ContactEntity{}).Where("id = ?", next.ID).Update("is_primary", true).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		return refreshPrimaryContacts(tx, r.cipher, personID)
+	})
+	if err != nil {
+		if errors.Is(err, personError.ErrContactNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete contact: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ContactRepositoryImpl) FindByID(personID, id int) (*personModel.Contact, error) {
+	var entity ContactEntity
+
+	result := r.db.Where("id = ? AND person_id = ?", id, personID).First(&entity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find contact: %w", result.Error)
+	}
+
+	return entity.ToDomain(r.cipher)
+}
+
+// FindByPerson lists the contacts of a person, the primary ones first.
+func (r *ContactRepositoryImpl) FindByPerson(personID int) ([]*personModel.Contact, error) {
+	var entities []ContactEntity
+
+	result := r.db.Where("person_id = ?", personID).Order("is_primary DESC, created_at, id").Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list contacts: %w", result.Error)
+	}
+
+	contacts := make([]*personModel.Contact, len(entities))
+	for i := range entities {
+		contact, err := entities[i].ToDomain(r.cipher)
+		if err != nil {
+			return nil, err
+		}
+		contacts[i] = contact
+	}
+
+	return contacts, nil
+}
+
+// demotePrimaryContact clears the primary flag of the person's contacts of the
+// given type other than keepID.
+func demotePrimaryContact(tx *gorm.DB, personID int, contactType string, keepID int) error {
+	return tx.Model(&ContactEntity{}).
+		Where("person_id = ? AND type = ? AND is_primary AND id <> ?", personID, contactType, keepID).
+		Update("is_primary", false).Error
+}
+
+// refreshPrimaryContacts copies the primary phone and email to the columns of
+// the person row that mirror them, and increments the person's version since
+// its representation changed. The sensitive columns are sealed again as a
+// whole, since they share the data key of the row.
+func refreshPrimaryContacts(tx *gorm.DB, cipher *encryption.Cipher, personID int) error {
+	var person PersonEntity
+	result := tx.Select(append([]string{"id"}, sensitiveColumns...)).Where("id = ?", personID).Limit(1).Find(&person)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	values, err := person.open(cipher)
+	if err != nil {
+		return err
+	}
+
+	var primaries []ContactEntity
+	if err := tx.Where("person_id = ? AND is_primary", personID).Find(&primaries).Error; err != nil {
+		return err
+	}
+
+	values.Phone, values.Email = "", ""
+	for _, contact := range primaries {
+		value, err := contact.open(cipher)
+		if err != nil {
+			return err
+		}
+
+		switch contact.Type {
+		case personModel.ContactPhone:
+			values.Phone = value
+		case personModel.ContactEmail:
+			values.Email = value
+		}
+	}
+
+	if err := person.seal(cipher, values); err != nil {
+		return err
+	}
+
+	updates := person.sensitiveUpdates()
+	updates["version"] = gorm.Expr("version + 1")
+	updates["updated_at"] = time.Now()
+
+	return tx.Model(&PersonEntity{}).Where("id = ?", personID).Updates(updates).Error
+}
@@ -0,0 +1,175 @@
+package person
+
+import (
+	"testing"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// setupContactTest saves John Doe, whose primary phone and email are
+// +5581912345678 and john.doe@example.com, and returns the repositories.
+func setupContactTest(t *testing.T) (*ContactRepositoryImpl, *PersonRepositoryImpl, int) {
+	db := setupPeopleSchemaDB(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+
+	id, err := personRepo.Save(createValidPerson(t))
+	if err != nil {
+		t.Fatalf("failed to save person: %v", err)
+	}
+
+	return NewContactRepository(db, nil).(*ContactRepositoryImpl), personRepo, id
+}
+
+// saveContact stores a contact for the person.
+func saveContact(t *testing.T, repo *ContactRepositoryImpl, personID int, contactType, value string, primary bool) int {
+	contact, err := personModel.NewContact(personID, personModel.ContactFields{Type: contactType, Value: value, Primary: primary})
+	if err != nil {
+		t.Fatalf("failed to create contact: %v", err)
+	}
+
+	id, err := repo.Save(contact)
+	if err != nil {
+		t.Fatalf("failed to save contact: %v", err)
+	}
+	return id
+}
+
+// storedPerson reads the person row, whose phone and email mirror the primary contacts.
+func storedPerson(t *testing.T, db *gorm.DB, id int) PersonEntity {
+	var entity PersonEntity
+	if err := db.First(&entity, id).Error; err != nil {
+		t.Fatalf("failed to read person: %v", err)
+	}
+	return entity
+}
+
+func TestPersonRepositoryImpl_Save_StoresContacts(t *testing.T) {
+	assert := assert.New(t)
+	repo, _, personID := setupContactTest(t)
+
+	contacts, err := repo.FindByPerson(personID)
+
+	assert.NoError(err)
+	assert.Len(contacts, 2)
+	for _, contact := range contacts {
+		assert.True(contact.Primary)
+		assert.Equal(personID, contact.PersonID)
+	}
+}
+
+func TestContactRepositoryImpl_Save_PrimaryDemotesPreviousOfSameType(t *testing.T) {
+	assert := assert.New(t)
+	repo, personRepo, personID := setupContactTest(t)
+
+	home := saveContact(t, repo, personID, personModel.ContactPhone, "8132221234", false)
+	mobile := saveContact(t, repo, personID, personModel.ContactPhone, "81988887777", true)
+
+	person, err := personRepo.FindByID(personID)
+	assert.NoError(err)
+	assert.Len(person.Contacts, 4)
+	assert.Equal(mobile, person.PrimaryContact(personModel.ContactPhone).ID)
+	assert.Equal("john.doe@example.com", person.Email(), "the primary email is kept")
+	assert.NotEqual(home, person.PrimaryContact(personModel.ContactPhone).ID)
+
+	row := storedPerson(t, repo.db, personID)
+	assert.Equal("+5581988887777", *row.PhoneNumber)
+	assert.Equal(3, row.Version, "every contact write increments the person version")
+}
+
+func TestContactRepositoryImpl_Update(t *testing.T) {
+	assert := assert.New(t)
+	repo, _, personID := setupContactTest(t)
+
+	work := saveContact(t, repo, personID, personModel.ContactEmail, "john@corp.com", false)
+
+	contact, _ := repo.FindByID(personID, work)
+	assert.NoError(contact.Replace(personModel.ContactFields{Type: personModel.ContactEmail, Value: "john.doe@corp.com", Label: "work", Primary: true, Verified: true}))
+	assert.NoError(repo.Update(contact))
+
+	updated, _ := repo.FindByID(personID, work)
+	assert.Equal("john.doe@corp.com", updated.Value)
+	assert.Equal("work", updated.Label)
+	assert.True(updated.Primary)
+	assert.True(updated.Verified)
+	assert.Equal("john.doe@corp.com", *storedPerson(t, repo.db, personID).Email)
+
+	contact.PersonID = personID + 1
+	assert.ErrorIs(repo.Update(contact), personError.ErrContactNotFound, "a contact is only updated within its person")
+}
+
+func TestContactRepositoryImpl_Delete_PromotesOldestOfSameType(t *testing.T) {
+	assert := assert.New(t)
+	repo, personRepo, personID := setupContactTest(t)
+
+	person, _ := personRepo.FindByID(personID)
+	primary := person.PrimaryContact(personModel.ContactPhone).ID
+	oldest := saveContact(t, repo, personID, personModel.ContactPhone, "8132221234", false)
+	saveContact(t, repo, personID, personModel.ContactPhone, "81988887777", false)
+
+	assert.ErrorIs(repo.Delete(personID+1, primary), personError.ErrContactNotFound)
+	assert.NoError(repo.Delete(personID, primary))
+
+	deleted, err := repo.FindByID(personID, primary)
+	assert.NoError(err)
+	assert.Nil(deleted)
+
+	person, _ = personRepo.FindByID(personID)
+	assert.Equal(oldest, person.PrimaryContact(personModel.ContactPhone).ID)
+	assert.Equal("+558132221234", *storedPerson(t, repo.db, personID).PhoneNumber)
+}
+
+func TestPersonRepositoryImpl_Update_KeepsSecondaryContacts(t *testing.T) {
+	assert := assert.New(t)
+	repo, personRepo, personID := setupContactTest(t)
+	saveContact(t, repo, personID, personModel.ContactPhone, "8132221234", false)
+
+	person, _ := personRepo.FindByID(personID)
+	newPhone := "81988887777"
+	_, err := person.ApplyChanges(personModel.PersonChanges{PhoneNumber: &newPhone})
+	assert.NoError(err)
+	assert.NoError(personRepo.Update(person))
+
+	found, _ := personRepo.FindByID(personID)
+	assert.Equal("+5581988887777", found.Phone())
+	assert.Len(found.Contacts, 3)
+	assert.Equal("+5581988887777", *storedPerson(t, repo.db, personID).PhoneNumber)
+}
+
+func TestPersonRepositoryImpl_FindByContact(t *testing.T) {
+	assert := assert.New(t)
+	repo, personRepo, personID := setupContactTest(t)
+	saveContact(t, repo, personID, personModel.ContactEmail, "John@Corp.com", false)
+	other, _ := personRepo.Save(newNamedPerson(t, "Jane Doe", "22233344405"))
+	saveContact(t, repo, other, personModel.ContactPhone, "8132221234", false)
+	saveContact(t, repo, personID, personModel.ContactPhone, "8132221234", false)
+
+	byEmail, err := personRepo.FindByContact(personModel.ContactEmail, "john@corp.com")
+	assert.NoError(err)
+	assert.Len(byEmail, 1)
+	assert.Equal(personID, byEmail[0].ID)
+	assert.Len(byEmail[0].Contacts, 4)
+
+	byPhone, err := personRepo.FindByContact(personModel.ContactPhone, "+558132221234")
+	assert.NoError(err)
+	assert.Len(byPhone, 2, "a shared phone number finds every person that has it")
+
+	none, err := personRepo.FindByContact(personModel.ContactEmail, "+558132221234")
+	assert.NoError(err)
+	assert.Empty(none)
+}
+
+func TestPersonRepositoryImpl_FindAll_FilterMatchesSecondaryContacts(t *testing.T) {
+	assert := assert.New(t)
+	repo, personRepo, personID := setupContactTest(t)
+	saveContact(t, repo, personID, personModel.ContactEmail, "john@corp.com", false)
+
+	persons, total, err := personRepo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{Email: "john@corp", EmailPrefix: true})
+
+	assert.NoError(err)
+	assert.Equal(int64(1), total)
+	assert.Equal("john.doe@example.com", persons[0].Email(), "the primary email is still reported")
+}
@@ -0,0 +1,59 @@
+package person
+
+import (
+	"time"
+
+	personModel "pessoas-api/internal/domain/person/model"
+)
+
+type DocumentEntity struct {
+	ID            int        `gorm:"column:id;primaryKey;autoIncrement"`
+	PersonID      int        `gorm:"column:person_id;not null;index"`
+	Type          string     `gorm:"column:type;type:varchar(20);not null;uniqueIndex:idx_person_document_number"`
+	Number        string     `gorm:"column:number;type:varchar(20);not null;uniqueIndex:idx_person_document_number"`
+	IssuingState  string     `gorm:"column:issuing_state;type:char(2);not null;default:'';uniqueIndex:idx_person_document_number"`
+	IssuingAgency string     `gorm:"column:issuing_agency;type:varchar(20);not null;default:''"`
+	Country       string     `gorm:"column:country;type:char(2);not null;default:'';uniqueIndex:idx_person_document_number"`
+	IssueDate     *time.Time `gorm:"column:issue_date;type:date"`
+	ExpiryDate    *time.Time `gorm:"column:expiry_date;type:date;index"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;type:timestamp;not null"`
+}
+
+func (DocumentEntity) TableName() string {
+	return "people.person_document"
+}
+
+func (e *DocumentEntity) ToDomain() *personModel.Document {
+	return &personModel.Document{
+		DocumentFields: personModel.DocumentFields{
+			Type:          e.Type,
+			Number:        e.Number,
+			IssuingState:  e.IssuingState,
+			IssuingAgency: e.IssuingAgency,
+			Country:       e.Country,
+			IssueDate:     e.IssueDate,
+			ExpiryDate:    e.ExpiryDate,
+		},
+		ID:        e.ID,
+		PersonID:  e.PersonID,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+func DocumentFromDomain(d *personModel.Document) *DocumentEntity {
+	return &DocumentEntity{
+		ID:            d.ID,
+		PersonID:      d.PersonID,
+		Type:          d.Type,
+		Number:        d.Number,
+		IssuingState:  d.IssuingState,
+		IssuingAgency: d.IssuingAgency,
+		Country:       d.Country,
+		IssueDate:     d.IssueDate,
+		ExpiryDate:    d.ExpiryDate,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
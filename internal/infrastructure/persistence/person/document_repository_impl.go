@@ -0,0 +1,140 @@
+package person
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"gorm.io/gorm"
+)
+
+// DocumentRepositoryImpl implements the ports.DocumentRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type DocumentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewDocumentRepository creates a new instance of DocumentRepositoryImpl.
+// It returns the implementation as the DocumentRepository interface.
+func NewDocumentRepository(db *gorm.DB) ports.DocumentRepository {
+	return &DocumentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *DocumentRepositoryImpl) Save(document *personModel.Document) (int, error) {
+	entity := DocumentFromDomain(document)
+
+	if err := r.db.Create(entity).Error; err != nil {
+		return 0, fmt.Errorf("failed to save document: %w", err)
+	}
+
+	return entity.ID, nil
+}
+
+func (r *DocumentRepositoryImpl) Update(document *personModel.Document) error {
+	entity := DocumentFromDomain(document)
+
+	result := r.db.Model(&DocumentEntity{}).
+		Where("id = ? AND person_id = ?", entity.ID, entity.PersonID).
+		Select("number", "issuing_state", "issuing_agency", "country", "issue_date", "expiry_date", "updated_at").
+		Updates(entity)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update document: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return personError.ErrDocumentNotFound
+	}
+
+	return nil
+}
+
+func (r *DocumentRepositoryImpl) Delete(personID, id int) error {
+	result := r.db.Where("id = ? AND person_id = ?", id, personID).Delete(&DocumentEntity{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete document: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return personError.ErrDocumentNotFound
+	}
+
+	return nil
+}
+
+func (r *DocumentRepositoryImpl) FindByID(personID, id int) (*personModel.Document, error) {
+	var entity DocumentEntity
+
+	result := r.db.Where("id = ? AND person_id = ?", id, personID).First(&entity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find document: %w", result.Error)
+	}
+
+	return entity.ToDomain(), nil
+}
+
+// FindByPerson lists the documents of a person by type, oldest first.
+func (r *DocumentRepositoryImpl) FindByPerson(personID int) ([]*personModel.Document, error) {
+	var entities []DocumentEntity
+
+	result := r.db.Where("person_id = ?", personID).Order("type, created_at, id").Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", result.Error)
+	}
+
+	return documentsToDomain(entities), nil
+}
+
+// FindByNumber returns the documents with the given number, of the given type
+// or of any type when documentType is empty.
+func (r *DocumentRepositoryImpl) FindByNumber(documentType, number string) ([]*personModel.Document, error) {
+	var entities []DocumentEntity
+
+	db := r.db.Where("number = ?", number)
+	if documentType != "" {
+		db = db.Where("type = ?", documentType)
+	}
+
+	if err := db.Order("person_id, id").Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("failed to find documents by number: %w", err)
+	}
+
+	return documentsToDomain(entities), nil
+}
+
+func (r *DocumentRepositoryImpl) FindExpiring(from *time.Time, until time.Time, page, pageSize int) ([]*personModel.Document, int64, error) {
+	var entities []DocumentEntity
+	var total int64
+
+	db := r.db.Model(&DocumentEntity{}).
+		Where("expiry_date IS NOT NULL AND expiry_date <= ?", until).
+		Where("person_id IN (?)", r.db.Model(&PersonEntity{}).Select("id"))
+	if from != nil {
+		db = db.Where("expiry_date >= ?", *from)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count expiring documents: %w", err)
+	}
+
+	result := db.Order("expiry_date, id").Offset((page - 1) * pageSize).Limit(pageSize).Find(&entities)
+	if result.Error != nil {
+		return nil, 0, fmt.Errorf("failed to find expiring documents: %w", result.Error)
+	}
+
+	return documentsToDomain(entities), total, nil
+}
+
+func documentsToDomain(entities []DocumentEntity) []*personModel.Document {
+	documents := make([]*personModel.Document, len(entities))
+	for i := range entities {
+		documents[i] = entities[i].ToDomain()
+	}
+	return documents
+}
@@ -0,0 +1,179 @@
+package person
+
+import (
+	"testing"
+	"time"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupDocumentTest creates the person_document table, saves John Doe and
+// returns the repositories with his ID.
+func setupDocumentTest(t *testing.T) (*DocumentRepositoryImpl, *PersonRepositoryImpl, int) {
+	db := setupPeopleSchemaDB(t)
+
+	statements := []string{
+		`CREATE TABLE people.person_document (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			type VARCHAR(20) NOT NULL,
+			number VARCHAR(20) NOT NULL,
+			issuing_state CHAR(2) NOT NULL DEFAULT '',
+			issuing_agency VARCHAR(20) NOT NULL DEFAULT '',
+			country CHAR(2) NOT NULL DEFAULT '',
+			issue_date DATE,
+			expiry_date DATE,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE UNIQUE INDEX people.idx_person_document_number ON person_document (type, number, issuing_state, country)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare document table: %v", err)
+		}
+	}
+
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+	id, err := personRepo.Save(createValidPerson(t))
+	if err != nil {
+		t.Fatalf("failed to save person: %v", err)
+	}
+
+	return NewDocumentRepository(db).(*DocumentRepositoryImpl), personRepo, id
+}
+
+// savePassport stores a Brazilian passport for the person expiring on the given date.
+func savePassport(t *testing.T, repo *DocumentRepositoryImpl, personID int, number string, expiry time.Time) int {
+	document, err := personModel.NewDocument(personID, personModel.DocumentFields{
+		Type:       personModel.DocumentPassport,
+		Number:     number,
+		Country:    "BR",
+		ExpiryDate: &expiry,
+	})
+	if err != nil {
+		t.Fatalf("failed to create passport: %v", err)
+	}
+
+	id, err := repo.Save(document)
+	if err != nil {
+		t.Fatalf("failed to save passport: %v", err)
+	}
+	return id
+}
+
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+func TestDocumentRepositoryImpl_SaveAndFind(t *testing.T) {
+	assert := assert.New(t)
+	repo, _, personID := setupDocumentTest(t)
+
+	rg, err := personModel.NewDocument(personID, personModel.DocumentFields{
+		Type:          personModel.DocumentRG,
+		Number:        "12.345.678-x",
+		IssuingState:  "pe",
+		IssuingAgency: "ssp",
+	})
+	assert.NoError(err)
+	rgID, err := repo.Save(rg)
+	assert.NoError(err)
+	savePassport(t, repo, personID, "FZ123456", today().AddDate(1, 0, 0))
+
+	found, err := repo.FindByID(personID, rgID)
+	assert.NoError(err)
+	assert.Equal("12345678X", found.Number)
+	assert.Equal("PE", found.IssuingState)
+	assert.Equal("SSP", found.IssuingAgency)
+
+	missing, err := repo.FindByID(personID+1, rgID)
+	assert.NoError(err)
+	assert.Nil(missing, "a document is only found within its person")
+
+	documents, err := repo.FindByPerson(personID)
+	assert.NoError(err)
+	assert.Len(documents, 2)
+	assert.Equal(personModel.DocumentPassport, documents[0].Type, "documents are listed by type")
+
+	matches, err := repo.FindByNumber("", "12345678X")
+	assert.NoError(err)
+	assert.Len(matches, 1)
+	matches, err = repo.FindByNumber(personModel.DocumentCNH, "12345678X")
+	assert.NoError(err)
+	assert.Empty(matches)
+}
+
+func TestDocumentRepositoryImpl_Save_DuplicateNumber_ShouldFail(t *testing.T) {
+	repo, _, personID := setupDocumentTest(t)
+
+	expiry := today().AddDate(1, 0, 0)
+	savePassport(t, repo, personID, "FZ123456", expiry)
+
+	duplicate, _ := personModel.NewDocument(personID, personModel.DocumentFields{
+		Type:       personModel.DocumentPassport,
+		Number:     "FZ123456",
+		Country:    "BR",
+		ExpiryDate: &expiry,
+	})
+	_, err := repo.Save(duplicate)
+
+	assert.Error(t, err)
+}
+
+func TestDocumentRepositoryImpl_UpdateAndDelete(t *testing.T) {
+	assert := assert.New(t)
+	repo, _, personID := setupDocumentTest(t)
+
+	id := savePassport(t, repo, personID, "FZ123456", today().AddDate(1, 0, 0))
+
+	document, _ := repo.FindByID(personID, id)
+	fields := document.DocumentFields
+	fields.Number = "GA654321"
+	assert.NoError(document.Replace(fields))
+	assert.NoError(repo.Update(document))
+
+	updated, _ := repo.FindByID(personID, id)
+	assert.Equal("GA654321", updated.Number)
+
+	document.PersonID = personID + 1
+	assert.ErrorIs(repo.Update(document), personError.ErrDocumentNotFound)
+
+	assert.ErrorIs(repo.Delete(personID+1, id), personError.ErrDocumentNotFound)
+	assert.NoError(repo.Delete(personID, id))
+	deleted, err := repo.FindByID(personID, id)
+	assert.NoError(err)
+	assert.Nil(deleted)
+}
+
+func TestDocumentRepositoryImpl_FindExpiring(t *testing.T) {
+	assert := assert.New(t)
+	repo, personRepo, personID := setupDocumentTest(t)
+
+	now := today()
+	expired := savePassport(t, repo, personID, "AA111111", now.AddDate(0, 0, -5))
+	soon := savePassport(t, repo, personID, "BB222222", now.AddDate(0, 0, 10))
+	savePassport(t, repo, personID, "CC333333", now.AddDate(0, 0, 100))
+
+	other, err := personModel.NewPerson("Jane Doe", "52998224725", time.Date(1985, time.May, 5, 0, 0, 0, 0, time.UTC), "81987654321", "jane.doe@example.com")
+	assert.NoError(err)
+	otherID, err := personRepo.Save(other)
+	assert.NoError(err)
+	savePassport(t, repo, otherID, "DD444444", now.AddDate(0, 0, 3))
+	assert.NoError(personRepo.Delete(otherID, 1))
+
+	until := now.AddDate(0, 0, 30)
+	documents, total, err := repo.FindExpiring(&now, until, 1, 10)
+	assert.NoError(err)
+	assert.Equal(int64(1), total, "documents of deleted persons are left out")
+	assert.Equal(soon, documents[0].ID)
+
+	documents, total, err = repo.FindExpiring(nil, until, 1, 1)
+	assert.NoError(err)
+	assert.Equal(int64(2), total)
+	assert.Len(documents, 1)
+	assert.Equal(expired, documents[0].ID, "ordered by expiry date")
+}
@@ -0,0 +1,205 @@
+package person
+
+import (
+	"fmt"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/encryption"
+
+	"gorm.io/gorm"
+)
+
+// DuplicateRepositoryImpl implements the ports.DuplicateRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type DuplicateRepositoryImpl struct {
+	db     *gorm.DB
+	cipher *encryption.Cipher
+}
+
+// NewDuplicateRepository creates a new instance of DuplicateRepositoryImpl.
+// Persons are read with cipher, as in NewPersonRepository.
+// It returns the implementation as the DuplicateRepository interface.
+func NewDuplicateRepository(db *gorm.DB, cipher *encryption.Cipher) ports.DuplicateRepository {
+	return &DuplicateRepositoryImpl{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+// FindCandidates ORs the criteria together; each of them is served by an
+// index, so that only a small share of the persons gets scored. Encrypted
+// birth dates, CPFs, phones and emails are matched through their blind indexes.
+func (r *DuplicateRepositoryImpl) FindCandidates(criteria personModel.DuplicateCriteria, limit int) ([]*personModel.Person, error) {
+	var entities []PersonEntity
+
+	matches := r.db.Where(birthDateCondition(r.cipher, criteria.BirthDate))
+
+	if criteria.FirstName != "" {
+		matches = matches.Or(`name_search LIKE ? ESCAPE '\'`, escapeLike(criteria.FirstName)+" %")
+	}
+
+	if len(criteria.CPFs) > 0 {
+		matches = matches.Or(cpfCondition(r.cipher, criteria.CPFs...))
+	}
+
+	if len(criteria.Phones) > 0 {
+		matches = matches.Or("id IN (?)", contactsOfType(r.db, personModel.ContactPhone).Where(contactValueCondition(r.cipher, personModel.ContactPhone, criteria.Phones...)))
+	}
+
+	if len(criteria.Emails) > 0 {
+		matches = matches.Or("id IN (?)", contactsOfType(r.db, personModel.ContactEmail).Where(contactValueCondition(r.cipher, personModel.ContactEmail, criteria.Emails...)))
+	}
+
+	result := withContacts(r.db).
+		Where("id <> ?", criteria.ExcludeID).
+		Where(matches).
+		Order("id").
+		Limit(limit).
+		Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find duplicate candidates: %w", result.Error)
+	}
+
+	persons := make([]*personModel.Person, len(entities))
+	for i := range entities {
+		person, err := entities[i].ToDomain(r.cipher)
+		if err != nil {
+			return nil, err
+		}
+		persons[i] = person
+	}
+
+	return persons, nil
+}
+
+func (r *DuplicateRepositoryImpl) Merge(merge *personModel.Merge, record func(tx ports.Repositories) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PersonEntity{}).
+			Where("id = ? AND version = ?", merge.SurvivorID, merge.SurvivorVersion).
+			Updates(map[string]interface{}{
+				"version":    merge.SurvivorVersion + 1,
+				"updated_at": merge.MergedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to merge persons: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return personError.ErrVersionConflict
+		}
+
+		result = tx.Model(&PersonEntity{}).
+			Where("id = ? AND version = ?", merge.DuplicateID, merge.DuplicateVersion).
+			Updates(map[string]interface{}{
+				"deleted_at":     merge.MergedAt,
+				"merged_into_id": merge.SurvivorID,
+				"version":        merge.DuplicateVersion + 1,
+				"updated_at":     merge.MergedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to merge persons: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return personError.ErrVersionConflict
+		}
+
+		for i := range merge.Contacts {
+			contact, err := ContactFromDomain(&merge.Contacts[i], r.cipher)
+			if err != nil {
+				return fmt.Errorf("failed to merge contacts: %w", err)
+			}
+			if err := tx.Create(contact).Error; err != nil {
+				return fmt.Errorf("failed to merge contacts: %w", err)
+			}
+		}
+
+		if err := moveAddresses(tx, merge.DuplicateID, merge.SurvivorID); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&DocumentEntity{}).Where("person_id = ?", merge.DuplicateID).Update("person_id", merge.SurvivorID).Error; err != nil {
+			return fmt.Errorf("failed to merge documents: %w", err)
+		}
+
+		if err := moveConsents(tx, merge); err != nil {
+			return err
+		}
+
+		if len(merge.DroppedRelationships) > 0 {
+			if err := tx.Where("id IN ?", merge.DroppedRelationships).Delete(&RelationshipEntity{}).Error; err != nil {
+				return fmt.Errorf("failed to merge relationships: %w", err)
+			}
+		}
+
+		for _, relationship := range merge.Relationships {
+			err := tx.Model(&RelationshipEntity{}).Where("id = ?", relationship.ID).Updates(map[string]interface{}{
+				"person_id":         relationship.PersonID,
+				"related_person_id": relationship.RelatedID,
+			}).Error
+			if err != nil {
+				return fmt.Errorf("failed to merge relationships: %w", err)
+			}
+		}
+
+		return record(transactionRepositories(tx, r.cipher))
+	})
+}
+
+// moveConsents hands the consents of the duplicate to the survivor for the
+// purposes the survivor has no consent for, appending a merge event to the
+// history of each moved consent. For the other purposes the survivor keeps
+// its own consent and the duplicate's stays with the duplicate.
+func moveConsents(tx *gorm.DB, merge *personModel.Merge) error {
+	var entities []ConsentEntity
+
+	err := tx.Where("person_id = ?", merge.DuplicateID).
+		Where("purpose NOT IN (?)", tx.Model(&ConsentEntity{}).Select("purpose").Where("person_id = ?", merge.SurvivorID)).
+		Order("id").
+		Find(&entities).Error
+	if err != nil {
+		return fmt.Errorf("failed to merge consents: %w", err)
+	}
+
+	for _, entity := range entities {
+		err := tx.Model(&ConsentEntity{}).Where("id = ?", entity.ID).Updates(map[string]interface{}{
+			"person_id":  merge.SurvivorID,
+			"updated_at": merge.MergedAt,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to merge consents: %w", err)
+		}
+
+		entity.PersonID = merge.SurvivorID
+		consent := entity.ToDomain()
+		event := consent.Event(personModel.ConsentActionMerge, consent.Channel, consent.EvidenceRef, merge.Actor)
+		event.OccurredAt = merge.MergedAt
+
+		if err := tx.Create(ConsentEventFromDomain(event)).Error; err != nil {
+			return fmt.Errorf("failed to save consent event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// moveAddresses hands the addresses of one person to another. The moved
+// addresses lose their primary flag when the receiving person already has
+// addresses, so that it keeps its own primary one.
+func moveAddresses(tx *gorm.DB, fromID, toID int) error {
+	var existing int64
+	if err := tx.Model(&AddressEntity{}).Where("person_id = ?", toID).Count(&existing).Error; err != nil {
+		return fmt.Errorf("failed to merge addresses: %w", err)
+	}
+
+	updates := map[string]interface{}{"person_id": toID}
+	if existing > 0 {
+		updates["is_primary"] = false
+	}
+
+	if err := tx.Model(&AddressEntity{}).Where("person_id = ?", fromID).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to merge addresses: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package person
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	auditModel "pessoas-api/internal/domain/audit/model"
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// setupDuplicateTest prepares every table a merge touches.
+func setupDuplicateTest(t *testing.T) (*DuplicateRepositoryImpl, *gorm.DB) {
+	db := setupAddressDB(t)
+
+	statements := []string{
+		`CREATE TABLE people.person_document (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			type VARCHAR(20) NOT NULL,
+			number VARCHAR(20) NOT NULL,
+			issuing_state CHAR(2) NOT NULL DEFAULT '',
+			issuing_agency VARCHAR(20) NOT NULL DEFAULT '',
+			country CHAR(2) NOT NULL DEFAULT '',
+			issue_date DATE,
+			expiry_date DATE,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE TABLE people.person_relationship (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			related_person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			type VARCHAR(30) NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE UNIQUE INDEX people.idx_person_relationship_link ON person_relationship (person_id, related_person_id, type)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare merge tables: %v", err)
+		}
+	}
+	createConsentTables(t, db)
+
+	return NewDuplicateRepository(db, nil).(*DuplicateRepositoryImpl), db
+}
+
+// recordNothing stands in for the audit of a merge.
+func recordNothing(ports.Repositories) error {
+	return nil
+}
+
+// saveDuplicateSubject stores a person with the given name, CPF, birth date and email.
+func saveDuplicateSubject(t *testing.T, repo *PersonRepositoryImpl, name, cpf string, birthDate time.Time, email string) *personModel.Person {
+	p, err := personModel.NewPerson(name, cpf, birthDate, "81912345678", email)
+	if err != nil {
+		t.Fatalf("failed to create person: %v", err)
+	}
+
+	id, err := repo.Save(p)
+	if err != nil {
+		t.Fatalf("failed to save person: %v", err)
+	}
+
+	saved, err := repo.FindByID(id)
+	if err != nil {
+		t.Fatalf("failed to reload person: %v", err)
+	}
+	return saved
+}
+
+func TestDuplicateRepositoryImpl_FindCandidates(t *testing.T) {
+	assert := assert.New(t)
+	repo, db := setupDuplicateTest(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+
+	birthDate := time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)
+	other := time.Date(1970, time.February, 3, 0, 0, 0, 0, time.UTC)
+
+	subject := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", birthDate, "jose@example.com")
+	sameBirth := saveDuplicateSubject(t, personRepo, "Maria Souza", "52998224725", birthDate, "maria@example.com")
+	sameFirstName := saveDuplicateSubject(t, personRepo, "Jose Santos", "39053344705", other, "santos@example.com")
+	sameEmail := saveDuplicateSubject(t, personRepo, "Pedro Lima", "22233344405", other, "JOSE@example.com")
+	cpfTypo := saveDuplicateSubject(t, personRepo, "Ana Costa", "12144477752", other, "ana@example.com")
+	saveDuplicateSubject(t, personRepo, "Carla Dias", "45317828791", other, "carla@example.com")
+
+	// Every person shares the primary phone, so phones are left out of the
+	// criteria to check the other conditions.
+	criteria := personModel.NewDuplicateCriteria(subject)
+	criteria.Phones = nil
+
+	candidates, err := repo.FindCandidates(criteria, 10)
+
+	assert.NoError(err)
+	ids := make([]int, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.ID
+	}
+	assert.Equal([]int{sameBirth.ID, sameFirstName.ID, sameEmail.ID, cpfTypo.ID}, ids)
+	assert.Len(candidates[0].Contacts, 2)
+
+	assert.NoError(personRepo.Delete(sameBirth.ID, sameBirth.Version))
+
+	candidates, err = repo.FindCandidates(criteria, 2)
+
+	assert.NoError(err)
+	assert.Len(candidates, 2)
+	assert.Equal(sameFirstName.ID, candidates[0].ID)
+}
+
+func TestDuplicateRepositoryImpl_FindCandidates_Encrypted(t *testing.T) {
+	assert := assert.New(t)
+	_, db := setupDuplicateTest(t)
+	cipher := newTestCipher(t, "k1")
+	repo := NewDuplicateRepository(db, cipher)
+	personRepo := NewPersonRepository(db, cipher).(*PersonRepositoryImpl)
+
+	birthDate := time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)
+	other := time.Date(1970, time.February, 3, 0, 0, 0, 0, time.UTC)
+
+	subject := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", birthDate, "jose@example.com")
+	sameBirth := saveDuplicateSubject(t, personRepo, "Maria Souza", "52998224725", birthDate, "maria@example.com")
+	cpfTypo := saveDuplicateSubject(t, personRepo, "Ana Costa", "12144477752", other, "ana@example.com")
+	saveDuplicateSubject(t, personRepo, "Carla Dias", "45317828791", other, "carla@example.com")
+
+	criteria := personModel.NewDuplicateCriteria(subject)
+	criteria.Phones = nil
+
+	candidates, err := repo.FindCandidates(criteria, 10)
+
+	assert.NoError(err)
+	assert.Len(candidates, 2, "birth dates and CPFs are matched through their blind indexes")
+	assert.Equal(sameBirth.ID, candidates[0].ID)
+	assert.Equal(birthDate, candidates[0].BirthDate)
+	assert.Equal(cpfTypo.ID, candidates[1].ID)
+}
+
+func TestDuplicateRepositoryImpl_Merge(t *testing.T) {
+	assert := assert.New(t)
+	repo, db := setupDuplicateTest(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+	addressRepo := NewAddressRepository(db).(*AddressRepositoryImpl)
+	documentRepo := NewDocumentRepository(db).(*DocumentRepositoryImpl)
+	relationshipRepo := NewRelationshipRepository(db).(*RelationshipRepositoryImpl)
+
+	birthDate := time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)
+	survivor := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", birthDate, "jose@example.com")
+	duplicate := saveDuplicateSubject(t, personRepo, "Jose da Silva", "12144477752", birthDate, "jose.silva@example.com")
+	child := saveDuplicateSubject(t, personRepo, "Lia Silva", "52998224725", time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC), "lia@example.com")
+
+	survivorAddress := saveAddress(t, addressRepo, survivor.ID, personModel.AddressResidential, true)
+	duplicateAddress := saveAddress(t, addressRepo, duplicate.ID, personModel.AddressWork, true)
+	passport := savePassport(t, documentRepo, duplicate.ID, "FZ123456", today().AddDate(1, 0, 0))
+	betweenThem := saveRelationship(t, relationshipRepo, survivor.ID, duplicate.ID, personModel.RelationshipSpouse)
+	childLink := saveRelationship(t, relationshipRepo, child.ID, duplicate.ID, personModel.RelationshipParent)
+
+	relationships, err := relationshipRepo.FindByPersons([]int{survivor.ID, duplicate.ID})
+	assert.NoError(err)
+	merge, err := personModel.NewMerge(survivor, duplicate, relationships, auditModel.Actor{OperatorID: 1}, time.Now())
+	assert.NoError(err)
+
+	assert.NoError(repo.Merge(merge, recordNothing))
+
+	merged, err := personRepo.FindByID(survivor.ID)
+	assert.NoError(err)
+	assert.Equal(survivor.Version+1, merged.Version)
+	assert.Len(merged.Contacts, 3)
+	assert.Equal("jose@example.com", merged.Email())
+
+	removed, err := personRepo.FindByIDIncludingDeleted(duplicate.ID)
+	assert.NoError(err)
+	assert.True(removed.IsDeleted())
+	assert.Equal(&survivor.ID, removed.MergedIntoID)
+
+	addresses, err := addressRepo.FindByPerson(survivor.ID)
+	assert.NoError(err)
+	assert.Len(addresses, 2)
+	assert.Equal(survivorAddress, primaryAddressID(t, addressRepo, survivor.ID))
+	assert.Contains([]int{addresses[0].ID, addresses[1].ID}, duplicateAddress)
+
+	document, err := documentRepo.FindByID(survivor.ID, passport)
+	assert.NoError(err)
+	assert.NotNil(document)
+
+	dropped, err := relationshipRepo.FindByID(betweenThem)
+	assert.NoError(err)
+	assert.Nil(dropped)
+
+	moved, err := relationshipRepo.FindByID(childLink)
+	assert.NoError(err)
+	assert.Equal(survivor.ID, moved.RelatedID)
+
+	mergedInto, err := personRepo.FindMergedInto([]int{survivor.ID})
+	assert.NoError(err)
+	assert.Equal([]int{duplicate.ID}, mergedInto)
+}
+
+func TestDuplicateRepositoryImpl_Merge_MovesConsents(t *testing.T) {
+	assert := assert.New(t)
+	repo, db := setupDuplicateTest(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+	consentRepo := NewConsentRepository(db).(*ConsentRepositoryImpl)
+
+	birthDate := time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)
+	survivor := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", birthDate, "jose@example.com")
+	duplicate := saveDuplicateSubject(t, personRepo, "Jose da Silva", "12144477752", birthDate, "jose.silva@example.com")
+
+	survivorMarketing := grantConsent(t, consentRepo, survivor.ID, personModel.ConsentMarketing)
+	duplicateMarketing := grantConsent(t, consentRepo, duplicate.ID, personModel.ConsentMarketing)
+	duplicateAnalytics := grantConsent(t, consentRepo, duplicate.ID, personModel.ConsentAnalytics)
+
+	merge, err := personModel.NewMerge(survivor, duplicate, nil, auditModel.Actor{OperatorID: 7, RequestID: "req-1"}, time.Now())
+	assert.NoError(err)
+
+	assert.NoError(repo.Merge(merge, recordNothing))
+
+	consents, err := consentRepo.FindByPerson(survivor.ID)
+	assert.NoError(err)
+	assert.Len(consents, 2)
+	assert.Equal(duplicateAnalytics.ID, consents[0].ID, "the survivor takes over the purposes it lacked")
+	assert.Equal(survivorMarketing.ID, consents[1].ID, "the survivor keeps its own consent")
+
+	kept, err := consentRepo.FindByPurpose(duplicate.ID, personModel.ConsentMarketing)
+	assert.NoError(err)
+	assert.Equal(duplicateMarketing.ID, kept.ID)
+
+	events, err := consentRepo.FindEvents([]int{survivor.ID})
+	assert.NoError(err)
+	assert.Len(events, 2)
+	assert.Equal(personModel.ConsentActionMerge, events[0].Action)
+	assert.Equal(duplicateAnalytics.ID, events[0].ConsentID)
+	assert.Equal(7, events[0].OperatorID)
+	assert.Equal("req-1", events[0].RequestID)
+}
+
+func TestDuplicateRepositoryImpl_Merge_VersionConflict(t *testing.T) {
+	assert := assert.New(t)
+	repo, db := setupDuplicateTest(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+
+	birthDate := time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)
+	survivor := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", birthDate, "jose@example.com")
+	duplicate := saveDuplicateSubject(t, personRepo, "Jose da Silva", "12144477752", birthDate, "jose.silva@example.com")
+
+	merge, err := personModel.NewMerge(survivor, duplicate, nil, auditModel.Actor{OperatorID: 1}, time.Now())
+	assert.NoError(err)
+	merge.DuplicateVersion++
+
+	err = repo.Merge(merge, recordNothing)
+
+	assert.ErrorIs(err, personError.ErrVersionConflict)
+
+	unchanged, err := personRepo.FindByID(survivor.ID)
+	assert.NoError(err)
+	assert.Equal(survivor.Version, unchanged.Version)
+	assert.Len(unchanged.Contacts, 2)
+}
+
+func TestDuplicateRepositoryImpl_Merge_RecordFailureRollsBack(t *testing.T) {
+	assert := assert.New(t)
+	repo, db := setupDuplicateTest(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+	auditFailure := errors.New("audit table unavailable")
+
+	birthDate := time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC)
+	survivor := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", birthDate, "jose@example.com")
+	duplicate := saveDuplicateSubject(t, personRepo, "Jose da Silva", "12144477752", birthDate, "jose.silva@example.com")
+
+	merge, err := personModel.NewMerge(survivor, duplicate, nil, auditModel.Actor{OperatorID: 1}, time.Now())
+	assert.NoError(err)
+
+	var reloaded *personModel.Person
+	err = repo.Merge(merge, func(tx ports.Repositories) error {
+		reloaded, err = tx.Persons.FindByID(survivor.ID)
+		if err != nil {
+			return err
+		}
+		return auditFailure
+	})
+
+	assert.ErrorIs(err, auditFailure)
+	assert.Equal(survivor.Version+1, reloaded.Version, "record sees the merge within the transaction")
+
+	unchanged, err := personRepo.FindByID(duplicate.ID)
+	assert.NoError(err)
+	assert.NotNil(unchanged, "the merge is rolled back without its audit entry")
+	assert.Equal(duplicate.Version, unchanged.Version)
+}
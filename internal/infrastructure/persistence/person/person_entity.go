@@ -1,48 +1,321 @@
 package person
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	personModel "pessoas-api/internal/domain/person/model"
+	personUtils "pessoas-api/internal/domain/person/utils"
+	"pessoas-api/internal/infrastructure/encryption"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// PersonEntity is a row of people.person. PhoneNumber and Email mirror the
+// primary phone and email contacts, so that listings can be sorted and
+// streamed without reading people.person_contact.
+//
+// The CPF, birth date, phone and email are sensitive: when encryption is
+// enabled they are stored in the *Encrypted columns under the row's data key
+// and their plaintext columns are left NULL (see seal). Rows written before
+// encryption was enabled keep them in plaintext until they are rewritten or
+// re-encrypted.
 type PersonEntity struct {
-	ID          int       `gorm:"column:id;primaryKey;autoIncrement"`
-	Name        string    `gorm:"column:name;type:varchar(255);not null"`
-	CPF         string    `gorm:"column:cpf;type:varchar(11);not null;uniqueIndex"`
-	BirthDate   time.Time `gorm:"column:birth_date;type:date;not null"`
-	PhoneNumber string    `gorm:"column:phone_number;type:varchar(11);not null"`
-	Email       string    `gorm:"column:email;type:varchar(255);not null"`
-	CreatedAt   time.Time `gorm:"column:created_at;type:timestamp;not null"`
-	UpdatedAt   time.Time `gorm:"column:updated_at;type:timestamp;not null"`
+	ID                   int             `gorm:"column:id;primaryKey;autoIncrement"`
+	Name                 string          `gorm:"column:name;type:varchar(255);not null"`
+	NameSearch           string          `gorm:"column:name_search;type:varchar(255);not null;default:''"`
+	CPF                  *string         `gorm:"column:cpf;type:varchar(11);uniqueIndex:idx_person_cpf_active,where:deleted_at IS NULL"`
+	BirthDate            *time.Time      `gorm:"column:birth_date;type:date"`
+	PhoneNumber          *string         `gorm:"column:phone_number;type:varchar(16)"`
+	Email                *string         `gorm:"column:email;type:varchar(255)"`
+	KeyID                *string         `gorm:"column:key_id;type:varchar(32);index"`
+	DataKey              *string         `gorm:"column:data_key;type:text"`
+	CPFEncrypted         *string         `gorm:"column:cpf_encrypted;type:text"`
+	BirthDateEncrypted   *string         `gorm:"column:birth_date_encrypted;type:text"`
+	PhoneNumberEncrypted *string         `gorm:"column:phone_number_encrypted;type:text"`
+	EmailEncrypted       *string         `gorm:"column:email_encrypted;type:text"`
+	CPFIndex             *string         `gorm:"column:cpf_index;type:char(64);uniqueIndex:idx_person_cpf_index_active,where:deleted_at IS NULL"`
+	BirthDateIndex       *string         `gorm:"column:birth_date_index;type:char(64);index"`
+	Version              int             `gorm:"column:version;not null;default:1"`
+	CreatedAt            time.Time       `gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt            time.Time       `gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt            gorm.DeletedAt  `gorm:"column:deleted_at;type:timestamp;index"`
+	MergedIntoID         *int            `gorm:"column:merged_into_id;index"`
+	AnonymizedAt         *time.Time      `gorm:"column:anonymized_at;type:timestamp"`
+	Contacts             []ContactEntity `gorm:"foreignKey:PersonID"`
+}
+
+// sensitiveColumns are the columns written by seal. They are always written
+// together, since the ciphertexts depend on the data key of the row.
+var sensitiveColumns = []string{
+	"cpf", "birth_date", "phone_number", "email",
+	"key_id", "data_key",
+	"cpf_encrypted", "birth_date_encrypted", "phone_number_encrypted", "email_encrypted",
+	"cpf_index", "birth_date_index",
+}
+
+// Purposes of the blind indexes, which keep the index of a CPF from matching
+// the index of the same digits in another field.
+const (
+	cpfIndexPurpose       = "person.cpf"
+	birthDateIndexPurpose = "person.birth_date"
+)
+
+// birthDateLayout is the format birth dates are encrypted and indexed in.
+const birthDateLayout = "2006-01-02"
+
+// ErrEncryptionDisabled is returned when reading an encrypted row while no
+// encryption key is configured.
+var ErrEncryptionDisabled = errors.New("person data is encrypted but no encryption key is configured")
+
+// sensitiveValues are the plaintext values of the sensitive columns.
+type sensitiveValues struct {
+	CPF       string
+	BirthDate time.Time
+	Phone     string
+	Email     string
 }
 
 func (PersonEntity) TableName() string {
 	return "people.person"
 }
 
-func (e *PersonEntity) ToDomain() *personModel.Person {
+// ToDomain converts the row, decrypting its sensitive columns with cipher.
+// Rows in plaintext are read without a cipher.
+func (e *PersonEntity) ToDomain(cipher *encryption.Cipher) (*personModel.Person, error) {
+	values, err := e.open(cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedAt *time.Time
+	if e.DeletedAt.Valid {
+		deletedAt = &e.DeletedAt.Time
+	}
+
+	contacts, err := e.contactsToDomain(cipher, values.Phone, values.Email)
+	if err != nil {
+		return nil, err
+	}
+
 	return &personModel.Person{
-		ID:          e.ID,
-		Name:        e.Name,
-		CPF:         e.CPF,
-		BirthDate:   e.BirthDate,
-		PhoneNumber: e.PhoneNumber,
-		Email:       e.Email,
-		CreatedAt:   e.CreatedAt,
-		UpdatedAt:   e.UpdatedAt,
-	}
-}
-
-func FromDomain(p *personModel.Person) *PersonEntity {
-	return &PersonEntity{
-		ID:          p.ID,
-		Name:        p.Name,
-		CPF:         p.CPF,
-		BirthDate:   p.BirthDate,
-		PhoneNumber: p.PhoneNumber,
-		Email:       p.Email,
-		CreatedAt:   p.CreatedAt,
-		UpdatedAt:   p.UpdatedAt,
+		ID:           e.ID,
+		Name:         e.Name,
+		CPF:          values.CPF,
+		BirthDate:    values.BirthDate,
+		Contacts:     contacts,
+		Version:      e.Version,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+		DeletedAt:    deletedAt,
+		MergedIntoID: e.MergedIntoID,
+		AnonymizedAt: e.AnonymizedAt,
+	}, nil
+}
+
+// contactsToDomain converts the loaded contacts. Persons read without them
+// (see Stream) get their primary phone and email from the mirrored columns.
+func (e *PersonEntity) contactsToDomain(cipher *encryption.Cipher, phone, email string) ([]personModel.Contact, error) {
+	if len(e.Contacts) == 0 {
+		return []personModel.Contact{
+			{ContactFields: personModel.ContactFields{Type: personModel.ContactPhone, Value: phone, Primary: true}, PersonID: e.ID},
+			{ContactFields: personModel.ContactFields{Type: personModel.ContactEmail, Value: email, Primary: true}, PersonID: e.ID},
+		}, nil
+	}
+
+	contacts := make([]personModel.Contact, len(e.Contacts))
+	for i := range e.Contacts {
+		contact, err := e.Contacts[i].ToDomain(cipher)
+		if err != nil {
+			return nil, err
+		}
+		contacts[i] = *contact
+	}
+
+	return contacts, nil
+}
+
+// FromDomain converts the person to a row. With a cipher its sensitive fields
+// and the values of its contacts are encrypted under new data keys; without
+// one they are kept in plaintext.
+func FromDomain(p *personModel.Person, cipher *encryption.Cipher) (*PersonEntity, error) {
+	var deletedAt gorm.DeletedAt
+	if p.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *p.DeletedAt, Valid: true}
+	}
+
+	contacts := make([]ContactEntity, len(p.Contacts))
+	for i := range p.Contacts {
+		contact, err := ContactFromDomain(&p.Contacts[i], cipher)
+		if err != nil {
+			return nil, err
+		}
+		contacts[i] = *contact
+	}
+
+	entity := &PersonEntity{
+		ID:           p.ID,
+		Name:         p.Name,
+		NameSearch:   personUtils.NormalizeSearchText(p.Name),
+		Contacts:     contacts,
+		Version:      p.Version,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+		DeletedAt:    deletedAt,
+		MergedIntoID: p.MergedIntoID,
+		AnonymizedAt: p.AnonymizedAt,
+	}
+
+	values := sensitiveValues{CPF: p.CPF, BirthDate: p.BirthDate, Phone: p.Phone(), Email: p.Email()}
+	if err := entity.seal(cipher, values); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// seal writes the sensitive values to the row. Without a cipher they go to the
+// plaintext columns. With one they are encrypted under a fresh data key
+// wrapped by the current key, the plaintext columns are cleared and the CPF
+// and birth date get blind indexes, so they can still be looked up.
+func (e *PersonEntity) seal(cipher *encryption.Cipher, values sensitiveValues) error {
+	if cipher == nil {
+		birthDate := values.BirthDate
+		e.CPF, e.BirthDate, e.PhoneNumber, e.Email = &values.CPF, &birthDate, &values.Phone, &values.Email
+		e.KeyID, e.DataKey = nil, nil
+		e.CPFEncrypted, e.BirthDateEncrypted, e.PhoneNumberEncrypted, e.EmailEncrypted = nil, nil, nil, nil
+		e.CPFIndex, e.BirthDateIndex = nil, nil
+		return nil
+	}
+
+	key, err := cipher.NewDataKey()
+	if err != nil {
+		return err
+	}
+
+	birthDate := values.BirthDate.Format(birthDateLayout)
+	sealed := make([]*string, 4)
+	for i, field := range []struct{ column, value string }{
+		{"cpf", values.CPF},
+		{"birth_date", birthDate},
+		{"phone_number", values.Phone},
+		{"email", values.Email},
+	} {
+		ciphertext, err := key.Seal(field.column, field.value)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt %s: %w", field.column, err)
+		}
+		sealed[i] = &ciphertext
+	}
+
+	cpfIndex := cipher.BlindIndex(cpfIndexPurpose, values.CPF)
+	birthDateIndex := cipher.BlindIndex(birthDateIndexPurpose, birthDate)
+
+	e.CPF, e.BirthDate, e.PhoneNumber, e.Email = nil, nil, nil, nil
+	e.KeyID, e.DataKey = &key.KeyID, &key.Wrapped
+	e.CPFEncrypted, e.BirthDateEncrypted, e.PhoneNumberEncrypted, e.EmailEncrypted = sealed[0], sealed[1], sealed[2], sealed[3]
+	e.CPFIndex, e.BirthDateIndex = &cpfIndex, &birthDateIndex
+	return nil
+}
+
+// open reads back the sensitive values of the row, decrypting them when the
+// row holds a data key.
+func (e *PersonEntity) open(cipher *encryption.Cipher) (sensitiveValues, error) {
+	if e.DataKey == nil || e.KeyID == nil {
+		var values sensitiveValues
+		if e.CPF != nil {
+			values.CPF = *e.CPF
+		}
+		if e.BirthDate != nil {
+			values.BirthDate = *e.BirthDate
+		}
+		if e.PhoneNumber != nil {
+			values.Phone = *e.PhoneNumber
+		}
+		if e.Email != nil {
+			values.Email = *e.Email
+		}
+		return values, nil
+	}
+
+	if cipher == nil {
+		return sensitiveValues{}, fmt.Errorf("person %d: %w", e.ID, ErrEncryptionDisabled)
+	}
+
+	key, err := cipher.OpenDataKey(*e.KeyID, *e.DataKey)
+	if err != nil {
+		return sensitiveValues{}, fmt.Errorf("failed to decrypt person %d: %w", e.ID, err)
+	}
+
+	opened := make([]string, 4)
+	for i, field := range []struct {
+		column string
+		value  *string
+	}{
+		{"cpf", e.CPFEncrypted},
+		{"birth_date", e.BirthDateEncrypted},
+		{"phone_number", e.PhoneNumberEncrypted},
+		{"email", e.EmailEncrypted},
+	} {
+		if field.value == nil {
+			continue
+		}
+		if opened[i], err = key.Open(field.column, *field.value); err != nil {
+			return sensitiveValues{}, fmt.Errorf("failed to decrypt person %d: %w", e.ID, err)
+		}
 	}
+
+	birthDate, err := time.Parse(birthDateLayout, opened[1])
+	if err != nil {
+		return sensitiveValues{}, fmt.Errorf("failed to decrypt person %d: invalid birth date", e.ID)
+	}
+
+	return sensitiveValues{CPF: opened[0], BirthDate: birthDate, Phone: opened[2], Email: opened[3]}, nil
+}
+
+// sensitiveUpdates returns the sensitive columns of the row, as written by
+// seal, for map updates.
+func (e *PersonEntity) sensitiveUpdates() map[string]interface{} {
+	return map[string]interface{}{
+		"cpf":                    e.CPF,
+		"birth_date":             e.BirthDate,
+		"phone_number":           e.PhoneNumber,
+		"email":                  e.Email,
+		"key_id":                 e.KeyID,
+		"data_key":               e.DataKey,
+		"cpf_encrypted":          e.CPFEncrypted,
+		"birth_date_encrypted":   e.BirthDateEncrypted,
+		"phone_number_encrypted": e.PhoneNumberEncrypted,
+		"email_encrypted":        e.EmailEncrypted,
+		"cpf_index":              e.CPFIndex,
+		"birth_date_index":       e.BirthDateIndex,
+	}
+}
+
+// cpfCondition matches the rows holding any of the CPFs. With a cipher the
+// blind index is matched as well as the plaintext column, so that rows not yet
+// re-encrypted are still found.
+func cpfCondition(cipher *encryption.Cipher, cpfs ...string) clause.Expr {
+	if cipher == nil {
+		return gorm.Expr("cpf IN ?", cpfs)
+	}
+
+	indexes := make([]string, len(cpfs))
+	for i, cpf := range cpfs {
+		indexes[i] = cipher.BlindIndex(cpfIndexPurpose, cpf)
+	}
+
+	return gorm.Expr("(cpf IN ? OR cpf_index IN ?)", cpfs, indexes)
+}
+
+// birthDateCondition matches the rows with the birth date, like cpfCondition.
+func birthDateCondition(cipher *encryption.Cipher, birthDate time.Time) clause.Expr {
+	if cipher == nil {
+		return gorm.Expr("birth_date = ?", birthDate)
+	}
+
+	index := cipher.BlindIndex(birthDateIndexPurpose, birthDate.Format(birthDateLayout))
+	return gorm.Expr("(birth_date = ? OR birth_date_index = ?)", birthDate, index)
 }
@@ -0,0 +1,300 @@
+package person
+
+import (
+	"context"
+	"fmt"
+
+	"pessoas-api/internal/infrastructure/encryption"
+	auditPersistence "pessoas-api/internal/infrastructure/persistence/audit"
+
+	"gorm.io/gorm"
+)
+
+// Reencryptor brings the rows of people.person, people.person_contact and
+// people.audit_log to the current key of a cipher, after a key rotation or after encryption is
+// first enabled: rows still in plaintext get encrypted, and data keys wrapped
+// by an older key are rewrapped with the current one, which leaves the
+// ciphertexts untouched.
+type Reencryptor struct {
+	db     *gorm.DB
+	cipher *encryption.Cipher
+}
+
+func NewReencryptor(db *gorm.DB, cipher *encryption.Cipher) *Reencryptor {
+	return &Reencryptor{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+// ReencryptResult counts the rows handled by Run, persons, contacts and audit
+// entries together.
+type ReencryptResult struct {
+	// Encrypted rows were in plaintext.
+	Encrypted int
+	// Rewrapped rows had their data key wrapped by an older key.
+	Rewrapped int
+	// Skipped rows changed while they were processed; the request that
+	// changed them already wrote them with the current key.
+	Skipped int
+}
+
+// Run processes the rows not yet on the current key, soft deleted persons
+// included, in batches of batchSize ordered by ID: first the persons, then
+// the contacts, then the audit entries. A person is only written if its
+// version did not change since it was read, a contact if its value and key
+// did not, and an audit entry if its snapshots and key did not; versions and
+// updated_at are kept, so clients holding an ETag are not affected. Run stops
+// at the first row it cannot process; running it again resumes the work.
+func (r *Reencryptor) Run(ctx context.Context, batchSize int) (ReencryptResult, error) {
+	var result ReencryptResult
+
+	if err := r.runPersons(ctx, batchSize, &result); err != nil {
+		return result, err
+	}
+
+	if err := r.runContacts(ctx, batchSize, &result); err != nil {
+		return result, err
+	}
+
+	return result, r.runAudit(ctx, batchSize, &result)
+}
+
+func (r *Reencryptor) runPersons(ctx context.Context, batchSize int, result *ReencryptResult) error {
+	currentKeyID := r.cipher.CurrentKeyID()
+	columns := append([]string{"id", "version"}, sensitiveColumns...)
+
+	for lastID := 0; ; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var entities []PersonEntity
+		err := r.db.Unscoped().Select(columns).
+			Where("id > ? AND (key_id IS NULL OR key_id <> ?)", lastID, currentKeyID).
+			Order("id").Limit(batchSize).Find(&entities).Error
+		if err != nil {
+			return fmt.Errorf("failed to read persons: %w", err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		for i := range entities {
+			if err := r.reencrypt(&entities[i], result); err != nil {
+				return fmt.Errorf("failed to re-encrypt person %d: %w", entities[i].ID, err)
+			}
+		}
+
+		lastID = entities[len(entities)-1].ID
+	}
+}
+
+func (r *Reencryptor) runContacts(ctx context.Context, batchSize int, result *ReencryptResult) error {
+	currentKeyID := r.cipher.CurrentKeyID()
+	columns := append([]string{"id", "type"}, contactSensitiveColumns...)
+
+	for lastID := 0; ; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var entities []ContactEntity
+		err := r.db.Select(columns).
+			Where("id > ? AND (key_id IS NULL OR key_id <> ?)", lastID, currentKeyID).
+			Order("id").Limit(batchSize).Find(&entities).Error
+		if err != nil {
+			return fmt.Errorf("failed to read contacts: %w", err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		for i := range entities {
+			if err := r.reencryptContact(&entities[i], result); err != nil {
+				return fmt.Errorf("failed to re-encrypt contact %d: %w", entities[i].ID, err)
+			}
+		}
+
+		lastID = entities[len(entities)-1].ID
+	}
+}
+
+func (r *Reencryptor) runAudit(ctx context.Context, batchSize int, result *ReencryptResult) error {
+	currentKeyID := r.cipher.CurrentKeyID()
+	columns := append([]string{"id"}, auditPersistence.SnapshotColumns...)
+
+	for lastID := 0; ; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var entities []auditPersistence.AuditEntity
+		err := r.db.Select(columns).
+			Where("id > ? AND (key_id IS NULL OR key_id <> ?)", lastID, currentKeyID).
+			Order("id").Limit(batchSize).Find(&entities).Error
+		if err != nil {
+			return fmt.Errorf("failed to read audit entries: %w", err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		for i := range entities {
+			if err := r.reencryptAudit(&entities[i], result); err != nil {
+				return fmt.Errorf("failed to re-encrypt audit entry %d: %w", entities[i].ID, err)
+			}
+		}
+
+		lastID = entities[len(entities)-1].ID
+	}
+}
+
+func (r *Reencryptor) reencrypt(entity *PersonEntity, result *ReencryptResult) error {
+	var updates map[string]interface{}
+	counter := &result.Rewrapped
+
+	if entity.DataKey == nil || entity.KeyID == nil {
+		values, err := entity.open(nil)
+		if err != nil {
+			return err
+		}
+		if err := entity.seal(r.cipher, values); err != nil {
+			return err
+		}
+		updates = entity.sensitiveUpdates()
+		counter = &result.Encrypted
+	} else {
+		key, err := r.cipher.OpenDataKey(*entity.KeyID, *entity.DataKey)
+		if err != nil {
+			return err
+		}
+		rewrapped, err := r.cipher.Rewrap(key)
+		if err != nil {
+			return err
+		}
+		updates = map[string]interface{}{
+			"key_id":   rewrapped.KeyID,
+			"data_key": rewrapped.Wrapped,
+		}
+	}
+
+	written := r.db.Unscoped().Model(&PersonEntity{}).
+		Where("id = ? AND version = ?", entity.ID, entity.Version).
+		UpdateColumns(updates)
+	if written.Error != nil {
+		return written.Error
+	}
+
+	if written.RowsAffected == 0 {
+		result.Skipped++
+	} else {
+		*counter++
+	}
+
+	return nil
+}
+
+// reencryptContact works like reencrypt. Contacts have no version, so the
+// write is conditioned on the value or data key that was read instead.
+func (r *Reencryptor) reencryptContact(entity *ContactEntity, result *ReencryptResult) error {
+	var updates map[string]interface{}
+	counter := &result.Rewrapped
+	written := r.db.Model(&ContactEntity{}).Where("id = ?", entity.ID)
+
+	if entity.DataKey == nil || entity.KeyID == nil {
+		value, err := entity.open(nil)
+		if err != nil {
+			return err
+		}
+		if err := entity.seal(r.cipher, value); err != nil {
+			return err
+		}
+		updates = entity.sensitiveUpdates()
+		counter = &result.Encrypted
+		written = written.Where("key_id IS NULL AND value = ?", value)
+	} else {
+		key, err := r.cipher.OpenDataKey(*entity.KeyID, *entity.DataKey)
+		if err != nil {
+			return err
+		}
+		rewrapped, err := r.cipher.Rewrap(key)
+		if err != nil {
+			return err
+		}
+		written = written.Where("key_id = ? AND data_key = ?", *entity.KeyID, *entity.DataKey)
+		updates = map[string]interface{}{
+			"key_id":   rewrapped.KeyID,
+			"data_key": rewrapped.Wrapped,
+		}
+	}
+
+	written = written.UpdateColumns(updates)
+	if written.Error != nil {
+		return written.Error
+	}
+
+	if written.RowsAffected == 0 {
+		result.Skipped++
+	} else {
+		*counter++
+	}
+
+	return nil
+}
+
+// reencryptAudit works like reencryptContact. A plaintext entry is only
+// written if its snapshots were not anonymized in the meantime.
+func (r *Reencryptor) reencryptAudit(entity *auditPersistence.AuditEntity, result *ReencryptResult) error {
+	var updates map[string]interface{}
+	counter := &result.Rewrapped
+	written := r.db.Model(&auditPersistence.AuditEntity{}).Where("id = ?", entity.ID)
+
+	if entity.DataKey == nil || entity.KeyID == nil {
+		written = written.Where("key_id IS NULL")
+		for column, value := range map[string]*string{"before_data": entity.BeforeData, "after_data": entity.AfterData} {
+			if value == nil {
+				written = written.Where(column + " IS NULL")
+			} else {
+				written = written.Where(column+" = ?", *value)
+			}
+		}
+
+		before, after, err := entity.Open(nil)
+		if err != nil {
+			return err
+		}
+		if err := entity.Seal(r.cipher, before, after); err != nil {
+			return err
+		}
+		updates = entity.SnapshotUpdates()
+		counter = &result.Encrypted
+	} else {
+		key, err := r.cipher.OpenDataKey(*entity.KeyID, *entity.DataKey)
+		if err != nil {
+			return err
+		}
+		rewrapped, err := r.cipher.Rewrap(key)
+		if err != nil {
+			return err
+		}
+		written = written.Where("key_id = ? AND data_key = ?", *entity.KeyID, *entity.DataKey)
+		updates = map[string]interface{}{
+			"key_id":   rewrapped.KeyID,
+			"data_key": rewrapped.Wrapped,
+		}
+	}
+
+	written = written.UpdateColumns(updates)
+	if written.Error != nil {
+		return written.Error
+	}
+
+	if written.RowsAffected == 0 {
+		result.Skipped++
+	} else {
+		*counter++
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package person
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	auditModel "pessoas-api/internal/domain/audit/model"
+	personModel "pessoas-api/internal/domain/person/model"
+	auditPersistence "pessoas-api/internal/infrastructure/persistence/audit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReencryptor_Run(t *testing.T) {
+	assert := assert.New(t)
+	db := setupTransactorTest(t)
+	legacyID := saveNamed(t, NewPersonRepository(db, nil), "Ana", "11144477735", time.Now())
+	deletedID := saveNamed(t, NewPersonRepository(db, nil), "Bruno", "22233344405", time.Now())
+	assert.NoError(NewPersonRepository(db, nil).Delete(deletedID, 1))
+	oldKeyID := saveNamed(t, NewPersonRepository(db, newTestCipher(t, "k1")), "Carla", "52998224725", time.Now())
+	oldKeyRow := storedRow(t, db, oldKeyID)
+	actor := auditModel.Actor{OperatorID: 1}
+	assert.NoError(auditPersistence.NewAuditRepository(db, nil).Save(auditModel.NewAuditEntry(auditModel.EntityPerson, legacyID, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":1,"cpf":"11144477735"}`))))
+	assert.NoError(auditPersistence.NewAuditRepository(db, newTestCipher(t, "k1")).Save(auditModel.NewAuditEntry(auditModel.EntityPerson, oldKeyID, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":3,"cpf":"52998224725"}`))))
+
+	rotated := newTestCipher(t, "k2", "k1")
+	result, err := NewReencryptor(db, rotated).Run(context.Background(), 2)
+
+	assert.NoError(err)
+	// Every person comes with its primary phone and email contacts
+	assert.Equal(ReencryptResult{Encrypted: 2 + 4 + 1, Rewrapped: 1 + 2 + 1}, result)
+
+	for _, id := range []int{legacyID, deletedID, oldKeyID} {
+		row := storedRow(t, db, id)
+		assert.Equal("k2", *row.KeyID)
+		assert.Nil(row.CPF)
+	}
+
+	var contacts []ContactEntity
+	assert.NoError(db.Find(&contacts).Error)
+	assert.Len(contacts, 6)
+	for _, contact := range contacts {
+		assert.Equal("k2", *contact.KeyID)
+		assert.Nil(contact.Value)
+		assert.Len(*contact.ValueIndex, 64)
+	}
+
+	rewrapped := storedRow(t, db, oldKeyID)
+	assert.Equal(*oldKeyRow.CPFEncrypted, *rewrapped.CPFEncrypted, "rewrapping keeps the ciphertexts")
+	assert.Equal(oldKeyRow.Version, rewrapped.Version, "re-encryption does not change the version")
+	assert.True(oldKeyRow.UpdatedAt.Equal(rewrapped.UpdatedAt))
+
+	repo := NewPersonRepository(db, newTestCipher(t, "k2"))
+	found, err := repo.FindByCPF("52998224725")
+	assert.NoError(err)
+	assert.Equal("Carla", found.Name)
+	legacy, err := repo.FindByCPF("11144477735")
+	assert.NoError(err)
+	assert.Equal(legacyID, legacy.ID)
+	assert.Equal("+5581912345678", legacy.Phone())
+
+	byPhone, err := repo.FindByContact(personModel.ContactPhone, "+5581912345678")
+	assert.NoError(err)
+	assert.Len(byPhone, 2, "the contacts of the legacy and of the re-keyed person are found by blind index")
+
+	var entries []auditPersistence.AuditEntity
+	assert.NoError(db.Find(&entries).Error)
+	assert.Len(entries, 2)
+	for _, entry := range entries {
+		assert.Equal("k2", *entry.KeyID)
+		assert.NotContains(*entry.AfterData, "cpf")
+	}
+
+	history, err := auditPersistence.NewAuditRepository(db, newTestCipher(t, "k2")).FindBySubject([]int{legacyID})
+	assert.NoError(err)
+	assert.Len(history, 1)
+	assert.JSONEq(`{"id":1,"cpf":"11144477735"}`, string(history[0].After))
+
+	again, err := NewReencryptor(db, rotated).Run(context.Background(), 2)
+	assert.NoError(err)
+	assert.Equal(ReencryptResult{}, again, "rows on the current key are left alone")
+}
+
+func TestReencryptor_Run_StopsWhenCanceled(t *testing.T) {
+	db := setupTransactorTest(t)
+	saveNamed(t, NewPersonRepository(db, nil), "Ana", "11144477735", time.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := NewReencryptor(db, newTestCipher(t, "k1")).Run(ctx, 10)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, ReencryptResult{}, result)
+}
@@ -2,29 +2,41 @@ package person
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	personError "pessoas-api/internal/domain/person/error"
 	personModel "pessoas-api/internal/domain/person/model"
 	"pessoas-api/internal/domain/person/ports"
+	personUtils "pessoas-api/internal/domain/person/utils"
+	"pessoas-api/internal/infrastructure/encryption"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // PersonRepositoryImpl implements the ports.PersonRepository interface.
 // This is the adapter for PostgreSQL database persistence.
 type PersonRepositoryImpl struct {
-	db *gorm.DB
+	db     *gorm.DB
+	cipher *encryption.Cipher
 }
 
 // NewPersonRepository creates a new instance of PersonRepositoryImpl.
-// It returns the implementation as the PersonRepository interface.
-func NewPersonRepository(db *gorm.DB) ports.PersonRepository {
+// The sensitive fields are encrypted with cipher; a nil cipher keeps them in
+// plaintext. It returns the implementation as the PersonRepository interface.
+func NewPersonRepository(db *gorm.DB, cipher *encryption.Cipher) ports.PersonRepository {
 	return &PersonRepositoryImpl{
-		db: db,
+		db:     db,
+		cipher: cipher,
 	}
 }
 
 func (r *PersonRepositoryImpl) Save(p *personModel.Person) (int, error) {
-	entity := FromDomain(p)
+	entity, err := FromDomain(p, r.cipher)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save person: %w", err)
+	}
 
 	result := r.db.Create(entity)
 	if result.Error != nil {
@@ -34,42 +46,330 @@ func (r *PersonRepositoryImpl) Save(p *personModel.Person) (int, error) {
 	return entity.ID, nil
 }
 
-func (r *PersonRepositoryImpl) FindAll(page, pageSize int, sortBy, sortOrder string) ([]*personModel.Person, int64, error) {
+func (r *PersonRepositoryImpl) SaveBatch(persons []*personModel.Person) ([]int, error) {
+	entities := make([]*PersonEntity, len(persons))
+	for i, p := range persons {
+		entity, err := FromDomain(p, r.cipher)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save persons: %w", err)
+		}
+		entities[i] = entity
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Create(entities).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save persons: %w", err)
+	}
+
+	ids := make([]int, len(entities))
+	for i, entity := range entities {
+		ids[i] = entity.ID
+	}
+
+	return ids, nil
+}
+
+func (r *PersonRepositoryImpl) FindAll(page, pageSize int, sortBy, sortOrder string, filter personModel.PersonFilter) ([]*personModel.Person, int64, error) {
 	var entities []PersonEntity
 	var total int64
 
+	if err := r.checkEncryptedQuery(sortBy, filter); err != nil {
+		return nil, 0, err
+	}
+
 	offset := (page - 1) * pageSize
 
-	if err := r.db.Model(&PersonEntity{}).Count(&total).Error; err != nil {
+	db := applyFilter(r.db.Model(&PersonEntity{}), filter, r.cipher)
+
+	if err := db.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count persons: %w", err)
 	}
 
 	orderClause := buildOrderClause(sortBy, sortOrder)
 
-	result := r.db.Offset(offset).Limit(pageSize).Order(orderClause).Find(&entities)
+	result := withContacts(db).Offset(offset).Limit(pageSize).Order(orderClause).Find(&entities)
 	if result.Error != nil {
 		return nil, 0, fmt.Errorf("failed to find persons: %w", result.Error)
 	}
 
-	persons := make([]*personModel.Person, len(entities))
-	for i, entity := range entities {
-		persons[i] = entity.ToDomain()
+	persons, err := r.toDomain(entities)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	return persons, total, nil
 }
 
-func buildOrderClause(sortBy, sortOrder string) string {
-	validFields := map[string]string{
-		"id":         "id",
-		"name":       "name",
-		"cpf":        "cpf",
-		"email":      "email",
-		"created_at": "created_at",
-		"updated_at": "updated_at",
+func (r *PersonRepositoryImpl) Count(filter personModel.PersonFilter) (int64, error) {
+	var total int64
+
+	if err := r.checkEncryptedQuery("", filter); err != nil {
+		return 0, err
+	}
+
+	if err := applyFilter(r.db.Model(&PersonEntity{}), filter, r.cipher).Count(&total).Error; err != nil {
+		return 0, fmt.Errorf("failed to count persons: %w", err)
+	}
+
+	return total, nil
+}
+
+// Stream runs a single query and scans its rows one by one while the driver
+// reads them off the connection, so memory use does not grow with the result.
+// Contacts are not loaded: persons only carry their primary phone and email.
+func (r *PersonRepositoryImpl) Stream(sortBy, sortOrder string, filter personModel.PersonFilter, fn func(*personModel.Person) error) error {
+	if err := r.checkEncryptedQuery(sortBy, filter); err != nil {
+		return err
+	}
+
+	order := buildOrderClause(sortBy, sortOrder)
+	if field, direction, _ := strings.Cut(order, " "); field != "id" {
+		order += ", id " + direction
+	}
+
+	db := applyFilter(r.db.Model(&PersonEntity{}), filter, r.cipher)
+
+	rows, err := db.Order(order).Rows()
+	if err != nil {
+		return fmt.Errorf("failed to stream persons: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entity PersonEntity
+		if err := r.db.ScanRows(rows, &entity); err != nil {
+			return fmt.Errorf("failed to scan person: %w", err)
+		}
+
+		person, err := entity.ToDomain(r.cipher)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(person); err != nil {
+			return err
+		}
 	}
 
-	field, exists := validFields[sortBy]
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to stream persons: %w", err)
+	}
+
+	return nil
+}
+
+// FindAfter reads a keyset page: rows strictly beyond the cursor position in the
+// direction of travel, ordered by the sort column with the ID as tie-breaker.
+func (r *PersonRepositoryImpl) FindAfter(cursor *personModel.Cursor, limit int, sortBy, sortOrder string, filter personModel.PersonFilter) ([]*personModel.Person, error) {
+	var entities []PersonEntity
+
+	if err := r.checkEncryptedQuery(sortBy, filter); err != nil {
+		return nil, err
+	}
+
+	field, exists := sortableColumns[sortBy]
+	if !exists {
+		field = "id"
+	}
+
+	descending := sortOrder != "asc" && sortOrder != "ASC"
+	if cursor != nil && cursor.Backward {
+		descending = !descending
+	}
+
+	direction, comparison := "ASC", ">"
+	if descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	db := applyFilter(r.db.Model(&PersonEntity{}), filter, r.cipher)
+
+	if cursor != nil {
+		if field == "id" {
+			db = db.Where("id "+comparison+" ?", cursor.ID)
+		} else {
+			value, err := cursorValue(field, cursor.Value)
+			if err != nil {
+				return nil, err
+			}
+			db = db.Where("("+field+" "+comparison+" ?) OR ("+field+" = ? AND id "+comparison+" ?)", value, value, cursor.ID)
+		}
+	}
+
+	order := fmt.Sprintf("%s %s", field, direction)
+	if field != "id" {
+		order += ", id " + direction
+	}
+
+	result := withContacts(db).Order(order).Limit(limit).Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find persons: %w", result.Error)
+	}
+
+	return r.toDomain(entities)
+}
+
+// toDomain converts the rows read by a query.
+func (r *PersonRepositoryImpl) toDomain(entities []PersonEntity) ([]*personModel.Person, error) {
+	persons := make([]*personModel.Person, len(entities))
+	for i := range entities {
+		person, err := entities[i].ToDomain(r.cipher)
+		if err != nil {
+			return nil, err
+		}
+		persons[i] = person
+	}
+
+	return persons, nil
+}
+
+// checkEncryptedQuery rejects, once the sensitive columns are encrypted, the
+// listings that would need to compare their values: sorting by CPF or email,
+// birth date ranges, partial CPFs and email or phone prefixes. A full CPF,
+// email or phone is still matched through its blind index.
+func (r *PersonRepositoryImpl) checkEncryptedQuery(sortBy string, filter personModel.PersonFilter) error {
+	if r.cipher == nil {
+		return nil
+	}
+
+	if sortBy == "cpf" || sortBy == "email" {
+		return fmt.Errorf("%w: cannot sort by %s", personError.ErrEncryptedField, sortBy)
+	}
+
+	if filter.BirthDateFrom != nil || filter.BirthDateTo != nil {
+		return fmt.Errorf("%w: cannot filter by a birth date range", personError.ErrEncryptedField)
+	}
+
+	if cpf := personUtils.OnlyDigits(filter.CPFPrefix); cpf != "" && len(cpf) != cpfLength {
+		return fmt.Errorf("%w: cpf_prefix must have all %d digits", personError.ErrEncryptedField, cpfLength)
+	}
+
+	if filter.Email != "" && filter.EmailPrefix {
+		return fmt.Errorf("%w: cannot match emails by prefix", personError.ErrEncryptedField)
+	}
+
+	if filter.PhoneNumber != "" && filter.PhonePrefix {
+		return fmt.Errorf("%w: cannot match phones by prefix", personError.ErrEncryptedField)
+	}
+
+	return nil
+}
+
+// cpfLength is the number of digits of a CPF.
+const cpfLength = 11
+
+// cursorValue converts the sort value carried by a cursor back to the column type.
+func cursorValue(field, value string) (interface{}, error) {
+	if field == "created_at" || field == "updated_at" {
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, personError.ErrInvalidCursor
+		}
+		return t, nil
+	}
+
+	return value, nil
+}
+
+// applyFilter translates a PersonFilter into WHERE conditions. Values are always
+// bound as parameters; LIKE patterns have their wildcards escaped. With a
+// cipher the CPF, emails and phones are matched in full through their blind
+// indexes; the filters that cannot run on encrypted columns are rejected by
+// checkEncryptedQuery first.
+func applyFilter(db *gorm.DB, filter personModel.PersonFilter, cipher *encryption.Cipher) *gorm.DB {
+	if filter.IncludeDeleted {
+		db = db.Unscoped()
+	}
+
+	if name := personUtils.NormalizeSearchText(filter.Name); name != "" {
+		db = db.Where(`name_search LIKE ? ESCAPE '\'`, "%"+escapeLike(name)+"%")
+	}
+
+	if filter.Email != "" {
+		email := strings.ToLower(strings.TrimSpace(filter.Email))
+		contacts := contactsOfType(db, personModel.ContactEmail)
+		if filter.EmailPrefix {
+			contacts = contacts.Where(`LOWER(value) LIKE ? ESCAPE '\'`, escapeLike(email)+"%")
+		} else {
+			contacts = contacts.Where(contactValueCondition(cipher, personModel.ContactEmail, email))
+		}
+		db = db.Where("id IN (?)", contacts)
+	}
+
+	if phone := personModel.PhoneSearchValue(filter.PhoneNumber, filter.PhonePrefix); phone != "" {
+		contacts := contactsOfType(db, personModel.ContactPhone)
+		if filter.PhonePrefix {
+			contacts = contacts.Where(`value LIKE ? ESCAPE '\'`, escapeLike(phone)+"%")
+		} else {
+			contacts = contacts.Where(contactValueCondition(cipher, personModel.ContactPhone, phone))
+		}
+		db = db.Where("id IN (?)", contacts)
+	}
+
+	if cpf := personUtils.OnlyDigits(filter.CPFPrefix); cpf != "" {
+		if cipher != nil {
+			db = db.Where(cpfCondition(cipher, cpf))
+		} else {
+			db = db.Where("cpf LIKE ?", cpf+"%")
+		}
+	}
+
+	if filter.ConsentPurpose != "" {
+		consents := db.Session(&gorm.Session{NewDB: true}).Model(&ConsentEntity{}).Select("person_id").
+			Where("purpose = ? AND revoked_at IS NULL", filter.ConsentPurpose)
+		db = db.Where("id IN (?)", consents)
+	}
+
+	db = applyRange(db, "birth_date", filter.BirthDateFrom, filter.BirthDateTo)
+	db = applyRange(db, "created_at", filter.CreatedFrom, filter.CreatedTo)
+	db = applyRange(db, "updated_at", filter.UpdatedFrom, filter.UpdatedTo)
+
+	return db
+}
+
+// contactsOfType starts a subquery selecting the IDs of the persons having a
+// contact of the given type. Email and phone filters match any contact, not
+// only the primary one.
+func contactsOfType(db *gorm.DB, contactType string) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Model(&ContactEntity{}).Select("person_id").Where("type = ?", contactType)
+}
+
+// withContacts loads the contacts of the persons read by db, the primary ones first.
+func withContacts(db *gorm.DB) *gorm.DB {
+	return db.Preload("Contacts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("is_primary DESC, created_at, id")
+	})
+}
+
+func applyRange(db *gorm.DB, column string, from, to *time.Time) *gorm.DB {
+	if from != nil {
+		db = db.Where(column+" >= ?", *from)
+	}
+	if to != nil {
+		db = db.Where(column+" <= ?", *to)
+	}
+	return db
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+var sortableColumns = map[string]string{
+	"id":         "id",
+	"name":       "name",
+	"cpf":        "cpf",
+	"email":      "email",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+func buildOrderClause(sortBy, sortOrder string) string {
+	field, exists := sortableColumns[sortBy]
 	if !exists {
 		field = "id"
 	}
@@ -85,7 +385,7 @@ func buildOrderClause(sortBy, sortOrder string) string {
 func (r *PersonRepositoryImpl) FindByCPF(cpf string) (*personModel.Person, error) {
 	var entity PersonEntity
 
-	result := r.db.Where("cpf = ?", cpf).First(&entity)
+	result := withContacts(r.db).Where(cpfCondition(r.cipher, cpf)).First(&entity)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -93,13 +393,83 @@ func (r *PersonRepositoryImpl) FindByCPF(cpf string) (*personModel.Person, error
 		return nil, fmt.Errorf("failed to find person by CPF: %w", result.Error)
 	}
 
-	return entity.ToDomain(), nil
+	return entity.ToDomain(r.cipher)
+}
+
+func (r *PersonRepositoryImpl) FindAllByCPF(cpf string) ([]*personModel.Person, error) {
+	var entities []PersonEntity
+
+	result := withContacts(r.db.Unscoped()).Where(cpfCondition(r.cipher, cpf)).Order("id").Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find persons by CPF: %w", result.Error)
+	}
+
+	return r.toDomain(entities)
+}
+
+// FindExistingCPFs reports which of the given CPFs already belong to an active person.
+func (r *PersonRepositoryImpl) FindExistingCPFs(cpfs []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(cpfs) == 0 {
+		return existing, nil
+	}
+
+	var found []PersonEntity
+	result := r.db.Model(&PersonEntity{}).Select("cpf", "cpf_index").Where(cpfCondition(r.cipher, cpfs...)).Find(&found)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find existing CPFs: %w", result.Error)
+	}
+
+	// Encrypted rows only give back the blind index of their CPF.
+	byIndex := make(map[string]string)
+	if r.cipher != nil {
+		for _, cpf := range cpfs {
+			byIndex[r.cipher.BlindIndex(cpfIndexPurpose, cpf)] = cpf
+		}
+	}
+
+	for _, entity := range found {
+		if entity.CPF != nil {
+			existing[*entity.CPF] = true
+		} else if entity.CPFIndex != nil {
+			existing[byIndex[*entity.CPFIndex]] = true
+		}
+	}
+
+	return existing, nil
+}
+
+func (r *PersonRepositoryImpl) FindByContact(contactType, value string) ([]*personModel.Person, error) {
+	var entities []PersonEntity
+
+	contacts := contactsOfType(r.db, contactType).Where(contactValueCondition(r.cipher, contactType, value))
+
+	result := withContacts(r.db).Where("id IN (?)", contacts).Order("id").Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find persons by contact: %w", result.Error)
+	}
+
+	return r.toDomain(entities)
 }
 
 func (r *PersonRepositoryImpl) FindByID(id int) (*personModel.Person, error) {
 	var entity PersonEntity
 
-	result := r.db.Table("people.person").Where("id = ?", id).First(&entity)
+	result := withContacts(r.db).Where("id = ?", id).First(&entity)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find person by ID: %w", result.Error)
+	}
+
+	return entity.ToDomain(r.cipher)
+}
+
+func (r *PersonRepositoryImpl) FindByIDIncludingDeleted(id int) (*personModel.Person, error) {
+	var entity PersonEntity
+
+	result := withContacts(r.db.Unscoped()).Where("id = ?", id).First(&entity)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -107,33 +477,210 @@ func (r *PersonRepositoryImpl) FindByID(id int) (*personModel.Person, error) {
 		return nil, fmt.Errorf("failed to find person by ID: %w", result.Error)
 	}
 
-	return entity.ToDomain(), nil
+	return entity.ToDomain(r.cipher)
+}
+
+func (r *PersonRepositoryImpl) FindMergedInto(ids []int) ([]int, error) {
+	var merged []int
+
+	result := r.db.Unscoped().Model(&PersonEntity{}).Where("merged_into_id IN ?", ids).Order("id").Pluck("id", &merged)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find merged persons: %w", result.Error)
+	}
+
+	return merged, nil
 }
 
 func (r *PersonRepositoryImpl) Update(p *personModel.Person) error {
-	entity := FromDomain(p)
+	entity, err := FromDomain(p, r.cipher)
+	if err != nil {
+		return fmt.Errorf("failed to update person: %w", err)
+	}
+	entity.Version = p.Version + 1
+
+	columns := append([]string{"name", "name_search", "version", "updated_at"}, sensitiveColumns...)
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PersonEntity{}).Where("id = ? AND version = ?", entity.ID, p.Version).Select(columns).Omit(clause.Associations).Updates(entity)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update person: %w", result.Error)
+		}
 
-	result := r.db.Model(&PersonEntity{}).Where("id = ?", entity.ID).Updates(entity)
+		if result.RowsAffected == 0 {
+			return r.writeMissError(tx, entity.ID)
+		}
+
+		return savePrimaryContacts(tx, r.cipher, p, personModel.ContactPhone, personModel.ContactEmail)
+	})
+	if err != nil {
+		return err
+	}
+
+	p.Version = entity.Version
+
+	return nil
+}
+
+// UpdateFields persists only the given fields of the person, plus updated_at.
+// Changing any sensitive field rewrites all of them, since they are encrypted
+// together under a new data key.
+func (r *PersonRepositoryImpl) UpdateFields(p *personModel.Person, fields []string) error {
+	entity, err := FromDomain(p, r.cipher)
+	if err != nil {
+		return fmt.Errorf("failed to update person: %w", err)
+	}
+	entity.Version = p.Version + 1
+
+	columns := make([]string, 0, len(fields)+len(sensitiveColumns)+2)
+	var contactTypes []string
+	sensitive := false
+	for _, field := range fields {
+		fieldColumns, exists := updatableColumns[field]
+		if !exists {
+			return fmt.Errorf("failed to update person: unknown field %q", field)
+		}
+		if fieldColumns == nil {
+			sensitive = true
+		}
+		columns = append(columns, fieldColumns...)
+		if contactType, isContact := contactFields[field]; isContact {
+			contactTypes = append(contactTypes, contactType)
+		}
+	}
+	if sensitive {
+		columns = append(columns, sensitiveColumns...)
+	}
+	columns = append(columns, "version", "updated_at")
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PersonEntity{}).Where("id = ? AND version = ?", entity.ID, p.Version).Select(columns).Omit(clause.Associations).Updates(entity)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update person: %w", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return r.writeMissError(tx, entity.ID)
+		}
+
+		return savePrimaryContacts(tx, r.cipher, p, contactTypes...)
+	})
+	if err != nil {
+		return err
+	}
+
+	p.Version = entity.Version
+
+	return nil
+}
+
+// updatableColumns maps domain fields to the columns that store them. The
+// sensitive fields map to nil: they are stored in sensitiveColumns.
+var updatableColumns = map[string][]string{
+	personModel.FieldName:        {"name", "name_search"},
+	personModel.FieldCPF:         nil,
+	personModel.FieldBirthDate:   nil,
+	personModel.FieldPhoneNumber: nil,
+	personModel.FieldEmail:       nil,
+}
+
+// contactFields maps the person fields stored as primary contacts to their type.
+var contactFields = map[string]string{
+	personModel.FieldPhoneNumber: personModel.ContactPhone,
+	personModel.FieldEmail:       personModel.ContactEmail,
+}
+
+// savePrimaryContacts writes the primary contacts of the given types, whose
+// values are changed through the person itself, sealing them with cipher. The
+// mirrored columns of the person row are written by the caller.
+func savePrimaryContacts(tx *gorm.DB, cipher *encryption.Cipher, p *personModel.Person, contactTypes ...string) error {
+	for _, contactType := range contactTypes {
+		contact := p.PrimaryContact(contactType)
+		if contact == nil {
+			continue
+		}
+
+		entity, err := ContactFromDomain(contact, cipher)
+		if err != nil {
+			return fmt.Errorf("failed to save person contacts: %w", err)
+		}
+		entity.PersonID = p.ID
+
+		if entity.ID == 0 {
+			err = tx.Create(entity).Error
+		} else {
+			err = tx.Model(&ContactEntity{}).
+				Where("id = ? AND person_id = ?", entity.ID, p.ID).
+				Select(append([]string{"verified", "updated_at"}, contactSensitiveColumns...)).
+				Updates(entity).Error
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save person contacts: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Delete soft deletes the person by setting deleted_at; the row is kept for auditing.
+func (r *PersonRepositoryImpl) Delete(id int, version int) error {
+	result := r.db.Model(&PersonEntity{}).Where("id = ? AND version = ?", id, version).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"version":    version + 1,
+	})
 	if result.Error != nil {
-		return fmt.Errorf("failed to update person: %w", result.Error)
+		return fmt.Errorf("failed to delete person: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("person not found")
+		return r.writeMissError(r.db, id)
 	}
 
 	return nil
 }
 
-func (r *PersonRepositoryImpl) Delete(id int) error {
-	result := r.db.Delete(&PersonEntity{}, id)
+// Restore clears the deletion mark of a soft deleted person.
+func (r *PersonRepositoryImpl) Restore(id int) error {
+	result := r.db.Unscoped().Model(&PersonEntity{}).Where("id = ? AND deleted_at IS NOT NULL", id).Updates(map[string]interface{}{
+		"deleted_at": nil,
+		"version":    gorm.Expr("version + 1"),
+	})
 	if result.Error != nil {
-		return fmt.Errorf("failed to delete person: %w", result.Error)
+		return fmt.Errorf("failed to restore person: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return personError.ErrPersonNotFound
+	}
+
+	return nil
+}
+
+// Purge permanently removes the person row, whether it is soft deleted or not.
+func (r *PersonRepositoryImpl) Purge(id int, version int) error {
+	result := r.db.Unscoped().Where("id = ? AND version = ?", id, version).Delete(&PersonEntity{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to purge person: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("person not found")
+		return r.writeMissError(r.db.Unscoped(), id)
 	}
 
 	return nil
 }
+
+// writeMissError explains why a versioned write matched no rows: either the person
+// does not exist or its version changed since it was read.
+func (r *PersonRepositoryImpl) writeMissError(db *gorm.DB, id int) error {
+	var count int64
+
+	if err := db.Model(&PersonEntity{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check person version: %w", err)
+	}
+
+	if count == 0 {
+		return personError.ErrPersonNotFound
+	}
+
+	return personError.ErrVersionConflict
+}
@@ -1,11 +1,17 @@
 package person
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	personError "pessoas-api/internal/domain/person/error"
 	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/encryption"
 
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/sqlite"
@@ -41,8 +47,8 @@ func (r *testPersonRepositoryImpl) Save(p *personModel.Person) (int, error) {
 		Name:        p.Name,
 		CPF:         p.CPF,
 		BirthDate:   p.BirthDate,
-		PhoneNumber: p.PhoneNumber,
-		Email:       p.Email,
+		PhoneNumber: p.Phone(),
+		Email:       p.Email(),
 		CreatedAt:   p.CreatedAt,
 		UpdatedAt:   p.UpdatedAt,
 	}
@@ -75,14 +81,13 @@ func (r *testPersonRepositoryImpl) FindAll(page, pageSize int, sortBy, sortOrder
 	persons := make([]*personModel.Person, len(entities))
 	for i, entity := range entities {
 		persons[i] = &personModel.Person{
-			ID:          entity.ID,
-			Name:        entity.Name,
-			CPF:         entity.CPF,
-			BirthDate:   entity.BirthDate,
-			PhoneNumber: entity.PhoneNumber,
-			Email:       entity.Email,
-			CreatedAt:   entity.CreatedAt,
-			UpdatedAt:   entity.UpdatedAt,
+			ID:        entity.ID,
+			Name:      entity.Name,
+			CPF:       entity.CPF,
+			BirthDate: entity.BirthDate,
+			Contacts:  primaryContacts(entity.PhoneNumber, entity.Email),
+			CreatedAt: entity.CreatedAt,
+			UpdatedAt: entity.UpdatedAt,
 		}
 	}
 
@@ -101,17 +106,24 @@ func (r *testPersonRepositoryImpl) FindByCPF(cpf string) (*personModel.Person, e
 	}
 
 	return &personModel.Person{
-		ID:          entity.ID,
-		Name:        entity.Name,
-		CPF:         entity.CPF,
-		BirthDate:   entity.BirthDate,
-		PhoneNumber: entity.PhoneNumber,
-		Email:       entity.Email,
-		CreatedAt:   entity.CreatedAt,
-		UpdatedAt:   entity.UpdatedAt,
+		ID:        entity.ID,
+		Name:      entity.Name,
+		CPF:       entity.CPF,
+		BirthDate: entity.BirthDate,
+		Contacts:  primaryContacts(entity.PhoneNumber, entity.Email),
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
 	}, nil
 }
 
+// primaryContacts builds the primary phone and email contacts of a person.
+func primaryContacts(phone, email string) []personModel.Contact {
+	return []personModel.Contact{
+		{ContactFields: personModel.ContactFields{Type: personModel.ContactPhone, Value: phone, Primary: true}},
+		{ContactFields: personModel.ContactFields{Type: personModel.ContactEmail, Value: email, Primary: true}},
+	}
+}
+
 func setupTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent),
@@ -128,6 +140,75 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	return db
 }
 
+// setupPeopleSchemaDB creates an in-memory database with an attached "people" schema,
+// so the real PersonRepositoryImpl can run against the "people.person" table.
+func setupPeopleSchemaDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database instance: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	statements := []string{
+		`ATTACH DATABASE ':memory:' AS people`,
+		`CREATE TABLE people.person (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(255) NOT NULL,
+			name_search VARCHAR(255) NOT NULL DEFAULT '',
+			cpf VARCHAR(11),
+			birth_date DATE,
+			phone_number VARCHAR(16),
+			email VARCHAR(255),
+			key_id VARCHAR(32),
+			data_key TEXT,
+			cpf_encrypted TEXT,
+			birth_date_encrypted TEXT,
+			phone_number_encrypted TEXT,
+			email_encrypted TEXT,
+			cpf_index CHAR(64),
+			birth_date_index CHAR(64),
+			version INTEGER NOT NULL DEFAULT 1,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			deleted_at TIMESTAMP,
+			merged_into_id INTEGER REFERENCES person(id),
+			anonymized_at TIMESTAMP
+		)`,
+		`CREATE UNIQUE INDEX people.idx_person_cpf_active ON person (cpf) WHERE deleted_at IS NULL`,
+		`CREATE UNIQUE INDEX people.idx_person_cpf_index_active ON person (cpf_index) WHERE deleted_at IS NULL`,
+		`CREATE TABLE people.person_contact (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			type VARCHAR(20) NOT NULL,
+			value VARCHAR(255),
+			key_id VARCHAR(32),
+			data_key TEXT,
+			value_encrypted TEXT,
+			value_index CHAR(64),
+			label VARCHAR(50) NOT NULL DEFAULT '',
+			is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+			verified BOOLEAN NOT NULL DEFAULT FALSE,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE UNIQUE INDEX people.idx_person_contact_primary ON person_contact (person_id, type) WHERE is_primary`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare people schema: %v", err)
+		}
+	}
+
+	return db
+}
+
 func createValidPerson(t *testing.T) *personModel.Person {
 	person, err := personModel.NewPerson(
 		"John Doe",
@@ -162,7 +243,7 @@ func TestPersonRepositoryImpl_Save_Success(t *testing.T) {
 	assert.Equal(id, savedEntity.ID)
 	assert.Equal("John Doe", savedEntity.Name)
 	assert.Equal("11144477735", savedEntity.CPF)
-	assert.Equal("81912345678", savedEntity.PhoneNumber)
+	assert.Equal("+5581912345678", savedEntity.PhoneNumber)
 	assert.Equal("john.doe@example.com", savedEntity.Email)
 	assert.Equal(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), savedEntity.BirthDate)
 }
@@ -271,14 +352,18 @@ func TestFromDomain_ConvertsCorrectly(t *testing.T) {
 	assert := assert.New(t)
 
 	person := createValidPerson(t)
-	entity := FromDomain(person)
+	entity, err := FromDomain(person, nil)
 
+	assert.NoError(err)
 	assert.NotNil(entity)
 	assert.Equal(person.Name, entity.Name)
-	assert.Equal(person.CPF, entity.CPF)
-	assert.Equal(person.BirthDate, entity.BirthDate)
-	assert.Equal(person.PhoneNumber, entity.PhoneNumber)
-	assert.Equal(person.Email, entity.Email)
+	assert.Equal(person.CPF, *entity.CPF)
+	assert.Equal(person.BirthDate, *entity.BirthDate)
+	assert.Equal(person.Phone(), *entity.PhoneNumber)
+	assert.Equal(person.Email(), *entity.Email)
+	assert.Nil(entity.DataKey)
+	assert.Nil(entity.CPFIndex)
+	assert.Len(entity.Contacts, 2)
 	assert.Equal(person.CreatedAt, entity.CreatedAt)
 	assert.Equal(person.UpdatedAt, entity.UpdatedAt)
 	assert.Zero(entity.ID)
@@ -287,29 +372,56 @@ func TestFromDomain_ConvertsCorrectly(t *testing.T) {
 func TestPersonEntity_ToDomain_ConvertsCorrectly(t *testing.T) {
 	assert := assert.New(t)
 
+	cpf, phone, email := "11144477735", "81912345678", "john@example.com"
+	birthDate := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
 	entity := &PersonEntity{
 		ID:          1,
 		Name:        "John Doe",
-		CPF:         "11144477735",
-		BirthDate:   time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
-		PhoneNumber: "81912345678",
-		Email:       "john@example.com",
+		CPF:         &cpf,
+		BirthDate:   &birthDate,
+		PhoneNumber: &phone,
+		Email:       &email,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
 
-	person := entity.ToDomain()
+	person, err := entity.ToDomain(nil)
 
+	assert.NoError(err)
 	assert.NotNil(person)
 	assert.Equal(entity.Name, person.Name)
-	assert.Equal(entity.CPF, person.CPF)
-	assert.Equal(entity.BirthDate, person.BirthDate)
-	assert.Equal(entity.PhoneNumber, person.PhoneNumber)
-	assert.Equal(entity.Email, person.Email)
+	assert.Equal(cpf, person.CPF)
+	assert.Equal(birthDate, person.BirthDate)
+	assert.Equal(phone, person.Phone())
+	assert.Equal(email, person.Email())
 	assert.Equal(entity.CreatedAt, person.CreatedAt)
 	assert.Equal(entity.UpdatedAt, person.UpdatedAt)
 }
 
+func TestPersonEntity_ToDomain_UsesLoadedContacts(t *testing.T) {
+	assert := assert.New(t)
+
+	phone, email, home := "81912345678", "john@example.com", "8132221234"
+	entity := &PersonEntity{
+		ID:          1,
+		PhoneNumber: &phone,
+		Email:       &email,
+		Contacts: []ContactEntity{
+			{ID: 4, PersonID: 1, Type: personModel.ContactEmail, Value: &email, Primary: true},
+			{ID: 5, PersonID: 1, Type: personModel.ContactPhone, Value: &phone, Primary: true, Verified: true},
+			{ID: 6, PersonID: 1, Type: personModel.ContactPhone, Value: &home, Label: "home"},
+		},
+	}
+
+	person, err := entity.ToDomain(nil)
+
+	assert.NoError(err)
+	assert.Len(person.Contacts, 3)
+	assert.Equal(5, person.PrimaryContact(personModel.ContactPhone).ID)
+	assert.True(person.PrimaryContact(personModel.ContactPhone).Verified)
+	assert.Equal("home", person.Contacts[2].Label)
+}
+
 func TestPersonEntity_TableName(t *testing.T) {
 	assert := assert.New(t)
 
@@ -529,8 +641,8 @@ func TestPersonRepositoryImpl_FindByCPF_Success(t *testing.T) {
 	assert.Equal(savedID, found.ID)
 	assert.Equal("John Doe", found.Name)
 	assert.Equal("11144477735", found.CPF)
-	assert.Equal("81912345678", found.PhoneNumber)
-	assert.Equal("john.doe@example.com", found.Email)
+	assert.Equal("+5581912345678", found.Phone())
+	assert.Equal("john.doe@example.com", found.Email())
 }
 
 func TestPersonRepositoryImpl_FindByCPF_NotFound(t *testing.T) {
@@ -577,3 +689,744 @@ func TestPersonRepositoryImpl_FindByCPF_WithMultiplePersons(t *testing.T) {
 	assert.Equal("Bob Johnson", found.Name)
 	assert.Equal("22233344405", found.CPF)
 }
+
+func TestPersonRepositoryImpl_FindAllByCPF_IncludesDeleted(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+
+	deletedID, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+	assert.NoError(repo.Delete(deletedID, 1))
+
+	activeID, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+
+	found, err := repo.FindAllByCPF("11144477735")
+
+	assert.NoError(err)
+	assert.Len(found, 2)
+	assert.Equal(deletedID, found[0].ID)
+	assert.True(found[0].IsDeleted())
+	assert.Equal(activeID, found[1].ID)
+	assert.Equal("john.doe@example.com", found[1].Email())
+
+	none, err := repo.FindAllByCPF("99999999999")
+
+	assert.NoError(err)
+	assert.Empty(none)
+}
+
+func TestPersonRepositoryImpl_UpdateFields_PersistsOnlyGivenFields(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+
+	id, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+
+	person, _ := repo.FindByID(id)
+	person.PrimaryContact(personModel.ContactEmail).Value = "john.new@example.com"
+	person.Name = "Not Persisted"
+
+	err = repo.UpdateFields(person, []string{personModel.FieldEmail})
+	assert.NoError(err)
+
+	found, err := repo.FindByID(id)
+	assert.NoError(err)
+	assert.Equal("john.new@example.com", found.Email())
+	assert.Len(found.Contacts, 2)
+	assert.Equal("John Doe", found.Name)
+	assert.Equal(2, found.Version)
+	assert.Equal(2, person.Version)
+	assert.False(found.UpdatedAt.Before(found.CreatedAt))
+}
+
+func TestPersonRepositoryImpl_UpdateFields_NotFound(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+	person := createValidPerson(t)
+	person.ID = 42
+
+	err := repo.UpdateFields(person, []string{personModel.FieldEmail})
+
+	assert.ErrorIs(err, personError.ErrPersonNotFound)
+}
+
+func TestPersonRepositoryImpl_UpdateFields_UnknownField(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+	person := createValidPerson(t)
+	person.ID = 1
+
+	err := repo.UpdateFields(person, []string{"password"})
+
+	assert.Error(err)
+	assert.Contains(err.Error(), "unknown field")
+}
+
+func TestPersonRepositoryImpl_Update_RejectsStaleVersion(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+	person := createValidPerson(t)
+
+	id, err := repo.Save(person)
+	assert.NoError(err)
+
+	first, _ := repo.FindByID(id)
+	second, _ := repo.FindByID(id)
+
+	first.Name = "First Writer"
+	assert.NoError(repo.Update(first))
+	assert.Equal(2, first.Version)
+
+	second.Name = "Second Writer"
+	err = repo.Update(second)
+	assert.ErrorIs(err, personError.ErrVersionConflict)
+
+	found, _ := repo.FindByID(id)
+	assert.Equal("First Writer", found.Name)
+	assert.Equal(2, found.Version)
+}
+
+func TestPersonRepositoryImpl_Delete_RejectsStaleVersion(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+	person := createValidPerson(t)
+
+	id, err := repo.Save(person)
+	assert.NoError(err)
+
+	err = repo.Delete(id, 7)
+	assert.ErrorIs(err, personError.ErrVersionConflict)
+
+	err = repo.Delete(id, 1)
+	assert.NoError(err)
+
+	err = repo.Delete(id, 1)
+	assert.ErrorIs(err, personError.ErrPersonNotFound)
+}
+
+func TestPersonRepositoryImpl_Delete_IsSoftDelete(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+
+	id, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+
+	assert.NoError(repo.Delete(id, 1))
+
+	found, err := repo.FindByID(id)
+	assert.NoError(err)
+	assert.Nil(found)
+
+	found, err = repo.FindByCPF("11144477735")
+	assert.NoError(err)
+	assert.Nil(found)
+
+	deleted, err := repo.FindByIDIncludingDeleted(id)
+	assert.NoError(err)
+	assert.NotNil(deleted)
+	assert.True(deleted.IsDeleted())
+	assert.Equal(2, deleted.Version)
+
+	persons, total, err := repo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{})
+	assert.NoError(err)
+	assert.Equal(int64(0), total)
+	assert.Len(persons, 0)
+
+	persons, total, err = repo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{IncludeDeleted: true})
+	assert.NoError(err)
+	assert.Equal(int64(1), total)
+	assert.Len(persons, 1)
+}
+
+func TestPersonRepositoryImpl_Restore(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+
+	id, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+	assert.NoError(repo.Delete(id, 1))
+
+	assert.NoError(repo.Restore(id))
+
+	found, err := repo.FindByID(id)
+	assert.NoError(err)
+	assert.NotNil(found)
+	assert.False(found.IsDeleted())
+	assert.Equal(3, found.Version)
+
+	err = repo.Restore(id)
+	assert.ErrorIs(err, personError.ErrPersonNotFound)
+}
+
+func TestPersonRepositoryImpl_DeletedCPF_CanBeRegisteredAgain(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+
+	id, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+
+	_, err = repo.Save(createValidPerson(t))
+	assert.Error(err)
+
+	assert.NoError(repo.Delete(id, 1))
+
+	newID, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+	assert.NotEqual(id, newID)
+}
+
+func TestPersonRepositoryImpl_Purge(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+
+	repo := NewPersonRepository(db, nil)
+
+	id, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+	assert.NoError(repo.Delete(id, 1))
+
+	err = repo.Purge(id, 1)
+	assert.ErrorIs(err, personError.ErrVersionConflict)
+
+	assert.NoError(repo.Purge(id, 2))
+
+	found, err := repo.FindByIDIncludingDeleted(id)
+	assert.NoError(err)
+	assert.Nil(found)
+
+	err = repo.Purge(id, 2)
+	assert.ErrorIs(err, personError.ErrPersonNotFound)
+}
+
+func seedFilterPersons(t *testing.T, repo ports.PersonRepository) {
+	seeds := []struct {
+		name, cpf, phone, email string
+		birthDate               time.Time
+	}{
+		{"José da Silva", "11144477735", "81912345678", "jose.silva@gmail.com", time.Date(1992, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"Maria SILVA Souza", "22233344405", "81998765432", "Maria@Empresa.com.br", time.Date(1985, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{"Ana Paula", "52998224725", "11987654321", "ana_paula@gmail.com", time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{"Pedro 100% Santos", "39053344705", "21912341234", "pedro@yahoo.com", time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, seed := range seeds {
+		person, err := personModel.NewPerson(seed.name, seed.cpf, seed.birthDate, seed.phone, seed.email)
+		if err != nil {
+			t.Fatalf("failed to create person %s: %v", seed.name, err)
+		}
+		if _, err := repo.Save(person); err != nil {
+			t.Fatalf("failed to save person %s: %v", seed.name, err)
+		}
+	}
+}
+
+func filteredNames(t *testing.T, filter personModel.PersonFilter) []string {
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+	seedFilterPersons(t, repo)
+
+	persons, total, err := repo.FindAll(1, 10, "name", "asc", filter)
+	if err != nil {
+		t.Fatalf("failed to filter persons: %v", err)
+	}
+
+	names := make([]string, len(persons))
+	for i, person := range persons {
+		names[i] = person.Name
+	}
+	assert.Equal(t, int64(len(persons)), total)
+
+	return names
+}
+
+func TestPersonRepositoryImpl_FindAll_FilterByName_IgnoresCaseAndAccents(t *testing.T) {
+	assert.Equal(t, []string{"José da Silva", "Maria SILVA Souza"}, filteredNames(t, personModel.PersonFilter{Name: "silva"}))
+	assert.Equal(t, []string{"José da Silva"}, filteredNames(t, personModel.PersonFilter{Name: "JOSE"}))
+	assert.Equal(t, []string{"José da Silva"}, filteredNames(t, personModel.PersonFilter{Name: "josé"}))
+}
+
+func TestPersonRepositoryImpl_FindAll_FilterByName_EscapesWildcards(t *testing.T) {
+	assert.Equal(t, []string{"Pedro 100% Santos"}, filteredNames(t, personModel.PersonFilter{Name: "100%"}))
+	assert.Equal(t, []string{"Pedro 100% Santos"}, filteredNames(t, personModel.PersonFilter{Name: "%"}))
+	assert.Empty(t, filteredNames(t, personModel.PersonFilter{Name: "_"}))
+}
+
+func TestPersonRepositoryImpl_FindAll_FilterByEmail(t *testing.T) {
+	assert.Equal(t, []string{"Maria SILVA Souza"}, filteredNames(t, personModel.PersonFilter{Email: "maria@empresa.com.br"}))
+	assert.Empty(t, filteredNames(t, personModel.PersonFilter{Email: "maria@"}))
+	assert.Equal(t, []string{"Maria SILVA Souza"}, filteredNames(t, personModel.PersonFilter{Email: "MARIA@", EmailPrefix: true}))
+	assert.Equal(t, []string{"Ana Paula"}, filteredNames(t, personModel.PersonFilter{Email: "ana_", EmailPrefix: true}))
+	assert.Empty(t, filteredNames(t, personModel.PersonFilter{Email: "an%", EmailPrefix: true}))
+}
+
+func TestPersonRepositoryImpl_FindAll_FilterByPhoneAndCPF(t *testing.T) {
+	assert.Equal(t, []string{"José da Silva"}, filteredNames(t, personModel.PersonFilter{PhoneNumber: "(81) 91234-5678"}))
+	assert.Equal(t, []string{"José da Silva", "Maria SILVA Souza"}, filteredNames(t, personModel.PersonFilter{PhoneNumber: "81", PhonePrefix: true}))
+	assert.Equal(t, []string{"Maria SILVA Souza"}, filteredNames(t, personModel.PersonFilter{CPFPrefix: "222.333"}))
+}
+
+func TestPersonRepositoryImpl_FindAll_FilterByBirthDateRange(t *testing.T) {
+	from := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []string{"Ana Paula", "José da Silva"}, filteredNames(t, personModel.PersonFilter{BirthDateFrom: &from, BirthDateTo: &to}))
+	assert.Equal(t, []string{"Ana Paula", "José da Silva", "Pedro 100% Santos"}, filteredNames(t, personModel.PersonFilter{BirthDateFrom: &from}))
+}
+
+func TestPersonRepositoryImpl_FindAll_CombinedFilters(t *testing.T) {
+	from := time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	names := filteredNames(t, personModel.PersonFilter{
+		Name:          "silva",
+		Email:         "@gmail",
+		BirthDateFrom: &from,
+		BirthDateTo:   &to,
+	})
+
+	assert.Empty(t, names)
+
+	names = filteredNames(t, personModel.PersonFilter{
+		Name:          "silva",
+		Email:         "jose",
+		EmailPrefix:   true,
+		BirthDateFrom: &from,
+		BirthDateTo:   &to,
+	})
+
+	assert.Equal(t, []string{"José da Silva"}, names)
+}
+
+func TestPersonRepositoryImpl_FindAll_FilterByCreatedRange(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	assert.Len(t, filteredNames(t, personModel.PersonFilter{CreatedFrom: &past, CreatedTo: &future}), 4)
+	assert.Empty(t, filteredNames(t, personModel.PersonFilter{CreatedFrom: &future}))
+	assert.Empty(t, filteredNames(t, personModel.PersonFilter{UpdatedTo: &past}))
+}
+
+func TestPersonRepositoryImpl_UpdateFields_RefreshesNameSearch(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+
+	person := createValidPerson(t)
+	id, err := repo.Save(person)
+	assert.NoError(err)
+
+	person.ID = id
+	newName := "João Conceição"
+	_, err = person.ApplyChanges(personModel.PersonChanges{Name: &newName})
+	assert.NoError(err)
+	assert.NoError(repo.UpdateFields(person, []string{personModel.FieldName}))
+
+	persons, _, err := repo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{Name: "conceicao"})
+	assert.NoError(err)
+	assert.Len(persons, 1)
+}
+
+// walkPages pages through the whole listing with FindAfter in the given direction,
+// the way PersonService does, and returns the IDs in display order.
+func walkPages(t *testing.T, repo ports.PersonRepository, sortBy, sortOrder string, size int) []int {
+	var ids []int
+	var cursor *personModel.Cursor
+
+	for {
+		persons, err := repo.FindAfter(cursor, size+1, sortBy, sortOrder, personModel.PersonFilter{})
+		if err != nil {
+			t.Fatalf("failed to read page: %v", err)
+		}
+
+		hasMore := len(persons) > size
+		if hasMore {
+			persons = persons[:size]
+		}
+		for _, person := range persons {
+			ids = append(ids, person.ID)
+		}
+
+		if !hasMore {
+			return ids
+		}
+		cursor = personModel.NewCursor(persons[len(persons)-1], sortBy, sortOrder, false)
+	}
+}
+
+func saveNamed(t *testing.T, repo ports.PersonRepository, name, cpf string, createdAt time.Time) int {
+	person, err := personModel.NewPerson(name, cpf, time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), "81912345678", "test@example.com")
+	if err != nil {
+		t.Fatalf("failed to create person: %v", err)
+	}
+	person.CreatedAt = createdAt
+	person.UpdatedAt = createdAt
+
+	id, err := repo.Save(person)
+	if err != nil {
+		t.Fatalf("failed to save person: %v", err)
+	}
+	return id
+}
+
+func TestPersonRepositoryImpl_FindAfter_WalksAllPagesWithTies(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+
+	now := time.Now()
+	ana1 := saveNamed(t, repo, "Ana", "11144477735", now)
+	bia := saveNamed(t, repo, "Bia", "22233344405", now)
+	ana2 := saveNamed(t, repo, "Ana", "52998224725", now)
+	caio := saveNamed(t, repo, "Caio", "39053344705", now)
+	ana3 := saveNamed(t, repo, "Ana", "12345678909", now)
+
+	assert.Equal([]int{ana1, ana2, ana3, bia, caio}, walkPages(t, repo, "name", "asc", 2))
+	assert.Equal([]int{caio, bia, ana3, ana2, ana1}, walkPages(t, repo, "name", "desc", 2))
+	assert.Equal([]int{ana3, caio, ana2, bia, ana1}, walkPages(t, repo, "id", "desc", 2))
+}
+
+func TestPersonRepositoryImpl_FindAfter_ByTimestamp(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+
+	base := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+	third := saveNamed(t, repo, "C", "11144477735", base.Add(2*time.Minute))
+	first := saveNamed(t, repo, "A", "22233344405", base)
+	second := saveNamed(t, repo, "B", "52998224725", base.Add(time.Minute))
+
+	assert.Equal([]int{first, second, third}, walkPages(t, repo, "created_at", "asc", 1))
+}
+
+func TestPersonRepositoryImpl_FindAfter_Backward(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+
+	now := time.Now()
+	var ids []int
+	for _, cpf := range []string{"11144477735", "22233344405", "52998224725", "39053344705"} {
+		ids = append(ids, saveNamed(t, repo, "Ana", cpf, now))
+	}
+
+	// Going back from the third person in ascending order yields the ones before it, nearest first.
+	cursor := &personModel.Cursor{Sort: "id", Order: "asc", ID: ids[2], Backward: true}
+	persons, err := repo.FindAfter(cursor, 10, "id", "asc", personModel.PersonFilter{})
+
+	assert.NoError(err)
+	assert.Len(persons, 2)
+	assert.Equal(ids[1], persons[0].ID)
+	assert.Equal(ids[0], persons[1].ID)
+}
+
+func TestPersonRepositoryImpl_FindAfter_StableUnderInserts(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+
+	now := time.Now()
+	first := saveNamed(t, repo, "Bruno", "11144477735", now)
+	second := saveNamed(t, repo, "Carla", "22233344405", now)
+	third := saveNamed(t, repo, "Daniel", "52998224725", now)
+
+	page, err := repo.FindAfter(nil, 2, "name", "asc", personModel.PersonFilter{})
+	assert.NoError(err)
+	assert.Equal(first, page[0].ID)
+	assert.Equal(second, page[1].ID)
+
+	// A person inserted before the cursor position must not shift the next page.
+	saveNamed(t, repo, "Alice", "39053344705", now)
+
+	cursor := personModel.NewCursor(page[1], "name", "asc", false)
+	next, err := repo.FindAfter(cursor, 2, "name", "asc", personModel.PersonFilter{})
+	assert.NoError(err)
+	assert.Len(next, 1)
+	assert.Equal(third, next[0].ID)
+}
+
+func TestPersonRepositoryImpl_FindAfter_InvalidTimestampCursor(t *testing.T) {
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+
+	cursor := &personModel.Cursor{Sort: "created_at", Order: "asc", Value: "yesterday", ID: 1}
+	_, err := repo.FindAfter(cursor, 10, "created_at", "asc", personModel.PersonFilter{})
+
+	assert.ErrorIs(t, err, personError.ErrInvalidCursor)
+}
+
+func TestPersonRepositoryImpl_Count(t *testing.T) {
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+	seedFilterPersons(t, repo)
+
+	total, err := repo.Count(personModel.PersonFilter{Name: "silva"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
+
+func newNamedPerson(t *testing.T, name, cpf string) *personModel.Person {
+	person, err := personModel.NewPerson(name, cpf, time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), "81912345678", "test@example.com")
+	if err != nil {
+		t.Fatalf("failed to create person: %v", err)
+	}
+	return person
+}
+
+func TestPersonRepositoryImpl_SaveBatch(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+
+	ids, err := repo.SaveBatch([]*personModel.Person{
+		newNamedPerson(t, "Ana", "11144477735"),
+		newNamedPerson(t, "Bruno", "22233344405"),
+	})
+
+	assert.NoError(err)
+	assert.Len(ids, 2)
+	assert.NotEqual(ids[0], ids[1])
+
+	saved, err := repo.FindByID(ids[1])
+	assert.NoError(err)
+	assert.Equal("Bruno", saved.Name)
+}
+
+func TestPersonRepositoryImpl_SaveBatch_RollsBackOnConflict(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+
+	ids, err := repo.SaveBatch([]*personModel.Person{
+		newNamedPerson(t, "Ana", "11144477735"),
+		newNamedPerson(t, "Bruno", "11144477735"),
+	})
+
+	assert.Error(err)
+	assert.Nil(ids)
+
+	total, err := repo.Count(personModel.PersonFilter{})
+	assert.NoError(err)
+	assert.Equal(int64(0), total)
+}
+
+func TestPersonRepositoryImpl_FindExistingCPFs(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+	saveNamed(t, repo, "Ana", "11144477735", time.Now())
+	deletedID := saveNamed(t, repo, "Bruno", "22233344405", time.Now())
+	assert.NoError(repo.Delete(deletedID, 1))
+
+	existing, err := repo.FindExistingCPFs([]string{"11144477735", "22233344405", "52998224725"})
+
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"11144477735": true}, existing)
+}
+
+func TestPersonRepositoryImpl_Stream(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+	seedFilterPersons(t, repo)
+
+	var names []string
+	err := repo.Stream("name", "asc", personModel.PersonFilter{Name: "silva"}, func(p *personModel.Person) error {
+		names = append(names, p.Name)
+		return nil
+	})
+
+	assert.NoError(err)
+	assert.Equal([]string{"José da Silva", "Maria SILVA Souza"}, names)
+}
+
+func TestPersonRepositoryImpl_Stream_StopsOnCallbackError(t *testing.T) {
+	assert := assert.New(t)
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), nil)
+	seedFilterPersons(t, repo)
+
+	stop := errors.New("stop")
+	calls := 0
+	err := repo.Stream("id", "asc", personModel.PersonFilter{}, func(p *personModel.Person) error {
+		calls++
+		return stop
+	})
+
+	assert.ErrorIs(err, stop)
+	assert.Equal(1, calls)
+}
+
+// newTestCipher returns a cipher holding the keys with the given IDs, the
+// first one being current. Keys and the blind index key are derived from
+// fixed values, so ciphers built with the same IDs read each other's rows.
+func newTestCipher(t *testing.T, keyIDs ...string) *encryption.Cipher {
+	entries := make([]string, len(keyIDs))
+	for i, id := range keyIDs {
+		entries[i] = id + ":" + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%-32s", id)))
+	}
+
+	provider, err := encryption.NewLocalKeyProvider(strings.Join(entries, ","), base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%-32s", "index"))))
+	if err != nil {
+		t.Fatalf("failed to create key provider: %v", err)
+	}
+	return encryption.NewCipher(provider)
+}
+
+// storedRow reads the person row as it is in the database, soft deleted or not.
+func storedRow(t *testing.T, db *gorm.DB, id int) PersonEntity {
+	var entity PersonEntity
+	if err := db.Unscoped().First(&entity, id).Error; err != nil {
+		t.Fatalf("failed to read person: %v", err)
+	}
+	return entity
+}
+
+func TestPersonRepositoryImpl_Encrypted_StoresCiphertextsAndBlindIndexes(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+	repo := NewPersonRepository(db, newTestCipher(t, "k1"))
+
+	id, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+
+	row := storedRow(t, db, id)
+	assert.Nil(row.CPF)
+	assert.Nil(row.BirthDate)
+	assert.Nil(row.PhoneNumber)
+	assert.Nil(row.Email)
+	assert.Equal("k1", *row.KeyID)
+	assert.NotContains(*row.CPFEncrypted, "11144477735")
+	assert.NotContains(*row.EmailEncrypted, "john.doe")
+	assert.Len(*row.CPFIndex, 64)
+	assert.Len(*row.BirthDateIndex, 64)
+
+	found, err := repo.FindByCPF("11144477735")
+	assert.NoError(err)
+	assert.Equal(id, found.ID)
+	assert.Equal("11144477735", found.CPF)
+	assert.Equal(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), found.BirthDate)
+	assert.Equal("+5581912345678", found.Phone())
+	assert.Equal("john.doe@example.com", found.Email())
+
+	existing, err := repo.FindExistingCPFs([]string{"11144477735", "52998224725"})
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"11144477735": true}, existing)
+
+	var contacts []ContactEntity
+	assert.NoError(db.Where("person_id = ?", id).Find(&contacts).Error)
+	assert.Len(contacts, 2)
+	for _, contact := range contacts {
+		assert.Nil(contact.Value)
+		assert.Equal("k1", *contact.KeyID)
+		assert.NotContains(*contact.ValueEncrypted, "john.doe")
+		assert.Len(*contact.ValueIndex, 64)
+	}
+
+	byEmail, err := repo.FindByContact(personModel.ContactEmail, "John.Doe@Example.com")
+	assert.NoError(err)
+	assert.Len(byEmail, 1, "emails are matched without case through the blind index")
+	assert.Equal("+5581912345678", byEmail[0].Contacts[0].Value)
+
+	listed, total, err := repo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{PhoneNumber: "(81) 91234-5678"})
+	assert.NoError(err)
+	assert.Equal(int64(1), total)
+	assert.Equal(id, listed[0].ID)
+
+	_, err = repo.Save(createValidPerson(t))
+	assert.Error(err, "the blind index keeps CPFs unique")
+
+	_, err = NewPersonRepository(db, nil).FindByID(id)
+	assert.ErrorIs(err, ErrEncryptionDisabled)
+}
+
+func TestPersonRepositoryImpl_Encrypted_FindsPlaintextRows(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+	legacyID := saveNamed(t, NewPersonRepository(db, nil), "Ana", "11144477735", time.Now())
+	repo := NewPersonRepository(db, newTestCipher(t, "k1"))
+	encryptedID := saveNamed(t, repo, "Bruno", "22233344405", time.Now())
+
+	found, err := repo.FindByCPF("11144477735")
+	assert.NoError(err)
+	assert.Equal(legacyID, found.ID)
+
+	existing, err := repo.FindExistingCPFs([]string{"11144477735", "22233344405"})
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"11144477735": true, "22233344405": true}, existing)
+
+	persons, total, err := repo.FindAll(1, 10, "id", "asc", personModel.PersonFilter{CPFPrefix: "222.333.444-05"})
+	assert.NoError(err)
+	assert.Equal(int64(1), total)
+	assert.Equal(encryptedID, persons[0].ID)
+}
+
+func TestPersonRepositoryImpl_Encrypted_UpdatesResealTheRow(t *testing.T) {
+	assert := assert.New(t)
+	db := setupPeopleSchemaDB(t)
+	cipher := newTestCipher(t, "k1")
+	repo := NewPersonRepository(db, cipher)
+	contactRepo := NewContactRepository(db, cipher).(*ContactRepositoryImpl)
+	id, err := repo.Save(createValidPerson(t))
+	assert.NoError(err)
+	dataKey := *storedRow(t, db, id).DataKey
+
+	person, _ := repo.FindByID(id)
+	newEmail := "john@corp.com"
+	fields, err := person.ApplyChanges(personModel.PersonChanges{Email: &newEmail})
+	assert.NoError(err)
+	assert.NoError(repo.UpdateFields(person, fields))
+
+	assert.NotEqual(dataKey, *storedRow(t, db, id).DataKey, "every write uses a new data key")
+
+	saveContact(t, contactRepo, id, personModel.ContactPhone, "81988887777", true)
+
+	var streamed []*personModel.Person
+	err = repo.Stream("id", "asc", personModel.PersonFilter{}, func(p *personModel.Person) error {
+		streamed = append(streamed, p)
+		return nil
+	})
+	assert.NoError(err)
+	assert.Len(streamed, 1)
+	assert.Equal("11144477735", streamed[0].CPF)
+	assert.Equal("john@corp.com", streamed[0].Email())
+	assert.Equal("+5581988887777", streamed[0].Phone(), "the mirrored phone is encrypted too")
+	assert.Nil(storedRow(t, db, id).PhoneNumber)
+}
+
+func TestPersonRepositoryImpl_Encrypted_RejectsQueriesOnCiphertexts(t *testing.T) {
+	repo := NewPersonRepository(setupPeopleSchemaDB(t), newTestCipher(t, "k1"))
+	bornAfter := time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		sortBy string
+		filter personModel.PersonFilter
+	}{
+		{name: "sort by cpf", sortBy: "cpf"},
+		{name: "sort by email", sortBy: "email"},
+		{name: "birth date range", sortBy: "id", filter: personModel.PersonFilter{BirthDateFrom: &bornAfter}},
+		{name: "partial cpf", sortBy: "id", filter: personModel.PersonFilter{CPFPrefix: "111"}},
+		{name: "email prefix", sortBy: "id", filter: personModel.PersonFilter{Email: "john", EmailPrefix: true}},
+		{name: "phone prefix", sortBy: "id", filter: personModel.PersonFilter{PhoneNumber: "81", PhonePrefix: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := repo.FindAll(1, 10, tt.sortBy, "asc", tt.filter)
+			assert.ErrorIs(t, err, personError.ErrEncryptedField)
+
+			_, err = repo.FindAfter(nil, 10, tt.sortBy, "asc", tt.filter)
+			assert.ErrorIs(t, err, personError.ErrEncryptedField)
+
+			err = repo.Stream(tt.sortBy, "asc", tt.filter, func(*personModel.Person) error { return nil })
+			assert.ErrorIs(t, err, personError.ErrEncryptedField)
+		})
+	}
+}
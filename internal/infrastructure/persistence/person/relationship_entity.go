@@ -0,0 +1,39 @@
+package person
+
+import (
+	"time"
+
+	personModel "pessoas-api/internal/domain/person/model"
+)
+
+type RelationshipEntity struct {
+	ID              int       `gorm:"column:id;primaryKey;autoIncrement"`
+	PersonID        int       `gorm:"column:person_id;not null;uniqueIndex:idx_person_relationship_link"`
+	RelatedPersonID int       `gorm:"column:related_person_id;not null;uniqueIndex:idx_person_relationship_link;index"`
+	Type            string    `gorm:"column:type;type:varchar(30);not null;uniqueIndex:idx_person_relationship_link"`
+	CreatedAt       time.Time `gorm:"column:created_at;type:timestamp;not null"`
+}
+
+func (RelationshipEntity) TableName() string {
+	return "people.person_relationship"
+}
+
+func (e *RelationshipEntity) ToDomain() *personModel.Relationship {
+	return &personModel.Relationship{
+		ID:        e.ID,
+		PersonID:  e.PersonID,
+		RelatedID: e.RelatedPersonID,
+		Type:      e.Type,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func RelationshipFromDomain(r *personModel.Relationship) *RelationshipEntity {
+	return &RelationshipEntity{
+		ID:              r.ID,
+		PersonID:        r.PersonID,
+		RelatedPersonID: r.RelatedID,
+		Type:            r.Type,
+		CreatedAt:       r.CreatedAt,
+	}
+}
@@ -0,0 +1,115 @@
+package person
+
+import (
+	"errors"
+	"fmt"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"gorm.io/gorm"
+)
+
+// RelationshipRepositoryImpl implements the ports.RelationshipRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type RelationshipRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewRelationshipRepository creates a new instance of RelationshipRepositoryImpl.
+// It returns the implementation as the RelationshipRepository interface.
+func NewRelationshipRepository(db *gorm.DB) ports.RelationshipRepository {
+	return &RelationshipRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *RelationshipRepositoryImpl) Save(relationship *personModel.Relationship) (int, error) {
+	entity := RelationshipFromDomain(relationship)
+
+	if err := r.db.Create(entity).Error; err != nil {
+		return 0, fmt.Errorf("failed to save relationship: %w", err)
+	}
+
+	return entity.ID, nil
+}
+
+func (r *RelationshipRepositoryImpl) Delete(id int) error {
+	result := r.db.Where("id = ?", id).Delete(&RelationshipEntity{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete relationship: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return personError.ErrRelationshipNotFound
+	}
+
+	return nil
+}
+
+func (r *RelationshipRepositoryImpl) FindByID(id int) (*personModel.Relationship, error) {
+	var entity RelationshipEntity
+
+	result := r.activeLinks().Where("id = ?", id).First(&entity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find relationship: %w", result.Error)
+	}
+
+	return entity.ToDomain(), nil
+}
+
+func (r *RelationshipRepositoryImpl) FindByPersons(personIDs []int) ([]*personModel.Relationship, error) {
+	var entities []RelationshipEntity
+
+	if len(personIDs) == 0 {
+		return []*personModel.Relationship{}, nil
+	}
+
+	result := r.activeLinks().
+		Where("person_id IN ? OR related_person_id IN ?", personIDs, personIDs).
+		Order("id").
+		Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find relationships: %w", result.Error)
+	}
+
+	relationships := make([]*personModel.Relationship, len(entities))
+	for i := range entities {
+		relationships[i] = entities[i].ToDomain()
+	}
+	return relationships, nil
+}
+
+func (r *RelationshipRepositoryImpl) FindAllByPersons(personIDs []int) ([]*personModel.Relationship, error) {
+	var entities []RelationshipEntity
+
+	if len(personIDs) == 0 {
+		return []*personModel.Relationship{}, nil
+	}
+
+	result := r.db.
+		Where("person_id IN ? OR related_person_id IN ?", personIDs, personIDs).
+		Order("id").
+		Find(&entities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find relationships: %w", result.Error)
+	}
+
+	relationships := make([]*personModel.Relationship, len(entities))
+	for i := range entities {
+		relationships[i] = entities[i].ToDomain()
+	}
+	return relationships, nil
+}
+
+// activeLinks scopes a query to the relationships whose both ends are active persons.
+func (r *RelationshipRepositoryImpl) activeLinks() *gorm.DB {
+	active := r.db.Model(&PersonEntity{}).Select("id")
+
+	return r.db.Model(&RelationshipEntity{}).
+		Where("person_id IN (?)", active).
+		Where("related_person_id IN (?)", active)
+}
@@ -0,0 +1,145 @@
+package person
+
+import (
+	"testing"
+	"time"
+
+	personError "pessoas-api/internal/domain/person/error"
+	personModel "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupRelationshipTest creates the person_relationship table, saves three
+// persons and returns the repositories with their IDs.
+func setupRelationshipTest(t *testing.T) (*RelationshipRepositoryImpl, *PersonRepositoryImpl, []int) {
+	db := setupPeopleSchemaDB(t)
+
+	statements := []string{
+		`CREATE TABLE people.person_relationship (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			related_person_id INTEGER NOT NULL REFERENCES person(id) ON DELETE CASCADE,
+			type VARCHAR(30) NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE UNIQUE INDEX people.idx_person_relationship_link ON person_relationship (person_id, related_person_id, type)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare relationship table: %v", err)
+		}
+	}
+
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+
+	var ids []int
+	for i, cpf := range []string{"11144477735", "52998224725", "39053344705"} {
+		p, err := personModel.NewPerson("Person", cpf, time.Date(1980+i, time.January, 1, 0, 0, 0, 0, time.UTC), "81912345678", "person@example.com")
+		if err != nil {
+			t.Fatalf("failed to create person: %v", err)
+		}
+		id, err := personRepo.Save(p)
+		if err != nil {
+			t.Fatalf("failed to save person: %v", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return NewRelationshipRepository(db).(*RelationshipRepositoryImpl), personRepo, ids
+}
+
+// saveRelationship stores a canonical relationship between two persons.
+func saveRelationship(t *testing.T, repo *RelationshipRepositoryImpl, personID, relatedID int, relationshipType string) int {
+	id, err := repo.Save(&personModel.Relationship{PersonID: personID, RelatedID: relatedID, Type: relationshipType, CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("failed to save relationship: %v", err)
+	}
+	return id
+}
+
+func TestRelationshipRepositoryImpl_SaveAndFind(t *testing.T) {
+	assert := assert.New(t)
+	repo, _, ids := setupRelationshipTest(t)
+
+	parent := saveRelationship(t, repo, ids[2], ids[0], personModel.RelationshipParent)
+	spouse := saveRelationship(t, repo, ids[0], ids[1], personModel.RelationshipSpouse)
+
+	found, err := repo.FindByID(parent)
+	assert.NoError(err)
+	assert.Equal(ids[2], found.PersonID)
+	assert.Equal(ids[0], found.RelatedID)
+	assert.Equal(personModel.RelationshipParent, found.Type)
+
+	relationships, err := repo.FindByPersons([]int{ids[0]})
+	assert.NoError(err)
+	assert.Len(relationships, 2, "relationships are found from either end")
+
+	relationships, err = repo.FindByPersons([]int{ids[1]})
+	assert.NoError(err)
+	assert.Len(relationships, 1)
+	assert.Equal(spouse, relationships[0].ID)
+
+	relationships, err = repo.FindByPersons(nil)
+	assert.NoError(err)
+	assert.Empty(relationships)
+
+	_, err = repo.Save(&personModel.Relationship{PersonID: ids[0], RelatedID: ids[1], Type: personModel.RelationshipSpouse, CreatedAt: time.Now()})
+	assert.Error(err, "a link is stored once")
+}
+
+func TestRelationshipRepositoryImpl_IgnoresDeletedPersons(t *testing.T) {
+	assert := assert.New(t)
+	repo, personRepo, ids := setupRelationshipTest(t)
+
+	guardian := saveRelationship(t, repo, ids[0], ids[1], personModel.RelationshipGuardian)
+	saveRelationship(t, repo, ids[0], ids[2], personModel.RelationshipEmergencyContact)
+
+	assert.NoError(personRepo.Delete(ids[1], 1))
+
+	found, err := repo.FindByID(guardian)
+	assert.NoError(err)
+	assert.Nil(found)
+
+	relationships, err := repo.FindByPersons([]int{ids[0]})
+	assert.NoError(err)
+	assert.Len(relationships, 1)
+	assert.Equal(personModel.RelationshipEmergencyContact, relationships[0].Type)
+
+	assert.NoError(personRepo.Restore(ids[1]))
+	relationships, _ = repo.FindByPersons([]int{ids[0]})
+	assert.Len(relationships, 2, "relationships come back with the restored person")
+}
+
+func TestRelationshipRepositoryImpl_FindAllByPersons_IncludesDeletedPersons(t *testing.T) {
+	assert := assert.New(t)
+	repo, personRepo, ids := setupRelationshipTest(t)
+
+	guardian := saveRelationship(t, repo, ids[0], ids[1], personModel.RelationshipGuardian)
+	saveRelationship(t, repo, ids[1], ids[2], personModel.RelationshipEmergencyContact)
+
+	assert.NoError(personRepo.Delete(ids[0], 1))
+
+	relationships, err := repo.FindAllByPersons([]int{ids[0]})
+	assert.NoError(err)
+	assert.Len(relationships, 1)
+	assert.Equal(guardian, relationships[0].ID)
+
+	relationships, err = repo.FindAllByPersons([]int{ids[1]})
+	assert.NoError(err)
+	assert.Len(relationships, 2)
+}
+
+func TestRelationshipRepositoryImpl_Delete(t *testing.T) {
+	assert := assert.New(t)
+	repo, _, ids := setupRelationshipTest(t)
+
+	id := saveRelationship(t, repo, ids[0], ids[1], personModel.RelationshipSpouse)
+
+	assert.NoError(repo.Delete(id))
+	assert.ErrorIs(repo.Delete(id), personError.ErrRelationshipNotFound)
+
+	relationships, err := repo.FindByPersons([]int{ids[0], ids[1]})
+	assert.NoError(err)
+	assert.Empty(relationships)
+}
@@ -0,0 +1,63 @@
+package person
+
+import (
+	"encoding/json"
+	"time"
+
+	personModel "pessoas-api/internal/domain/person/model"
+)
+
+// SubjectRequestEntity is a row of people.data_subject_request. PersonIDs
+// holds the JSON array of the IDs of the persons the request covered.
+type SubjectRequestEntity struct {
+	ID         int       `gorm:"column:id;primaryKey;autoIncrement"`
+	Type       string    `gorm:"column:type;type:varchar(20);not null"`
+	SubjectCPF string    `gorm:"column:subject_cpf;type:varchar(14);not null"`
+	PersonIDs  string    `gorm:"column:person_ids;type:jsonb;not null"`
+	Status     string    `gorm:"column:status;type:varchar(20);not null"`
+	OperatorID int       `gorm:"column:operator_id;not null"`
+	RequestID  string    `gorm:"column:request_id;type:varchar(64)"`
+	ClientIP   string    `gorm:"column:client_ip;type:varchar(45)"`
+	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp;not null"`
+}
+
+func (SubjectRequestEntity) TableName() string {
+	return "people.data_subject_request"
+}
+
+func (e *SubjectRequestEntity) ToDomain() *personModel.SubjectRequest {
+	personIDs := []int{}
+	_ = json.Unmarshal([]byte(e.PersonIDs), &personIDs)
+
+	return &personModel.SubjectRequest{
+		ID:         e.ID,
+		Type:       e.Type,
+		SubjectCPF: e.SubjectCPF,
+		PersonIDs:  personIDs,
+		Status:     e.Status,
+		OperatorID: e.OperatorID,
+		RequestID:  e.RequestID,
+		ClientIP:   e.ClientIP,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func SubjectRequestFromDomain(r *personModel.SubjectRequest) *SubjectRequestEntity {
+	personIDs := r.PersonIDs
+	if personIDs == nil {
+		personIDs = []int{}
+	}
+	encoded, _ := json.Marshal(personIDs)
+
+	return &SubjectRequestEntity{
+		ID:         r.ID,
+		Type:       r.Type,
+		SubjectCPF: r.SubjectCPF,
+		PersonIDs:  string(encoded),
+		Status:     r.Status,
+		OperatorID: r.OperatorID,
+		RequestID:  r.RequestID,
+		ClientIP:   r.ClientIP,
+		CreatedAt:  r.CreatedAt,
+	}
+}
@@ -0,0 +1,180 @@
+package person
+
+import (
+	"fmt"
+
+	auditModel "pessoas-api/internal/domain/audit/model"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	personUtils "pessoas-api/internal/domain/person/utils"
+	"pessoas-api/internal/infrastructure/encryption"
+	auditPersistence "pessoas-api/internal/infrastructure/persistence/audit"
+
+	"gorm.io/gorm"
+)
+
+// SubjectRightsRepositoryImpl implements the ports.SubjectRightsRepository interface.
+// This is the adapter for PostgreSQL database persistence.
+type SubjectRightsRepositoryImpl struct {
+	db     *gorm.DB
+	cipher *encryption.Cipher
+}
+
+// NewSubjectRightsRepository creates a new instance of SubjectRightsRepositoryImpl.
+// Anonymized persons are written with cipher, as in NewPersonRepository.
+// It returns the implementation as the SubjectRightsRepository interface.
+func NewSubjectRightsRepository(db *gorm.DB, cipher *encryption.Cipher) ports.SubjectRightsRepository {
+	return &SubjectRightsRepositoryImpl{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+func (r *SubjectRightsRepositoryImpl) Save(request *personModel.SubjectRequest) (int, error) {
+	return saveSubjectRequest(r.db, request)
+}
+
+func (r *SubjectRightsRepositoryImpl) FindRequests(page, pageSize int) ([]*personModel.SubjectRequest, int64, error) {
+	var entities []SubjectRequestEntity
+	var total int64
+
+	if err := r.db.Model(&SubjectRequestEntity{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count subject requests: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+
+	result := r.db.Order("created_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&entities)
+	if result.Error != nil {
+		return nil, 0, fmt.Errorf("failed to find subject requests: %w", result.Error)
+	}
+
+	requests := make([]*personModel.SubjectRequest, len(entities))
+	for i := range entities {
+		requests[i] = entities[i].ToDomain()
+	}
+
+	return requests, total, nil
+}
+
+// Anonymize replaces the identifying data of the persons while keeping their
+// rows, so that every reference to them stays valid: names, CPFs, phones and
+// emails are overwritten, birth dates are cut to the year and the persons are
+// soft deleted. Contacts, documents and consents are removed, addresses keep
+// only their type, city, state and country, relationships are kept, the
+// consent history keeps its events without their evidence references and the
+// snapshots of the audit entries about the persons are redacted down to their
+// references.
+// The anonymization is recorded after the redaction, so that its own entries
+// are kept.
+func (r *SubjectRightsRepositoryImpl) Anonymize(personIDs []int, request *personModel.SubjectRequest, record func(tx ports.Repositories) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var entities []PersonEntity
+		if err := tx.Unscoped().Where("id IN ?", personIDs).Find(&entities).Error; err != nil {
+			return fmt.Errorf("failed to anonymize persons: %w", err)
+		}
+
+		for _, entity := range entities {
+			values, err := entity.open(r.cipher)
+			if err != nil {
+				return fmt.Errorf("failed to anonymize person: %w", err)
+			}
+
+			anonymized := sensitiveValues{
+				CPF:       personModel.AnonymizedCPF(entity.ID),
+				BirthDate: personModel.AnonymizedBirthDate(values.BirthDate),
+			}
+			if err := entity.seal(r.cipher, anonymized); err != nil {
+				return fmt.Errorf("failed to anonymize person: %w", err)
+			}
+
+			updates := entity.sensitiveUpdates()
+			updates["name"] = personModel.AnonymizedName
+			updates["name_search"] = personUtils.NormalizeSearchText(personModel.AnonymizedName)
+			updates["anonymized_at"] = request.CreatedAt
+			updates["deleted_at"] = gorm.Expr("COALESCE(deleted_at, ?)", request.CreatedAt)
+			updates["version"] = entity.Version + 1
+			updates["updated_at"] = request.CreatedAt
+
+			result := tx.Unscoped().Model(&PersonEntity{}).Where("id = ?", entity.ID).Updates(updates)
+			if result.Error != nil {
+				return fmt.Errorf("failed to anonymize person: %w", result.Error)
+			}
+		}
+
+		if err := tx.Where("person_id IN ?", personIDs).Delete(&ContactEntity{}).Error; err != nil {
+			return fmt.Errorf("failed to anonymize contacts: %w", err)
+		}
+
+		if err := tx.Where("person_id IN ?", personIDs).Delete(&DocumentEntity{}).Error; err != nil {
+			return fmt.Errorf("failed to anonymize documents: %w", err)
+		}
+
+		if err := tx.Where("person_id IN ?", personIDs).Delete(&ConsentEntity{}).Error; err != nil {
+			return fmt.Errorf("failed to anonymize consents: %w", err)
+		}
+
+		if err := tx.Model(&ConsentEventEntity{}).Where("person_id IN ?", personIDs).Update("evidence_ref", "").Error; err != nil {
+			return fmt.Errorf("failed to anonymize consent events: %w", err)
+		}
+
+		err := tx.Model(&AddressEntity{}).Where("person_id IN ?", personIDs).Updates(map[string]interface{}{
+			"cep":        "",
+			"street":     "",
+			"number":     "",
+			"complement": "",
+			"district":   "",
+			"updated_at": request.CreatedAt,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to anonymize addresses: %w", err)
+		}
+
+		if err := redactSubjectAudit(tx, personIDs); err != nil {
+			return err
+		}
+
+		if err := record(transactionRepositories(tx, r.cipher)); err != nil {
+			return err
+		}
+
+		_, err = saveSubjectRequest(tx, request)
+		return err
+	})
+}
+
+// redactSubjectAudit strips the snapshots of the audit entries about the
+// persons down to their references, dropping their encrypted copies.
+// Relationship snapshots only hold references already and are left as they
+// are.
+func redactSubjectAudit(tx *gorm.DB, personIDs []int) error {
+	var entities []auditPersistence.AuditEntity
+
+	err := auditPersistence.SubjectScope(tx.Model(&auditPersistence.AuditEntity{}), personIDs).
+		Where("entity_type <> ?", auditModel.EntityRelationship).
+		Find(&entities).Error
+	if err != nil {
+		return fmt.Errorf("failed to anonymize audit entries: %w", err)
+	}
+
+	for _, entity := range entities {
+		err := tx.Model(&auditPersistence.AuditEntity{}).Where("id = ?", entity.ID).Updates(entity.RedactedUpdates()).Error
+		if err != nil {
+			return fmt.Errorf("failed to anonymize audit entries: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func saveSubjectRequest(db *gorm.DB, request *personModel.SubjectRequest) (int, error) {
+	entity := SubjectRequestFromDomain(request)
+
+	if err := db.Create(entity).Error; err != nil {
+		return 0, fmt.Errorf("failed to save subject request: %w", err)
+	}
+
+	request.ID = entity.ID
+
+	return entity.ID, nil
+}
@@ -0,0 +1,266 @@
+package person
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	auditModel "pessoas-api/internal/domain/audit/model"
+	personModel "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	auditPersistence "pessoas-api/internal/infrastructure/persistence/audit"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// setupSubjectRightsTest prepares every table an anonymization touches.
+func setupSubjectRightsTest(t *testing.T) (*SubjectRightsRepositoryImpl, *gorm.DB) {
+	_, db := setupDuplicateTest(t)
+
+	statements := []string{
+		`CREATE TABLE people.audit_log (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			entity_type VARCHAR(50) NOT NULL,
+			entity_id INTEGER NOT NULL,
+			action VARCHAR(20) NOT NULL,
+			operator_id INTEGER NOT NULL,
+			request_id VARCHAR(64),
+			client_ip VARCHAR(45),
+			before_data TEXT,
+			after_data TEXT,
+			key_id VARCHAR(32),
+			data_key TEXT,
+			before_encrypted TEXT,
+			after_encrypted TEXT,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		`CREATE TABLE people.data_subject_request (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			type VARCHAR(20) NOT NULL,
+			subject_cpf VARCHAR(14) NOT NULL,
+			person_ids TEXT NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			operator_id INTEGER NOT NULL,
+			request_id VARCHAR(64),
+			client_ip VARCHAR(45),
+			created_at TIMESTAMP NOT NULL
+		)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("failed to prepare subject rights tables: %v", err)
+		}
+	}
+
+	return NewSubjectRightsRepository(db, nil).(*SubjectRightsRepositoryImpl), db
+}
+
+func TestSubjectRightsRepositoryImpl_SaveAndFindRequests(t *testing.T) {
+	assert := assert.New(t)
+	repo, _ := setupSubjectRightsTest(t)
+	actor := auditModel.Actor{OperatorID: 7, RequestID: "req-1", ClientIP: "203.0.113.10"}
+
+	access := personModel.NewSubjectRequest(personModel.SubjectRequestAccess, "11144477735", []int{1, 2}, actor)
+	access.CreatedAt = time.Now().Add(-time.Minute)
+	notFound := personModel.NewSubjectRequest(personModel.SubjectRequestPortability, "52998224725", nil, actor)
+
+	id, err := repo.Save(access)
+	assert.NoError(err)
+	assert.Equal(id, access.ID)
+	_, err = repo.Save(notFound)
+	assert.NoError(err)
+
+	requests, total, err := repo.FindRequests(1, 10)
+
+	assert.NoError(err)
+	assert.Equal(int64(2), total)
+	assert.Len(requests, 2)
+	assert.Equal(notFound.ID, requests[0].ID)
+	assert.Equal(personModel.SubjectRequestNotFound, requests[0].Status)
+	assert.Empty(requests[0].PersonIDs)
+	assert.Equal("***.444.777-**", requests[1].SubjectCPF)
+	assert.Equal([]int{1, 2}, requests[1].PersonIDs)
+	assert.Equal(7, requests[1].OperatorID)
+
+	secondPage, _, err := repo.FindRequests(2, 1)
+
+	assert.NoError(err)
+	assert.Len(secondPage, 1)
+	assert.Equal(access.ID, secondPage[0].ID)
+}
+
+func TestSubjectRightsRepositoryImpl_Anonymize(t *testing.T) {
+	assert := assert.New(t)
+	repo, db := setupSubjectRightsTest(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+	addressRepo := NewAddressRepository(db).(*AddressRepositoryImpl)
+	documentRepo := NewDocumentRepository(db).(*DocumentRepositoryImpl)
+	relationshipRepo := NewRelationshipRepository(db).(*RelationshipRepositoryImpl)
+	auditRepo := auditPersistence.NewAuditRepository(db, nil)
+
+	subject := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC), "jose@example.com")
+	other := saveDuplicateSubject(t, personRepo, "Lia Silva", "52998224725", time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC), "lia@example.com")
+	saveAddress(t, addressRepo, subject.ID, personModel.AddressResidential, true)
+	savePassport(t, documentRepo, subject.ID, "FZ123456", today().AddDate(1, 0, 0))
+	link := saveRelationship(t, relationshipRepo, other.ID, subject.ID, personModel.RelationshipParent)
+	consentRepo := NewConsentRepository(db).(*ConsentRepositoryImpl)
+	grantConsent(t, consentRepo, subject.ID, personModel.ConsentMarketing)
+	grantConsent(t, consentRepo, other.ID, personModel.ConsentMarketing)
+
+	actor := auditModel.Actor{OperatorID: 1}
+	assert.NoError(auditRepo.SaveAll([]*auditModel.AuditEntry{
+		auditModel.NewAuditEntry(auditModel.EntityPerson, subject.ID, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":1,"name":"José da Silva"}`)),
+		auditModel.NewAuditEntry(auditModel.EntityAddress, 1, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":1,"person_id":1,"street":"Rua da Aurora"}`)),
+		auditModel.NewAuditEntry(auditModel.EntityRelationship, link, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":1,"person_id":2,"related_person_id":1}`)),
+		auditModel.NewAuditEntry(auditModel.EntityPerson, other.ID, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":2,"name":"Lia Silva"}`)),
+	}))
+
+	request := personModel.NewSubjectRequest(personModel.SubjectRequestAnonymization, subject.CPF, []int{subject.ID}, actor)
+
+	assert.NoError(repo.Anonymize([]int{subject.ID}, request, recordNothing))
+	assert.NotZero(request.ID)
+
+	active, err := personRepo.FindByID(subject.ID)
+	assert.NoError(err)
+	assert.Nil(active)
+	anonymized, err := personRepo.FindByIDIncludingDeleted(subject.ID)
+	assert.NoError(err)
+	assert.True(anonymized.IsDeleted())
+	assert.True(anonymized.IsAnonymized())
+	assert.Equal(personModel.AnonymizedName, anonymized.Name)
+	assert.Equal(personModel.AnonymizedCPF(subject.ID), anonymized.CPF)
+	assert.Equal(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), anonymized.BirthDate)
+	assert.Equal(subject.Version+1, anonymized.Version)
+	assert.Empty(anonymized.Email())
+	assert.Empty(anonymized.Phone())
+
+	var contacts int64
+	db.Model(&ContactEntity{}).Where("person_id = ?", subject.ID).Count(&contacts)
+	assert.Zero(contacts)
+
+	documents, err := documentRepo.FindByPerson(subject.ID)
+	assert.NoError(err)
+	assert.Empty(documents)
+
+	addresses, err := addressRepo.FindByPerson(subject.ID)
+	assert.NoError(err)
+	assert.Len(addresses, 1)
+	assert.Empty(addresses[0].Street)
+	assert.Empty(addresses[0].CEP)
+	assert.NotEmpty(addresses[0].City)
+	assert.NotEmpty(addresses[0].State)
+
+	relationships, err := relationshipRepo.FindAllByPersons([]int{subject.ID})
+	assert.NoError(err)
+	assert.Len(relationships, 1)
+
+	consents, err := consentRepo.FindByPerson(subject.ID)
+	assert.NoError(err)
+	assert.Empty(consents)
+	events, err := consentRepo.FindEvents([]int{subject.ID})
+	assert.NoError(err)
+	assert.Len(events, 1, "the consent history is kept")
+	assert.Empty(events[0].EvidenceRef)
+	otherConsent, err := consentRepo.FindByPurpose(other.ID, personModel.ConsentMarketing)
+	assert.NoError(err)
+	assert.Equal("form-marketing", otherConsent.EvidenceRef)
+
+	entries, err := auditRepo.FindBySubject([]int{subject.ID})
+	assert.NoError(err)
+	assert.Len(entries, 3)
+	assert.JSONEq(`{"id":1,"person_id":2,"related_person_id":1}`, string(entries[0].After))
+	assert.JSONEq(`{"id":1,"person_id":1}`, string(entries[1].After))
+	assert.JSONEq(`{"id":1}`, string(entries[2].After))
+
+	untouched, err := personRepo.FindByID(other.ID)
+	assert.NoError(err)
+	assert.Equal("Lia Silva", untouched.Name)
+	otherHistory, _, err := auditRepo.FindByEntity(auditModel.EntityPerson, other.ID, 1, 10)
+	assert.NoError(err)
+	assert.JSONEq(`{"id":2,"name":"Lia Silva"}`, string(otherHistory[0].After))
+
+	requests, _, err := repo.FindRequests(1, 10)
+	assert.NoError(err)
+	assert.Equal([]int{subject.ID}, requests[0].PersonIDs)
+}
+
+func TestSubjectRightsRepositoryImpl_Anonymize_RecordsAfterRedaction(t *testing.T) {
+	assert := assert.New(t)
+	repo, db := setupSubjectRightsTest(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+	auditRepo := auditPersistence.NewAuditRepository(db, nil)
+
+	subject := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC), "jose@example.com")
+	actor := auditModel.Actor{OperatorID: 1}
+	request := personModel.NewSubjectRequest(personModel.SubjectRequestAnonymization, subject.CPF, []int{subject.ID}, actor)
+
+	err := repo.Anonymize([]int{subject.ID}, request, func(tx ports.Repositories) error {
+		return tx.Audits.Save(auditModel.NewAuditEntry(auditModel.EntityPerson, subject.ID, auditModel.ActionAnonymize, actor, nil, json.RawMessage(`{"id":1,"name":"Titular anonimizado"}`)))
+	})
+
+	assert.NoError(err)
+	entries, _, err := auditRepo.FindByEntity(auditModel.EntityPerson, subject.ID, 1, 10)
+	assert.NoError(err)
+	assert.Len(entries, 1)
+	assert.JSONEq(`{"id":1,"name":"Titular anonimizado"}`, string(entries[0].After), "the anonymization entry is not redacted")
+}
+
+func TestSubjectRightsRepositoryImpl_Anonymize_RecordFailureRollsBack(t *testing.T) {
+	assert := assert.New(t)
+	repo, db := setupSubjectRightsTest(t)
+	personRepo := NewPersonRepository(db, nil).(*PersonRepositoryImpl)
+	auditFailure := errors.New("audit table unavailable")
+
+	subject := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC), "jose@example.com")
+	request := personModel.NewSubjectRequest(personModel.SubjectRequestAnonymization, subject.CPF, []int{subject.ID}, auditModel.Actor{OperatorID: 1})
+
+	err := repo.Anonymize([]int{subject.ID}, request, func(ports.Repositories) error {
+		return auditFailure
+	})
+
+	assert.ErrorIs(err, auditFailure)
+	unchanged, err := personRepo.FindByID(subject.ID)
+	assert.NoError(err)
+	assert.Equal("José da Silva", unchanged.Name, "the anonymization is rolled back without its audit entry")
+	requests, total, err := repo.FindRequests(1, 10)
+	assert.NoError(err)
+	assert.Zero(total)
+	assert.Empty(requests)
+}
+
+func TestSubjectRightsRepositoryImpl_Anonymize_Encrypted(t *testing.T) {
+	assert := assert.New(t)
+	_, db := setupSubjectRightsTest(t)
+	cipher := newTestCipher(t, "k1")
+	repo := NewSubjectRightsRepository(db, cipher)
+	personRepo := NewPersonRepository(db, cipher).(*PersonRepositoryImpl)
+
+	auditRepo := auditPersistence.NewAuditRepository(db, cipher)
+
+	subject := saveDuplicateSubject(t, personRepo, "José da Silva", "11144477735", time.Date(1990, time.May, 20, 0, 0, 0, 0, time.UTC), "jose@example.com")
+	actor := auditModel.Actor{OperatorID: 1}
+	assert.NoError(auditRepo.Save(auditModel.NewAuditEntry(auditModel.EntityPerson, subject.ID, auditModel.ActionCreate, actor, nil, json.RawMessage(`{"id":1,"cpf":"11144477735"}`))))
+	request := personModel.NewSubjectRequest(personModel.SubjectRequestAnonymization, subject.CPF, []int{subject.ID}, actor)
+
+	assert.NoError(repo.Anonymize([]int{subject.ID}, request, recordNothing))
+
+	anonymized, err := personRepo.FindByIDIncludingDeleted(subject.ID)
+	assert.NoError(err)
+	assert.Equal(personModel.AnonymizedCPF(subject.ID), anonymized.CPF)
+	assert.Equal(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), anonymized.BirthDate)
+	assert.Empty(anonymized.Email())
+
+	row := storedRow(t, db, subject.ID)
+	assert.Nil(row.CPF, "anonymized values stay encrypted")
+	assert.NotNil(row.CPFEncrypted)
+
+	var entry auditPersistence.AuditEntity
+	assert.NoError(db.Where("entity_type = ?", auditModel.EntityPerson).First(&entry).Error)
+	assert.Nil(entry.AfterEncrypted, "the encrypted snapshot is dropped")
+	assert.Nil(entry.KeyID)
+	entries, err := auditRepo.FindBySubject([]int{subject.ID})
+	assert.NoError(err)
+	assert.JSONEq(`{"id":1}`, string(entries[0].After))
+}
@@ -0,0 +1,46 @@
+package person
+
+import (
+	"pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/encryption"
+	auditPersistence "pessoas-api/internal/infrastructure/persistence/audit"
+
+	"gorm.io/gorm"
+)
+
+// TransactorImpl implements the ports.Transactor interface with a GORM
+// transaction shared by the repositories of the person aggregate and the
+// audit repository.
+type TransactorImpl struct {
+	db     *gorm.DB
+	cipher *encryption.Cipher
+}
+
+// NewTransactor creates a new instance of TransactorImpl. The persons, the
+// contacts and the audit snapshots are encrypted with cipher, as by their
+// repositories.
+func NewTransactor(db *gorm.DB, cipher *encryption.Cipher) ports.Transactor {
+	return &TransactorImpl{
+		db:     db,
+		cipher: cipher,
+	}
+}
+
+func (t *TransactorImpl) WithinTransaction(fn func(tx ports.Repositories) error) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		return fn(transactionRepositories(tx, t.cipher))
+	})
+}
+
+// transactionRepositories binds the repositories of the person aggregate and
+// the audit repository to the transaction tx.
+func transactionRepositories(tx *gorm.DB, cipher *encryption.Cipher) ports.Repositories {
+	return ports.Repositories{
+		Persons:       NewPersonRepository(tx, cipher),
+		Contacts:      NewContactRepository(tx, cipher),
+		Addresses:     NewAddressRepository(tx),
+		Documents:     NewDocumentRepository(tx),
+		Relationships: NewRelationshipRepository(tx),
+		Audits:        auditPersistence.NewAuditRepository(tx, cipher),
+	}
+}
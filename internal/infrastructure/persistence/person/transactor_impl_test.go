@@ -0,0 +1,81 @@
+package person
+
+import (
+	"errors"
+	"testing"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// setupTransactorTest prepares the person tables along with the audit trail.
+func setupTransactorTest(t *testing.T) *gorm.DB {
+	db := setupPeopleSchemaDB(t)
+
+	err := db.Exec(`CREATE TABLE people.audit_log (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		entity_type VARCHAR(50) NOT NULL,
+		entity_id INTEGER NOT NULL,
+		action VARCHAR(20) NOT NULL,
+		operator_id INTEGER NOT NULL,
+		request_id VARCHAR(64),
+		client_ip VARCHAR(45),
+		before_data TEXT,
+		after_data TEXT,
+		key_id VARCHAR(32),
+		data_key TEXT,
+		before_encrypted TEXT,
+		after_encrypted TEXT,
+		created_at TIMESTAMP NOT NULL
+	)`).Error
+	if err != nil {
+		t.Fatalf("failed to prepare audit table: %v", err)
+	}
+
+	return db
+}
+
+func countRows(t *testing.T, db *gorm.DB, table string) int64 {
+	var count int64
+	if err := db.Table(table).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count %s: %v", table, err)
+	}
+	return count
+}
+
+func TestTransactorImpl_WithinTransaction_Commits(t *testing.T) {
+	assert := assert.New(t)
+	db := setupTransactorTest(t)
+
+	err := NewTransactor(db, nil).WithinTransaction(func(tx ports.Repositories) error {
+		id, err := tx.Persons.Save(createValidPerson(t))
+		if err != nil {
+			return err
+		}
+		return tx.Audits.Save(audit.NewAuditEntry(audit.EntityPerson, id, audit.ActionCreate, audit.Actor{OperatorID: 7}, nil, nil))
+	})
+
+	assert.NoError(err)
+	assert.Equal(int64(1), countRows(t, db, "people.person"))
+	assert.Equal(int64(1), countRows(t, db, "people.audit_log"))
+}
+
+func TestTransactorImpl_WithinTransaction_RollsBackWithoutAudit(t *testing.T) {
+	assert := assert.New(t)
+	db := setupTransactorTest(t)
+	auditFailure := errors.New("audit table unavailable")
+
+	err := NewTransactor(db, nil).WithinTransaction(func(tx ports.Repositories) error {
+		if _, err := tx.Persons.Save(createValidPerson(t)); err != nil {
+			return err
+		}
+		return auditFailure
+	})
+
+	assert.ErrorIs(err, auditFailure)
+	assert.Equal(int64(0), countRows(t, db, "people.person"), "the person is not kept without its audit entry")
+	assert.Equal(int64(0), countRows(t, db, "people.person_contact"))
+}
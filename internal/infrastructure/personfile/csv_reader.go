@@ -0,0 +1,138 @@
+package personfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// ErrInvalidHeader is returned when a CSV file lacks one of the person columns.
+var ErrInvalidHeader = errors.New("invalid CSV header")
+
+// columnAliases maps accepted header names to the person field they hold.
+var columnAliases = map[string]string{
+	"name":         "name",
+	"cpf":          "cpf",
+	"birth_date":   "birth_date",
+	"phone":        "phone",
+	"phone_number": "phone",
+	"email":        "email",
+}
+
+var requiredColumns = []string{"name", "cpf", "birth_date", "phone", "email"}
+
+// CSVReader reads persons from a CSV file whose first line is a header naming
+// the columns. Both comma and semicolon separated files are accepted.
+type CSVReader struct {
+	source  io.Reader
+	reader  *csv.Reader
+	columns map[string]int
+	width   int
+}
+
+// NewCSVReader creates a CSVReader over the given source.
+// It returns the reader as the PersonRowReader interface.
+func NewCSVReader(source io.Reader) ports.PersonRowReader {
+	return &CSVReader{source: source}
+}
+
+func (r *CSVReader) Read() (contract.ImportRow, error) {
+	if r.reader == nil {
+		if err := r.readHeader(); err != nil {
+			return contract.ImportRow{}, err
+		}
+	}
+
+	record, err := r.reader.Read()
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return contract.ImportRow{Line: parseErr.StartLine, Err: fmt.Errorf("%w: %v", personError.ErrMalformedRow, parseErr.Err)}, nil
+	}
+	if err != nil {
+		return contract.ImportRow{}, err
+	}
+
+	line, _ := r.reader.FieldPos(0)
+	row := contract.ImportRow{Line: line}
+
+	if len(record) < r.width {
+		row.Err = fmt.Errorf("%w: expected %d columns, found %d", personError.ErrMalformedRow, r.width, len(record))
+		return row, nil
+	}
+
+	birthDate, err := parseBirthDate(r.field(record, "birth_date"))
+	if err != nil {
+		row.Err = err
+		return row, nil
+	}
+
+	row.Person = contract.NewPersonDTO{
+		Name:        r.field(record, "name"),
+		CPF:         r.field(record, "cpf"),
+		BirthDate:   birthDate,
+		PhoneNumber: r.field(record, "phone"),
+		Email:       r.field(record, "email"),
+	}
+
+	return row, nil
+}
+
+func (r *CSVReader) readHeader() error {
+	buffered := bufio.NewReader(r.source)
+
+	firstLine, err := buffered.Peek(4096)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return err
+	}
+	if i := bytes.IndexByte(firstLine, '\n'); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+
+	r.reader = csv.NewReader(buffered)
+	r.reader.FieldsPerRecord = -1
+	r.reader.TrimLeadingSpace = true
+	r.reader.ReuseRecord = true
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		r.reader.Comma = ';'
+	}
+
+	header, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: file is empty", ErrInvalidHeader)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+
+	r.columns = make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if field, known := columnAliases[name]; known {
+			r.columns[field] = i
+			if i+1 > r.width {
+				r.width = i + 1
+			}
+		}
+	}
+
+	for _, column := range requiredColumns {
+		if _, found := r.columns[column]; !found {
+			return fmt.Errorf("%w: missing column %q", ErrInvalidHeader, column)
+		}
+	}
+
+	return nil
+}
+
+func (r *CSVReader) field(record []string, column string) string {
+	return strings.TrimSpace(record[r.columns[column]])
+}
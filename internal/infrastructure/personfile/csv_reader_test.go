@@ -0,0 +1,103 @@
+package personfile
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readAll drains the reader and returns every row it produced.
+func readAll(t *testing.T, reader ports.PersonRowReader) []contract.ImportRow {
+	var rows []contract.ImportRow
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows
+		}
+		if err != nil {
+			t.Fatalf("unexpected read error: %v", err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func TestCSVReader_ReadsRows(t *testing.T) {
+	assert := assert.New(t)
+	file := "\ufeffName,CPF,Birth_Date,Phone_Number,Email\n" +
+		"João da Silva,111.444.777-35,1990-01-15,81912345678,joao@example.com\n" +
+		"\n" +
+		"\"Souza, Maria\",22233344405,01/07/1985,81998765432,maria@example.com\n"
+
+	rows := readAll(t, NewCSVReader(strings.NewReader(file)))
+
+	assert.Len(rows, 2)
+	assert.Equal(2, rows[0].Line)
+	assert.NoError(rows[0].Err)
+	assert.Equal(contract.NewPersonDTO{
+		Name:        "João da Silva",
+		CPF:         "111.444.777-35",
+		BirthDate:   time.Date(1990, time.January, 15, 0, 0, 0, 0, time.UTC),
+		PhoneNumber: "81912345678",
+		Email:       "joao@example.com",
+	}, rows[0].Person)
+	assert.Equal(4, rows[1].Line)
+	assert.Equal("Souza, Maria", rows[1].Person.Name)
+	assert.Equal(time.Date(1985, time.July, 1, 0, 0, 0, 0, time.UTC), rows[1].Person.BirthDate)
+}
+
+func TestCSVReader_SemicolonSeparatedWithReorderedColumns(t *testing.T) {
+	assert := assert.New(t)
+	file := "email;name;cpf;phone;birth_date;notes\n" +
+		"ana@example.com;Ana;52998224725;11987654321;1999-12-31;cliente antiga\n"
+
+	rows := readAll(t, NewCSVReader(strings.NewReader(file)))
+
+	assert.Len(rows, 1)
+	assert.Equal("Ana", rows[0].Person.Name)
+	assert.Equal("52998224725", rows[0].Person.CPF)
+	assert.Equal("ana@example.com", rows[0].Person.Email)
+}
+
+func TestCSVReader_RowErrors(t *testing.T) {
+	assert := assert.New(t)
+	file := "name,cpf,birth_date,phone,email\n" +
+		"Ana,52998224725\n" +
+		"Bruno,22233344405,31-12-1999,81998765432,bruno@example.com\n" +
+		"Carla,\"39053344705,1990-01-01,81912345678,carla@example.com\n"
+
+	rows := readAll(t, NewCSVReader(strings.NewReader(file)))
+
+	assert.Len(rows, 3)
+	assert.ErrorIs(rows[0].Err, personError.ErrMalformedRow)
+	assert.Equal(2, rows[0].Line)
+	assert.ErrorIs(rows[1].Err, personError.ErrBirthDateInvalid)
+	assert.Equal(3, rows[1].Line)
+	assert.ErrorIs(rows[2].Err, personError.ErrMalformedRow)
+	assert.Equal(4, rows[2].Line)
+}
+
+func TestCSVReader_InvalidHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{"empty file", ""},
+		{"missing column", "name,cpf,birth_date,phone\nAna,52998224725,1999-12-31,11987654321\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCSVReader(strings.NewReader(tt.file)).Read()
+
+			assert.ErrorIs(t, err, ErrInvalidHeader)
+		})
+	}
+}
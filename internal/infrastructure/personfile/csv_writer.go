@@ -0,0 +1,50 @@
+package personfile
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// CSVWriter writes persons as comma separated values with a header line.
+type CSVWriter struct {
+	writer *csv.Writer
+	record []string
+}
+
+// NewCSVWriter creates a CSVWriter over the given destination.
+// It returns the writer as the PersonRowWriter interface.
+func NewCSVWriter(destination io.Writer) ports.PersonRowWriter {
+	return &CSVWriter{writer: csv.NewWriter(destination)}
+}
+
+func (w *CSVWriter) WriteHeader(columns []string) error {
+	w.record = make([]string, len(columns))
+	return w.writer.Write(columns)
+}
+
+func (w *CSVWriter) WriteRow(values []any) error {
+	for i, value := range values {
+		w.record[i] = formatValue(value)
+	}
+	return w.writer.Write(w.record)
+}
+
+func (w *CSVWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// formatValue renders an exported value as text; nil becomes an empty string.
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,27 @@
+package personfile
+
+import (
+	"fmt"
+	"time"
+
+	personError "pessoas-api/internal/domain/person/error"
+)
+
+// birthDateLayouts are the accepted birth date formats: ISO, Brazilian and RFC 3339.
+var birthDateLayouts = []string{"2006-01-02", "02/01/2006", time.RFC3339}
+
+// parseBirthDate parses a birth date in any accepted layout. An empty value
+// yields the zero time, which the domain rejects as an invalid birth date.
+func parseBirthDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range birthDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: birth date %q must be YYYY-MM-DD or DD/MM/YYYY", personError.ErrBirthDateInvalid, value)
+}
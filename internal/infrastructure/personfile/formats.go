@@ -0,0 +1,33 @@
+package personfile
+
+import (
+	"io"
+
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// Content types of the supported file formats.
+const (
+	CSVContentType    = "text/csv; charset=utf-8"
+	NDJSONContentType = "application/x-ndjson"
+	XLSXContentType   = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// ExportFormat describes how persons are exported in a given format.
+type ExportFormat struct {
+	ContentType string
+	NewWriter   func(io.Writer) ports.PersonRowWriter
+}
+
+// ExportFormats are the export formats by name, which is also the file extension.
+var ExportFormats = map[string]ExportFormat{
+	"csv":    {CSVContentType, NewCSVWriter},
+	"ndjson": {NDJSONContentType, NewNDJSONWriter},
+	"xlsx":   {XLSXContentType, NewXLSXWriter},
+}
+
+// ImportFormats are the readers of the import formats by name.
+var ImportFormats = map[string]func(io.Reader) ports.PersonRowReader{
+	"csv":    NewCSVReader,
+	"ndjson": NewNDJSONReader,
+}
@@ -0,0 +1,82 @@
+package personfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	contract "pessoas-api/internal/contract/person"
+	personError "pessoas-api/internal/domain/person/error"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// maxNDJSONLine bounds the size of a single NDJSON line.
+const maxNDJSONLine = 1 << 20
+
+// ndjsonPerson is one NDJSON line; it uses the same field names as the JSON API.
+type ndjsonPerson struct {
+	Name        string `json:"name"`
+	CPF         string `json:"cpf"`
+	BirthDate   string `json:"birth_date"`
+	PhoneNumber string `json:"phone"`
+	Email       string `json:"email"`
+}
+
+// NDJSONReader reads persons from newline delimited JSON, one object per line.
+// Blank lines are skipped.
+type NDJSONReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewNDJSONReader creates an NDJSONReader over the given source.
+// It returns the reader as the PersonRowReader interface.
+func NewNDJSONReader(source io.Reader) ports.PersonRowReader {
+	scanner := bufio.NewScanner(source)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLine)
+
+	return &NDJSONReader{scanner: scanner}
+}
+
+func (r *NDJSONReader) Read() (contract.ImportRow, error) {
+	for r.scanner.Scan() {
+		r.line++
+
+		data := bytes.TrimSpace(r.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		row := contract.ImportRow{Line: r.line}
+
+		var decoded ndjsonPerson
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			row.Err = fmt.Errorf("%w: %v", personError.ErrMalformedRow, err)
+			return row, nil
+		}
+
+		birthDate, err := parseBirthDate(decoded.BirthDate)
+		if err != nil {
+			row.Err = err
+			return row, nil
+		}
+
+		row.Person = contract.NewPersonDTO{
+			Name:        decoded.Name,
+			CPF:         decoded.CPF,
+			BirthDate:   birthDate,
+			PhoneNumber: decoded.PhoneNumber,
+			Email:       decoded.Email,
+		}
+
+		return row, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return contract.ImportRow{}, err
+	}
+
+	return contract.ImportRow{}, io.EOF
+}
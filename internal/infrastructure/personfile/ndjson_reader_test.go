@@ -0,0 +1,51 @@
+package personfile
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"time"
+
+	personError "pessoas-api/internal/domain/person/error"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNDJSONReader_ReadsRows(t *testing.T) {
+	assert := assert.New(t)
+	file := `{"name":"Ana","cpf":"52998224725","birth_date":"1999-12-31","phone":"11987654321","email":"ana@example.com"}` + "\n" +
+		"\n" +
+		`{"name":"Bruno","cpf":"22233344405","birth_date":"1985-07-01T00:00:00Z","phone":"81998765432","email":"bruno@example.com"}`
+
+	rows := readAll(t, NewNDJSONReader(strings.NewReader(file)))
+
+	assert.Len(rows, 2)
+	assert.Equal(1, rows[0].Line)
+	assert.Equal("Ana", rows[0].Person.Name)
+	assert.Equal(time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC), rows[0].Person.BirthDate)
+	assert.Equal(3, rows[1].Line)
+	assert.Equal("81998765432", rows[1].Person.PhoneNumber)
+}
+
+func TestNDJSONReader_RowErrors(t *testing.T) {
+	assert := assert.New(t)
+	file := `{"name":"Ana",` + "\n" +
+		`{"name":"Bruno","birth_date":"ontem"}` + "\n" +
+		`{"name":"Carla","cpf":"39053344705"}` + "\n"
+
+	rows := readAll(t, NewNDJSONReader(strings.NewReader(file)))
+
+	assert.Len(rows, 3)
+	assert.ErrorIs(rows[0].Err, personError.ErrMalformedRow)
+	assert.ErrorIs(rows[1].Err, personError.ErrBirthDateInvalid)
+	assert.NoError(rows[2].Err)
+	assert.True(rows[2].Person.BirthDate.IsZero())
+}
+
+func TestNDJSONReader_LineTooLong(t *testing.T) {
+	file := `{"name":"` + strings.Repeat("a", maxNDJSONLine) + `"}`
+
+	_, err := NewNDJSONReader(strings.NewReader(file)).Read()
+
+	assert.ErrorIs(t, err, bufio.ErrTooLong)
+}
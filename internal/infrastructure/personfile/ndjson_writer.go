@@ -0,0 +1,64 @@
+package personfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// NDJSONWriter writes persons as newline delimited JSON, one object per line,
+// with the keys in column order.
+type NDJSONWriter struct {
+	writer  *bufio.Writer
+	keys    [][]byte
+	value   bytes.Buffer
+	encoder *json.Encoder
+}
+
+// NewNDJSONWriter creates an NDJSONWriter over the given destination.
+// It returns the writer as the PersonRowWriter interface.
+func NewNDJSONWriter(destination io.Writer) ports.PersonRowWriter {
+	w := &NDJSONWriter{writer: bufio.NewWriter(destination)}
+	w.encoder = json.NewEncoder(&w.value)
+	w.encoder.SetEscapeHTML(false)
+	return w
+}
+
+func (w *NDJSONWriter) WriteHeader(columns []string) error {
+	w.keys = make([][]byte, len(columns))
+	for i, column := range columns {
+		key, err := json.Marshal(column)
+		if err != nil {
+			return err
+		}
+		w.keys[i] = key
+	}
+	return nil
+}
+
+func (w *NDJSONWriter) WriteRow(values []any) error {
+	w.writer.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			w.writer.WriteByte(',')
+		}
+
+		w.value.Reset()
+		if err := w.encoder.Encode(value); err != nil {
+			return err
+		}
+
+		w.writer.Write(w.keys[i])
+		w.writer.WriteByte(':')
+		w.writer.Write(bytes.TrimSuffix(w.value.Bytes(), []byte("\n")))
+	}
+	_, err := w.writer.WriteString("}\n")
+	return err
+}
+
+func (w *NDJSONWriter) Close() error {
+	return w.writer.Flush()
+}
@@ -0,0 +1,105 @@
+package personfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"pessoas-api/internal/domain/person/ports"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	exportColumns = []string{"id", "name", "deleted_at"}
+	exportRows    = [][]any{
+		{1, "Ana", nil},
+		{2, `Souza, "Maria" <m&s>`, "2024-05-02T13:04:05Z"},
+	}
+)
+
+// writeAll exports the test rows through writer.
+func writeAll(t *testing.T, writer ports.PersonRowWriter) {
+	if err := writer.WriteHeader(exportColumns); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+	for _, row := range exportRows {
+		if err := writer.WriteRow(row); err != nil {
+			t.Fatalf("failed to write row: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("failed to close writer: %v", err)
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var output bytes.Buffer
+
+	writeAll(t, NewCSVWriter(&output))
+
+	assert.Equal(t, "id,name,deleted_at\n"+
+		"1,Ana,\n"+
+		"2,\"Souza, \"\"Maria\"\" <m&s>\",2024-05-02T13:04:05Z\n", output.String())
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	var output bytes.Buffer
+
+	writeAll(t, NewNDJSONWriter(&output))
+
+	assert.Equal(t, `{"id":1,"name":"Ana","deleted_at":null}`+"\n"+
+		`{"id":2,"name":"Souza, \"Maria\" <m&s>","deleted_at":"2024-05-02T13:04:05Z"}`+"\n", output.String())
+}
+
+func TestCSVWriter_OutputCanBeImported(t *testing.T) {
+	var output bytes.Buffer
+	writer := NewCSVWriter(&output)
+	writer.WriteHeader([]string{"name", "cpf", "birth_date", "phone", "email"})
+	writer.WriteRow([]any{"Ana", "529.982.247-25", "1999-12-31", "(11) 98765-4321", "ana@example.com"})
+	writer.Close()
+
+	rows := readAll(t, NewCSVReader(&output))
+
+	assert.Len(t, rows, 1)
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, "529.982.247-25", rows[0].Person.CPF)
+}
+
+func TestXLSXWriter(t *testing.T) {
+	assert := assert.New(t)
+	var output bytes.Buffer
+
+	writeAll(t, NewXLSXWriter(&output))
+
+	archive, err := zip.NewReader(bytes.NewReader(output.Bytes()), int64(output.Len()))
+	assert.NoError(err)
+
+	parts := make(map[string]string)
+	for _, file := range archive.File {
+		content, err := file.Open()
+		assert.NoError(err)
+		data, _ := io.ReadAll(content)
+		parts[file.Name] = string(data)
+
+		decoder := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			if _, err := decoder.Token(); err != nil {
+				assert.ErrorIs(err, io.EOF, "%s is not well-formed", file.Name)
+				break
+			}
+		}
+	}
+
+	assert.Contains(parts, "[Content_Types].xml")
+	assert.Contains(parts, "xl/workbook.xml")
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Equal(3, strings.Count(sheet, "<row>"))
+	assert.Contains(sheet, `<c><v>2</v></c>`)
+	assert.Contains(sheet, `Souza, &#34;Maria&#34; &lt;m&amp;s&gt;`)
+	assert.Contains(sheet, `<c/>`)
+}
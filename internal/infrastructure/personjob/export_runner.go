@@ -0,0 +1,126 @@
+package personjob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	jobError "pessoas-api/internal/domain/job/error"
+	job "pessoas-api/internal/domain/job/model"
+	jobPorts "pessoas-api/internal/domain/job/ports"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	personPorts "pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/personfile"
+)
+
+// TypeExport is the job type of person exports.
+const TypeExport = "person_export"
+
+// ExportPayload holds the parameters of an export job, the same ones accepted by GET /persons/export.
+type ExportPayload struct {
+	Format  string              `json:"format"`
+	Sort    string              `json:"sort"`
+	Order   string              `json:"order"`
+	Filter  person.PersonFilter `json:"filter"`
+	Columns []string            `json:"columns,omitempty"`
+	Masked  bool                `json:"masked"`
+}
+
+// ExportRunner runs export jobs, writing the file to the artifact store.
+type ExportRunner struct {
+	service   personPorts.PersonService
+	artifacts jobPorts.ArtifactStore
+}
+
+// NewExportRunner creates a new instance of ExportRunner.
+// It returns the runner as the Runner interface.
+func NewExportRunner(service personPorts.PersonService, artifacts jobPorts.ArtifactStore) jobPorts.Runner {
+	return &ExportRunner{
+		service:   service,
+		artifacts: artifacts,
+	}
+}
+
+func (r *ExportRunner) Type() string {
+	return TypeExport
+}
+
+func (r *ExportRunner) Run(ctx context.Context, j *job.Job, progress func(int)) (*job.Artifact, error) {
+	var payload ExportPayload
+	if err := json.Unmarshal(j.Payload, &payload); err != nil {
+		return nil, jobError.Permanent(fmt.Errorf("invalid export payload: %w", err))
+	}
+
+	format, known := personfile.ExportFormats[payload.Format]
+	if !known {
+		return nil, jobError.Permanent(fmt.Errorf("unsupported export format %q", payload.Format))
+	}
+
+	total, err := r.service.CountPersons(payload.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, file, err := r.artifacts.Create(payload.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &progressWriter{
+		PersonRowWriter: format.NewWriter(file),
+		ctx:             ctx,
+		total:           total,
+		progress:        progress,
+	}
+	options := person.ExportOptions{Columns: payload.Columns, Masked: payload.Masked}
+
+	_, err = r.service.ExportPersons(writer, payload.Sort, payload.Order, payload.Filter, options)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if deleteErr := r.artifacts.Delete(ref); deleteErr != nil {
+			log.Printf("[ERROR] ExportRunner - Failed to delete partial export %s: %v", ref, deleteErr)
+		}
+		if errors.Is(err, personError.ErrUnknownColumn) {
+			return nil, jobError.Permanent(err)
+		}
+		return nil, err
+	}
+
+	return &job.Artifact{
+		Ref:         ref,
+		Name:        fmt.Sprintf("persons-%d.%s", j.ID, payload.Format),
+		ContentType: format.ContentType,
+	}, nil
+}
+
+// progressWriter reports the share of persons written so far and stops the
+// export once the job is cancelled.
+type progressWriter struct {
+	personPorts.PersonRowWriter
+	ctx      context.Context
+	total    int64
+	written  int64
+	progress func(int)
+}
+
+func (w *progressWriter) WriteRow(values []any) error {
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := w.PersonRowWriter.WriteRow(values); err != nil {
+		return err
+	}
+
+	w.written++
+	if w.total > 0 {
+		w.progress(int(min(w.written*100/w.total, 99)))
+	}
+
+	return nil
+}
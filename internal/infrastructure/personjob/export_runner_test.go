@@ -0,0 +1,164 @@
+package personjob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	jobError "pessoas-api/internal/domain/job/error"
+	job "pessoas-api/internal/domain/job/model"
+	jobPorts "pessoas-api/internal/domain/job/ports"
+	personError "pessoas-api/internal/domain/person/error"
+	person "pessoas-api/internal/domain/person/model"
+	personPorts "pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/artifact"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+	"pessoas-api/internal/infrastructure/personfile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newTestJob builds a job of the given type with its payload encoded.
+func newTestJob(t *testing.T, jobType string, payload any) *job.Job {
+	j, err := job.NewJob(jobType, payload, audit.Actor{OperatorID: 7, RequestID: "req-123"})
+	if err != nil {
+		t.Fatalf("failed to create job: %v", err)
+	}
+	j.ID = 42
+	j.Attempts = 1
+	return j
+}
+
+// newTestStore creates an artifact store in a temporary directory.
+func newTestStore(t *testing.T) (jobPorts.ArtifactStore, string) {
+	dir := t.TempDir()
+	store, err := artifact.NewFilesystemStore(dir)
+	if err != nil {
+		t.Fatalf("failed to create artifact store: %v", err)
+	}
+	return store, dir
+}
+
+// readArtifact returns the content of a stored artifact.
+func readArtifact(t *testing.T, store jobPorts.ArtifactStore, ref string) string {
+	file, _, err := store.Open(ref)
+	if err != nil {
+		t.Fatalf("failed to open artifact: %v", err)
+	}
+	defer file.Close()
+
+	content, _ := io.ReadAll(file)
+	return string(content)
+}
+
+// writeRows makes the mocked ExportPersons write the given rows through the writer it receives.
+func writeRows(rows ...[]any) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		writer := args.Get(0).(personPorts.PersonRowWriter)
+		writer.WriteHeader([]string{"id", "name"})
+		for _, row := range rows {
+			if err := writer.WriteRow(row); err != nil {
+				return
+			}
+		}
+		writer.Close()
+	}
+}
+
+func TestExportRunner_Run(t *testing.T) {
+	assert := assert.New(t)
+	service := new(mocks.MockPersonService)
+	store, _ := newTestStore(t)
+	filter := person.PersonFilter{Name: "silva"}
+	payload := ExportPayload{Format: "csv", Sort: "name", Order: "desc", Filter: filter, Columns: []string{"id", "name"}, Masked: true}
+
+	service.On("CountPersons", filter).Return(int64(2), nil)
+	service.On("ExportPersons", mock.Anything, "name", "desc", filter, person.ExportOptions{Columns: []string{"id", "name"}, Masked: true}).
+		Run(writeRows([]any{1, "Ana Silva"}, []any{2, "Bruno Silva"})).
+		Return(2, nil)
+
+	var reported []int
+	result, err := NewExportRunner(service, store).Run(context.Background(), newTestJob(t, TypeExport, payload), func(percent int) {
+		reported = append(reported, percent)
+	})
+
+	assert.NoError(err)
+	assert.Equal("persons-42.csv", result.Name)
+	assert.Equal(personfile.CSVContentType, result.ContentType)
+	assert.Equal("id,name\n1,Ana Silva\n2,Bruno Silva\n", readArtifact(t, store, result.Ref))
+	assert.Equal([]int{50, 99}, reported)
+}
+
+func TestExportRunner_Run_ShouldFailPermanently_WhenPayloadIsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload any
+	}{
+		{"unknown format", ExportPayload{Format: "pdf"}},
+		{"malformed payload", []string{"csv"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, _ := newTestStore(t)
+
+			_, err := NewExportRunner(new(mocks.MockPersonService), store).Run(context.Background(), newTestJob(t, TypeExport, tt.payload), func(int) {})
+
+			var permanent *jobError.PermanentError
+			assert.ErrorAs(t, err, &permanent)
+		})
+	}
+}
+
+func TestExportRunner_Run_ShouldDeletePartialFile_WhenExportFails(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		permanent bool
+	}{
+		{"database error", errors.New("connection reset"), false},
+		{"unknown column", personError.ErrUnknownColumn, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			service := new(mocks.MockPersonService)
+			store, dir := newTestStore(t)
+			service.On("CountPersons", mock.Anything).Return(int64(1), nil)
+			service.On("ExportPersons", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, tt.err)
+
+			_, err := NewExportRunner(service, store).Run(context.Background(), newTestJob(t, TypeExport, ExportPayload{Format: "ndjson"}), func(int) {})
+
+			assert.ErrorIs(err, tt.err)
+			var permanent *jobError.PermanentError
+			assert.Equal(tt.permanent, errors.As(err, &permanent))
+			entries, _ := os.ReadDir(dir)
+			assert.Empty(entries)
+		})
+	}
+}
+
+func TestExportRunner_Run_ShouldStop_WhenJobIsCancelled(t *testing.T) {
+	service := new(mocks.MockPersonService)
+	store, _ := newTestStore(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var writeErr error
+	service.On("CountPersons", mock.Anything).Return(int64(1), nil)
+	service.On("ExportPersons", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			writeErr = args.Get(0).(personPorts.PersonRowWriter).WriteRow([]any{1, "Ana"})
+		}).
+		Return(0, context.Canceled)
+
+	_, err := NewExportRunner(service, store).Run(ctx, newTestJob(t, TypeExport, ExportPayload{Format: "csv"}), func(int) {})
+
+	assert.ErrorIs(t, writeErr, context.Canceled)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,124 @@
+package personjob
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	contract "pessoas-api/internal/contract/person"
+	jobError "pessoas-api/internal/domain/job/error"
+	job "pessoas-api/internal/domain/job/model"
+	jobPorts "pessoas-api/internal/domain/job/ports"
+	personPorts "pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/personfile"
+)
+
+// TypeImport is the job type of person imports.
+const TypeImport = "person_import"
+
+// ImportPayload holds the parameters of an import job. The uploaded file is
+// kept in the artifact store under InputRef until the import succeeds.
+type ImportPayload struct {
+	InputRef string `json:"input_ref"`
+	Format   string `json:"format"`
+	DryRun   bool   `json:"dry_run"`
+}
+
+// ImportRunner runs import jobs. The import report becomes the job result.
+type ImportRunner struct {
+	service   personPorts.PersonService
+	artifacts jobPorts.ArtifactStore
+}
+
+// NewImportRunner creates a new instance of ImportRunner.
+// It returns the runner as the Runner interface.
+func NewImportRunner(service personPorts.PersonService, artifacts jobPorts.ArtifactStore) jobPorts.Runner {
+	return &ImportRunner{
+		service:   service,
+		artifacts: artifacts,
+	}
+}
+
+func (r *ImportRunner) Type() string {
+	return TypeImport
+}
+
+func (r *ImportRunner) Run(ctx context.Context, j *job.Job, progress func(int)) (*job.Artifact, error) {
+	var payload ImportPayload
+	if err := json.Unmarshal(j.Payload, &payload); err != nil {
+		return nil, jobError.Permanent(fmt.Errorf("invalid import payload: %w", err))
+	}
+
+	newReader, known := personfile.ImportFormats[payload.Format]
+	if !known {
+		return nil, jobError.Permanent(fmt.Errorf("unsupported import format %q", payload.Format))
+	}
+
+	input, size, err := r.artifacts.Open(payload.InputRef)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	source := &progressReader{reader: input, ctx: ctx, size: size, progress: progress}
+
+	report, err := r.service.ImportPersons(newReader(source), payload.DryRun, j.Actor())
+	if err != nil {
+		if errors.Is(err, personfile.ErrInvalidHeader) || errors.Is(err, bufio.ErrTooLong) {
+			return nil, jobError.Permanent(err)
+		}
+		return nil, err
+	}
+
+	ref, file, err := r.artifacts.Create("json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.NewEncoder(file).Encode(contract.NewImportReportDTO(report))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		r.artifacts.Delete(ref)
+		return nil, err
+	}
+
+	if err := r.artifacts.Delete(payload.InputRef); err != nil {
+		log.Printf("[ERROR] ImportRunner - Failed to delete import file %s: %v", payload.InputRef, err)
+	}
+
+	return &job.Artifact{
+		Ref:         ref,
+		Name:        fmt.Sprintf("import-report-%d.json", j.ID),
+		ContentType: "application/json",
+	}, nil
+}
+
+// progressReader reports the share of the file read so far and stops the
+// import once the job is cancelled.
+type progressReader struct {
+	reader   io.Reader
+	ctx      context.Context
+	size     int64
+	read     int64
+	progress func(int)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.size > 0 {
+		r.progress(int(min(r.read*100/r.size, 99)))
+	}
+
+	return n, err
+}
@@ -0,0 +1,136 @@
+package personjob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	audit "pessoas-api/internal/domain/audit/model"
+	jobError "pessoas-api/internal/domain/job/error"
+	jobPorts "pessoas-api/internal/domain/job/ports"
+	person "pessoas-api/internal/domain/person/model"
+	personPorts "pessoas-api/internal/domain/person/ports"
+	"pessoas-api/internal/infrastructure/http/handler/mocks"
+	"pessoas-api/internal/infrastructure/personfile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const importFile = "name,cpf,birth_date,phone,email\n" +
+	"Ana,52998224725,1999-12-31,11987654321,ana@example.com\n" +
+	"Bruno,22233344405,1985-07-01,81998765432,bruno@example.com\n"
+
+// storeInput saves an uploaded import file in the artifact store.
+func storeInput(t *testing.T, store jobPorts.ArtifactStore, content string) string {
+	ref, file, err := store.Create("csv")
+	if err != nil {
+		t.Fatalf("failed to create artifact: %v", err)
+	}
+	io.WriteString(file, content)
+	file.Close()
+	return ref
+}
+
+// drainRows makes the mocked ImportPersons read every row of the file it receives.
+func drainRows(args mock.Arguments) {
+	reader := args.Get(0).(personPorts.PersonRowReader)
+	for {
+		if _, err := reader.Read(); err != nil {
+			return
+		}
+	}
+}
+
+func TestImportRunner_Run(t *testing.T) {
+	assert := assert.New(t)
+	service := new(mocks.MockPersonService)
+	store, _ := newTestStore(t)
+	input := storeInput(t, store, importFile)
+
+	report := &person.ImportReport{TotalRows: 2}
+	report.Succeed(2, person.ImportStatusImported, 10)
+	report.Fail(3, "cpf_already_registered", "CPF already registered")
+	service.On("ImportPersons", mock.Anything, false, audit.Actor{OperatorID: 7, RequestID: "req-123"}).
+		Run(drainRows).
+		Return(report, nil)
+
+	var last int
+	result, err := NewImportRunner(service, store).Run(context.Background(), newTestJob(t, TypeImport, ImportPayload{InputRef: input, Format: "csv"}), func(percent int) {
+		last = percent
+	})
+
+	assert.NoError(err)
+	assert.Equal("import-report-42.json", result.Name)
+	assert.Equal("application/json", result.ContentType)
+	assert.JSONEq(`{
+		"dry_run": false, "total_rows": 2, "succeeded": 1, "failed": 1,
+		"rows": [
+			{"line": 2, "status": "imported", "id": 10},
+			{"line": 3, "status": "failed", "error_code": "cpf_already_registered", "message": "CPF already registered"}
+		]
+	}`, readArtifact(t, store, result.Ref))
+	assert.Equal(99, last)
+
+	_, _, err = store.Open(input)
+	assert.Error(err, "the uploaded file is deleted once the import succeeds")
+}
+
+func TestImportRunner_Run_ShouldKeepInput_WhenImportFails(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		permanent bool
+	}{
+		{"database error", errors.New("connection reset"), false},
+		{"invalid header", personfile.ErrInvalidHeader, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			service := new(mocks.MockPersonService)
+			store, _ := newTestStore(t)
+			input := storeInput(t, store, importFile)
+			service.On("ImportPersons", mock.Anything, true, mock.Anything).Return(nil, tt.err)
+
+			_, err := NewImportRunner(service, store).Run(context.Background(), newTestJob(t, TypeImport, ImportPayload{InputRef: input, Format: "csv", DryRun: true}), func(int) {})
+
+			assert.ErrorIs(err, tt.err)
+			var permanent *jobError.PermanentError
+			assert.Equal(tt.permanent, errors.As(err, &permanent))
+			assert.Equal(importFile, readArtifact(t, store, input), "a retry needs the uploaded file")
+		})
+	}
+}
+
+func TestImportRunner_Run_ShouldStop_WhenJobIsCancelled(t *testing.T) {
+	service := new(mocks.MockPersonService)
+	store, _ := newTestStore(t)
+	input := storeInput(t, store, importFile)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var readErr error
+	service.On("ImportPersons", mock.Anything, false, mock.Anything).
+		Run(func(args mock.Arguments) {
+			_, readErr = args.Get(0).(personPorts.PersonRowReader).Read()
+		}).
+		Return(nil, context.Canceled)
+
+	_, err := NewImportRunner(service, store).Run(ctx, newTestJob(t, TypeImport, ImportPayload{InputRef: input, Format: "csv"}), func(int) {})
+
+	assert.True(t, strings.Contains(readErr.Error(), context.Canceled.Error()))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestImportRunner_Run_ShouldFailPermanently_WhenFormatIsUnknown(t *testing.T) {
+	store, _ := newTestStore(t)
+
+	_, err := NewImportRunner(new(mocks.MockPersonService), store).Run(context.Background(), newTestJob(t, TypeImport, ImportPayload{Format: "xml"}), func(int) {})
+
+	var permanent *jobError.PermanentError
+	assert.ErrorAs(t, err, &permanent)
+}
@@ -0,0 +1,104 @@
+package postalcode
+
+import (
+	"sync"
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// Default cache settings. Unknown CEPs are kept for a shorter time, since the
+// postal service keeps creating new ones.
+const (
+	DefaultCacheTTL         = 24 * time.Hour
+	DefaultNotFoundCacheTTL = time.Hour
+	DefaultCacheSize        = 10000
+)
+
+// CachingProvider keeps the results of another provider in memory, including
+// CEPs that were not found. Failed lookups are not cached.
+type CachingProvider struct {
+	next        ports.PostalCodeProvider
+	ttl         time.Duration
+	notFoundTTL time.Duration
+	maxEntries  int
+	now         func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	postalCode *person.PostalCode
+	expiresAt  time.Time
+}
+
+// NewCachingProvider wraps next with a cache of the default size and lifetimes.
+// It returns the provider as the PostalCodeProvider interface.
+func NewCachingProvider(next ports.PostalCodeProvider) ports.PostalCodeProvider {
+	return &CachingProvider{
+		next:        next,
+		ttl:         DefaultCacheTTL,
+		notFoundTTL: DefaultNotFoundCacheTTL,
+		maxEntries:  DefaultCacheSize,
+		now:         time.Now,
+		entries:     make(map[string]cacheEntry),
+	}
+}
+
+func (p *CachingProvider) Lookup(cep string) (*person.PostalCode, error) {
+	if postalCode, found := p.cached(cep); found {
+		return postalCode, nil
+	}
+
+	postalCode, err := p.next.Lookup(cep)
+	if err != nil {
+		return nil, err
+	}
+
+	p.store(cep, postalCode)
+
+	return postalCode, nil
+}
+
+func (p *CachingProvider) cached(cep string) (*person.PostalCode, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	entry, found := p.entries[cep]
+	if !found || !p.now().Before(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.postalCode, true
+}
+
+func (p *CachingProvider) store(cep string, postalCode *person.PostalCode) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+
+	if len(p.entries) >= p.maxEntries {
+		for key, entry := range p.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(p.entries, key)
+			}
+		}
+	}
+	// Still full: make room by dropping any entry
+	for key := range p.entries {
+		if len(p.entries) < p.maxEntries {
+			break
+		}
+		delete(p.entries, key)
+	}
+
+	ttl := p.ttl
+	if postalCode == nil {
+		ttl = p.notFoundTTL
+	}
+
+	p.entries[cep] = cacheEntry{postalCode: postalCode, expiresAt: now.Add(ttl)}
+}
@@ -0,0 +1,95 @@
+package postalcode
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type providerMock struct {
+	mock.Mock
+}
+
+func (p *providerMock) Lookup(cep string) (*person.PostalCode, error) {
+	args := p.Called(cep)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*person.PostalCode), args.Error(1)
+}
+
+// newTestCache wraps next in a cache whose clock is controlled by the test.
+func newTestCache(next *providerMock, maxEntries int) (*CachingProvider, *time.Time) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewCachingProvider(next).(*CachingProvider)
+	cache.maxEntries = maxEntries
+	cache.now = func() time.Time { return now }
+	return cache, &now
+}
+
+func TestCachingProvider_ShouldCacheFoundPostalCode(t *testing.T) {
+	assert := assert.New(t)
+	next := new(providerMock)
+	next.On("Lookup", "50030230").Return(&person.PostalCode{CEP: "50030230", City: "Recife"}, nil)
+	cache, now := newTestCache(next, 10)
+
+	first, _ := cache.Lookup("50030230")
+	second, _ := cache.Lookup("50030230")
+
+	assert.Equal("Recife", first.City)
+	assert.Equal(first, second)
+	next.AssertNumberOfCalls(t, "Lookup", 1)
+
+	*now = now.Add(DefaultCacheTTL)
+	cache.Lookup("50030230")
+	next.AssertNumberOfCalls(t, "Lookup", 2)
+}
+
+func TestCachingProvider_ShouldCacheNotFoundForShorterTime(t *testing.T) {
+	next := new(providerMock)
+	next.On("Lookup", "99999999").Return(nil, nil)
+	cache, now := newTestCache(next, 10)
+
+	cache.Lookup("99999999")
+	postalCode, err := cache.Lookup("99999999")
+
+	assert.NoError(t, err)
+	assert.Nil(t, postalCode)
+	next.AssertNumberOfCalls(t, "Lookup", 1)
+
+	*now = now.Add(DefaultNotFoundCacheTTL)
+	cache.Lookup("99999999")
+	next.AssertNumberOfCalls(t, "Lookup", 2)
+}
+
+func TestCachingProvider_ShouldNotCacheErrors(t *testing.T) {
+	next := new(providerMock)
+	next.On("Lookup", "50030230").Return(nil, errors.New("timeout"))
+	cache, _ := newTestCache(next, 10)
+
+	_, firstErr := cache.Lookup("50030230")
+	_, secondErr := cache.Lookup("50030230")
+
+	assert.Error(t, firstErr)
+	assert.Error(t, secondErr)
+	next.AssertNumberOfCalls(t, "Lookup", 2)
+}
+
+func TestCachingProvider_ShouldBoundEntries(t *testing.T) {
+	next := new(providerMock)
+	next.On("Lookup", mock.Anything).Return(nil, nil)
+	cache, _ := newTestCache(next, 2)
+
+	for _, cep := range []string{"11111111", "22222222", "33333333", "44444444"} {
+		cache.Lookup(cep)
+	}
+
+	assert.Len(t, cache.entries, 2)
+	_, cached := cache.entries["44444444"]
+	assert.True(t, cached)
+}
@@ -0,0 +1,101 @@
+package postalcode
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+	personUtils "pessoas-api/internal/domain/person/utils"
+)
+
+// offlineColumns are the columns required in the CSV of an OfflineProvider.
+var offlineColumns = []string{"cep", "street", "district", "city", "state"}
+
+// OfflineProvider answers lookups from an in-memory table of CEPs, for
+// environments without access to a remote service.
+type OfflineProvider struct {
+	postalCodes map[string]person.PostalCode
+}
+
+// NewOfflineProvider loads the CEP table from a CSV with the header
+// cep,street,district,city,state (columns in any order, extra ones ignored).
+// It returns the provider as the PostalCodeProvider interface.
+func NewOfflineProvider(source io.Reader) (ports.PostalCodeProvider, error) {
+	reader := csv.NewReader(source)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read postal code header: %w", err)
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, column := range offlineColumns {
+		if _, ok := positions[column]; !ok {
+			return nil, fmt.Errorf("postal code file is missing the %q column", column)
+		}
+	}
+
+	provider := &OfflineProvider{postalCodes: make(map[string]person.PostalCode)}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read postal codes: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		value := func(column string) string {
+			if position := positions[column]; position < len(record) {
+				return strings.TrimSpace(record[position])
+			}
+			return ""
+		}
+
+		cep := personUtils.OnlyDigits(value("cep"))
+		if !person.IsValidCEP(cep) {
+			return nil, fmt.Errorf("invalid CEP on line %d of the postal code file", line)
+		}
+
+		provider.postalCodes[cep] = person.PostalCode{
+			CEP:      cep,
+			Street:   value("street"),
+			District: value("district"),
+			City:     value("city"),
+			State:    strings.ToUpper(value("state")),
+		}
+	}
+
+	return provider, nil
+}
+
+// LoadOfflineProvider creates an OfflineProvider from the CSV file at path.
+func LoadOfflineProvider(path string) (ports.PostalCodeProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postal code file: %w", err)
+	}
+	defer file.Close()
+
+	return NewOfflineProvider(file)
+}
+
+func (p *OfflineProvider) Lookup(cep string) (*person.PostalCode, error) {
+	postalCode, found := p.postalCodes[cep]
+	if !found {
+		return nil, nil
+	}
+
+	return &postalCode, nil
+}
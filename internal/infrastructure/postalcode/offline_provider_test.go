@@ -0,0 +1,63 @@
+package postalcode
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPostalCodes = "\ufeffCEP,Street,District,City,State,IBGE\n" +
+	"50030-230,Rua da Aurora,Boa Vista,Recife,pe,2611606\n" +
+	"01310100,Avenida Paulista,Bela Vista,São Paulo,SP,3550308\n"
+
+func TestOfflineProvider_Lookup(t *testing.T) {
+	assert := assert.New(t)
+	provider, err := NewOfflineProvider(strings.NewReader(testPostalCodes))
+	assert.NoError(err)
+
+	postalCode, err := provider.Lookup("50030230")
+	assert.NoError(err)
+	assert.Equal("Rua da Aurora", postalCode.Street)
+	assert.Equal("PE", postalCode.State)
+
+	missing, err := provider.Lookup("99999999")
+	assert.NoError(err)
+	assert.Nil(missing)
+}
+
+func TestNewOfflineProvider_ShouldRejectInvalidFile(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "empty", file: ""},
+		{name: "missing column", file: "cep,street,city,state\n50030230,Rua da Aurora,Recife,PE\n"},
+		{name: "invalid CEP", file: "cep,street,district,city,state\n5003,Rua da Aurora,Boa Vista,Recife,PE\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOfflineProvider(strings.NewReader(tt.file))
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadOfflineProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ceps.csv")
+	assert.NoError(t, os.WriteFile(path, []byte(testPostalCodes), 0o600))
+
+	provider, err := LoadOfflineProvider(path)
+	assert.NoError(t, err)
+
+	postalCode, err := provider.Lookup("01310100")
+	assert.NoError(t, err)
+	assert.Equal(t, "Avenida Paulista", postalCode.Street)
+
+	_, err = LoadOfflineProvider(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package postalcode
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	person "pessoas-api/internal/domain/person/model"
+	"pessoas-api/internal/domain/person/ports"
+)
+
+// DefaultViaCEPURL is the public ViaCEP service.
+const DefaultViaCEPURL = "https://viacep.com.br"
+
+// maxResponseSize bounds the body read from the remote service.
+const maxResponseSize = 64 << 10
+
+// ViaCEPProvider looks CEPs up in a ViaCEP compatible service
+// (GET {baseURL}/ws/{cep}/json/).
+type ViaCEPProvider struct {
+	baseURL string
+	client  *http.Client
+}
+
+// viaCEPResponse is the body returned by ViaCEP. Unknown CEPs are answered
+// with 200 and "erro", which older versions send as a string.
+type viaCEPResponse struct {
+	CEP        string `json:"cep"`
+	Logradouro string `json:"logradouro"`
+	Bairro     string `json:"bairro"`
+	Localidade string `json:"localidade"`
+	UF         string `json:"uf"`
+	Erro       any    `json:"erro"`
+}
+
+// NewViaCEPProvider creates a ViaCEPProvider for the service at baseURL whose
+// requests give up after timeout.
+// It returns the provider as the PostalCodeProvider interface.
+func NewViaCEPProvider(baseURL string, timeout time.Duration) ports.PostalCodeProvider {
+	return &ViaCEPProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *ViaCEPProvider) Lookup(cep string) (*person.PostalCode, error) {
+	resp, err := p.client.Get(fmt.Sprintf("%s/ws/%s/json/", p.baseURL, cep))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ViaCEP: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// ViaCEP answers 400 for malformed CEPs, which do not exist either
+	if resp.StatusCode == http.StatusBadRequest {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ViaCEP answered with status %d", resp.StatusCode)
+	}
+
+	var body viaCEPResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode ViaCEP response: %w", err)
+	}
+
+	if body.Erro == true || body.Erro == "true" {
+		return nil, nil
+	}
+
+	return &person.PostalCode{
+		CEP:      cep,
+		Street:   body.Logradouro,
+		District: body.Bairro,
+		City:     body.Localidade,
+		State:    body.UF,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package postalcode
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newViaCEPServer(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ws/50030230/json/", r.URL.Path)
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestViaCEPProvider_Lookup_ShouldMapResponse(t *testing.T) {
+	assert := assert.New(t)
+	server := newViaCEPServer(t, http.StatusOK, `{
+		"cep": "50030-230", "logradouro": "Rua da Aurora", "complemento": "até 999/1000",
+		"bairro": "Boa Vista", "localidade": "Recife", "uf": "PE", "ibge": "2611606"
+	}`)
+
+	postalCode, err := NewViaCEPProvider(server.URL+"/", time.Second).Lookup("50030230")
+
+	assert.NoError(err)
+	assert.Equal("50030230", postalCode.CEP)
+	assert.Equal("Rua da Aurora", postalCode.Street)
+	assert.Equal("Boa Vista", postalCode.District)
+	assert.Equal("Recife", postalCode.City)
+	assert.Equal("PE", postalCode.State)
+}
+
+func TestViaCEPProvider_Lookup_ShouldReturnNilWhenNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "boolean erro", status: http.StatusOK, body: `{"erro": true}`},
+		{name: "string erro", status: http.StatusOK, body: `{"erro": "true"}`},
+		{name: "bad request", status: http.StatusBadRequest, body: `<h1>Bad Request</h1>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newViaCEPServer(t, tt.status, tt.body)
+
+			postalCode, err := NewViaCEPProvider(server.URL, time.Second).Lookup("50030230")
+
+			assert.NoError(t, err)
+			assert.Nil(t, postalCode)
+		})
+	}
+}
+
+func TestViaCEPProvider_Lookup_ShouldReturnErrorWhenServiceFails(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "server error", status: http.StatusInternalServerError, body: `oops`},
+		{name: "malformed body", status: http.StatusOK, body: `<html>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newViaCEPServer(t, tt.status, tt.body)
+
+			postalCode, err := NewViaCEPProvider(server.URL, time.Second).Lookup("50030230")
+
+			assert.Error(t, err)
+			assert.Nil(t, postalCode)
+		})
+	}
+}
+
+func TestViaCEPProvider_Lookup_ShouldTimeOut(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	_, err := NewViaCEPProvider(server.URL, 20*time.Millisecond).Lookup("50030230")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,19 @@
+-- Add encryption at rest to people.audit_log. The snapshots of an encrypted
+-- entry are stored in before_encrypted and after_encrypted under a data key of
+-- the row, kept wrapped in data_key by the key named in key_id; before_data
+-- and after_data keep only the references (id, person_id, related_person_id)
+-- used to find the entries of a person. Entries written before encryption was
+-- enabled keep the plaintext snapshots until cmd/reencrypt seals them
+ALTER TABLE people.audit_log
+    ADD COLUMN IF NOT EXISTS key_id VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS data_key TEXT,
+    ADD COLUMN IF NOT EXISTS before_encrypted TEXT,
+    ADD COLUMN IF NOT EXISTS after_encrypted TEXT;
+
+-- Finds the rows cmd/reencrypt still has to process
+CREATE INDEX IF NOT EXISTS idx_audit_log_key_id ON people.audit_log(key_id);
+
+COMMENT ON COLUMN people.audit_log.key_id IS 'ID of the key that wraps data_key; NULL for entries in plaintext';
+COMMENT ON COLUMN people.audit_log.data_key IS 'Data key of the entry (AES-256-GCM), wrapped by the key key_id';
+COMMENT ON COLUMN people.audit_log.before_encrypted IS 'Encrypted snapshot before the change; before_data then holds only its references';
+COMMENT ON COLUMN people.audit_log.after_encrypted IS 'Encrypted snapshot after the change; after_data then holds only its references';
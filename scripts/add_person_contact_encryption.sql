@@ -0,0 +1,22 @@
+-- Add encryption at rest to people.person_contact. The value of an encrypted
+-- contact is stored in value_encrypted under a data key of the row, kept
+-- wrapped in data_key by the key named in key_id, and value is left NULL.
+-- Lookups match value_index. Rows written before encryption was enabled keep
+-- the plaintext value until cmd/reencrypt (or any update) seals them
+ALTER TABLE people.person_contact
+    ADD COLUMN IF NOT EXISTS key_id VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS data_key TEXT,
+    ADD COLUMN IF NOT EXISTS value_encrypted TEXT,
+    ADD COLUMN IF NOT EXISTS value_index CHAR(64);
+
+ALTER TABLE people.person_contact
+    ALTER COLUMN value DROP NOT NULL;
+
+-- Lookup by contact value, the exact email/phone filters and duplicate detection
+CREATE INDEX IF NOT EXISTS idx_person_contact_value_index ON people.person_contact(type, value_index);
+-- Finds the rows cmd/reencrypt still has to process
+CREATE INDEX IF NOT EXISTS idx_person_contact_key_id ON people.person_contact(key_id);
+
+COMMENT ON COLUMN people.person_contact.key_id IS 'ID of the key that wraps data_key; NULL for rows in plaintext';
+COMMENT ON COLUMN people.person_contact.data_key IS 'Data key of the row (AES-256-GCM), wrapped by the key key_id';
+COMMENT ON COLUMN people.person_contact.value_index IS 'HMAC-SHA256 of the value (lowercase for emails), to look it up without decrypting';
@@ -0,0 +1,31 @@
+-- Add encryption at rest to people.person. The CPF, birth date, phone and
+-- email of encrypted rows are stored in the *_encrypted columns under a data
+-- key of the row, kept wrapped in data_key by the key named in key_id; their
+-- plaintext columns are left NULL. Rows written before encryption was enabled
+-- keep the plaintext columns until cmd/reencrypt (or any update) seals them
+ALTER TABLE people.person
+    ADD COLUMN IF NOT EXISTS key_id VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS data_key TEXT,
+    ADD COLUMN IF NOT EXISTS cpf_encrypted TEXT,
+    ADD COLUMN IF NOT EXISTS birth_date_encrypted TEXT,
+    ADD COLUMN IF NOT EXISTS phone_number_encrypted TEXT,
+    ADD COLUMN IF NOT EXISTS email_encrypted TEXT,
+    ADD COLUMN IF NOT EXISTS cpf_index CHAR(64),
+    ADD COLUMN IF NOT EXISTS birth_date_index CHAR(64);
+
+ALTER TABLE people.person
+    ALTER COLUMN cpf DROP NOT NULL,
+    ALTER COLUMN birth_date DROP NOT NULL,
+    ALTER COLUMN phone_number DROP NOT NULL,
+    ALTER COLUMN email DROP NOT NULL;
+
+-- The blind index keeps CPFs unique among active persons, like idx_person_cpf_active
+CREATE UNIQUE INDEX IF NOT EXISTS idx_person_cpf_index_active ON people.person(cpf_index) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_person_birth_date_index ON people.person(birth_date_index);
+-- Finds the rows cmd/reencrypt still has to process
+CREATE INDEX IF NOT EXISTS idx_person_key_id ON people.person(key_id);
+
+COMMENT ON COLUMN people.person.key_id IS 'ID of the key that wraps data_key; NULL for rows in plaintext';
+COMMENT ON COLUMN people.person.data_key IS 'Data key of the row (AES-256-GCM), wrapped by the key key_id';
+COMMENT ON COLUMN people.person.cpf_index IS 'HMAC-SHA256 of the CPF, to look it up without decrypting';
+COMMENT ON COLUMN people.person.birth_date_index IS 'HMAC-SHA256 of the birth date, to find duplicate candidates';
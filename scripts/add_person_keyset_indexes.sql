@@ -0,0 +1,6 @@
+-- Composite indexes for cursor (keyset) pagination: every sort column is paired with id as tie-breaker
+CREATE INDEX IF NOT EXISTS idx_person_name_id ON people.person(name, id);
+CREATE INDEX IF NOT EXISTS idx_person_cpf_id ON people.person(cpf, id);
+CREATE INDEX IF NOT EXISTS idx_person_email_id ON people.person(email, id);
+CREATE INDEX IF NOT EXISTS idx_person_created_at_id ON people.person(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_person_updated_at_id ON people.person(updated_at, id);
@@ -0,0 +1,8 @@
+-- Add duplicate merge support to people.person. A merged person is soft
+-- deleted and points to the person that took over its records
+ALTER TABLE people.person
+    ADD COLUMN IF NOT EXISTS merged_into_id INTEGER REFERENCES people.person(id);
+
+CREATE INDEX IF NOT EXISTS idx_person_merged_into ON people.person(merged_into_id) WHERE merged_into_id IS NOT NULL;
+
+COMMENT ON COLUMN people.person.merged_into_id IS 'Person this one was merged into; NULL unless merged';
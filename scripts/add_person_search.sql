@@ -0,0 +1,18 @@
+-- Search support for the person list filters
+-- name_search holds the name lowercased and without accents; the application keeps it in sync
+CREATE EXTENSION IF NOT EXISTS unaccent;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE people.person
+    ADD COLUMN IF NOT EXISTS name_search VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE people.person SET name_search = lower(unaccent(trim(name))) WHERE name_search = '';
+
+-- "contains" searches on names and prefix searches on email, phone and CPF
+CREATE INDEX IF NOT EXISTS idx_person_name_search_trgm ON people.person USING gin (name_search gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_person_email_lower ON people.person (lower(email) varchar_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_person_phone_number ON people.person (phone_number varchar_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_person_cpf_pattern ON people.person (cpf varchar_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_person_birth_date ON people.person (birth_date);
+
+COMMENT ON COLUMN people.person.name_search IS 'Name lowercased and without accents, used by the name filter';
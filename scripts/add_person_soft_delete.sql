@@ -0,0 +1,13 @@
+-- Add soft delete support to people.person
+ALTER TABLE people.person
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_person_deleted_at ON people.person(deleted_at);
+
+-- CPF must be unique only among active persons, so a deleted CPF can be registered again
+ALTER TABLE people.person DROP CONSTRAINT IF EXISTS person_cpf_key;
+ALTER TABLE people.person DROP CONSTRAINT IF EXISTS uni_person_cpf;
+DROP INDEX IF EXISTS people.idx_person_cpf;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_person_cpf_active ON people.person(cpf) WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN people.person.deleted_at IS 'Soft deletion timestamp; NULL for active persons';
@@ -0,0 +1,5 @@
+-- Add version column used for optimistic concurrency control on people.person
+ALTER TABLE people.person
+    ADD COLUMN IF NOT EXISTS version INTEGER DEFAULT 1 NOT NULL;
+
+COMMENT ON COLUMN people.person.version IS 'Incremented on every write; exposed to clients as the ETag';
@@ -0,0 +1,20 @@
+-- Phone numbers are stored in E.164 (+5581912345678) instead of digits only
+ALTER TABLE people.person ALTER COLUMN phone_number TYPE VARCHAR(16);
+
+-- Existing numbers were validated as 10 or 11 digit Brazilian numbers
+UPDATE people.person
+SET phone_number = '+55' || phone_number
+WHERE phone_number ~ '^[0-9]{10,11}$';
+
+UPDATE people.person_contact
+SET value = '+55' || value
+WHERE type = 'phone' AND value ~ '^[0-9]{10,11}$';
+
+COMMENT ON COLUMN people.person.phone_number IS 'Copy of the primary phone contact (E.164), kept for sorting and exports';
+COMMENT ON COLUMN people.person_contact.value IS 'E.164 for phones';
+
+-- Numbers accepted before the DDD check may still be invalid. The application
+-- rejects updates of these persons until the phone is fixed; list them with:
+--   SELECT id, person_id, value FROM people.person_contact
+--   WHERE type = 'phone' AND value !~ '^\+55(1[1-9]|2[12478]|3[1-578]|4[1-9]|5[1345]|6[1-9]|7[13-579]|8[1-9]|9[1-9])(9[0-9]{8}|[2-5][0-9]{7})$'
+--     AND value LIKE '+55%';
@@ -0,0 +1,21 @@
+-- Audit trail: one append-only row per change, with JSON snapshots before and after
+CREATE TABLE IF NOT EXISTS people.audit_log (
+    id SERIAL PRIMARY KEY,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id INTEGER NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    operator_id INTEGER NOT NULL,
+    request_id VARCHAR(64),
+    client_ip VARCHAR(45),
+    before_data JSONB,
+    after_data JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- No foreign key to people.person: the history must outlive purged persons
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity ON people.audit_log(entity_type, entity_id, created_at DESC);
+
+COMMENT ON TABLE people.audit_log IS 'Append-only change history of audited entities';
+COMMENT ON COLUMN people.audit_log.operator_id IS 'Operator (people.operators.id) who performed the change';
+COMMENT ON COLUMN people.audit_log.before_data IS 'Snapshot before the change; NULL on create';
+COMMENT ON COLUMN people.audit_log.after_data IS 'Snapshot after the change; NULL on purge';
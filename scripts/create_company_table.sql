@@ -0,0 +1,26 @@
+-- Legal entities, identified by their CNPJ. CNPJs may be alphanumeric (the
+-- format adopted by the Receita Federal from July 2026), so they are stored as
+-- 14 uppercase characters without mask
+CREATE TABLE IF NOT EXISTS people.company (
+    id SERIAL PRIMARY KEY,
+    cnpj VARCHAR(14) NOT NULL,
+    legal_name VARCHAR(255) NOT NULL,
+    trade_name VARCHAR(255) NOT NULL DEFAULT '',
+    founding_date DATE NOT NULL,
+    state_registration VARCHAR(14) NOT NULL DEFAULT '',
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    CONSTRAINT chk_company_cnpj_format CHECK (cnpj ~ '^[0-9A-Z]{12}[0-9]{2}$')
+);
+
+-- A CNPJ belongs to at most one active company; deleted companies keep theirs
+CREATE UNIQUE INDEX IF NOT EXISTS idx_company_cnpj_active ON people.company(cnpj) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_company_deleted_at ON people.company(deleted_at);
+
+COMMENT ON TABLE people.company IS 'Legal entities (pessoas jurídicas)';
+COMMENT ON COLUMN people.company.legal_name IS 'Razão social';
+COMMENT ON COLUMN people.company.trade_name IS 'Nome fantasia';
+COMMENT ON COLUMN people.company.state_registration IS 'Inscrição estadual, digits only, or ISENTO';
@@ -0,0 +1,24 @@
+-- Support LGPD data subject requests. Anonymized persons keep their row, with
+-- their personal data scrubbed, and every request is logged for the DPO
+ALTER TABLE people.person
+    ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMP;
+
+COMMENT ON COLUMN people.person.anonymized_at IS 'When the personal data was scrubbed; NULL unless anonymized';
+
+CREATE TABLE IF NOT EXISTS people.data_subject_request (
+    id SERIAL PRIMARY KEY,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('access', 'portability', 'anonymization')),
+    subject_cpf VARCHAR(14) NOT NULL,
+    person_ids JSONB NOT NULL DEFAULT '[]',
+    status VARCHAR(20) NOT NULL CHECK (status IN ('completed', 'not_found')),
+    operator_id INTEGER NOT NULL,
+    request_id VARCHAR(64),
+    client_ip VARCHAR(45),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_data_subject_request_created ON people.data_subject_request(created_at DESC, id DESC);
+
+COMMENT ON TABLE people.data_subject_request IS 'Log of the LGPD requests answered, for the data protection officer';
+COMMENT ON COLUMN people.data_subject_request.subject_cpf IS 'CPF of the data subject, masked as ***.444.777-**';
+COMMENT ON COLUMN people.data_subject_request.person_ids IS 'IDs of the persons covered by the request';
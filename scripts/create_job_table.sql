@@ -0,0 +1,34 @@
+-- Background jobs (imports, exports...). The table is also the work queue shared by all API replicas:
+-- workers claim jobs with SELECT ... FOR UPDATE SKIP LOCKED
+CREATE TABLE IF NOT EXISTS people.job (
+    id SERIAL PRIMARY KEY,
+    type VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    payload JSONB NOT NULL,
+    progress INTEGER NOT NULL DEFAULT 0,
+    result_ref VARCHAR(255),
+    result_name VARCHAR(255),
+    result_content_type VARCHAR(255),
+    error TEXT,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL DEFAULT 3,
+    operator_id INTEGER NOT NULL,
+    request_id VARCHAR(64),
+    client_ip VARCHAR(45),
+    run_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    heartbeat_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    CONSTRAINT chk_job_status CHECK (status IN ('queued', 'running', 'succeeded', 'failed', 'cancelled')),
+    CONSTRAINT chk_job_progress CHECK (progress BETWEEN 0 AND 100)
+);
+
+-- Workers only ever look for active jobs
+CREATE INDEX IF NOT EXISTS idx_job_queue ON people.job(run_at, id) WHERE status IN ('queued', 'running');
+
+COMMENT ON TABLE people.job IS 'Background jobs and their work queue';
+COMMENT ON COLUMN people.job.attempts IS 'Times the job was claimed by a worker; also fences out workers whose claim expired';
+COMMENT ON COLUMN people.job.heartbeat_at IS 'Last sign of life of the worker running the job; a stale heartbeat lets another worker take over';
+COMMENT ON COLUMN people.job.result_ref IS 'Reference of the result file in the artifact store';
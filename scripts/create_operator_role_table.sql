@@ -0,0 +1,22 @@
+-- Create operator_role table for role-based access control
+CREATE TABLE IF NOT EXISTS operator_role (
+    operator_id INTEGER NOT NULL REFERENCES operators(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('viewer', 'editor', 'auditor', 'admin')),
+    PRIMARY KEY (operator_id, role)
+);
+
+-- Create index to count the operators holding a role
+CREATE INDEX IF NOT EXISTS idx_operator_role_role ON operator_role(role);
+
+-- Existing operators keep read access
+INSERT INTO operator_role (operator_id, role)
+SELECT id, 'viewer' FROM operators
+ON CONFLICT DO NOTHING;
+
+-- No operator is promoted here: anyone can register through /auth/register.
+-- The first admin is a new operator created at startup from ADMIN_USERNAME,
+-- ADMIN_EMAIL and ADMIN_PASSWORD, only while there is no active admin
+
+-- Add comments for documentation
+COMMENT ON TABLE operator_role IS 'Roles held by each operator; the permissions of a role are defined by the application';
+COMMENT ON COLUMN operator_role.role IS 'Role: viewer, editor, auditor or admin';
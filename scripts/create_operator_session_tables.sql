@@ -0,0 +1,34 @@
+-- Create operator_refresh_token table for operator sessions
+CREATE TABLE IF NOT EXISTS operator_refresh_token (
+    id SERIAL PRIMARY KEY,
+    operator_id INTEGER NOT NULL REFERENCES operators(id) ON DELETE CASCADE,
+    session_id VARCHAR(32) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    access_token_id VARCHAR(32) NOT NULL,
+    access_token_expires_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP
+);
+
+-- Create revoked_access_token table, checked on every authenticated request
+CREATE TABLE IF NOT EXISTS revoked_access_token (
+    token_id VARCHAR(32) PRIMARY KEY,
+    operator_id INTEGER NOT NULL REFERENCES operators(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NOT NULL
+);
+
+-- Create indexes to revoke the sessions of an operator and to purge expired rows
+CREATE INDEX IF NOT EXISTS idx_operator_refresh_token_operator_id ON operator_refresh_token(operator_id);
+CREATE INDEX IF NOT EXISTS idx_operator_refresh_token_session_id ON operator_refresh_token(session_id);
+CREATE INDEX IF NOT EXISTS idx_operator_refresh_token_expires_at ON operator_refresh_token(expires_at);
+CREATE INDEX IF NOT EXISTS idx_revoked_access_token_expires_at ON revoked_access_token(expires_at);
+
+-- Add comments for documentation
+COMMENT ON TABLE operator_refresh_token IS 'Refresh tokens issued to operators; each refresh revokes the token used and issues the next one of the session';
+COMMENT ON COLUMN operator_refresh_token.session_id IS 'Shared by the tokens issued from one login';
+COMMENT ON COLUMN operator_refresh_token.token_hash IS 'SHA-256 hash of the refresh token; the token itself is never stored';
+COMMENT ON COLUMN operator_refresh_token.access_token_id IS 'ID (jti) of the access token issued with this refresh token';
+COMMENT ON COLUMN operator_refresh_token.revoked_at IS 'When the token was used, or its session revoked; reusing it revokes the session';
+COMMENT ON TABLE revoked_access_token IS 'Access tokens revoked before expiring, on logout or deactivation of the operator';
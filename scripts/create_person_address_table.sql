@@ -0,0 +1,29 @@
+-- Postal addresses of a person (residential, billing, work). A person may have
+-- several addresses; exactly one of them is the primary address
+CREATE TABLE IF NOT EXISTS people.person_address (
+    id SERIAL PRIMARY KEY,
+    person_id INTEGER NOT NULL REFERENCES people.person(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    cep VARCHAR(16) NOT NULL,
+    street VARCHAR(255) NOT NULL,
+    number VARCHAR(20) NOT NULL,
+    complement VARCHAR(255) NOT NULL DEFAULT '',
+    district VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(255) NOT NULL,
+    state VARCHAR(50) NOT NULL,
+    country CHAR(2) NOT NULL DEFAULT 'BR',
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_person_address_type CHECK (type IN ('residential', 'billing', 'work'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_person_address_person ON people.person_address(person_id);
+
+-- At most one primary address per person
+CREATE UNIQUE INDEX IF NOT EXISTS idx_person_address_primary ON people.person_address(person_id) WHERE is_primary;
+
+COMMENT ON TABLE people.person_address IS 'Postal addresses of persons';
+COMMENT ON COLUMN people.person_address.cep IS 'Postal code; digits only (CEP) for Brazilian addresses';
+COMMENT ON COLUMN people.person_address.state IS 'UF for Brazilian addresses';
+COMMENT ON COLUMN people.person_address.country IS 'ISO 3166-1 alpha-2 country code';
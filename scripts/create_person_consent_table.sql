@@ -0,0 +1,74 @@
+-- Consents of a person to the processing of their data for a purpose (LGPD).
+-- person_consent holds the current state, one row per person and purpose;
+-- person_consent_event is the append-only history of grants and revocations
+CREATE TABLE IF NOT EXISTS people.person_consent (
+    id SERIAL PRIMARY KEY,
+    person_id INTEGER NOT NULL REFERENCES people.person(id) ON DELETE CASCADE,
+    purpose VARCHAR(20) NOT NULL,
+    legal_basis VARCHAR(30) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    evidence_ref VARCHAR(255) NOT NULL DEFAULT '',
+    granted_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_person_consent_purpose CHECK (purpose IN ('marketing', 'analytics', 'data_sharing')),
+    CONSTRAINT chk_person_consent_legal_basis CHECK (legal_basis IN ('consent', 'contract', 'legal_obligation', 'legitimate_interest')),
+    CONSTRAINT chk_person_consent_channel CHECK (channel IN ('web', 'mobile_app', 'call_center', 'in_person', 'email', 'paper')),
+    CONSTRAINT chk_person_consent_evidence CHECK (legal_basis <> 'consent' OR evidence_ref <> '')
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_person_consent_purpose ON people.person_consent(person_id, purpose);
+
+-- "Has an active consent for purpose X" filter of the person list
+CREATE INDEX IF NOT EXISTS idx_person_consent_active ON people.person_consent(purpose, person_id) WHERE revoked_at IS NULL;
+
+COMMENT ON TABLE people.person_consent IS 'Current consent of each person for each processing purpose';
+COMMENT ON COLUMN people.person_consent.evidence_ref IS 'Reference to the proof of the last grant, such as a signed form or a recorded call';
+COMMENT ON COLUMN people.person_consent.revoked_at IS 'NULL while the consent is active';
+
+-- No foreign key to the person: the history outlives purged persons
+CREATE TABLE IF NOT EXISTS people.person_consent_event (
+    id SERIAL PRIMARY KEY,
+    consent_id INTEGER NOT NULL,
+    person_id INTEGER NOT NULL,
+    purpose VARCHAR(20) NOT NULL,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('grant', 'revoke', 'merge')),
+    legal_basis VARCHAR(30) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    evidence_ref VARCHAR(255) NOT NULL DEFAULT '',
+    operator_id INTEGER NOT NULL,
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    client_ip VARCHAR(45) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_person_consent_event_person ON people.person_consent_event(person_id, occurred_at DESC, id DESC);
+
+-- The history is append-only. The only change allowed is the anonymization of
+-- a person, which clears the evidence references of their events
+CREATE OR REPLACE FUNCTION people.reject_consent_event_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND NEW.evidence_ref = ''
+        AND (NEW.id, NEW.consent_id, NEW.person_id, NEW.purpose, NEW.action, NEW.legal_basis, NEW.channel,
+             NEW.operator_id, NEW.request_id, NEW.client_ip, NEW.occurred_at)
+            IS NOT DISTINCT FROM
+            (OLD.id, OLD.consent_id, OLD.person_id, OLD.purpose, OLD.action, OLD.legal_basis, OLD.channel,
+             OLD.operator_id, OLD.request_id, OLD.client_ip, OLD.occurred_at)
+        AND EXISTS (SELECT 1 FROM people.person WHERE id = OLD.person_id AND anonymized_at IS NOT NULL)
+    THEN
+        RETURN NEW;
+    END IF;
+
+    RAISE EXCEPTION 'person_consent_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_person_consent_event_append_only ON people.person_consent_event;
+CREATE TRIGGER trg_person_consent_event_append_only
+    BEFORE UPDATE OR DELETE ON people.person_consent_event
+    FOR EACH ROW EXECUTE FUNCTION people.reject_consent_event_change();
+
+COMMENT ON TABLE people.person_consent_event IS 'Append-only history of consent grants and revocations; anonymization only clears evidence_ref';
+COMMENT ON COLUMN people.person_consent_event.action IS 'merge: the consent was taken over from a duplicate merged into the person';
+COMMENT ON COLUMN people.person_consent_event.channel IS 'Channel of the grant or of the revocation';
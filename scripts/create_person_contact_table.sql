@@ -0,0 +1,38 @@
+-- Phone numbers and email addresses of a person. A person may have several
+-- contacts of each type; exactly one phone and one email are primary
+CREATE TABLE IF NOT EXISTS people.person_contact (
+    id SERIAL PRIMARY KEY,
+    person_id INTEGER NOT NULL REFERENCES people.person(id) ON DELETE CASCADE,
+    type VARCHAR(10) NOT NULL,
+    value VARCHAR(255) NOT NULL,
+    label VARCHAR(50) NOT NULL DEFAULT '',
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    verified BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_person_contact_type CHECK (type IN ('phone', 'email'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_person_contact_person ON people.person_contact(person_id);
+
+-- At most one primary contact of each type per person
+CREATE UNIQUE INDEX IF NOT EXISTS idx_person_contact_primary ON people.person_contact(person_id, type) WHERE is_primary;
+
+-- Lookup by contact value and the email/phone filters (exact and prefix)
+CREATE INDEX IF NOT EXISTS idx_person_contact_value ON people.person_contact(type, LOWER(value) varchar_pattern_ops);
+
+-- The existing phone numbers and emails become the primary contacts
+INSERT INTO people.person_contact (person_id, type, value, is_primary, created_at, updated_at)
+SELECT p.id, 'phone', p.phone_number, TRUE, p.created_at, p.updated_at
+FROM people.person p
+WHERE NOT EXISTS (SELECT 1 FROM people.person_contact c WHERE c.person_id = p.id AND c.type = 'phone');
+
+INSERT INTO people.person_contact (person_id, type, value, is_primary, created_at, updated_at)
+SELECT p.id, 'email', p.email, TRUE, p.created_at, p.updated_at
+FROM people.person p
+WHERE NOT EXISTS (SELECT 1 FROM people.person_contact c WHERE c.person_id = p.id AND c.type = 'email');
+
+COMMENT ON TABLE people.person_contact IS 'Phone numbers and email addresses of persons';
+COMMENT ON COLUMN people.person_contact.value IS 'Digits only for phones';
+COMMENT ON COLUMN people.person.phone_number IS 'Copy of the primary phone contact, kept for sorting and exports';
+COMMENT ON COLUMN people.person.email IS 'Copy of the primary email contact, kept for sorting and exports';
@@ -0,0 +1,34 @@
+-- Identity documents of a person besides the CPF: RG, CNH, passport,
+-- voter ID (título de eleitor) and PIS/NIS
+CREATE TABLE IF NOT EXISTS people.person_document (
+    id SERIAL PRIMARY KEY,
+    person_id INTEGER NOT NULL REFERENCES people.person(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    number VARCHAR(20) NOT NULL,
+    issuing_state CHAR(2) NOT NULL DEFAULT '',
+    issuing_agency VARCHAR(20) NOT NULL DEFAULT '',
+    country CHAR(2) NOT NULL DEFAULT '',
+    issue_date DATE,
+    expiry_date DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_person_document_type CHECK (type IN ('rg', 'cnh', 'passport', 'voter_id', 'pis')),
+    CONSTRAINT chk_person_document_dates CHECK (expiry_date IS NULL OR issue_date IS NULL OR expiry_date > issue_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_person_document_person ON people.person_document(person_id);
+
+-- A number is registered once per type and issuer. The issuing state only
+-- applies to RGs and the country to passports; both are blank otherwise
+CREATE UNIQUE INDEX IF NOT EXISTS idx_person_document_number ON people.person_document(type, number, issuing_state, country);
+
+-- Lookup by number across types
+CREATE INDEX IF NOT EXISTS idx_person_document_number_any ON people.person_document(number);
+
+-- Expiring soon query
+CREATE INDEX IF NOT EXISTS idx_person_document_expiry ON people.person_document(expiry_date) WHERE expiry_date IS NOT NULL;
+
+COMMENT ON TABLE people.person_document IS 'Identity documents of persons (RG, CNH, passport, voter ID, PIS/NIS)';
+COMMENT ON COLUMN people.person_document.number IS 'Uppercase letters and digits, without mask';
+COMMENT ON COLUMN people.person_document.issuing_state IS 'UF of the issuer, RG only';
+COMMENT ON COLUMN people.person_document.country IS 'ISO 3166-1 alpha-2 code of the issuer, passport only';
@@ -0,0 +1,23 @@
+-- Links between persons: family members, guardians, legal representatives and
+-- emergency contacts. Each link is stored once, in canonical form: the related
+-- person is the type of the person (related_person_id is a parent of
+-- person_id). Child, ward, represented and emergency_contact_for are the
+-- inverse types, derived when the link is read from the other end
+CREATE TABLE IF NOT EXISTS people.person_relationship (
+    id SERIAL PRIMARY KEY,
+    person_id INTEGER NOT NULL REFERENCES people.person(id) ON DELETE CASCADE,
+    related_person_id INTEGER NOT NULL REFERENCES people.person(id) ON DELETE CASCADE,
+    type VARCHAR(30) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_person_relationship_type CHECK (type IN ('parent', 'spouse', 'guardian', 'legal_representative', 'emergency_contact')),
+    CONSTRAINT chk_person_relationship_self CHECK (person_id <> related_person_id),
+    CONSTRAINT chk_person_relationship_spouse CHECK (type <> 'spouse' OR person_id < related_person_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_person_relationship_link ON people.person_relationship(person_id, related_person_id, type);
+
+-- Lookup from the related end
+CREATE INDEX IF NOT EXISTS idx_person_relationship_related ON people.person_relationship(related_person_id);
+
+COMMENT ON TABLE people.person_relationship IS 'Relationships between persons, stored once per link';
+COMMENT ON COLUMN people.person_relationship.type IS 'What related_person_id is to person_id';